@@ -0,0 +1,100 @@
+package cmd
+
+import (
+	"fmt"
+
+	relaychain "github.com/keep-network/keep-core/pkg/beacon/relay/chain"
+	dkgResult "github.com/keep-network/keep-core/pkg/beacon/relay/dkg/result"
+	"github.com/keep-network/keep-core/pkg/chain/ethereum"
+	"github.com/urfave/cli"
+)
+
+// SubmissionCalldataCommand contains the definition of the submission-calldata
+// command-line subcommand.
+var SubmissionCalldataCommand cli.Command
+
+const submissionCalldataDescription = `The submission-calldata command prints
+	the hex-encoded calldata of the operator contract call submitting a DKG
+	result with the collected signatures in the name of the given member. The
+	calldata is not sent; it can be submitted to the operator contract with any
+	tooling. The request ID is not part of the calldata as the operator
+	contract determines it from its own state.
+
+	The result and signatures files have the same format as the ones accepted
+	by the verify-signatures command. Signatures are not verified; use the
+	verify-signatures command to make sure they meet the signature threshold.`
+
+const memberIndexFlag = "member"
+
+func init() {
+	SubmissionCalldataCommand = cli.Command{
+		Name:        "submission-calldata",
+		Usage:       "Prints calldata submitting a DKG result without sending it.",
+		Description: submissionCalldataDescription,
+		Action:      submissionCalldata,
+		Flags: []cli.Flag{
+			&cli.StringFlag{
+				Name:  resultFileFlag,
+				Usage: "path to the DKG result file",
+			},
+			&cli.StringFlag{
+				Name:  signaturesFileFlag,
+				Usage: "path to the collected signatures file",
+			},
+			&cli.UintFlag{
+				Name:  memberIndexFlag,
+				Usage: "index of the member submitting the result",
+			},
+		},
+	}
+}
+
+// submissionCalldata prints the calldata submitting the DKG result from the
+// result file with signatures from the signatures file.
+func submissionCalldata(c *cli.Context) error {
+	resultPath := c.String(resultFileFlag)
+	signaturesPath := c.String(signaturesFileFlag)
+	memberIndex := c.Uint(memberIndexFlag)
+	if resultPath == "" || signaturesPath == "" || memberIndex == 0 {
+		return fmt.Errorf(
+			"[%v], [%v] and [%v] are required",
+			resultFileFlag,
+			signaturesFileFlag,
+			memberIndexFlag,
+		)
+	}
+
+	result, err := dkgResult.ReadResultFile(resultPath)
+	if err != nil {
+		return err
+	}
+
+	memberSignatures, err := dkgResult.ReadSignaturesFile(signaturesPath)
+	if err != nil {
+		return err
+	}
+
+	signatures := make(map[relaychain.GroupMemberIndex][]byte)
+	for _, memberSignature := range memberSignatures {
+		if _, ok := signatures[memberSignature.MemberIndex]; ok {
+			return fmt.Errorf(
+				"multiple signatures of member [%v]",
+				memberSignature.MemberIndex,
+			)
+		}
+		signatures[memberSignature.MemberIndex] = memberSignature.Signature
+	}
+
+	calldata, err := ethereum.DKGResultSubmissionCalldata(
+		relaychain.GroupMemberIndex(memberIndex),
+		result,
+		signatures,
+	)
+	if err != nil {
+		return fmt.Errorf("could not encode calldata: [%v]", err)
+	}
+
+	fmt.Printf("0x%x\n", calldata)
+
+	return nil
+}
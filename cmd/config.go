@@ -0,0 +1,66 @@
+package cmd
+
+import (
+	"fmt"
+	"os"
+
+	"github.com/keep-network/keep-core/config"
+	"github.com/urfave/cli"
+)
+
+// ConfigCommand contains the definition of the config command-line subcommand
+// and its own subcommands.
+var ConfigCommand cli.Command
+
+const configDescription = `The config command allows inspecting the client
+	configuration. The "dump" subcommand prints the effective configuration
+	resolved from the config file, environment variables and command-line
+	overrides, with secrets such as passwords redacted, so that operators can
+	verify which values are actually in effect.`
+
+const formatFlag = "format"
+
+func init() {
+	ConfigCommand = cli.Command{
+		Name:        "config",
+		Usage:       `Provides access to the client configuration.`,
+		Description: configDescription,
+		Subcommands: []cli.Command{
+			{
+				Name:   "dump",
+				Usage:  "Prints the effective configuration.",
+				Action: configDump,
+				Flags: []cli.Flag{
+					&cli.StringFlag{
+						Name:  formatFlag,
+						Value: config.TOMLFormat,
+						Usage: "output format, either toml or json",
+					},
+					&cli.IntFlag{
+						Name:  portFlag + "," + portShort,
+						Usage: "overrides the port as the start command does",
+					},
+					&cli.BoolFlag{
+						Name:  observerFlag,
+						Usage: "enables observer mode as the start command does",
+					},
+				},
+			},
+		},
+	}
+}
+
+// configDump prints the effective configuration with secrets redacted.
+func configDump(c *cli.Context) error {
+	effectiveConfig, err := config.ReadConfig(
+		c.GlobalString("config"),
+		c.GlobalString("password-file"),
+	)
+	if err != nil {
+		return fmt.Errorf("error reading config file: %v", err)
+	}
+
+	applyConfigOverrides(c, effectiveConfig)
+
+	return config.Dump(effectiveConfig, c.String(formatFlag), os.Stdout)
+}
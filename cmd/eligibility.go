@@ -0,0 +1,162 @@
+package cmd
+
+import (
+	"fmt"
+	"io/ioutil"
+	"math/big"
+	"os"
+	"text/tabwriter"
+	"time"
+
+	dkgResult "github.com/keep-network/keep-core/pkg/beacon/relay/dkg/result"
+	"github.com/keep-network/keep-core/pkg/beacon/relay/group"
+	"github.com/urfave/cli"
+)
+
+// ReplayEligibilityCommand contains the definition of the replay-eligibility
+// command-line subcommand.
+var ReplayEligibilityCommand cli.Command
+
+const replayEligibilityDescription = `The replay-eligibility command replays
+	the DKG result submission eligibility logic against a recorded block
+	timeline, without connecting to the network or the chain. For each
+	observed block, it prints members which became eligible to submit the
+	result and reports which active member would have published it first.
+	Useful for post-mortem analysis of a real DKG result submission.
+
+	The timeline file is a JSON array of block observations ordered by block
+	height, e.g.:
+
+	[{"time": "2020-06-01T10:00:00Z", "blockHeight": 100}, ...]`
+
+const (
+	startBlockFlag   = "start-block"
+	blockStepFlag    = "block-step"
+	minBlockStepFlag = "min-block-step"
+	rotationSeedFlag = "rotation-seed"
+	activeMemberFlag = "active-member"
+)
+
+func init() {
+	ReplayEligibilityCommand = cli.Command{
+		Name:        "replay-eligibility",
+		Usage:       "Replays DKG result submission eligibility against a block timeline.",
+		ArgsUsage:   "<timeline-file>",
+		Description: replayEligibilityDescription,
+		Action:      replayEligibility,
+		Flags: []cli.Flag{
+			&cli.IntFlag{
+				Name:  groupSizeFlag,
+				Usage: "size of the group",
+			},
+			&cli.Uint64Flag{
+				Name:  startBlockFlag,
+				Usage: "block height at which the result submission started",
+			},
+			&cli.Uint64Flag{
+				Name:  blockStepFlag,
+				Usage: "chain's result publication block step",
+			},
+			&cli.Uint64Flag{
+				Name:  minBlockStepFlag,
+				Usage: "minimum result publication block step of members",
+			},
+			&cli.StringFlag{
+				Name:  rotationSeedFlag,
+				Usage: "request ID the submission order was rotated for",
+			},
+			&cli.IntSliceFlag{
+				Name:  activeMemberFlag,
+				Usage: "index of a member able to publish; may be repeated",
+			},
+		},
+	}
+}
+
+// replayEligibility replays the submission eligibility against the block
+// timeline from the file given as the command argument and prints the
+// eligibility steps along with the publisher.
+func replayEligibility(c *cli.Context) error {
+	if c.NArg() != 1 {
+		return fmt.Errorf("timeline file is required")
+	}
+
+	groupSize := c.Int(groupSizeFlag)
+	blockStep := c.Uint64(blockStepFlag)
+	if groupSize == 0 || blockStep == 0 {
+		return fmt.Errorf(
+			"[%v] and [%v] are required",
+			groupSizeFlag,
+			blockStepFlag,
+		)
+	}
+
+	content, err := ioutil.ReadFile(c.Args().First())
+	if err != nil {
+		return fmt.Errorf("error reading timeline file: [%v]", err)
+	}
+
+	timeline, err := dkgResult.ParseBlockTimeline(content)
+	if err != nil {
+		return err
+	}
+
+	var rotationSeed *big.Int
+	if seed := c.String(rotationSeedFlag); seed != "" {
+		var ok bool
+		rotationSeed, ok = new(big.Int).SetString(seed, 0)
+		if !ok {
+			return fmt.Errorf("invalid rotation seed [%v]", seed)
+		}
+	}
+
+	activeMembers := make([]group.MemberIndex, 0)
+	for _, memberIndex := range c.IntSlice(activeMemberFlag) {
+		activeMembers = append(activeMembers, group.MemberIndex(memberIndex))
+	}
+
+	replay, err := dkgResult.ReplayEligibility(
+		&dkgResult.EligibilityReplayConfig{
+			GroupSize:        groupSize,
+			StartBlockHeight: c.Uint64(startBlockFlag),
+			BlockStep:        blockStep,
+			MinBlockStep:     c.Uint64(minBlockStepFlag),
+			RotationSeed:     rotationSeed,
+			ActiveMembers:    activeMembers,
+		},
+		timeline,
+	)
+	if err != nil {
+		return fmt.Errorf("error replaying eligibility: [%v]", err)
+	}
+
+	writer := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+	fmt.Fprintln(writer, "TIME\tBLOCK\tNEWLY ELIGIBLE")
+	for _, step := range replay.Steps {
+		fmt.Fprintf(
+			writer,
+			"%v\t%v\t%v\n",
+			step.Observation.Time.Format(time.RFC3339),
+			step.Observation.BlockHeight,
+			step.NewlyEligible,
+		)
+	}
+	if err := writer.Flush(); err != nil {
+		return err
+	}
+
+	fmt.Printf("\npublication deadline: [%v]\n", replay.DeadlineBlockHeight)
+	if replay.PublishedAt == nil {
+		fmt.Println("no active member eligible to publish before the deadline")
+		return nil
+	}
+
+	fmt.Printf(
+		"publisher: member [%v] eligible at block [%v] observed at [%v]\n",
+		replay.Publisher,
+		replay.EligibleBlockHeights[replay.Publisher],
+		replay.PublishedAt.Time.Format(time.RFC3339),
+	)
+
+	return nil
+}
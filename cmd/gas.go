@@ -0,0 +1,106 @@
+package cmd
+
+import (
+	"fmt"
+	"os"
+	"text/tabwriter"
+
+	"github.com/keep-network/keep-core/config"
+	dkgResult "github.com/keep-network/keep-core/pkg/beacon/relay/dkg/result"
+	"github.com/keep-network/keep-core/pkg/chain/ethereum"
+	"github.com/urfave/cli"
+)
+
+// EstimateGasTableCommand contains the definition of the estimate-gas-table
+// command-line subcommand.
+var EstimateGasTableCommand cli.Command
+
+const estimateGasTableDescription = `The estimate-gas-table command estimates
+	the gas cost of a DKG result submission for all combinations of the given
+	group sizes and honest thresholds and prints them as a table. The number
+	of signatures in the submission grows with the threshold. Estimates are
+	obtained from the configured Ethereum node, so the operator contract may
+	reject the placeholder signatures used for the estimation; such rows report
+	the error instead of the gas cost.`
+
+const (
+	groupSizeFlag       = "group-size"
+	honestThresholdFlag = "honest-threshold"
+)
+
+func init() {
+	EstimateGasTableCommand = cli.Command{
+		Name:        "estimate-gas-table",
+		Usage:       "Estimates DKG result submission gas cost across group sizes.",
+		Description: estimateGasTableDescription,
+		Action:      estimateGasTable,
+		Flags: []cli.Flag{
+			&cli.IntSliceFlag{
+				Name:  groupSizeFlag,
+				Usage: "group size to estimate for; may be repeated",
+			},
+			&cli.IntSliceFlag{
+				Name:  honestThresholdFlag,
+				Usage: "honest threshold to estimate for; may be repeated",
+			},
+		},
+	}
+}
+
+// estimateGasTable prints the estimated DKG result submission gas cost for
+// the requested group sizes and thresholds.
+func estimateGasTable(c *cli.Context) error {
+	groupSizes := c.IntSlice(groupSizeFlag)
+	honestThresholds := c.IntSlice(honestThresholdFlag)
+	if len(groupSizes) == 0 || len(honestThresholds) == 0 {
+		return fmt.Errorf(
+			"at least one [%v] and one [%v] is required",
+			groupSizeFlag,
+			honestThresholdFlag,
+		)
+	}
+
+	cfg, err := config.ReadConfig(
+		c.GlobalString("config"),
+		c.GlobalString("password-file"),
+	)
+	if err != nil {
+		return fmt.Errorf("error reading config file: [%v]", err)
+	}
+
+	chainHandle, err := ethereum.Connect(cfg.Ethereum)
+	if err != nil {
+		return fmt.Errorf("error connecting to Ethereum node: [%v]", err)
+	}
+
+	estimator, ok := chainHandle.ThresholdRelay().(dkgResult.SubmissionGasEstimator)
+	if !ok {
+		return fmt.Errorf("chain does not support gas estimation")
+	}
+
+	estimates := dkgResult.EstimateSubmissionGasTable(
+		estimator,
+		groupSizes,
+		honestThresholds,
+	)
+
+	writer := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+	fmt.Fprintln(writer, "GROUP SIZE\tHONEST THRESHOLD\tSIGNATURES\tGAS")
+	for _, estimate := range estimates {
+		gas := fmt.Sprintf("%v", estimate.Gas)
+		if estimate.Err != nil {
+			gas = fmt.Sprintf("error: [%v]", estimate.Err)
+		}
+
+		fmt.Fprintf(
+			writer,
+			"%v\t%v\t%v\t%v\n",
+			estimate.GroupSize,
+			estimate.HonestThreshold,
+			estimate.Signatures,
+			gas,
+		)
+	}
+
+	return writer.Flush()
+}
@@ -0,0 +1,67 @@
+package cmd
+
+import (
+	"fmt"
+	"sort"
+
+	"github.com/keep-network/keep-core/pkg/operator"
+	"github.com/urfave/cli"
+)
+
+// KeyFileCommand contains the definition of the keyfile command-line
+// subcommand and its own subcommands.
+var KeyFileCommand cli.Command
+
+const keyFileDescription = `The keyfile command allows inspecting operator key
+	files. The "info" subcommand prints the metadata of an encrypted key file,
+	such as the address, the key derivation function with its parameters and
+	the cipher, without decrypting the key file, so that operators can audit
+	the security parameters of their key files without providing passwords.`
+
+func init() {
+	KeyFileCommand = cli.Command{
+		Name:        "keyfile",
+		Usage:       `Provides access to operator key files.`,
+		Description: keyFileDescription,
+		Subcommands: []cli.Command{
+			{
+				Name:      "info",
+				Usage:     "Prints the metadata of an encrypted key file.",
+				ArgsUsage: "<path>",
+				Action:    keyFileInfo,
+			},
+		},
+	}
+}
+
+// keyFileInfo prints the metadata of the key file at the path given as the
+// only argument.
+func keyFileInfo(c *cli.Context) error {
+	if c.NArg() != 1 {
+		return fmt.Errorf("expected key file path as the only argument")
+	}
+
+	info, err := operator.ReadKeyFileInfo(c.Args().First())
+	if err != nil {
+		return err
+	}
+
+	fmt.Printf("address: %v\n", info.Address)
+	fmt.Printf("id:      %v\n", info.ID)
+	fmt.Printf("version: %v\n", info.Version)
+	fmt.Printf("cipher:  %v\n", info.Cipher)
+	fmt.Printf("kdf:     %v\n", info.KDF)
+
+	params := make([]string, 0, len(info.KDFParams))
+	for param := range info.KDFParams {
+		params = append(params, param)
+	}
+	sort.Strings(params)
+
+	fmt.Printf("kdf params:\n")
+	for _, param := range params {
+		fmt.Printf("  %v: %v\n", param, info.KDFParams[param])
+	}
+
+	return nil
+}
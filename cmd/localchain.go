@@ -0,0 +1,105 @@
+package cmd
+
+import (
+	"context"
+	"crypto/rand"
+	"fmt"
+	"math/big"
+
+	"github.com/keep-network/keep-core/config"
+	"github.com/keep-network/keep-core/pkg/chain/local"
+	"github.com/keep-network/keep-core/pkg/operator"
+)
+
+// The local chain groups are formed from the virtual stakers of the node's
+// operator. The local stake monitor stakes five times the minimum stake for
+// the operator, so the operator has exactly as many virtual stakers as there
+// are members in a group.
+const (
+	localGroupSize       = 5
+	localHonestThreshold = 3
+)
+
+var localMinimumStake = big.NewInt(1000)
+
+// connectLocalChain creates the chain simulated in the process and stakes
+// tokens for the operator so that the node can run the beacon end-to-end
+// without an Ethereum node.
+func connectLocalChain(
+	config *config.Config,
+	operatorPrivateKey *operator.PrivateKey,
+) (local.Chain, error) {
+	localChain := local.ConnectWithKey(
+		localGroupSize,
+		localHonestThreshold,
+		localMinimumStake,
+		operatorPrivateKey,
+	)
+
+	stakeMonitor, err := localChain.StakeMonitor()
+	if err != nil {
+		return nil, err
+	}
+
+	localStakeMonitor, ok := stakeMonitor.(*local.StakeMonitor)
+	if !ok {
+		return nil, fmt.Errorf("unexpected local stake monitor type")
+	}
+
+	err = localStakeMonitor.StakeTokens(config.Ethereum.Account.Address)
+	if err != nil {
+		return nil, fmt.Errorf("could not stake local chain tokens: [%v]", err)
+	}
+
+	logger.Warningf(
+		"running against the local chain simulated in the process; " +
+			"FOR DEVELOPMENT ONLY",
+	)
+
+	return localChain, nil
+}
+
+// driveLocalChain plays the role of the contracts and their users on the
+// local chain. It starts a group selection and then requests a new relay
+// entry every configured number of blocks.
+func driveLocalChain(
+	ctx context.Context,
+	localChain local.Chain,
+	localConfig config.LocalChain,
+) error {
+	interval := localConfig.RelayRequestIntervalBlocks
+	if interval == 0 {
+		interval = config.DefaultLocalRelayRequestInterval
+	}
+
+	blockCounter, err := localChain.BlockCounter()
+	if err != nil {
+		return err
+	}
+
+	seed, err := rand.Int(rand.Reader, new(big.Int).Lsh(big.NewInt(1), 256))
+	if err != nil {
+		return fmt.Errorf("could not generate group selection seed: [%v]", err)
+	}
+
+	localChain.StartGroupSelection(seed)
+
+	go func() {
+		blocks := blockCounter.WatchBlocks(ctx)
+		for block := range blocks {
+			if block%interval != 0 {
+				continue
+			}
+
+			if err := localChain.RequestRelayEntry(); err != nil {
+				logger.Infof(
+					"not requesting relay entry at block [%v]: [%v]",
+					block,
+					err,
+				)
+			}
+		}
+	}()
+
+	return nil
+}
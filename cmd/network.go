@@ -14,6 +14,7 @@ import (
 	"github.com/ethereum/go-ethereum/accounts/keystore"
 	"github.com/ethereum/go-ethereum/crypto"
 	"github.com/ethereum/go-ethereum/crypto/secp256k1"
+	"github.com/keep-network/keep-core/config"
 	"github.com/keep-network/keep-core/pkg/firewall"
 	"github.com/keep-network/keep-core/pkg/net"
 	"github.com/keep-network/keep-core/pkg/net/key"
@@ -36,7 +37,26 @@ const (
 const pingDescription = `The ping command conducts a simple peer-to-peer test
    between a bootstrap node and another peer: can known peers communicate over
    a peer-to-peer network. Both peers send a "PING" and expect to receive a
-   corresponding "PONG". Notably, this does not exercise peer discovery.`
+   corresponding "PONG". Notably, this does not exercise peer discovery.
+
+   The "diagnose" subcommand checks the connectivity of the configured client
+   to the network instead.`
+
+const pingDiagnoseDescription = `The diagnose subcommand checks the network
+   connectivity of the client before it is started. It dials each bootstrap
+   peer from the config file with the operator's network key, measures the
+   round-trip latency over the libp2p ping protocol and checks whether the
+   client is publicly dialable: whether the bootstrap peers see it at a public
+   address it announces, or it is behind NAT. Only addresses are compared, so
+   the announced port still has to accept incoming connections. The outcome
+   of each check is reported and the command fails if any of the checks
+   fails. The client must not be running on the same port.`
+
+const pingCountFlag = "count"
+
+// defaultPingCount is the number of pings sent to each bootstrap peer by
+// the diagnose subcommand if not set with a flag.
+const defaultPingCount = 3
 
 func init() {
 	PingCommand =
@@ -46,6 +66,21 @@ func init() {
 			ArgsUsage:   "[multiaddr]",
 			Description: pingDescription,
 			Action:      pingRequest,
+			Subcommands: []cli.Command{
+				{
+					Name:        "diagnose",
+					Usage:       "Checks the network connectivity of the client",
+					Description: pingDiagnoseDescription,
+					Action:      pingDiagnose,
+					Flags: []cli.Flag{
+						&cli.IntFlag{
+							Name:  pingCountFlag,
+							Usage: "number of pings sent to each bootstrap peer",
+							Value: defaultPingCount,
+						},
+					},
+				},
+			},
 		}
 }
 
@@ -99,8 +134,10 @@ func pingRequest(c *cli.Context) error {
 			}
 		}
 
+		// The command has subcommands so the name of the application
+		// running it already ends with the command name.
 		fmt.Printf("You can ping this node using:\n"+
-			"    %s ping %s\n\n",
+			"    %s %s\n\n",
 			c.App.Name,
 			bootstrapAddr,
 		)
@@ -248,6 +285,83 @@ func pingRequest(c *cli.Context) error {
 	}
 }
 
+// pingDiagnose checks the network connectivity of the configured client and
+// prints the outcome of each check.
+func pingDiagnose(c *cli.Context) error {
+	pingCount := c.Int(pingCountFlag)
+	if pingCount < 1 {
+		return fmt.Errorf("ping count must be at least 1")
+	}
+
+	cfg, err := config.ReadConfig(
+		c.GlobalString("config"),
+		c.GlobalString("password-file"),
+	)
+	if err != nil {
+		return fmt.Errorf("error reading config file: [%v]", err)
+	}
+
+	if len(cfg.LibP2P.Peers) == 0 {
+		return fmt.Errorf("no bootstrap peers configured")
+	}
+
+	operatorPrivateKey, operatorPublicKey, err := loadStaticKey(
+		cfg.Ethereum.Account.KeyFile,
+		cfg.Ethereum.Account.KeyFilePassword,
+	)
+	if err != nil {
+		return err
+	}
+	networkPrivateKey, _ := key.OperatorKeyToNetworkKey(
+		operatorPrivateKey,
+		operatorPublicKey,
+	)
+
+	// Bootstrap peers are not staked so the firewall would reject them.
+	diagnosis, err := libp2p.Diagnose(
+		context.Background(),
+		cfg.LibP2P,
+		networkPrivateKey,
+		firewall.Disabled,
+		pingCount,
+	)
+	if err != nil {
+		return fmt.Errorf("could not diagnose the network: [%v]", err)
+	}
+
+	failed := 0
+	for _, bootstrapPeer := range diagnosis.BootstrapPeers {
+		if bootstrapPeer.Err != nil {
+			failed++
+			fmt.Printf(
+				"FAIL  bootstrap peer [%v] is reachable: %v\n",
+				bootstrapPeer.Address,
+				bootstrapPeer.Err,
+			)
+			continue
+		}
+
+		fmt.Printf(
+			"PASS  bootstrap peer [%v] is reachable: latency %v\n",
+			bootstrapPeer.Address,
+			bootstrapPeer.Latency,
+		)
+	}
+
+	if address, err := diagnosis.PubliclyDialable(); err != nil {
+		failed++
+		fmt.Printf("FAIL  client is publicly dialable: %v\n", err)
+	} else {
+		fmt.Printf("PASS  client is publicly dialable at [%v]\n", address)
+	}
+
+	if failed > 0 {
+		return fmt.Errorf("[%v] network checks failed", failed)
+	}
+
+	return nil
+}
+
 // PingMessage is a network message sent between bootstrap peer and
 // non-bootstrap peer in order to test the connection.
 type PingMessage struct {
@@ -2,7 +2,10 @@ package cmd
 
 import (
 	"fmt"
+	"math/big"
 	"os"
+	"os/signal"
+	"syscall"
 	"time"
 
 	"github.com/keep-network/keep-core/config"
@@ -19,9 +22,17 @@ const relayDescription = `The relay command allows interacting with Keep's
 	threshold relay. The "request" subcommand allows for requesting a new entry
 	from the relay, which is equivalent to asking for a new random number. This
 	subcommand waits for the entry to appear on-chain and then reports the value.
+	The request is paid with the current entry fee estimate unless the payment
+	is set explicitly in wei. The "entry" subcommand reports the latest relay
+	entry and, if asked to follow, reports new entries as they are generated.
 	The "genesis" subcommand triggers the first group selection. This action 
     can be done only once when there are no groups on the chain.`
 
+const (
+	paymentFlag = "payment"
+	followFlag  = "follow"
+)
+
 func init() {
 	RelayCommand = cli.Command{
 		Name:        "relay",
@@ -32,6 +43,24 @@ func init() {
 				Name:   "request",
 				Usage:  "Requests a new entry from the relay.",
 				Action: relayRequest,
+				Flags: []cli.Flag{
+					&cli.StringFlag{
+						Name: paymentFlag,
+						Usage: "payment for the request in wei; defaults " +
+							"to the current entry fee estimate",
+					},
+				},
+			},
+			{
+				Name:   "entry",
+				Usage:  "Reports the latest entry of the relay.",
+				Action: relayEntry,
+				Flags: []cli.Flag{
+					&cli.BoolFlag{
+						Name:  followFlag,
+						Usage: "keeps reporting new entries as they are generated",
+					},
+				},
 			},
 			{
 				Name:   "genesis",
@@ -46,11 +75,26 @@ func init() {
 // request id. By default, it also waits until the associated relay entry is
 // generated and prints out the entry.
 func relayRequest(c *cli.Context) error {
-	cfg, err := config.ReadConfig(c.GlobalString("config"))
+	cfg, err := config.ReadConfig(
+		c.GlobalString("config"),
+		c.GlobalString("password-file"),
+	)
 	if err != nil {
 		return fmt.Errorf("error reading config file: [%v]", err)
 	}
 
+	var payment *big.Int
+	if c.IsSet(paymentFlag) {
+		var ok bool
+		payment, ok = new(big.Int).SetString(c.String(paymentFlag), 10)
+		if !ok || payment.Sign() < 0 {
+			return fmt.Errorf(
+				"invalid payment [%v]; expected amount of wei",
+				c.String(paymentFlag),
+			)
+		}
+	}
+
 	utility, err := ethereum.ConnectUtility(cfg.Ethereum)
 	if err != nil {
 		return fmt.Errorf("error connecting to Ethereum node: [%v]", err)
@@ -60,7 +104,7 @@ func relayRequest(c *cli.Context) error {
 
 	fmt.Printf("Requesting for a new relay entry at [%s]\n", time.Now())
 
-	utility.RequestRelayEntry().
+	utility.RequestRelayEntry(payment).
 		OnSuccess(func(event *event.EntryGenerated) {
 			fmt.Fprintf(
 				os.Stderr,
@@ -84,9 +128,63 @@ func relayRequest(c *cli.Context) error {
 	}
 }
 
+// relayEntry prints the latest relay entry. If asked to follow, it keeps
+// printing new relay entries as they are generated until interrupted.
+func relayEntry(c *cli.Context) error {
+	cfg, err := config.ReadConfig(
+		c.GlobalString("config"),
+		c.GlobalString("password-file"),
+	)
+	if err != nil {
+		return fmt.Errorf("error reading config file: [%v]", err)
+	}
+
+	utility, err := ethereum.ConnectUtility(cfg.Ethereum)
+	if err != nil {
+		return fmt.Errorf("error connecting to Ethereum node: [%v]", err)
+	}
+
+	latestEntry, err := utility.LatestRelayEntry()
+	if err != nil {
+		return fmt.Errorf("error getting latest relay entry: [%v]", err)
+	}
+
+	fmt.Printf("Latest relay entry: [%v]\n", latestEntry)
+
+	if !c.Bool(followFlag) {
+		return nil
+	}
+
+	subscription, err := utility.OnRelayEntryGenerated(
+		func(event *event.EntryGenerated) {
+			fmt.Printf(
+				"Relay entry generated at block [%v] with value: [%v]\n",
+				event.BlockNumber,
+				event.Value,
+			)
+		},
+	)
+	if err != nil {
+		return fmt.Errorf(
+			"error subscribing to generated relay entries: [%v]",
+			err,
+		)
+	}
+	defer subscription.Unsubscribe()
+
+	interrupt := make(chan os.Signal, 1)
+	signal.Notify(interrupt, os.Interrupt, syscall.SIGTERM)
+	<-interrupt
+
+	return nil
+}
+
 // genesis kicks off protocol to create the first group.
 func genesis(c *cli.Context) error {
-	cfg, err := config.ReadConfig(c.GlobalString("config"))
+	cfg, err := config.ReadConfig(
+		c.GlobalString("config"),
+		c.GlobalString("password-file"),
+	)
 	if err != nil {
 		return fmt.Errorf("error reading config file: [%v]", err)
 	}
@@ -0,0 +1,162 @@
+package cmd
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"reflect"
+	"strings"
+
+	"github.com/keep-network/keep-common/pkg/logging"
+	"github.com/keep-network/keep-core/config"
+	"github.com/keep-network/keep-core/pkg/chain"
+	"github.com/keep-network/keep-core/pkg/chain/ethereum"
+	"github.com/keep-network/keep-core/pkg/metrics"
+	"github.com/keep-network/keep-core/pkg/net"
+	"github.com/urfave/cli"
+)
+
+// gasPriceUpdater is implemented by chains whose transactions can be repriced
+// without reconnecting.
+type gasPriceUpdater interface {
+	UpdateGasPrice(config ethereum.GasPriceConfig) error
+}
+
+// bootstrapPeersUpdater is implemented by network providers which can switch
+// to other bootstrap peers without reconnecting.
+type bootstrapPeersUpdater interface {
+	UpdateBootstrapPeers(ctx context.Context, bootstrapPeers []string) error
+}
+
+// configReloader applies settings of the reloaded config file which can
+// change without restarting the node and dropping group memberships or DKG
+// state: log levels, metrics port, gas price and bootstrap peers.
+type configReloader struct {
+	ctx context.Context
+	cli *cli.Context
+
+	current *config.Config
+
+	metricsRecorder metrics.Recorder
+	chainProvider   chain.Handle
+	netProvider     net.Provider
+}
+
+// watchConfig reloads the config file each time the node receives SIGHUP or
+// the file changes.
+func (cr *configReloader) watchConfig() {
+	config.Watch(
+		cr.ctx,
+		cr.cli.GlobalString("config"),
+		cr.current.Ethereum.Account.KeyFilePassword,
+		cr.reload,
+	)
+}
+
+func (cr *configReloader) reload(updated *config.Config) {
+	applyConfigOverrides(cr.cli, updated)
+
+	current := cr.current
+
+	if updated.LogLevel != current.LogLevel {
+		if err := configureLogging(cr.cli, updated.LogLevel); err != nil {
+			logger.Errorf("could not reload log level: [%v]", err)
+			updated.LogLevel = current.LogLevel
+		} else {
+			logger.Infof("reloaded log level [%v]", updated.LogLevel)
+		}
+	}
+
+	if updated.Metrics.Port != current.Metrics.Port &&
+		updated.Metrics.Backend == current.Metrics.Backend {
+		recorder, ok := cr.metricsRecorder.(*metrics.PrometheusRecorder)
+		if ok && updated.Metrics.Port != 0 {
+			recorder.Serve(updated.Metrics.Port)
+			logger.Infof("reloaded metrics port [%v]", updated.Metrics.Port)
+		} else {
+			updated.Metrics.Port = current.Metrics.Port
+		}
+	}
+
+	if !reflect.DeepEqual(updated.EthereumGasPrice, current.EthereumGasPrice) {
+		err := cr.updateGasPrice(updated.EthereumGasPrice)
+		if err != nil {
+			logger.Errorf("could not reload gas price: [%v]", err)
+			updated.EthereumGasPrice = current.EthereumGasPrice
+		} else {
+			logger.Infof("reloaded gas price")
+		}
+	}
+
+	if !reflect.DeepEqual(updated.LibP2P.Peers, current.LibP2P.Peers) {
+		err := cr.updateBootstrapPeers(updated.LibP2P.Peers)
+		if err != nil {
+			logger.Errorf("could not reload bootstrap peers: [%v]", err)
+			updated.LibP2P.Peers = current.LibP2P.Peers
+		} else {
+			logger.Infof("reloaded bootstrap peers %v", updated.LibP2P.Peers)
+		}
+	}
+
+	if !reflect.DeepEqual(
+		withoutReloadableSettings(*updated),
+		withoutReloadableSettings(*current),
+	) {
+		logger.Warningf(
+			"config file has changes which take effect after the restart",
+		)
+	}
+
+	cr.current = updated
+}
+
+func (cr *configReloader) updateGasPrice(
+	gasPrice ethereum.GasPriceConfig,
+) error {
+	updater, ok := cr.chainProvider.(gasPriceUpdater)
+	if !ok {
+		return fmt.Errorf("gas price of the connected chain cannot be updated")
+	}
+
+	return updater.UpdateGasPrice(gasPrice)
+}
+
+func (cr *configReloader) updateBootstrapPeers(peers []string) error {
+	updater, ok := cr.netProvider.(bootstrapPeersUpdater)
+	if !ok {
+		return fmt.Errorf(
+			"bootstrap peers of the network provider cannot be updated",
+		)
+	}
+
+	return updater.UpdateBootstrapPeers(cr.ctx, peers)
+}
+
+// withoutReloadableSettings returns a copy of the given config with all the
+// settings applied on reload cleared, so that changes of other settings can
+// be detected. Password fields are not read from the config file and are
+// left untouched.
+func withoutReloadableSettings(config config.Config) config.Config {
+	config.LogLevel = ""
+	config.Metrics.Port = 0
+	config.EthereumGasPrice = ethereum.GasPriceConfig{}
+	config.LibP2P.Peers = nil
+	return config
+}
+
+// configureLogging configures log levels from LOG_LEVEL, the --log-level flag
+// and the given config level directives, the latter taking precedence.
+func configureLogging(c *cli.Context, configLogLevel string) error {
+	var directives []string
+	for _, levels := range []string{
+		os.Getenv("LOG_LEVEL"),
+		c.GlobalString("log-level"),
+		configLogLevel,
+	} {
+		if levels = strings.TrimSpace(levels); levels != "" {
+			directives = append(directives, levels)
+		}
+	}
+
+	return logging.Configure(strings.Join(directives, " "))
+}
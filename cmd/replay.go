@@ -0,0 +1,95 @@
+package cmd
+
+import (
+	"fmt"
+	"io/ioutil"
+	"sort"
+
+	"github.com/keep-network/keep-core/config"
+	"github.com/keep-network/keep-core/pkg/beacon/relay/dkg"
+	"github.com/keep-network/keep-core/pkg/beacon/relay/dkg/checkpoint"
+	"github.com/keep-network/keep-core/pkg/beacon/relay/dkg/transcript"
+	"github.com/keep-network/keep-core/pkg/beacon/relay/group"
+	"github.com/keep-network/keep-core/pkg/identifier"
+	"github.com/urfave/cli"
+)
+
+// ReplayCommand contains the definition of the replay command-line subcommand.
+var ReplayCommand cli.Command
+
+const replayDescription = `The replay command feeds a DKG transcript recorded
+	by the client back through the protocol logic, without connecting to the
+	network or the chain, and reports the DKG result the member prepared along
+	with members supporting it. The transcript is decrypted with the same key
+	as DKG checkpoints.`
+
+func init() {
+	ReplayCommand = cli.Command{
+		Name:        "replay",
+		Usage:       `Replays a recorded DKG transcript.`,
+		ArgsUsage:   "<transcript-file>",
+		Description: replayDescription,
+		Action:      replay,
+	}
+}
+
+// replay replays the DKG transcript from the file given as the command
+// argument and prints the reproduced DKG result.
+func replay(c *cli.Context) error {
+	if c.NArg() != 1 {
+		return fmt.Errorf("transcript file is required")
+	}
+
+	cfg, err := config.ReadConfig(
+		c.GlobalString("config"),
+		c.GlobalString("password-file"),
+	)
+	if err != nil {
+		return fmt.Errorf("error reading config file: [%v]", err)
+	}
+
+	key, err := checkpoint.EncryptionKey(
+		cfg.Ethereum.Account.KeyFilePassword,
+		cfg.Storage.DataEncryptionKey,
+	)
+	if err != nil {
+		return fmt.Errorf("error deriving transcript key: [%v]", err)
+	}
+
+	encrypted, err := ioutil.ReadFile(c.Args().First())
+	if err != nil {
+		return fmt.Errorf("error reading transcript file: [%v]", err)
+	}
+
+	recorded, err := transcript.Decrypt(encrypted, key)
+	if err != nil {
+		return err
+	}
+
+	result, signatures, err := dkg.Replay(recorded)
+	if err != nil {
+		return fmt.Errorf("error replaying transcript: [%v]", err)
+	}
+
+	supporting := make([]group.MemberIndex, 0, len(signatures))
+	for memberIndex := range signatures {
+		supporting = append(supporting, memberIndex)
+	}
+	sort.Slice(supporting, func(i, j int) bool {
+		return supporting[i] < supporting[j]
+	})
+
+	fmt.Printf(
+		"seed: [%v], member: [%v]\n"+
+			"group public key: [0x%x]\n"+
+			"misbehaved members: %v\n"+
+			"supporting members: %v\n",
+		identifier.String(recorded.Seed),
+		recorded.MemberIndex,
+		result.GroupPublicKey,
+		result.Misbehaved,
+		supporting,
+	)
+
+	return nil
+}
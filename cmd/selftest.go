@@ -0,0 +1,54 @@
+package cmd
+
+import (
+	"fmt"
+
+	"github.com/keep-network/keep-core/pkg/bls"
+	"github.com/urfave/cli"
+)
+
+// SelfTestCommand contains the definition of the self-test command-line
+// subcommand and its own subcommands.
+var SelfTestCommand cli.Command
+
+const selfTestDescription = `The self-test command verifies the client works
+	correctly on the current hardware and platform before it is trusted in
+	production. The "crypto" subcommand generates a BLS key pair, signs and
+	verifies a message, reconstructs a threshold signature in a small simulated
+	group and verifies it with the group public key, reporting the outcome of
+	each check. The command fails if any of the checks fails.`
+
+func init() {
+	SelfTestCommand = cli.Command{
+		Name:        "self-test",
+		Usage:       `Verifies the client works correctly on this platform.`,
+		Description: selfTestDescription,
+		Subcommands: []cli.Command{
+			{
+				Name:   "crypto",
+				Usage:  "Verifies BLS cryptography works correctly.",
+				Action: selfTestCrypto,
+			},
+		},
+	}
+}
+
+// selfTestCrypto runs the BLS self-test and prints the outcome of each check.
+func selfTestCrypto(c *cli.Context) error {
+	failed := 0
+	for _, check := range bls.SelfTest() {
+		if check.Passed() {
+			fmt.Printf("PASS  %v\n", check.Name)
+			continue
+		}
+
+		failed++
+		fmt.Printf("FAIL  %v: %v\n", check.Name, check.Err)
+	}
+
+	if failed > 0 {
+		return fmt.Errorf("[%v] crypto self-test checks failed", failed)
+	}
+
+	return nil
+}
@@ -0,0 +1,158 @@
+package cmd
+
+import (
+	"fmt"
+
+	"github.com/keep-network/keep-core/config"
+	dkgResult "github.com/keep-network/keep-core/pkg/beacon/relay/dkg/result"
+	"github.com/keep-network/keep-core/pkg/chain/ethereum"
+	"github.com/urfave/cli"
+)
+
+// VerifySignaturesCommand contains the definition of the verify-signatures
+// command-line subcommand.
+var VerifySignaturesCommand cli.Command
+
+const verifySignaturesDescription = `The verify-signatures command verifies
+	a set of signatures collected offline for a DKG result before the result is
+	submitted. Each signature is verified against the result hash calculated by
+	the configured chain and the public key of the member currently selected to
+	the group under the signature's index. The command reports valid, invalid
+	and missing signatures and fails if valid signatures do not meet the
+	signature threshold of the chain.
+
+	The result file is a JSON object with hex-encoded "groupPublicKey" and
+	"misbehaved" fields. The signatures file is a JSON array of objects with
+	"memberIndex" and hex-encoded "publicKey" and "signature" fields.
+
+	If the weights file is given, the quorum is weight-based: it is met when
+	the total weight of members with valid signatures reaches the share of the
+	total weight of the group equal to the share of the signature threshold in
+	the group size. The weights file is a JSON object mapping member indexes to
+	their weights; members not listed have no weight.`
+
+const (
+	resultFileFlag     = "result"
+	signaturesFileFlag = "signatures"
+	weightsFileFlag    = "weights"
+)
+
+func init() {
+	VerifySignaturesCommand = cli.Command{
+		Name:        "verify-signatures",
+		Usage:       "Verifies signatures collected for a DKG result reach the threshold.",
+		Description: verifySignaturesDescription,
+		Action:      verifySignatures,
+		Flags: []cli.Flag{
+			&cli.StringFlag{
+				Name:  resultFileFlag,
+				Usage: "path to the DKG result file",
+			},
+			&cli.StringFlag{
+				Name:  signaturesFileFlag,
+				Usage: "path to the collected signatures file",
+			},
+			&cli.StringFlag{
+				Name:  weightsFileFlag,
+				Usage: "path to the member weights file for the weighted quorum",
+			},
+		},
+	}
+}
+
+// verifySignatures verifies signatures from the signatures file against the
+// DKG result from the result file and prints the verification report.
+func verifySignatures(c *cli.Context) error {
+	resultPath := c.String(resultFileFlag)
+	signaturesPath := c.String(signaturesFileFlag)
+	if resultPath == "" || signaturesPath == "" {
+		return fmt.Errorf(
+			"both [%v] and [%v] are required",
+			resultFileFlag,
+			signaturesFileFlag,
+		)
+	}
+
+	result, err := dkgResult.ReadResultFile(resultPath)
+	if err != nil {
+		return err
+	}
+
+	signatures, err := dkgResult.ReadSignaturesFile(signaturesPath)
+	if err != nil {
+		return err
+	}
+
+	var weights dkgResult.MemberWeights
+	if weightsPath := c.String(weightsFileFlag); weightsPath != "" {
+		weights, err = dkgResult.ReadWeightsFile(weightsPath)
+		if err != nil {
+			return err
+		}
+	}
+
+	cfg, err := config.ReadConfig(
+		c.GlobalString("config"),
+		c.GlobalString("password-file"),
+	)
+	if err != nil {
+		return fmt.Errorf("error reading config file: [%v]", err)
+	}
+
+	chainHandle, err := ethereum.Connect(cfg.Ethereum)
+	if err != nil {
+		return fmt.Errorf("error connecting to Ethereum node: [%v]", err)
+	}
+	relayChain := chainHandle.ThresholdRelay()
+
+	chainConfig, err := relayChain.GetConfig()
+	if err != nil {
+		return fmt.Errorf("could not get chain config: [%v]", err)
+	}
+
+	resultHash, err := relayChain.CalculateDKGResultHash(result)
+	if err != nil {
+		return fmt.Errorf("could not calculate result hash: [%v]", err)
+	}
+
+	members, err := relayChain.GetSelectedParticipants()
+	if err != nil {
+		return fmt.Errorf("could not get group members: [%v]", err)
+	}
+
+	verification := dkgResult.VerifySignatureSet(
+		resultHash,
+		signatures,
+		members,
+		chainHandle.Signing(),
+		chainConfig,
+		weights,
+	)
+
+	fmt.Printf(
+		"result hash: [0x%x]\n"+
+			"valid signatures: %v\n"+
+			"invalid signatures: %v\n"+
+			"missing signatures: %v\n"+
+			"threshold: [%v/%v]\n",
+		resultHash,
+		verification.Valid,
+		verification.Invalid,
+		verification.Missing,
+		verification.Quorum.Have,
+		verification.Quorum.Required,
+	)
+
+	if !verification.Quorum.Met {
+		return fmt.Errorf(
+			"signature threshold not met; [%v] valid signatures out of "+
+				"required [%v]",
+			verification.Quorum.Have,
+			verification.Quorum.Required,
+		)
+	}
+
+	fmt.Println("signature threshold met")
+
+	return nil
+}
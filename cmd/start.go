@@ -3,6 +3,8 @@ package cmd
 import (
 	"context"
 	"fmt"
+	"os"
+	"path"
 	"time"
 
 	"github.com/ipfs/go-log"
@@ -10,11 +12,20 @@ import (
 	"github.com/keep-network/keep-common/pkg/persistence"
 	"github.com/keep-network/keep-core/config"
 	"github.com/keep-network/keep-core/pkg/beacon"
+	"github.com/keep-network/keep-core/pkg/beacon/relay"
+	"github.com/keep-network/keep-core/pkg/beacon/relay/dkg"
+	"github.com/keep-network/keep-core/pkg/beacon/relay/dkg/checkpoint"
+	"github.com/keep-network/keep-core/pkg/beacon/relay/dkg/transcript"
 	"github.com/keep-network/keep-core/pkg/chain"
 	"github.com/keep-network/keep-core/pkg/chain/ethereum"
+	"github.com/keep-network/keep-core/pkg/chain/local"
+	"github.com/keep-network/keep-core/pkg/diagnostics"
 	"github.com/keep-network/keep-core/pkg/firewall"
+	"github.com/keep-network/keep-core/pkg/metrics"
+	"github.com/keep-network/keep-core/pkg/net"
 	"github.com/keep-network/keep-core/pkg/net/key"
 	"github.com/keep-network/keep-core/pkg/net/libp2p"
+	netlocal "github.com/keep-network/keep-core/pkg/net/local"
 	"github.com/keep-network/keep-core/pkg/net/retransmission"
 	"github.com/keep-network/keep-core/pkg/operator"
 	"github.com/urfave/cli"
@@ -32,8 +43,32 @@ const (
 	portShort         = "p"
 	waitForStakeFlag  = "wait-for-stake"
 	waitForStakeShort = "w"
+	startAtBlockFlag  = "start-at-block"
+	startAtTimeFlag   = "start-at-time"
+	observerFlag      = "observer"
+	chainFlag         = "chain"
 )
 
+// dkgCheckpointsDir is the name of the data directory subdirectory under which
+// DKG checkpoints are stored.
+const dkgCheckpointsDir = "dkg"
+
+// chainConfigCacheFile is the name of the data directory file to which the
+// last-known-good relay config fetched from the chain is persisted.
+const chainConfigCacheFile = "chain-config.json"
+
+// dkgTranscriptsDir is the name of the data directory subdirectory under which
+// DKG transcripts are stored.
+const dkgTranscriptsDir = "dkg-transcripts"
+
+// dkgParticipationsDir is the name of the data directory subdirectory under
+// which the history of DKG participations is stored.
+const dkgParticipationsDir = "dkg-participations"
+
+// connectedPeersMetric is the name of the gauge of peers the node is connected
+// to.
+const connectedPeersMetric = "connected_peers"
+
 const startDescription = `Starts the Keep client in the foreground. Currently this only consists of the
    threshold relay client for the Keep random beacon.`
 
@@ -51,6 +86,30 @@ func init() {
 				&cli.IntFlag{
 					Name: waitForStakeFlag + "," + waitForStakeShort,
 				},
+				&cli.Uint64Flag{
+					Name:  startAtBlockFlag,
+					Usage: "defers the protocols start until the given block",
+				},
+				&cli.StringFlag{
+					Name: startAtTimeFlag,
+					Usage: "defers the protocols start until the given " +
+						"RFC 3339 time, e.g. 2020-03-01T12:00:00Z",
+				},
+				&cli.BoolFlag{
+					Name: observerFlag,
+					Usage: "runs a read-only observer node never sending " +
+						"transactions",
+				},
+				&cli.BoolFlag{
+					Name: dryRunFlag,
+					Usage: "runs the node as usual but logs tickets, DKG " +
+						"results and relay entries instead of submitting them",
+				},
+				&cli.StringFlag{
+					Name: chainFlag,
+					Usage: "chain to run against, either ethereum or " +
+						"local simulated in the process for development",
+				},
 			},
 		}
 }
@@ -58,13 +117,29 @@ func init() {
 // Start starts a node; if it's not a bootstrap node it will get the Node.URLs
 // from the config file
 func Start(c *cli.Context) error {
-	config, err := config.ReadConfig(c.GlobalString("config"))
+	config, err := config.ReadConfig(
+		c.GlobalString("config"),
+		c.GlobalString("password-file"),
+	)
 	if err != nil {
 		return fmt.Errorf("error reading config file: %v", err)
 	}
 
-	if c.Int(portFlag) > 0 {
-		config.LibP2P.Port = c.Int(portFlag)
+	applyConfigOverrides(c, config)
+
+	// Settings reloaded from the config file are compared against the ones
+	// read at startup, before any of them are adjusted while connecting.
+	startConfig := *config
+
+	if config.LogLevel != "" {
+		if err := configureLogging(c, config.LogLevel); err != nil {
+			return fmt.Errorf("failed to configure logging: [%v]", err)
+		}
+	}
+
+	startBarrier, err := readStartBarrier(c)
+	if err != nil {
+		return err
 	}
 
 	// FIXME This needs to happen inside the `pkg/chain/ethereum` scope,
@@ -77,9 +152,21 @@ func Start(c *cli.Context) error {
 		return fmt.Errorf("error loading static peer's key [%v]", err)
 	}
 
-	chainProvider, err := ethereum.Connect(config.Ethereum)
+	ctx := context.Background()
+
+	metricsRecorder, err := metrics.Initialize(config.Metrics)
 	if err != nil {
-		return fmt.Errorf("error connecting to Ethereum node: [%v]", err)
+		return fmt.Errorf("failed while initializing metrics: [%v]", err)
+	}
+
+	chainProvider, err := connectChain(
+		ctx,
+		config,
+		operatorPrivateKey,
+		metricsRecorder,
+	)
+	if err != nil {
+		return err
 	}
 
 	blockCounter, err := chainProvider.BlockCounter()
@@ -112,22 +199,44 @@ func Start(c *cli.Context) error {
 		)
 	}
 
-	ctx := context.Background()
-	networkPrivateKey, _ := key.OperatorKeyToNetworkKey(
+	networkPrivateKey, networkPublicKey := key.OperatorKeyToNetworkKey(
 		operatorPrivateKey, operatorPublicKey,
 	)
-	netProvider, err := libp2p.Connect(
-		ctx,
-		config.LibP2P,
-		networkPrivateKey,
-		firewall.MinimumStakePolicy(stakeMonitor),
-		retransmission.NewTicker(blockCounter.WatchBlocks(ctx)),
-	)
-	if err != nil {
-		return err
+
+	var netProvider net.Provider
+	if _, ok := chainProvider.(local.Chain); ok {
+		// All group members of the local chain run in this process so their
+		// messages are delivered in the process as well.
+		netProvider = netlocal.ConnectWithKey(networkPublicKey)
+	} else {
+		netProvider, err = libp2p.Connect(
+			ctx,
+			config.LibP2P,
+			networkPrivateKey,
+			firewall.MinimumStakePolicy(stakeMonitor),
+			retransmission.NewTicker(blockCounter.WatchBlocks(ctx)),
+		)
+		if err != nil {
+			return err
+		}
+
+		nodeHeader(
+			netProvider.ConnectionManager().AddrStrings(),
+			config.LibP2P.Port,
+		)
 	}
 
-	nodeHeader(netProvider.ConnectionManager().AddrStrings(), config.LibP2P.Port)
+	go metrics.ObserveGauge(
+		ctx,
+		metricsRecorder,
+		connectedPeersMetric,
+		metrics.DefaultObservationInterval,
+		func() (float64, error) {
+			return float64(
+				len(netProvider.ConnectionManager().ConnectedPeers()),
+			), nil
+		},
+	)
 
 	handle, err := persistence.NewDiskHandle(config.Storage.DataDir)
 	if err != nil {
@@ -138,17 +247,80 @@ func Start(c *cli.Context) error {
 		config.Ethereum.Account.KeyFilePassword,
 	)
 
+	dkgCheckpoints, err := newDKGCheckpointStorage(config)
+	if err != nil {
+		return fmt.Errorf("failed while creating DKG checkpoint storage: [%v]", err)
+	}
+
+	var dkgTranscripts *transcript.Storage
+	if config.Relay.RecordDKGTranscripts {
+		dkgTranscripts, err = newDKGTranscriptStorage(config)
+		if err != nil {
+			return fmt.Errorf(
+				"failed while creating DKG transcript storage: [%v]",
+				err,
+			)
+		}
+	}
+
+	dkgParticipations, err := newDKGParticipationStore(config)
+	if err != nil {
+		return fmt.Errorf(
+			"failed while creating DKG participation store: [%v]",
+			err,
+		)
+	}
+
+	diagnosticsRegistry := diagnostics.Initialize(config.Diagnostics.Port)
+	registerStatusSources(
+		ctx,
+		diagnosticsRegistry,
+		config,
+		netProvider,
+		blockCounter,
+	)
+
+	// Nodes connect to the network before waiting for the barrier so that
+	// all of them are ready once the protocols start.
+	if err := startBarrier.Wait(blockCounter); err != nil {
+		return err
+	}
+
 	err = beacon.Initialize(
 		ctx,
 		config.Ethereum.Account.Address,
 		chainProvider,
 		netProvider,
 		persistence,
+		relay.DKGStorage{
+			Checkpoints:    dkgCheckpoints,
+			Transcripts:    dkgTranscripts,
+			Participations: dkgParticipations,
+		},
+		&config.Relay,
+		diagnosticsRegistry,
+		metricsRecorder,
 	)
 	if err != nil {
 		return fmt.Errorf("error initializing beacon: [%v]", err)
 	}
 
+	if localChain, ok := chainProvider.(local.Chain); ok {
+		if err := driveLocalChain(ctx, localChain, config.LocalChain); err != nil {
+			return err
+		}
+	}
+
+	reloader := &configReloader{
+		ctx:             ctx,
+		cli:             c,
+		current:         &startConfig,
+		metricsRecorder: metricsRecorder,
+		chainProvider:   chainProvider,
+		netProvider:     netProvider,
+	}
+	reloader.watchConfig()
+
 	select {
 	case <-ctx.Done():
 		if err != nil {
@@ -159,6 +331,207 @@ func Start(c *cli.Context) error {
 	}
 }
 
+// connectChain connects to the chain selected in the configuration.
+func connectChain(
+	ctx context.Context,
+	clientConfig *config.Config,
+	operatorPrivateKey *operator.PrivateKey,
+	metricsRecorder metrics.Recorder,
+) (chain.Handle, error) {
+	switch clientConfig.Chain {
+	case "", config.EthereumChainName:
+		return connectEthereum(ctx, clientConfig, metricsRecorder)
+	case config.LocalChainName:
+		return connectLocalChain(clientConfig, operatorPrivateKey)
+	default:
+		return nil, fmt.Errorf("unsupported chain [%v]", clientConfig.Chain)
+	}
+}
+
+// connectEthereum connects to the Ethereum node, selecting the Ethereum
+// endpoint with the lowest latency if alternative endpoints are configured.
+func connectEthereum(
+	ctx context.Context,
+	clientConfig *config.Config,
+	metricsRecorder metrics.Recorder,
+) (chain.Handle, error) {
+	var endpoints *ethereum.EndpointSelector
+	if len(clientConfig.EthereumEndpoints.URLs) > 0 {
+		var err error
+		endpoints, err = selectEthereumEndpoint(
+			ctx,
+			clientConfig.EthereumEndpoints,
+		)
+		if err != nil {
+			return nil, fmt.Errorf(
+				"error selecting Ethereum endpoint: [%v]",
+				err,
+			)
+		}
+		clientConfig.Ethereum.URL = endpoints.Selected()
+	}
+
+	chainProvider, err := ethereum.ConnectWithOptions(
+		clientConfig.Ethereum,
+		ethereum.ConnectOptions{
+			ConfigCacheFile: path.Join(
+				clientConfig.Storage.DataDir,
+				chainConfigCacheFile,
+			),
+			History:         clientConfig.EthereumHistory,
+			ExpectedChainID: clientConfig.EthereumChainID,
+			PrivateRelay:    clientConfig.DKGResultPrivateRelay,
+			GasPrice:        clientConfig.EthereumGasPrice,
+			Endpoints:       endpoints,
+			MetricsRecorder: metricsRecorder,
+		},
+	)
+	if err != nil {
+		return nil, fmt.Errorf("error connecting to Ethereum node: [%v]", err)
+	}
+
+	return chainProvider, nil
+}
+
+// selectEthereumEndpoint selects the healthy Ethereum endpoint with the
+// lowest latency and keeps re-evaluating the selection in the background.
+// The node connects to the selected endpoint and fails over to another one
+// each time the selection changes.
+func selectEthereumEndpoint(
+	ctx context.Context,
+	endpoints config.EthereumEndpoints,
+) (*ethereum.EndpointSelector, error) {
+	selector := ethereum.NewEndpointSelector(
+		endpoints.URLs,
+		time.Duration(endpoints.ProbeTimeoutSeconds)*time.Second,
+		endpoints.MaxBlockLag,
+	)
+
+	if _, err := selector.Select(ctx); err != nil {
+		return nil, err
+	}
+
+	probeInterval := time.Duration(endpoints.ProbeIntervalSeconds) * time.Second
+	if probeInterval == 0 {
+		probeInterval = config.DefaultEndpointProbeInterval
+	}
+
+	go selector.Monitor(ctx, probeInterval, nil)
+
+	return selector, nil
+}
+
+// applyConfigOverrides overrides values read from the config file with values
+// passed as command-line flags.
+func applyConfigOverrides(c *cli.Context, config *config.Config) {
+	if c.Int(portFlag) > 0 {
+		config.LibP2P.Port = c.Int(portFlag)
+	}
+	if c.Bool(observerFlag) {
+		config.Relay.ObserverMode = true
+	}
+	if c.Bool(dryRunFlag) {
+		config.Relay.DryRun = true
+	}
+	if c.String(chainFlag) != "" {
+		config.Chain = c.String(chainFlag)
+	}
+}
+
+// readStartBarrier reads the conditions deferring the protocols start from
+// the command flags.
+func readStartBarrier(c *cli.Context) (*beacon.StartBarrier, error) {
+	barrier := &beacon.StartBarrier{
+		Block: c.Uint64(startAtBlockFlag),
+	}
+
+	if startAt := c.String(startAtTimeFlag); startAt != "" {
+		startTime, err := time.Parse(time.RFC3339, startAt)
+		if err != nil {
+			return nil, fmt.Errorf(
+				"invalid value of %v flag: [%v]",
+				startAtTimeFlag,
+				err,
+			)
+		}
+		barrier.Time = startTime
+	}
+
+	return barrier, nil
+}
+
+// newDKGCheckpointStorage creates an encrypted storage for DKG checkpoints in
+// a dedicated subdirectory of the configured data directory.
+func newDKGCheckpointStorage(
+	config *config.Config,
+) (*checkpoint.Storage, error) {
+	checkpointsDir := path.Join(config.Storage.DataDir, dkgCheckpointsDir)
+	if err := os.MkdirAll(checkpointsDir, 0700); err != nil {
+		return nil, err
+	}
+
+	handle, err := persistence.NewDiskHandle(checkpointsDir)
+	if err != nil {
+		return nil, err
+	}
+
+	key, err := checkpoint.EncryptionKey(
+		config.Ethereum.Account.KeyFilePassword,
+		config.Storage.DataEncryptionKey,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return checkpoint.NewStorage(handle, key), nil
+}
+
+// newDKGTranscriptStorage creates an encrypted storage for DKG transcripts in
+// a dedicated subdirectory of the configured data directory. Transcripts are
+// encrypted with the same key as DKG checkpoints.
+func newDKGTranscriptStorage(
+	config *config.Config,
+) (*transcript.Storage, error) {
+	transcriptsDir := path.Join(config.Storage.DataDir, dkgTranscriptsDir)
+	if err := os.MkdirAll(transcriptsDir, 0700); err != nil {
+		return nil, err
+	}
+
+	handle, err := persistence.NewDiskHandle(transcriptsDir)
+	if err != nil {
+		return nil, err
+	}
+
+	key, err := checkpoint.EncryptionKey(
+		config.Ethereum.Account.KeyFilePassword,
+		config.Storage.DataEncryptionKey,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return transcript.NewStorage(handle, key), nil
+}
+
+// newDKGParticipationStore creates a store of the DKG participation history
+// in a dedicated subdirectory of the configured data directory and loads
+// participations recorded before.
+func newDKGParticipationStore(
+	config *config.Config,
+) (*dkg.ParticipationStore, error) {
+	participationsDir := path.Join(config.Storage.DataDir, dkgParticipationsDir)
+	if err := os.MkdirAll(participationsDir, 0700); err != nil {
+		return nil, err
+	}
+
+	handle, err := persistence.NewDiskHandle(participationsDir)
+	if err != nil {
+		return nil, err
+	}
+
+	return dkg.NewParticipationStore(handle)
+}
+
 func loadStaticKey(
 	keyFile string,
 	keyFilePassword string,
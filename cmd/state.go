@@ -0,0 +1,157 @@
+package cmd
+
+import (
+	"fmt"
+
+	"github.com/keep-network/keep-core/config"
+	"github.com/keep-network/keep-core/pkg/beacon/relay/dkg/checkpoint"
+	"github.com/keep-network/keep-core/pkg/chain/ethereum"
+	"github.com/keep-network/keep-core/pkg/identifier"
+	"github.com/urfave/cli"
+)
+
+// StateCommand contains the definition of the state command-line subcommand
+// and its own subcommands.
+var StateCommand cli.Command
+
+const stateDescription = `The state command allows inspecting DKG state
+	persisted by the client in the data directory. The "list" subcommand shows
+	all persisted DKG executions along with their status. The "prune"
+	subcommand archives state of DKG executions which have already ended
+	on-chain, either with the group registered or timed out. State which could
+	not be read or decrypted is reported and skipped.`
+
+const dryRunFlag = "dry-run"
+
+func init() {
+	StateCommand = cli.Command{
+		Name:        "state",
+		Usage:       `Provides access to persisted DKG state.`,
+		Description: stateDescription,
+		Subcommands: []cli.Command{
+			{
+				Name:   "list",
+				Usage:  "Lists persisted DKG state.",
+				Action: stateList,
+			},
+			{
+				Name:   "prune",
+				Usage:  "Archives persisted DKG state resolved on-chain.",
+				Action: statePrune,
+				Flags: []cli.Flag{
+					&cli.BoolFlag{
+						Name:  dryRunFlag,
+						Usage: "only list state which would be pruned",
+					},
+				},
+			},
+		},
+	}
+}
+
+// stateList prints all DKG executions with persisted state along with the
+// information whether they have been resolved on-chain.
+func stateList(c *cli.Context) error {
+	states, err := withCheckpointStorage(
+		c,
+		func(
+			storage *checkpoint.Storage,
+			chain checkpoint.ResolutionChecker,
+		) ([]*checkpoint.State, []error, error) {
+			return storage.States(chain)
+		},
+	)
+	if err != nil {
+		return err
+	}
+
+	for _, state := range states {
+		status := "pending"
+		if state.Resolved {
+			status = "resolved"
+		}
+
+		fmt.Printf(
+			"seed: [%v], members: %v, status: [%v]\n",
+			identifier.String(state.Seed),
+			state.MemberIndexes,
+			status,
+		)
+	}
+
+	return nil
+}
+
+// statePrune archives state of all DKG executions resolved on-chain. With the
+// dry run flag set, it only prints the state which would be archived.
+func statePrune(c *cli.Context) error {
+	dryRun := c.Bool(dryRunFlag)
+
+	pruned, err := withCheckpointStorage(
+		c,
+		func(
+			storage *checkpoint.Storage,
+			chain checkpoint.ResolutionChecker,
+		) ([]*checkpoint.State, []error, error) {
+			return storage.Prune(chain, dryRun)
+		},
+	)
+	if err != nil {
+		return err
+	}
+
+	action := "pruned"
+	if dryRun {
+		action = "would prune"
+	}
+
+	for _, state := range pruned {
+		fmt.Printf(
+			"%v seed: [%v], members: %v\n",
+			action,
+			identifier.String(state.Seed),
+			state.MemberIndexes,
+		)
+	}
+
+	return nil
+}
+
+// withCheckpointStorage executes the given action on the checkpoint storage
+// and reports all checkpoints skipped by the action as unreadable.
+func withCheckpointStorage(
+	c *cli.Context,
+	action func(
+		storage *checkpoint.Storage,
+		chain checkpoint.ResolutionChecker,
+	) ([]*checkpoint.State, []error, error),
+) ([]*checkpoint.State, error) {
+	cfg, err := config.ReadConfig(
+		c.GlobalString("config"),
+		c.GlobalString("password-file"),
+	)
+	if err != nil {
+		return nil, fmt.Errorf("error reading config file: [%v]", err)
+	}
+
+	storage, err := newDKGCheckpointStorage(cfg)
+	if err != nil {
+		return nil, fmt.Errorf(
+			"error opening DKG checkpoint storage: [%v]",
+			err,
+		)
+	}
+
+	chainHandle, err := ethereum.Connect(cfg.Ethereum)
+	if err != nil {
+		return nil, fmt.Errorf("error connecting to Ethereum node: [%v]", err)
+	}
+
+	states, skipped, err := action(storage, chainHandle.ThresholdRelay())
+
+	for _, skippedErr := range skipped {
+		fmt.Printf("skipped unreadable state: [%v]\n", skippedErr)
+	}
+
+	return states, err
+}
@@ -0,0 +1,331 @@
+package cmd
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/keep-network/keep-core/config"
+	"github.com/keep-network/keep-core/pkg/beacon/relay"
+	"github.com/keep-network/keep-core/pkg/beacon/relay/registry"
+	"github.com/keep-network/keep-core/pkg/chain"
+	"github.com/keep-network/keep-core/pkg/chain/ethereum"
+	"github.com/keep-network/keep-core/pkg/diagnostics"
+	"github.com/keep-network/keep-core/pkg/net"
+	"github.com/keep-network/keep-core/pkg/net/libp2p"
+	"github.com/urfave/cli"
+)
+
+// StatusCommand contains the definition of the status command-line
+// subcommand.
+var StatusCommand cli.Command
+
+const statusDescription = `The status command connects to the diagnostics endpoint
+   of the running client and prints the number of connected peers, connected
+   bootstrap peers, the health of the Ethereum connection, group memberships
+   and the last relay entry observed by the client. The diagnostics endpoint
+   has to be enabled with the Diagnostics.Port setting of the client.`
+
+const diagnosticsAddressFlag = "diagnostics-address"
+
+// statusRequestTimeout is the time after which a request to the diagnostics
+// endpoint of the running client is abandoned.
+const statusRequestTimeout = 10 * time.Second
+
+const (
+	networkStatusSource    = "network"
+	ethereumStatusSource   = "ethereum"
+	groupsStatusSource     = "groups"
+	relayEntryStatusSource = "relayEntry"
+)
+
+func init() {
+	StatusCommand = cli.Command{
+		Name:        "status",
+		Usage:       "Prints the status of the running client",
+		Description: statusDescription,
+		Action:      Status,
+		Flags: []cli.Flag{
+			&cli.StringFlag{
+				Name: diagnosticsAddressFlag,
+				Usage: "address of the diagnostics endpoint, e.g. " +
+					"localhost:8081; defaults to localhost and the port " +
+					"from the config file",
+			},
+		},
+	}
+}
+
+// networkStatus describes the connectivity of the client to the network.
+type networkStatus struct {
+	ConnectedPeers int
+	// BootstrapPeers tells for each configured bootstrap peer address if
+	// the client is connected to the peer.
+	BootstrapPeers map[string]bool
+}
+
+// ethereumStatus describes the health of the client's Ethereum connection.
+type ethereumStatus struct {
+	Healthy bool
+	// Latency is the time it took the Ethereum endpoint to respond to the
+	// health probe; zero if the endpoint is not healthy.
+	Latency time.Duration
+	// Error is the reason the endpoint is not healthy.
+	Error string `json:",omitempty"`
+	// BlockHeight is the last block height seen by the client.
+	BlockHeight uint64
+}
+
+// registerStatusSources registers diagnostics sources describing the client's
+// connectivity to the network and Ethereum, read by the status command.
+func registerStatusSources(
+	ctx context.Context,
+	diagnosticsRegistry *diagnostics.Registry,
+	config *config.Config,
+	netProvider net.Provider,
+	blockCounter chain.BlockCounter,
+) {
+	diagnosticsRegistry.RegisterSource(
+		networkStatusSource,
+		func() (interface{}, error) {
+			connectedPeers := netProvider.ConnectionManager().ConnectedPeers()
+
+			connected := make(map[string]bool, len(connectedPeers))
+			for _, peer := range connectedPeers {
+				connected[peer] = true
+			}
+
+			bootstrapPeers := make(map[string]bool, len(config.LibP2P.Peers))
+			for _, address := range config.LibP2P.Peers {
+				peerID, err := libp2p.PeerIDFromMultiaddress(address)
+				if err != nil {
+					return nil, fmt.Errorf(
+						"invalid bootstrap peer address [%v]: [%v]",
+						address,
+						err,
+					)
+				}
+
+				bootstrapPeers[address] = connected[peerID]
+			}
+
+			return &networkStatus{
+				ConnectedPeers: len(connectedPeers),
+				BootstrapPeers: bootstrapPeers,
+			}, nil
+		},
+	)
+
+	diagnosticsRegistry.RegisterSource(
+		ethereumStatusSource,
+		func() (interface{}, error) {
+			status := &ethereumStatus{}
+
+			latency, err := ethereum.ProbeEndpoint(ctx, config.Ethereum.URL, 0)
+			if err != nil {
+				status.Error = err.Error()
+			} else {
+				status.Healthy = true
+				status.Latency = latency
+			}
+
+			blockHeight, err := blockCounter.CurrentBlock()
+			if err != nil {
+				return nil, fmt.Errorf(
+					"could not get current block height: [%v]",
+					err,
+				)
+			}
+			status.BlockHeight = blockHeight
+
+			return status, nil
+		},
+	)
+}
+
+// Status prints the status of the running client read from its diagnostics
+// endpoint.
+func Status(c *cli.Context) error {
+	address := c.String(diagnosticsAddressFlag)
+	if address == "" {
+		diagnosticsConfig, err := config.ReadDiagnosticsConfig(
+			c.GlobalString("config"),
+		)
+		if err != nil {
+			return fmt.Errorf("error reading config file: [%v]", err)
+		}
+
+		if diagnosticsConfig.Port == 0 {
+			return fmt.Errorf(
+				"diagnostics endpoint is not enabled; set Diagnostics.Port " +
+					"in the config file of the running client or use the " +
+					"--" + diagnosticsAddressFlag + " flag",
+			)
+		}
+
+		address = fmt.Sprintf("localhost:%v", diagnosticsConfig.Port)
+	}
+
+	client := &statusClient{
+		baseURL:    "http://" + address,
+		httpClient: &http.Client{Timeout: statusRequestTimeout},
+	}
+
+	network := &networkStatus{}
+	if err := client.read(networkStatusSource, network); err != nil {
+		if _, ok := err.(*sourceUnavailableError); !ok {
+			return fmt.Errorf(
+				"could not connect to the client's diagnostics endpoint "+
+					"at [%v]; make sure the client is running: [%v]",
+				address,
+				err,
+			)
+		}
+		printStatusError("network", err)
+	} else {
+		printNetworkStatus(network)
+	}
+
+	ethereumConnection := &ethereumStatus{}
+	if err := client.read(ethereumStatusSource, ethereumConnection); err != nil {
+		printStatusError("ethereum", err)
+	} else {
+		printEthereumStatus(ethereumConnection)
+	}
+
+	groups := make([]*registry.GroupMemberships, 0)
+	if err := client.read(groupsStatusSource, &groups); err != nil {
+		printStatusError("groups", err)
+	} else {
+		printGroupsStatus(groups)
+	}
+
+	var relayEntry *relay.RelayEntryStatus
+	if err := client.read(relayEntryStatusSource, &relayEntry); err != nil {
+		printStatusError("last relay entry", err)
+	} else {
+		printRelayEntryStatus(relayEntry)
+	}
+
+	return nil
+}
+
+// statusClient reads diagnostics sources of the running client.
+type statusClient struct {
+	baseURL    string
+	httpClient *http.Client
+}
+
+// sourceUnavailableError is returned when the diagnostics endpoint responded
+// but could not provide the source, for example because the client has not
+// started the protocols yet.
+type sourceUnavailableError struct {
+	source string
+	reason string
+}
+
+func (sue *sourceUnavailableError) Error() string {
+	return fmt.Sprintf("[%v] is not available: [%v]", sue.source, sue.reason)
+}
+
+// read reads the diagnostics source with the given name and decodes it into
+// the given value.
+func (sc *statusClient) read(source string, value interface{}) error {
+	response, err := sc.httpClient.Get(sc.baseURL + "/" + source)
+	if err != nil {
+		return err
+	}
+	defer response.Body.Close()
+
+	if response.StatusCode != http.StatusOK {
+		body, _ := ioutil.ReadAll(response.Body)
+		return &sourceUnavailableError{
+			source: source,
+			reason: strings.TrimSpace(string(body)),
+		}
+	}
+
+	if err := json.NewDecoder(response.Body).Decode(value); err != nil {
+		return fmt.Errorf("could not decode [%v]: [%v]", source, err)
+	}
+
+	return nil
+}
+
+func printStatusError(section string, err error) {
+	fmt.Printf("%v:\n  unknown: %v\n", section, err)
+}
+
+func printNetworkStatus(status *networkStatus) {
+	addresses := make([]string, 0, len(status.BootstrapPeers))
+	connectedBootstrapPeers := 0
+	for address, connected := range status.BootstrapPeers {
+		addresses = append(addresses, address)
+		if connected {
+			connectedBootstrapPeers++
+		}
+	}
+	sort.Strings(addresses)
+
+	fmt.Printf("network:\n")
+	fmt.Printf("  connected peers: [%v]\n", status.ConnectedPeers)
+	fmt.Printf(
+		"  connected bootstrap peers: [%v] of [%v]\n",
+		connectedBootstrapPeers,
+		len(addresses),
+	)
+	for _, address := range addresses {
+		state := "disconnected"
+		if status.BootstrapPeers[address] {
+			state = "connected"
+		}
+		fmt.Printf("    %v: [%v]\n", address, state)
+	}
+}
+
+func printEthereumStatus(status *ethereumStatus) {
+	fmt.Printf("ethereum:\n")
+	if status.Healthy {
+		fmt.Printf("  connection: [healthy], latency: [%v]\n", status.Latency)
+	} else {
+		fmt.Printf("  connection: [unhealthy], error: [%v]\n", status.Error)
+	}
+	fmt.Printf("  block height: [%v]\n", status.BlockHeight)
+}
+
+func printGroupsStatus(groups []*registry.GroupMemberships) {
+	fmt.Printf("groups:\n")
+	if len(groups) == 0 {
+		fmt.Printf("  none\n")
+		return
+	}
+
+	for _, group := range groups {
+		fmt.Printf(
+			"  group: [%v], members: %v\n",
+			group.GroupPublicKey,
+			group.MemberIndexes,
+		)
+	}
+}
+
+func printRelayEntryStatus(status *relay.RelayEntryStatus) {
+	fmt.Printf("last relay entry:\n")
+	if status == nil {
+		fmt.Printf("  none observed since the client started\n")
+		return
+	}
+
+	fmt.Printf(
+		"  requested at block: [%v], submitted at block: [%v], "+
+			"observed at: [%v]\n",
+		status.RequestBlockNumber,
+		status.BlockNumber,
+		status.ObservedAt.Format(time.RFC3339),
+	)
+}
@@ -0,0 +1,171 @@
+package cmd
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"time"
+
+	"github.com/ethereum/go-ethereum/ethclient"
+	"github.com/keep-network/keep-common/pkg/chain/ethereum/ethutil"
+	"github.com/keep-network/keep-core/config"
+	"github.com/keep-network/keep-core/pkg/chain/ethereum"
+	"github.com/keep-network/keep-core/pkg/net/libp2p"
+	"github.com/urfave/cli"
+)
+
+// ValidateConfigCommand contains the definition of the validate-config
+// command-line subcommand.
+var ValidateConfigCommand cli.Command
+
+const validateConfigDescription = `The validate-config command checks the
+	configuration before the client is started. It parses the config file,
+	verifies the key files exist and decrypt with the provided passwords,
+	checks the Ethereum URLs are reachable, validates the bootstrap peer
+	multiaddresses and confirms the configured contracts respond with the
+	relay configuration. The outcome of each check is reported and the
+	command fails if any of the checks fails.`
+
+// ethereumDialTimeout is the time the validate-config command waits for an
+// Ethereum endpoint to respond.
+const ethereumDialTimeout = 10 * time.Second
+
+func init() {
+	ValidateConfigCommand = cli.Command{
+		Name:        "validate-config",
+		Usage:       `Checks the configuration before the client is started.`,
+		Description: validateConfigDescription,
+		Action:      validateConfig,
+	}
+}
+
+// configCheck is a single validation of the configuration.
+type configCheck struct {
+	name string
+	run  func() error
+}
+
+// validateConfig runs configuration checks and prints the outcome of each
+// of them.
+func validateConfig(c *cli.Context) error {
+	clientConfig, err := config.ReadConfig(
+		c.GlobalString("config"),
+		c.GlobalString("password-file"),
+	)
+	if err != nil {
+		fmt.Printf("FAIL  config file is valid: %v\n", err)
+		return fmt.Errorf("configuration checks failed")
+	}
+	fmt.Printf("PASS  config file is valid\n")
+
+	checks := []configCheck{
+		{
+			name: "operator key file decrypts",
+			run: func() error {
+				return checkKeyFile(
+					clientConfig.Ethereum.Account.KeyFile,
+					clientConfig.Ethereum.Account.KeyFilePassword,
+				)
+			},
+		},
+	}
+
+	checks = append(checks, configCheck{
+		name: "Ethereum URL is reachable",
+		run: func() error {
+			return checkEthereumURL(clientConfig.Ethereum.URL)
+		},
+	})
+
+	if clientConfig.Ethereum.URLRPC != "" {
+		checks = append(checks, configCheck{
+			name: "Ethereum RPC URL is reachable",
+			run: func() error {
+				return checkEthereumURL(clientConfig.Ethereum.URLRPC)
+			},
+		})
+	}
+
+	for _, peer := range clientConfig.LibP2P.Peers {
+		peer := peer
+		checks = append(checks, configCheck{
+			name: fmt.Sprintf("bootstrap peer [%v] is valid", peer),
+			run: func() error {
+				_, err := libp2p.PeerIDFromMultiaddress(peer)
+				return err
+			},
+		})
+	}
+
+	failed := 0
+	for _, check := range checks {
+		if err := check.run(); err != nil {
+			failed++
+			fmt.Printf("FAIL  %v: %v\n", check.name, err)
+			continue
+		}
+
+		fmt.Printf("PASS  %v\n", check.name)
+	}
+
+	// Contracts can be queried only once the key file and the Ethereum
+	// connection are known to work.
+	if failed == 0 {
+		if err := checkContracts(clientConfig); err != nil {
+			failed++
+			fmt.Printf("FAIL  contracts respond with relay config: %v\n", err)
+		} else {
+			fmt.Printf("PASS  contracts respond with relay config\n")
+		}
+	} else {
+		fmt.Printf("SKIP  contracts respond with relay config\n")
+	}
+
+	if failed > 0 {
+		return fmt.Errorf("[%v] configuration checks failed", failed)
+	}
+
+	return nil
+}
+
+func checkKeyFile(keyFile string, password string) error {
+	if _, err := os.Stat(keyFile); err != nil {
+		return fmt.Errorf("could not access key file: [%v]", err)
+	}
+
+	if _, err := ethutil.DecryptKeyFile(keyFile, password); err != nil {
+		return fmt.Errorf("could not decrypt key file: [%v]", err)
+	}
+
+	return nil
+}
+
+func checkEthereumURL(url string) error {
+	ctx, cancelCtx := context.WithTimeout(
+		context.Background(),
+		ethereumDialTimeout,
+	)
+	defer cancelCtx()
+
+	client, err := ethclient.DialContext(ctx, url)
+	if err != nil {
+		return fmt.Errorf("could not connect to [%v]: [%v]", url, err)
+	}
+	defer client.Close()
+
+	if _, err := client.ChainID(ctx); err != nil {
+		return fmt.Errorf("no response from [%v]: [%v]", url, err)
+	}
+
+	return nil
+}
+
+func checkContracts(clientConfig *config.Config) error {
+	chainHandle, err := ethereum.Connect(clientConfig.Ethereum)
+	if err != nil {
+		return err
+	}
+
+	_, err = chainHandle.ThresholdRelay().GetConfig()
+	return err
+}
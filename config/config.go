@@ -2,28 +2,121 @@ package config
 
 import (
 	"fmt"
+	"io/ioutil"
 	"os"
 	"strings"
 	"syscall"
+	"time"
 
 	"github.com/BurntSushi/toml"
+	"github.com/ipfs/go-log"
 	"github.com/keep-network/keep-common/pkg/chain/ethereum"
+	relayconfig "github.com/keep-network/keep-core/pkg/beacon/relay/config"
+	ethereumChain "github.com/keep-network/keep-core/pkg/chain/ethereum"
+	"github.com/keep-network/keep-core/pkg/metrics"
 	"github.com/keep-network/keep-core/pkg/net/libp2p"
 	"golang.org/x/crypto/ssh/terminal"
 )
 
+var logger = log.Logger("keep-config")
+
 const passwordEnvVariable = "KEEP_ETHEREUM_PASSWORD"
 
 // Config is the top level config structure.
 type Config struct {
-	Ethereum ethereum.Config
-	LibP2P   libp2p.Config
-	Storage  Storage
+	// Chain is the name of the chain the node runs against, either ethereum
+	// or local. Defaults to ethereum. The local chain is simulated in the
+	// process and meant for development only.
+	Chain      string
+	LocalChain LocalChain
+	Ethereum   ethereum.Config
+	// EthereumChainID is the optional ID of the chain the node is expected
+	// to connect to. If set, the node fails at startup if the connected
+	// Ethereum endpoint reports a different chain ID.
+	EthereumChainID uint64
+	// DKGResultPrivateRelay configures submission of DKG results through
+	// a private transaction relay. If not set, DKG results are submitted to
+	// the public mempool.
+	DKGResultPrivateRelay ethereumChain.PrivateRelayConfig
+	// EthereumGasPrice configures how the gas price of relay entry, ticket
+	// and DKG result submissions is determined. If not set, the gas price
+	// suggested by the Ethereum node is used.
+	EthereumGasPrice ethereumChain.GasPriceConfig
+	// EthereumEndpoints are optional alternative Ethereum endpoints the node
+	// selects the one with the lowest latency from and fails over between.
+	EthereumEndpoints EthereumEndpoints
+	// EthereumHistory configures how past Ethereum events are queried.
+	EthereumHistory ethereumChain.HistoryConfig
+	LibP2P          libp2p.Config
+	Storage         Storage
+	Relay           relayconfig.Node
+	Diagnostics     Diagnostics
+	Metrics         metrics.Config
+	// LogLevel is an optional space-delimited set of log level directives,
+	// e.g. "info keep-relay=debug", applied on top of LOG_LEVEL and the
+	// --log-level flag.
+	LogLevel string
+}
+
+// EthereumEndpoints stores configuration of Ethereum endpoints the node
+// selects from based on their health and latency.
+type EthereumEndpoints struct {
+	// URLs of the Ethereum endpoints. If set, the node probes all of them at
+	// startup and connects to the healthy one with the lowest latency instead
+	// of the one configured in the Ethereum section. The node fails over to
+	// another endpoint when the connected one errors or lags behind.
+	URLs []string
+	// ProbeIntervalSeconds is the interval in seconds at which endpoints are
+	// probed again to re-evaluate the selection. If not set,
+	// DefaultEndpointProbeInterval is used.
+	ProbeIntervalSeconds uint64
+	// ProbeTimeoutSeconds is the time in seconds after which an endpoint not
+	// responding to the probe is considered unhealthy.
+	ProbeTimeoutSeconds uint64
+	// MaxBlockLag is the number of blocks an endpoint may stay behind the most
+	// up-to-date endpoint before it is considered unhealthy. If not set,
+	// 5 blocks are allowed.
+	MaxBlockLag uint64
+}
+
+const (
+	// EthereumChainName is the name of the Ethereum chain.
+	EthereumChainName = "ethereum"
+	// LocalChainName is the name of the chain simulated in the process.
+	LocalChainName = "local"
+)
+
+// LocalChain stores configuration of the chain simulated in the process when
+// the node runs against the local chain.
+type LocalChain struct {
+	// RelayRequestIntervalBlocks is the number of blocks between relay entry
+	// requests. If not set, DefaultLocalRelayRequestInterval is used.
+	RelayRequestIntervalBlocks uint64
+}
+
+// DefaultLocalRelayRequestInterval is the default number of blocks between
+// relay entry requests on the local chain.
+const DefaultLocalRelayRequestInterval = 20
+
+// DefaultEndpointProbeInterval is the default interval at which Ethereum
+// endpoints are probed again.
+const DefaultEndpointProbeInterval = 5 * time.Minute
+
+// Diagnostics stores configuration of the diagnostics endpoint exposing the
+// internal state of the running node.
+type Diagnostics struct {
+	// Port on which the diagnostics endpoint listens. If not set, the
+	// endpoint is disabled.
+	Port int
 }
 
 // Storage stores meta-info about keeping data on disk
 type Storage struct {
 	DataDir string
+	// DataEncryptionKey is an optional hex-encoded 32-byte key used to
+	// encrypt DKG state persisted on disk. If not set, the key is derived
+	// from the operator's key file password.
+	DataEncryptionKey string
 }
 
 var (
@@ -33,32 +126,38 @@ var (
 
 // ReadConfig reads in the configuration file at `filePath` and returns the
 // valid config stored there, or an error if something fails while reading the
-// file or the config is invalid in a known way.
-func ReadConfig(filePath string) (*Config, error) {
+// file or the config is invalid in a known way. If `passwordFile` is set,
+// the operator's key file password is read from that file instead of the
+// environment.
+func ReadConfig(filePath string, passwordFile string) (*Config, error) {
+	return readConfig(filePath, func() (string, error) {
+		return readKeyFilePassword(passwordFile, readPassword)
+	})
+}
+
+// readConfig reads and validates the configuration file at `filePath` with the
+// operator's key file password determined by the given function.
+func readConfig(
+	filePath string,
+	keyFilePassword func() (string, error),
+) (*Config, error) {
 	config := &Config{}
 	if _, err := toml.DecodeFile(filePath, config); err != nil {
 		return nil, fmt.Errorf("unable to decode .toml file [%s] error [%s]", filePath, err)
 	}
 
-	envPassword := os.Getenv(passwordEnvVariable)
-	if envPassword == "prompt" {
-		var (
-			password string
-			err      error
-		)
-		if password, err = readPassword("Enter Account Password: "); err != nil {
-			return nil, err
-		}
-		config.Ethereum.Account.KeyFilePassword = password
-	} else {
-		config.Ethereum.Account.KeyFilePassword = envPassword
+	password, err := keyFilePassword()
+	if err != nil {
+		return nil, err
 	}
+	config.Ethereum.Account.KeyFilePassword = password
 
 	if config.Ethereum.Account.KeyFilePassword == "" {
 		return nil, fmt.Errorf(
-			"password is required; set in the config file, set environment "+
-				"variable %v to the password, or set the same environment "+
-				"variable to 'prompt' to be prompted for the password at startup",
+			"password is required; use the --password-file flag to read "+
+				"the password from a file, set environment variable %v to "+
+				"the password, or set the same environment variable to "+
+				"'prompt' to be prompted for the password at startup",
 			passwordEnvVariable,
 		)
 	}
@@ -67,13 +166,63 @@ func ReadConfig(filePath string) (*Config, error) {
 		return nil, fmt.Errorf("missing value for port; see node section in config file or use --port flag")
 	}
 
+	if config.LibP2P.PeerBanThreshold < 0 ||
+		config.LibP2P.PeerBanDurationSeconds < 0 {
+		return nil, fmt.Errorf(
+			"peer ban threshold and duration must not be negative",
+		)
+	}
+
 	if config.Storage.DataDir == "" {
 		return nil, fmt.Errorf("missing value for storage directory data")
 	}
 
+	if err := validateChain(config.Chain); err != nil {
+		return nil, err
+	}
+
+	if err := validateDKGSubmissionOrder(
+		config.Chain,
+		config.Relay.RotateDKGSubmissionOrder,
+	); err != nil {
+		return nil, err
+	}
+
 	return config, nil
 }
 
+// validateChain checks the chain of the given name is supported.
+func validateChain(chain string) error {
+	switch chain {
+	case "", EthereumChainName, LocalChainName:
+		return nil
+	default:
+		return fmt.Errorf(
+			"unsupported chain [%v]; use [%v] or [%v]",
+			chain,
+			EthereumChainName,
+			LocalChainName,
+		)
+	}
+}
+
+// validateDKGSubmissionOrder checks the given chain accepts DKG results
+// submitted in the rotated submission order if the rotation is enabled. The
+// operator contract on the ethereum chain requires members to submit in the
+// order of their indexes and rejects results submitted by members not yet
+// eligible in that order.
+func validateDKGSubmissionOrder(chain string, rotate bool) error {
+	if !rotate || (chain != "" && chain != EthereumChainName) {
+		return nil
+	}
+
+	return fmt.Errorf(
+		"rotation of the DKG result submission order is not supported by "+
+			"the [%v] chain; disable RotateDKGSubmissionOrder",
+		EthereumChainName,
+	)
+}
+
 // ReadEthereumConfig reads in the configuration file at `filePath` and returns
 // its contained Ethereum config, or an error if something fails while reading
 // the file.
@@ -83,7 +232,7 @@ func ReadConfig(filePath string) (*Config, error) {
 // to interact solely with Ethereum and are therefore independent of the rest of
 // the config structure.
 func ReadEthereumConfig(filePath string) (ethereum.Config, error) {
-	config, err := ReadConfig(filePath)
+	config, err := ReadConfig(filePath, "")
 	if err != nil {
 		return ethereum.Config{}, err
 	}
@@ -91,6 +240,84 @@ func ReadEthereumConfig(filePath string) (ethereum.Config, error) {
 	return config.Ethereum, nil
 }
 
+// ReadDiagnosticsConfig reads in the configuration file at `filePath` and
+// returns its contained diagnostics config, or an error if something fails
+// while reading the file.
+//
+// Unlike ReadConfig, it does not require the key file password, so that tools
+// inspecting the running client do not need access to the operator's key.
+func ReadDiagnosticsConfig(filePath string) (Diagnostics, error) {
+	config := &Config{}
+	if _, err := toml.DecodeFile(filePath, config); err != nil {
+		return Diagnostics{}, fmt.Errorf(
+			"unable to decode .toml file [%s] error [%s]",
+			filePath,
+			err,
+		)
+	}
+
+	return config.Diagnostics, nil
+}
+
+// readKeyFilePassword determines the operator's key file password. The
+// password is read from the password file if it is set. Otherwise, it is taken
+// from the password environment variable unless the variable is set to
+// 'prompt', in which case the operator is prompted for the password.
+func readKeyFilePassword(
+	passwordFile string,
+	prompt func(prompt string) (string, error),
+) (string, error) {
+	if passwordFile != "" {
+		return readPasswordFile(passwordFile)
+	}
+
+	envPassword := os.Getenv(passwordEnvVariable)
+	if envPassword == "prompt" {
+		return prompt("Enter Account Password: ")
+	}
+
+	return envPassword, nil
+}
+
+// readPasswordFile reads the password from the file at the given path with
+// the trailing newline trimmed. It warns if the file is readable by all
+// users of the system.
+func readPasswordFile(passwordFile string) (string, error) {
+	info, err := os.Stat(passwordFile)
+	if err != nil {
+		return "", fmt.Errorf(
+			"could not read password file [%v]: [%v]",
+			passwordFile,
+			err,
+		)
+	}
+
+	if isWorldReadable(info.Mode()) {
+		logger.Warningf(
+			"password file [%v] is readable by all users; "+
+				"restrict its permissions to the owner",
+			passwordFile,
+		)
+	}
+
+	content, err := ioutil.ReadFile(passwordFile)
+	if err != nil {
+		return "", fmt.Errorf(
+			"could not read password file [%v]: [%v]",
+			passwordFile,
+			err,
+		)
+	}
+
+	return strings.TrimRight(string(content), "\r\n"), nil
+}
+
+// isWorldReadable returns true if the file with the given mode can be read by
+// all users of the system.
+func isWorldReadable(mode os.FileMode) bool {
+	return mode.Perm()&0004 != 0
+}
+
 // ReadPassword prompts a user to enter a password.   The read password uses
 // the system password reading call that helps to prevent key loggers from
 // capturing the password.
@@ -1,7 +1,10 @@
 package config
 
 import (
+	"fmt"
+	"io/ioutil"
 	"os"
+	"path/filepath"
 	"reflect"
 	"testing"
 )
@@ -13,7 +16,7 @@ func TestReadConfig(t *testing.T) {
 	}
 
 	filepath := "../test/config.toml"
-	cfg, err := ReadConfig(filepath)
+	cfg, err := ReadConfig(filepath, "")
 	if err != nil {
 		t.Fatalf(
 			"failed to read test config: [%v]",
@@ -63,3 +66,263 @@ func TestReadConfig(t *testing.T) {
 	}
 
 }
+
+func TestReadDiagnosticsConfigWithoutPassword(t *testing.T) {
+	err := os.Unsetenv("KEEP_ETHEREUM_PASSWORD")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	diagnostics, err := ReadDiagnosticsConfig("../test/config.toml")
+	if err != nil {
+		t.Fatalf("failed to read test config: [%v]", err)
+	}
+
+	expectedPort := 8081
+	if diagnostics.Port != expectedPort {
+		t.Errorf(
+			"unexpected diagnostics port\nexpected: %v\nactual:   %v\n",
+			expectedPort,
+			diagnostics.Port,
+		)
+	}
+}
+
+func TestReadKeyFilePasswordPrecedence(t *testing.T) {
+	dir, err := ioutil.TempDir("", "password")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	passwordFile := filepath.Join(dir, "password")
+	err = ioutil.WriteFile(passwordFile, []byte("file-password\n"), 0600)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	prompt := func(string) (string, error) {
+		return "prompt-password", nil
+	}
+
+	var tests = map[string]struct {
+		passwordFile     string
+		envPassword      string
+		expectedPassword string
+	}{
+		"file takes precedence over env variable": {
+			passwordFile:     passwordFile,
+			envPassword:      "env-password",
+			expectedPassword: "file-password",
+		},
+		"file takes precedence over prompt": {
+			passwordFile:     passwordFile,
+			envPassword:      "prompt",
+			expectedPassword: "file-password",
+		},
+		"env variable without file": {
+			envPassword:      "env-password",
+			expectedPassword: "env-password",
+		},
+		"prompt without file": {
+			envPassword:      "prompt",
+			expectedPassword: "prompt-password",
+		},
+	}
+
+	for testName, test := range tests {
+		t.Run(testName, func(t *testing.T) {
+			err := os.Setenv(passwordEnvVariable, test.envPassword)
+			if err != nil {
+				t.Fatal(err)
+			}
+			defer os.Unsetenv(passwordEnvVariable)
+
+			password, err := readKeyFilePassword(test.passwordFile, prompt)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			if password != test.expectedPassword {
+				t.Errorf(
+					"unexpected password\nexpected: %v\nactual:   %v\n",
+					test.expectedPassword,
+					password,
+				)
+			}
+		})
+	}
+}
+
+func TestReadPasswordFileMissing(t *testing.T) {
+	_, err := readKeyFilePassword(
+		"/nonexistent/password",
+		func(string) (string, error) { return "", nil },
+	)
+
+	expectedErr := fmt.Errorf(
+		"could not read password file [/nonexistent/password]: " +
+			"[stat /nonexistent/password: no such file or directory]",
+	)
+	if !reflect.DeepEqual(expectedErr, err) {
+		t.Errorf(
+			"unexpected error\nexpected: %v\nactual:   %v\n",
+			expectedErr,
+			err,
+		)
+	}
+}
+
+func TestPasswordFilePermissionWarning(t *testing.T) {
+	dir, err := ioutil.TempDir("", "password")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	var tests = map[string]struct {
+		mode            os.FileMode
+		expectedWarning bool
+	}{
+		"readable by owner only": {
+			mode:            0600,
+			expectedWarning: false,
+		},
+		"readable by group": {
+			mode:            0640,
+			expectedWarning: false,
+		},
+		"readable by all users": {
+			mode:            0644,
+			expectedWarning: true,
+		},
+	}
+
+	for testName, test := range tests {
+		t.Run(testName, func(t *testing.T) {
+			passwordFile := filepath.Join(dir, fmt.Sprintf("%o", test.mode))
+			err := ioutil.WriteFile(passwordFile, []byte("password"), 0600)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if err := os.Chmod(passwordFile, test.mode); err != nil {
+				t.Fatal(err)
+			}
+
+			info, err := os.Stat(passwordFile)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			warning := isWorldReadable(info.Mode())
+			if warning != test.expectedWarning {
+				t.Errorf(
+					"unexpected permission warning\nexpected: %v\nactual:   %v\n",
+					test.expectedWarning,
+					warning,
+				)
+			}
+
+			password, err := readPasswordFile(passwordFile)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if password != "password" {
+				t.Errorf(
+					"unexpected password\nexpected: %v\nactual:   %v\n",
+					"password",
+					password,
+				)
+			}
+		})
+	}
+}
+
+func TestValidateChain(t *testing.T) {
+	var tests = map[string]struct {
+		chain         string
+		expectedError error
+	}{
+		"default chain": {
+			chain:         "",
+			expectedError: nil,
+		},
+		"ethereum chain": {
+			chain:         "ethereum",
+			expectedError: nil,
+		},
+		"local chain": {
+			chain:         "local",
+			expectedError: nil,
+		},
+		"unsupported chain": {
+			chain: "bitcoin",
+			expectedError: fmt.Errorf(
+				"unsupported chain [bitcoin]; use [ethereum] or [local]",
+			),
+		},
+	}
+
+	for testName, test := range tests {
+		t.Run(testName, func(t *testing.T) {
+			err := validateChain(test.chain)
+			if !reflect.DeepEqual(test.expectedError, err) {
+				t.Errorf(
+					"unexpected error\nexpected: %v\nactual:   %v\n",
+					test.expectedError,
+					err,
+				)
+			}
+		})
+	}
+}
+
+func TestValidateDKGSubmissionOrder(t *testing.T) {
+	var tests = map[string]struct {
+		chain         string
+		rotate        bool
+		expectedError error
+	}{
+		"no rotation on the ethereum chain": {
+			chain:         "ethereum",
+			rotate:        false,
+			expectedError: nil,
+		},
+		"rotation on the default chain": {
+			chain:  "",
+			rotate: true,
+			expectedError: fmt.Errorf(
+				"rotation of the DKG result submission order is not " +
+					"supported by the [ethereum] chain; disable " +
+					"RotateDKGSubmissionOrder",
+			),
+		},
+		"rotation on the ethereum chain": {
+			chain:  "ethereum",
+			rotate: true,
+			expectedError: fmt.Errorf(
+				"rotation of the DKG result submission order is not " +
+					"supported by the [ethereum] chain; disable " +
+					"RotateDKGSubmissionOrder",
+			),
+		},
+		"rotation on the local chain": {
+			chain:         "local",
+			rotate:        true,
+			expectedError: nil,
+		},
+	}
+
+	for testName, test := range tests {
+		t.Run(testName, func(t *testing.T) {
+			err := validateDKGSubmissionOrder(test.chain, test.rotate)
+			if !reflect.DeepEqual(test.expectedError, err) {
+				t.Errorf(
+					"unexpected error\nexpected: %v\nactual:   %v\n",
+					test.expectedError,
+					err,
+				)
+			}
+		})
+	}
+}
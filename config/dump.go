@@ -0,0 +1,64 @@
+package config
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+
+	"github.com/BurntSushi/toml"
+)
+
+const (
+	// TOMLFormat dumps the config in TOML, the format of the config file.
+	TOMLFormat = "toml"
+	// JSONFormat dumps the config in JSON.
+	JSONFormat = "json"
+)
+
+// redactedValue replaces values of secret config fields in the dump.
+const redactedValue = "[REDACTED]"
+
+// Redacted returns a copy of the config with values of secret fields, such as
+// key file passwords and encryption keys, replaced with a placeholder. Secret
+// fields which are not set are left empty, so that it is still visible
+// whether they are in effect.
+func (c *Config) Redacted() *Config {
+	redacted := *c
+
+	redact := func(value *string) {
+		if *value != "" {
+			*value = redactedValue
+		}
+	}
+
+	redact(&redacted.Ethereum.Account.KeyFilePassword)
+	redact(&redacted.Storage.DataEncryptionKey)
+	// Webhook URLs commonly embed the token authorizing the notification.
+	redact(&redacted.Relay.DKGSubmissionWebhookURL)
+
+	return &redacted
+}
+
+// Dump writes the config, with secrets redacted, to the writer in the given
+// format. The dumped config reflects all values resolved from the config
+// file, environment variables and overrides applied to the config.
+func Dump(config *Config, format string, writer io.Writer) error {
+	redacted := config.Redacted()
+
+	switch format {
+	case TOMLFormat:
+		if err := toml.NewEncoder(writer).Encode(redacted); err != nil {
+			return fmt.Errorf("could not encode config to TOML: [%v]", err)
+		}
+	case JSONFormat:
+		encoder := json.NewEncoder(writer)
+		encoder.SetIndent("", "  ")
+		if err := encoder.Encode(redacted); err != nil {
+			return fmt.Errorf("could not encode config to JSON: [%v]", err)
+		}
+	default:
+		return fmt.Errorf("unsupported config dump format [%v]", format)
+	}
+
+	return nil
+}
@@ -0,0 +1,111 @@
+package config
+
+import (
+	"bytes"
+	"encoding/json"
+	"os"
+	"strings"
+	"testing"
+
+	"github.com/BurntSushi/toml"
+)
+
+func TestDump(t *testing.T) {
+	var tests = map[string]struct {
+		format string
+		decode func(data []byte, config *Config) error
+	}{
+		"toml": {
+			format: TOMLFormat,
+			decode: func(data []byte, config *Config) error {
+				_, err := toml.Decode(string(data), config)
+				return err
+			},
+		},
+		"json": {
+			format: JSONFormat,
+			decode: func(data []byte, config *Config) error {
+				return json.Unmarshal(data, config)
+			},
+		},
+	}
+
+	for testName, test := range tests {
+		t.Run(testName, func(t *testing.T) {
+			err := os.Setenv("KEEP_ETHEREUM_PASSWORD", "not-my-password")
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			cfg, err := ReadConfig("../test/config.toml", "")
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			// overrides applied on top of the config file
+			cfg.LibP2P.Port = 3919
+			cfg.Storage.DataEncryptionKey = "0badc0ffee"
+			cfg.Relay.DKGSubmissionWebhookURL = "https://hooks.example.com/token"
+
+			buffer := &bytes.Buffer{}
+			if err := Dump(cfg, test.format, buffer); err != nil {
+				t.Fatal(err)
+			}
+
+			for _, secret := range []string{
+				"not-my-password",
+				"0badc0ffee",
+				"https://hooks.example.com/token",
+			} {
+				if strings.Contains(buffer.String(), secret) {
+					t.Errorf("secret [%v] not redacted in the dump", secret)
+				}
+			}
+
+			dumped := &Config{}
+			if err := test.decode(buffer.Bytes(), dumped); err != nil {
+				t.Fatal(err)
+			}
+
+			if dumped.LibP2P.Port != 3919 {
+				t.Errorf(
+					"unexpected port\nexpected: %v\nactual:   %v\n",
+					3919,
+					dumped.LibP2P.Port,
+				)
+			}
+			if dumped.Ethereum.URL != cfg.Ethereum.URL {
+				t.Errorf(
+					"unexpected Ethereum URL\nexpected: %v\nactual:   %v\n",
+					cfg.Ethereum.URL,
+					dumped.Ethereum.URL,
+				)
+			}
+			if dumped.Ethereum.Account.KeyFilePassword != redactedValue {
+				t.Errorf(
+					"unexpected key file password\nexpected: %v\nactual:   %v\n",
+					redactedValue,
+					dumped.Ethereum.Account.KeyFilePassword,
+				)
+			}
+			if dumped.Storage.DataEncryptionKey != redactedValue {
+				t.Errorf(
+					"unexpected data encryption key\nexpected: %v\nactual:   %v\n",
+					redactedValue,
+					dumped.Storage.DataEncryptionKey,
+				)
+			}
+
+			if cfg.Ethereum.Account.KeyFilePassword != "not-my-password" {
+				t.Errorf("dump must not modify the config")
+			}
+		})
+	}
+}
+
+func TestDumpUnsupportedFormat(t *testing.T) {
+	err := Dump(&Config{}, "yaml", &bytes.Buffer{})
+	if err == nil {
+		t.Fatal("expected error for unsupported format")
+	}
+}
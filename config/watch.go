@@ -0,0 +1,94 @@
+package config
+
+import (
+	"context"
+	"os"
+	"os/signal"
+	"syscall"
+	"time"
+)
+
+// FileCheckInterval is the interval at which the watched configuration file
+// is checked for modifications.
+const FileCheckInterval = 5 * time.Second
+
+// Watch re-reads the configuration file from the given path each time the
+// process receives SIGHUP or the file gets modified, and passes the new
+// configuration to the given handler. The operator's key file password is not
+// read again, so that the operator is never prompted for it; the given
+// password is used instead. Configurations which could not be read are logged
+// and ignored. Watching stops once the context is done.
+func Watch(
+	ctx context.Context,
+	filePath string,
+	keyFilePassword string,
+	handler func(config *Config),
+) {
+	hangups := make(chan os.Signal, 1)
+	signal.Notify(hangups, syscall.SIGHUP)
+
+	ticker := time.NewTicker(FileCheckInterval)
+
+	go func() {
+		defer signal.Stop(hangups)
+		defer ticker.Stop()
+
+		watch(ctx, filePath, keyFilePassword, hangups, ticker.C, handler)
+	}()
+}
+
+func watch(
+	ctx context.Context,
+	filePath string,
+	keyFilePassword string,
+	hangups <-chan os.Signal,
+	ticks <-chan time.Time,
+	handler func(config *Config),
+) {
+	lastModification := modificationTime(filePath)
+
+	reload := func(trigger string) {
+		config, err := readConfig(filePath, func() (string, error) {
+			return keyFilePassword, nil
+		})
+		if err != nil {
+			logger.Errorf(
+				"could not reload config file [%v] after %v: [%v]",
+				filePath,
+				trigger,
+				err,
+			)
+			return
+		}
+
+		logger.Infof("reloading config file [%v] after %v", filePath, trigger)
+		handler(config)
+	}
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-hangups:
+			lastModification = modificationTime(filePath)
+			reload("SIGHUP")
+		case <-ticks:
+			modification := modificationTime(filePath)
+			if modification.Equal(lastModification) {
+				continue
+			}
+
+			lastModification = modification
+			reload("file modification")
+		}
+	}
+}
+
+func modificationTime(filePath string) time.Time {
+	info, err := os.Stat(filePath)
+	if err != nil {
+		return time.Time{}
+	}
+
+	return info.ModTime()
+}
@@ -0,0 +1,81 @@
+package config
+
+import (
+	"context"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"syscall"
+	"testing"
+	"time"
+)
+
+func TestWatch(t *testing.T) {
+	content, err := ioutil.ReadFile("../test/config.toml")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	directory, err := ioutil.TempDir("", "config-watch")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(directory)
+
+	configFile := filepath.Join(directory, "config.toml")
+	if err := ioutil.WriteFile(configFile, content, 0600); err != nil {
+		t.Fatal(err)
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	hangups := make(chan os.Signal)
+	ticks := make(chan time.Time)
+	reloads := make(chan *Config)
+
+	go watch(ctx, configFile, "not-my-password", hangups, ticks, func(config *Config) {
+		reloads <- config
+	})
+
+	expectReload := func(trigger string, expected bool) {
+		select {
+		case config := <-reloads:
+			if !expected {
+				t.Errorf("unexpected reload after %v", trigger)
+			} else if config.Ethereum.Account.KeyFilePassword !=
+				"not-my-password" {
+				t.Errorf("unexpected config reloaded after %v", trigger)
+			}
+		case <-time.After(100 * time.Millisecond):
+			if expected {
+				t.Errorf("expected reload after %v", trigger)
+			}
+		}
+	}
+
+	hangups <- syscall.SIGHUP
+	expectReload("SIGHUP", true)
+
+	ticks <- time.Now()
+	expectReload("tick without modification", false)
+
+	modification := time.Now().Add(time.Minute)
+	if err := os.Chtimes(configFile, modification, modification); err != nil {
+		t.Fatal(err)
+	}
+
+	ticks <- time.Now()
+	expectReload("file modification", true)
+
+	if err := ioutil.WriteFile(configFile, []byte("["), 0600); err != nil {
+		t.Fatal(err)
+	}
+	modification = modification.Add(time.Minute)
+	if err := os.Chtimes(configFile, modification, modification); err != nil {
+		t.Fatal(err)
+	}
+
+	ticks <- time.Now()
+	expectReload("modification making the file invalid", false)
+}
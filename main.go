@@ -10,6 +10,8 @@ import (
 	"github.com/ipfs/go-log"
 	"github.com/keep-network/keep-common/pkg/logging"
 	"github.com/keep-network/keep-core/cmd"
+	"github.com/keep-network/keep-core/pkg/identifier"
+	"github.com/keep-network/keep-core/pkg/logformat"
 	"github.com/urfave/cli"
 )
 
@@ -37,6 +39,15 @@ func main() {
 		fmt.Fprintf(os.Stderr, "failed to configure logging: [%v]\n", err)
 	}
 
+	err = identifier.Configure(os.Getenv("KEEP_ID_FORMAT"))
+	if err != nil {
+		fmt.Fprintf(
+			os.Stderr,
+			"failed to configure identifier format: [%v]\n",
+			err,
+		)
+	}
+
 	app := cli.NewApp()
 	app.Name = path.Base(os.Args[0])
 	app.Usage = "CLI for The Keep Network"
@@ -56,19 +67,58 @@ func main() {
 			Destination: &configPath,
 			Usage:       "full path to the configuration file",
 		},
+		cli.StringFlag{
+			Name:  "password-file",
+			Usage: "full path to the file containing the key file password",
+		},
+		cli.StringFlag{
+			Name: "log-level",
+			Usage: "space-delimited set of log level directives applied on " +
+				"top of LOG_LEVEL, e.g. \"info keep-relay=debug\"",
+		},
+		cli.StringFlag{
+			Name:   "log-format",
+			EnvVar: "LOG_FORMAT",
+			Usage:  "format of log entries; \"console\" (default) or \"json\"",
+		},
+	}
+	app.Before = func(c *cli.Context) error {
+		if c.IsSet("log-level") {
+			if err := logging.Configure(c.String("log-level")); err != nil {
+				return fmt.Errorf("failed to configure logging: [%v]", err)
+			}
+		}
+
+		return logformat.Configure(c.String("log-format"))
 	}
 	app.Commands = []cli.Command{
 		cmd.StartCommand,
+		cmd.StatusCommand,
 		cmd.RelayCommand,
 		cmd.PingCommand,
 		cmd.EthereumCommand,
+		cmd.StateCommand,
+		cmd.ReplayCommand,
+		cmd.ReplayEligibilityCommand,
+		cmd.EstimateGasTableCommand,
+		cmd.VerifySignaturesCommand,
+		cmd.SubmissionCalldataCommand,
+		cmd.SelfTestCommand,
+		cmd.ConfigCommand,
+		cmd.KeyFileCommand,
+		cmd.ValidateConfigCommand,
 	}
 
 	cli.AppHelpTemplate = fmt.Sprintf(`%s
 ENVIRONMENT VARIABLES:
-   KEEP_ETHEREUM_PASSWORD    keep client password
+   KEEP_ETHEREUM_PASSWORD    keep client password; ignored if --password-file
+                             is set
    LOG_LEVEL                 space-delimited set of log level directives; set to
                              "help" for help
+   LOG_FORMAT                format of log entries; "console" (default) or
+                             "json"
+   KEEP_ID_FORMAT            display format of request IDs and seeds in logs
+                             and command output; "decimal" (default) or "hex"
 
 `, cli.AppHelpTemplate)
 
@@ -0,0 +1,43 @@
+package beacon
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/keep-network/keep-core/pkg/chain"
+)
+
+// StartBarrier defers the start of the beacon protocols until the given block
+// height is mined and the given wall-clock time passes. It lets all nodes of
+// a test network begin in lockstep once they are connected to each other.
+// Zero values disable the respective condition.
+type StartBarrier struct {
+	Block uint64
+	Time  time.Time
+}
+
+// Wait blocks until all conditions of the barrier are met.
+func (sb *StartBarrier) Wait(blockCounter chain.BlockCounter) error {
+	if !sb.Time.IsZero() {
+		if delay := time.Until(sb.Time); delay > 0 {
+			logger.Infof(
+				"waiting until [%v] to start the protocols",
+				sb.Time.Format(time.RFC3339),
+			)
+			time.Sleep(delay)
+		}
+	}
+
+	if sb.Block != 0 {
+		logger.Infof("waiting for block [%v] to start the protocols", sb.Block)
+		if err := blockCounter.WaitForBlockHeight(sb.Block); err != nil {
+			return fmt.Errorf(
+				"could not wait for start block [%v]: [%v]",
+				sb.Block,
+				err,
+			)
+		}
+	}
+
+	return nil
+}
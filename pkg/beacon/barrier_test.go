@@ -0,0 +1,94 @@
+package beacon
+
+import (
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/keep-network/keep-core/pkg/chain/local"
+)
+
+func TestStartBarrierWait(t *testing.T) {
+	var tests = map[string]struct {
+		startBlockOffset uint64
+		startTimeOffset  time.Duration
+		expectedMinDelay time.Duration
+	}{
+		"no barrier": {},
+		"start deferred until the given block": {
+			startBlockOffset: 2,
+			expectedMinDelay: 500 * time.Millisecond,
+		},
+		"start deferred until the given time": {
+			startTimeOffset:  700 * time.Millisecond,
+			expectedMinDelay: 700 * time.Millisecond,
+		},
+		"start time already passed": {
+			startTimeOffset: -time.Hour,
+		},
+	}
+
+	for testName, test := range tests {
+		t.Run(testName, func(t *testing.T) {
+			blockCounter, err := local.Connect(
+				5,
+				3,
+				big.NewInt(200),
+			).BlockCounter()
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			currentBlock, err := blockCounter.CurrentBlock()
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			barrier := &StartBarrier{}
+			if test.startBlockOffset != 0 {
+				barrier.Block = currentBlock + test.startBlockOffset
+			}
+			if test.startTimeOffset != 0 {
+				barrier.Time = time.Now().Add(test.startTimeOffset)
+			}
+
+			start := time.Now()
+			if err := barrier.Wait(blockCounter); err != nil {
+				t.Fatal(err)
+			}
+			delay := time.Since(start)
+
+			if delay < test.expectedMinDelay {
+				t.Errorf(
+					"start not deferred long enough\nexpected: >= %v\nactual:   %v\n",
+					test.expectedMinDelay,
+					delay,
+				)
+			}
+
+			if barrier.Block != 0 {
+				block, err := blockCounter.CurrentBlock()
+				if err != nil {
+					t.Fatal(err)
+				}
+				if block < barrier.Block {
+					t.Errorf(
+						"start not deferred until the block\n"+
+							"expected: >= %v\nactual:   %v\n",
+						barrier.Block,
+						block,
+					)
+				}
+			}
+
+			if !barrier.Time.IsZero() && time.Now().Before(barrier.Time) {
+				t.Errorf(
+					"start not deferred until the time\n"+
+						"expected: >= %v\nactual:   %v\n",
+					barrier.Time,
+					time.Now(),
+				)
+			}
+		})
+	}
+}
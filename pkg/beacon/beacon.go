@@ -3,16 +3,24 @@ package beacon
 import (
 	"context"
 	"encoding/hex"
+	"fmt"
+	"net/url"
 	"sync"
 
 	"github.com/ipfs/go-log"
 
 	"github.com/keep-network/keep-common/pkg/persistence"
 	"github.com/keep-network/keep-core/pkg/beacon/relay"
+	relaychain "github.com/keep-network/keep-core/pkg/beacon/relay/chain"
+	relayconfig "github.com/keep-network/keep-core/pkg/beacon/relay/config"
+	"github.com/keep-network/keep-core/pkg/beacon/relay/dkg"
 	"github.com/keep-network/keep-core/pkg/beacon/relay/event"
 	"github.com/keep-network/keep-core/pkg/beacon/relay/groupselection"
 	"github.com/keep-network/keep-core/pkg/beacon/relay/registry"
 	"github.com/keep-network/keep-core/pkg/chain"
+	"github.com/keep-network/keep-core/pkg/diagnostics"
+	"github.com/keep-network/keep-core/pkg/identifier"
+	"github.com/keep-network/keep-core/pkg/metrics"
 	"github.com/keep-network/keep-core/pkg/net"
 )
 
@@ -28,11 +36,13 @@ func Initialize(
 	chainHandle chain.Handle,
 	netProvider net.Provider,
 	persistence persistence.Handle,
+	dkgStorage relay.DKGStorage,
+	nodeConfig *relayconfig.Node,
+	diagnosticsRegistry *diagnostics.Registry,
+	metricsRecorder metrics.Recorder,
 ) error {
-	relayChain := chainHandle.ThresholdRelay()
-	chainConfig, err := relayChain.GetConfig()
-	if err != nil {
-		return err
+	if err := nodeConfig.Validate(); err != nil {
+		return fmt.Errorf("invalid relay configuration: [%v]", err)
 	}
 
 	stakeMonitor, err := chainHandle.StakeMonitor()
@@ -50,19 +60,98 @@ func Initialize(
 		return err
 	}
 
+	observerMode := nodeConfig.ObserverMode
+
+	relayChain := chainHandle.ThresholdRelay()
+	if observerMode {
+		logger.Warningf(
+			"running in observer mode; the node does not participate in " +
+				"group selection, DKG and relay entry signing and never " +
+				"sends transactions",
+		)
+		relayChain = relaychain.NewObserverChain(relayChain)
+	}
+
+	if nodeConfig.DryRun {
+		logger.Warningf(
+			"running in dry-run mode; the node participates in group " +
+				"selection, DKG and relay entry signing but never sends " +
+				"transactions",
+		)
+		relayChain = relaychain.NewDryRunChain(
+			relayChain,
+			staker.Address(),
+			blockCounter.CurrentBlock,
+		)
+	}
+
+	if nodeConfig.TraceChainCalls {
+		logger.Infof("tracing calls to the chain")
+		relayChain = relaychain.NewTracingChain(
+			relayChain,
+			relaychain.LogChainCall,
+		)
+	}
+
+	chainConfig, err := relayChain.GetConfig()
+	if err != nil {
+		return err
+	}
+
 	signing := chainHandle.Signing()
 
 	groupRegistry := registry.NewGroupRegistry(relayChain, persistence)
 	groupRegistry.LoadExistingGroups()
 
+	if nodeConfig.DevGroupKeyFile != "" {
+		err := importDevGroupKeys(groupRegistry, nodeConfig.DevGroupKeyFile)
+		if err != nil {
+			return fmt.Errorf("could not import group keys: [%v]", err)
+		}
+	}
+
 	node := relay.NewNode(
 		staker,
 		netProvider,
 		blockCounter,
 		chainConfig,
+		nodeConfig,
+		dkgStorage,
 		groupRegistry,
+		metricsRecorder,
 	)
 
+	go node.ConsumeDKGSubmissions(ctx, relayChain)
+	go node.FeedDKGProgress(ctx)
+
+	diagnosticsRegistry.RegisterSource("dkg", func() (interface{}, error) {
+		return node.DKGStatus()
+	})
+	diagnosticsRegistry.RegisterSource("dkgPublishers", func() (interface{}, error) {
+		return node.DKGPublishers(), nil
+	})
+	diagnosticsRegistry.RegisterSource("groups", func() (interface{}, error) {
+		return groupRegistry.Memberships(), nil
+	})
+	diagnosticsRegistry.RegisterSource("relayEntry", func() (interface{}, error) {
+		return node.LastRelayEntry(), nil
+	})
+	diagnosticsRegistry.RegisterQuery("dkgParticipations", func(
+		parameters url.Values,
+	) (interface{}, error) {
+		filter, err := dkg.ParseParticipationFilter(parameters)
+		if err != nil {
+			return nil, diagnostics.NewInvalidQueryError(err)
+		}
+		return node.DKGParticipations(filter), nil
+	})
+	diagnosticsRegistry.RegisterStream("dkg", func(handler func(event interface{})) func() {
+		subscription := node.OnDKGProgress(func(event *dkg.ProgressEvent) {
+			handler(event)
+		})
+		return subscription.Unsubscribe
+	})
+
 	pendingGroupSelections := &event.GroupSelectionTrack{
 		Data:  make(map[string]bool),
 		Mutex: &sync.Mutex{},
@@ -75,7 +164,7 @@ func Initialize(
 
 	relayChain.OnRelayEntryRequested(func(request *event.Request) {
 		previousEntry := hex.EncodeToString(request.PreviousEntry[:])
-		if node.IsInGroup(request.GroupPublicKey) {
+		if !observerMode && node.IsInGroup(request.GroupPublicKey) {
 			go func() {
 				if ok := pendingRelayRequests.Add(previousEntry); !ok {
 					logger.Errorf(
@@ -107,11 +196,22 @@ func Initialize(
 		go node.MonitorRelayEntry(
 			relayChain,
 			request.BlockNumber,
+			request.GroupPublicKey,
 			chainConfig,
 		)
 	})
 
 	relayChain.OnGroupSelectionStarted(func(event *event.GroupSelectionStart) {
+		if observerMode {
+			logger.Infof(
+				"group selection started with seed [%v] at block [%v]; "+
+					"not candidating in observer mode",
+				identifier.String(event.NewEntry),
+				event.BlockNumber,
+			)
+			return
+		}
+
 		onGroupSelected := func(group *groupselection.Result) {
 			for index, staker := range group.SelectedStakers {
 				logger.Infof(
@@ -132,8 +232,8 @@ func Initialize(
 		go func() {
 			if ok := pendingGroupSelections.Add(newEntry); !ok {
 				logger.Errorf(
-					"group selection event with seed [0x%x] has been registered already",
-					event.NewEntry,
+					"group selection event with seed [%v] has been registered already",
+					identifier.String(event.NewEntry),
 				)
 				return
 			}
@@ -141,8 +241,8 @@ func Initialize(
 			defer pendingGroupSelections.Remove(newEntry)
 
 			logger.Infof(
-				"group selection started with seed [0x%x] at block [%v]",
-				event.NewEntry,
+				"group selection started with seed [%v] at block [%v]",
+				identifier.String(event.NewEntry),
 				event.BlockNumber,
 			)
 
@@ -172,3 +272,46 @@ func Initialize(
 
 	return nil
 }
+
+// importDevGroupKeys registers group memberships from the group key file so
+// that the node can participate in relay entry signing without executing DKG.
+// FOR TESTING ONLY; see dkg.ImportThresholdSigners.
+func importDevGroupKeys(groupRegistry *registry.Groups, path string) error {
+	logger.Warningf(
+		"importing group keys from [%v]; group key import skips DKG and "+
+			"must never be used in production",
+		path,
+	)
+
+	signers, err := dkg.ImportThresholdSigners(path)
+	if err != nil {
+		return err
+	}
+
+	for _, signer := range signers {
+		alreadyRegistered := false
+		for _, membership := range groupRegistry.GetGroup(
+			signer.GroupPublicKeyBytes(),
+		) {
+			if membership.Signer.MemberID() == signer.MemberID() {
+				alreadyRegistered = true
+			}
+		}
+		if alreadyRegistered {
+			continue
+		}
+
+		channelName := hex.EncodeToString(signer.GroupPublicKeyBytesCompressed())
+		if err := groupRegistry.RegisterGroup(signer, channelName); err != nil {
+			return err
+		}
+
+		logger.Warningf(
+			"imported member [%v] of group with public key [0x%x]",
+			signer.MemberID(),
+			signer.GroupPublicKeyBytes(),
+		)
+	}
+
+	return nil
+}
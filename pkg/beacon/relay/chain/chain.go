@@ -58,6 +58,10 @@ type GroupSelectionInterface interface {
 	// GetSelectedParticipants returns `GroupSize` slice of addresses of
 	// candidates which have been selected to the currently assembling group.
 	GetSelectedParticipants() ([]StakerAddress, error)
+	// IsGroupSelectionPossible checks if a new group selection can be
+	// started on-chain. It is possible once the previous group selection
+	// and the DKG following it have either completed or timed out.
+	IsGroupSelectionPossible() (bool, error)
 }
 
 // GroupRegistrationInterface defines the subset of the relay chain interface
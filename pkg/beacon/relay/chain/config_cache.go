@@ -0,0 +1,210 @@
+package chain
+
+import (
+	"encoding/json"
+	"io/ioutil"
+	"math/big"
+	"sync"
+	"time"
+
+	"github.com/ipfs/go-log"
+	"github.com/keep-network/keep-core/pkg/beacon/relay/config"
+)
+
+var logger = log.Logger("keep-relay-chain")
+
+// ConfigCache caches the relay config fetched from the chain for the given
+// time to live. Once the cached config becomes stale, it is fetched from the
+// chain again on the next read so that all members eventually use current
+// on-chain parameters, e.g. after a governance change.
+//
+// The cache can optionally persist the last-known-good config to a file so
+// that the node can start with the persisted config if the chain is not
+// available at startup.
+type ConfigCache struct {
+	mutex sync.Mutex
+
+	fetch func() (*config.Chain, error)
+	ttl   time.Duration
+	now   func() time.Time
+
+	cached    *config.Chain
+	fetchedAt time.Time
+
+	// file is the path of the file the last-known-good config is persisted
+	// to; if empty, the config is not persisted.
+	file string
+	// cachedFromFile is set if the cached config has been read from the file
+	// and not fetched from the chain yet.
+	cachedFromFile bool
+}
+
+// NewConfigCache creates a cache of the config returned by the given fetch
+// function. The cached config is considered stale after the ttl passes.
+func NewConfigCache(
+	fetch func() (*config.Chain, error),
+	ttl time.Duration,
+) *ConfigCache {
+	return &ConfigCache{
+		fetch: fetch,
+		ttl:   ttl,
+		now:   time.Now,
+	}
+}
+
+// PersistTo makes the cache persist each config fetched from the chain to
+// the given file. If the config could not be fetched from the chain before
+// any config has been fetched, the cache falls back to the config persisted
+// in the file.
+func (cc *ConfigCache) PersistTo(file string) {
+	cc.mutex.Lock()
+	defer cc.mutex.Unlock()
+
+	cc.file = file
+}
+
+// Get returns the cached config if it is still fresh. Otherwise, it fetches
+// the config from the chain, logs parameters which have changed since the
+// last fetch and caches the new config.
+//
+// If the config could not be fetched and no config has been fetched from the
+// chain so far, the config persisted in the file is returned, if the cache
+// persists configs. The persisted config is never considered fresh so the
+// cache attempts to fetch the config from the chain on each read until it
+// succeeds.
+func (cc *ConfigCache) Get() (*config.Chain, error) {
+	cc.mutex.Lock()
+	defer cc.mutex.Unlock()
+
+	if cc.cached != nil &&
+		!cc.cachedFromFile &&
+		cc.now().Sub(cc.fetchedAt) < cc.ttl {
+		return cc.cached, nil
+	}
+
+	fetched, err := cc.fetch()
+	if err != nil {
+		if cc.file == "" || (cc.cached != nil && !cc.cachedFromFile) {
+			return nil, err
+		}
+
+		persisted, readErr := readPersistedConfig(cc.file)
+		if readErr != nil {
+			logger.Errorf(
+				"could not read chain config persisted in [%v]: [%v]",
+				cc.file,
+				readErr,
+			)
+			return nil, err
+		}
+
+		logger.Warningf(
+			"!!! could not fetch chain config: [%v]; falling back to the "+
+				"last-known-good config persisted in [%v]; the config may "+
+				"be outdated !!!",
+			err,
+			cc.file,
+		)
+
+		cc.cached = persisted
+		cc.cachedFromFile = true
+
+		return persisted, nil
+	}
+
+	if cc.cached != nil {
+		logConfigChanges(cc.cached, fetched)
+	}
+
+	cc.cached = fetched
+	cc.cachedFromFile = false
+	cc.fetchedAt = cc.now()
+
+	if cc.file != "" {
+		if err := persistConfig(cc.file, fetched); err != nil {
+			logger.Warningf(
+				"could not persist chain config to [%v]: [%v]",
+				cc.file,
+				err,
+			)
+		}
+	}
+
+	return fetched, nil
+}
+
+func persistConfig(file string, chainConfig *config.Chain) error {
+	content, err := json.Marshal(chainConfig)
+	if err != nil {
+		return err
+	}
+
+	return ioutil.WriteFile(file, content, 0600)
+}
+
+func readPersistedConfig(file string) (*config.Chain, error) {
+	content, err := ioutil.ReadFile(file)
+	if err != nil {
+		return nil, err
+	}
+
+	chainConfig := &config.Chain{}
+	if err := json.Unmarshal(content, chainConfig); err != nil {
+		return nil, err
+	}
+
+	return chainConfig, nil
+}
+
+func logConfigChanges(previous *config.Chain, current *config.Chain) {
+	logChange := func(name string, previous, current interface{}) {
+		logger.Warningf(
+			"chain config parameter [%v] changed from [%v] to [%v]",
+			name,
+			previous,
+			current,
+		)
+	}
+
+	if previous.GroupSize != current.GroupSize {
+		logChange("GroupSize", previous.GroupSize, current.GroupSize)
+	}
+	if previous.HonestThreshold != current.HonestThreshold {
+		logChange(
+			"HonestThreshold",
+			previous.HonestThreshold,
+			current.HonestThreshold,
+		)
+	}
+	if previous.TicketSubmissionTimeout != current.TicketSubmissionTimeout {
+		logChange(
+			"TicketSubmissionTimeout",
+			previous.TicketSubmissionTimeout,
+			current.TicketSubmissionTimeout,
+		)
+	}
+	if previous.ResultPublicationBlockStep != current.ResultPublicationBlockStep {
+		logChange(
+			"ResultPublicationBlockStep",
+			previous.ResultPublicationBlockStep,
+			current.ResultPublicationBlockStep,
+		)
+	}
+	if !equalOrBothNil(previous.MinimumStake, current.MinimumStake) {
+		logChange("MinimumStake", previous.MinimumStake, current.MinimumStake)
+	}
+	if previous.RelayEntryTimeout != current.RelayEntryTimeout {
+		logChange(
+			"RelayEntryTimeout",
+			previous.RelayEntryTimeout,
+			current.RelayEntryTimeout,
+		)
+	}
+}
+
+func equalOrBothNil(a, b *big.Int) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+	return a.Cmp(b) == 0
+}
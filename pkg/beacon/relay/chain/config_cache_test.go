@@ -0,0 +1,283 @@
+package chain
+
+import (
+	"fmt"
+	"io/ioutil"
+	"math/big"
+	"path/filepath"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/keep-network/keep-core/pkg/beacon/relay/config"
+)
+
+func TestConfigCacheGet(t *testing.T) {
+	ttl := 30 * time.Second
+
+	initialConfig := &config.Chain{
+		GroupSize:                  5,
+		HonestThreshold:            3,
+		ResultPublicationBlockStep: 3,
+		MinimumStake:               big.NewInt(200),
+	}
+	updatedConfig := &config.Chain{
+		GroupSize:                  5,
+		HonestThreshold:            4,
+		ResultPublicationBlockStep: 3,
+		MinimumStake:               big.NewInt(200),
+	}
+
+	var tests = map[string]struct {
+		elapsed                    time.Duration
+		refetchErr                 error
+		expectedFetches            int
+		expectedConfig             *config.Chain
+		expectedDishonestThreshold int
+		expectedErr                error
+	}{
+		"fresh config is returned from the cache": {
+			elapsed:                    ttl - time.Second,
+			expectedFetches:            1,
+			expectedConfig:             initialConfig,
+			expectedDishonestThreshold: 2,
+		},
+		"stale config is fetched again": {
+			elapsed:                    ttl,
+			expectedFetches:            2,
+			expectedConfig:             updatedConfig,
+			expectedDishonestThreshold: 1,
+		},
+		"stale config could not be fetched again": {
+			elapsed:         ttl + time.Second,
+			refetchErr:      fmt.Errorf("chain unavailable"),
+			expectedFetches: 2,
+			expectedErr:     fmt.Errorf("chain unavailable"),
+		},
+	}
+
+	for testName, test := range tests {
+		t.Run(testName, func(t *testing.T) {
+			fetches := 0
+			fetch := func() (*config.Chain, error) {
+				fetches++
+				if fetches == 1 {
+					return initialConfig, nil
+				}
+				if test.refetchErr != nil {
+					return nil, test.refetchErr
+				}
+				return updatedConfig, nil
+			}
+
+			now := time.Unix(1000, 0)
+			cache := NewConfigCache(fetch, ttl)
+			cache.now = func() time.Time { return now }
+
+			if _, err := cache.Get(); err != nil {
+				t.Fatal(err)
+			}
+
+			now = now.Add(test.elapsed)
+
+			chainConfig, err := cache.Get()
+			if !reflect.DeepEqual(test.expectedErr, err) {
+				t.Fatalf(
+					"unexpected error\nexpected: %v\nactual:   %v\n",
+					test.expectedErr,
+					err,
+				)
+			}
+
+			if test.expectedFetches != fetches {
+				t.Errorf(
+					"unexpected number of fetches\nexpected: %v\nactual:   %v\n",
+					test.expectedFetches,
+					fetches,
+				)
+			}
+
+			if test.expectedConfig == nil {
+				return
+			}
+
+			if test.expectedConfig != chainConfig {
+				t.Errorf(
+					"unexpected config\nexpected: %+v\nactual:   %+v\n",
+					test.expectedConfig,
+					chainConfig,
+				)
+			}
+
+			if test.expectedDishonestThreshold != chainConfig.DishonestThreshold() {
+				t.Errorf(
+					"unexpected dishonest threshold\nexpected: %v\nactual:   %v\n",
+					test.expectedDishonestThreshold,
+					chainConfig.DishonestThreshold(),
+				)
+			}
+		})
+	}
+}
+
+func TestConfigCacheKeepsFetchedConfigFresh(t *testing.T) {
+	ttl := 30 * time.Second
+
+	fetches := 0
+	fetch := func() (*config.Chain, error) {
+		fetches++
+		return &config.Chain{HonestThreshold: fetches}, nil
+	}
+
+	now := time.Unix(1000, 0)
+	cache := NewConfigCache(fetch, ttl)
+	cache.now = func() time.Time { return now }
+
+	if _, err := cache.Get(); err != nil {
+		t.Fatal(err)
+	}
+
+	// refetch the stale config; the fetch time should be updated so that
+	// the refetched config is cached for another ttl
+	now = now.Add(ttl)
+	if _, err := cache.Get(); err != nil {
+		t.Fatal(err)
+	}
+
+	now = now.Add(ttl - time.Second)
+	chainConfig, err := cache.Get()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if fetches != 2 {
+		t.Errorf(
+			"unexpected number of fetches\nexpected: %v\nactual:   %v\n",
+			2,
+			fetches,
+		)
+	}
+	if chainConfig.HonestThreshold != 2 {
+		t.Errorf(
+			"unexpected honest threshold\nexpected: %v\nactual:   %v\n",
+			2,
+			chainConfig.HonestThreshold,
+		)
+	}
+}
+
+func TestConfigCacheFallsBackToPersistedConfig(t *testing.T) {
+	file := configCacheFile(t)
+
+	persistedConfig := &config.Chain{
+		GroupSize:                  5,
+		HonestThreshold:            3,
+		ResultPublicationBlockStep: 3,
+		MinimumStake:               big.NewInt(200),
+	}
+	if err := persistConfig(file, persistedConfig); err != nil {
+		t.Fatal(err)
+	}
+
+	fetches := 0
+	fetch := func() (*config.Chain, error) {
+		fetches++
+		return nil, fmt.Errorf("chain unavailable")
+	}
+
+	cache := NewConfigCache(fetch, 30*time.Second)
+	cache.PersistTo(file)
+
+	chainConfig, err := cache.Get()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if !reflect.DeepEqual(persistedConfig, chainConfig) {
+		t.Errorf(
+			"unexpected config\nexpected: %+v\nactual:   %+v\n",
+			persistedConfig,
+			chainConfig,
+		)
+	}
+
+	// the persisted config is never fresh so the cache should try to fetch
+	// the config again and fall back to the persisted one again
+	if _, err := cache.Get(); err != nil {
+		t.Fatal(err)
+	}
+
+	if fetches != 2 {
+		t.Errorf(
+			"unexpected number of fetches\nexpected: %v\nactual:   %v\n",
+			2,
+			fetches,
+		)
+	}
+}
+
+func TestConfigCacheDoesNotFallBackWithoutPersistedConfig(t *testing.T) {
+	fetch := func() (*config.Chain, error) {
+		return nil, fmt.Errorf("chain unavailable")
+	}
+
+	cache := NewConfigCache(fetch, 30*time.Second)
+	cache.PersistTo(configCacheFile(t))
+
+	expectedErr := fmt.Errorf("chain unavailable")
+	_, err := cache.Get()
+	if !reflect.DeepEqual(expectedErr, err) {
+		t.Fatalf(
+			"unexpected error\nexpected: %v\nactual:   %v\n",
+			expectedErr,
+			err,
+		)
+	}
+}
+
+func TestConfigCacheRefreshesPersistedConfig(t *testing.T) {
+	file := configCacheFile(t)
+
+	if err := persistConfig(file, &config.Chain{HonestThreshold: 1}); err != nil {
+		t.Fatal(err)
+	}
+
+	fetchedConfig := &config.Chain{
+		GroupSize:                  5,
+		HonestThreshold:            4,
+		ResultPublicationBlockStep: 6,
+		MinimumStake:               big.NewInt(300),
+	}
+	fetch := func() (*config.Chain, error) {
+		return fetchedConfig, nil
+	}
+
+	cache := NewConfigCache(fetch, 30*time.Second)
+	cache.PersistTo(file)
+
+	if _, err := cache.Get(); err != nil {
+		t.Fatal(err)
+	}
+
+	persistedConfig, err := readPersistedConfig(file)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if !reflect.DeepEqual(fetchedConfig, persistedConfig) {
+		t.Errorf(
+			"unexpected persisted config\nexpected: %+v\nactual:   %+v\n",
+			fetchedConfig,
+			persistedConfig,
+		)
+	}
+}
+
+func configCacheFile(t *testing.T) string {
+	dir, err := ioutil.TempDir("", "chain-config")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	return filepath.Join(dir, "chain-config.json")
+}
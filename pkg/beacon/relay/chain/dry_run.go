@@ -0,0 +1,259 @@
+package chain
+
+import (
+	"encoding/binary"
+	"fmt"
+	"math/big"
+	"sort"
+	"sync"
+
+	"github.com/keep-network/keep-core/pkg/beacon/relay/event"
+	"github.com/keep-network/keep-core/pkg/gen/async"
+	"github.com/keep-network/keep-core/pkg/subscription"
+)
+
+// dryRunChain is a view of the relay chain replacing all submissions with
+// logged no-ops. Unlike the observer chain, it is meant for nodes taking part
+// in the off-chain protocols as usual, so that operators can validate their
+// setup without spending gas.
+//
+// Since tickets are not submitted, the view simulates the group selection
+// locally: tickets skipped by the node are merged with tickets submitted
+// on-chain as if they were submitted, so that the node takes part in DKG of
+// groups it would be selected to.
+type dryRunChain struct {
+	Interface
+
+	stakerAddress StakerAddress
+	currentBlock  func() (uint64, error)
+
+	ticketsMutex sync.Mutex
+	tickets      []uint64
+}
+
+// NewDryRunChain returns a view of the given relay chain for nodes in dry-run
+// mode. Subscriptions and reads are delegated to the given chain while all
+// submissions are logged and complete successfully without sending
+// a transaction. Tickets skipped on behalf of the staker with the given
+// address are taken into account in the group selection. Skipped submissions
+// are reported at the block returned by the given function.
+func NewDryRunChain(
+	chain Interface,
+	stakerAddress StakerAddress,
+	currentBlock func() (uint64, error),
+) Interface {
+	return &dryRunChain{
+		Interface:     chain,
+		stakerAddress: stakerAddress,
+		currentBlock:  currentBlock,
+	}
+}
+
+// OnGroupSelectionStarted forgets tickets skipped in the previous group
+// selection before the given handler is notified about the new one.
+func (drc *dryRunChain) OnGroupSelectionStarted(
+	handler func(groupSelectionStarted *event.GroupSelectionStart),
+) (subscription.EventSubscription, error) {
+	return drc.Interface.OnGroupSelectionStarted(
+		func(groupSelectionStarted *event.GroupSelectionStart) {
+			drc.ticketsMutex.Lock()
+			drc.tickets = nil
+			drc.ticketsMutex.Unlock()
+
+			handler(groupSelectionStarted)
+		},
+	)
+}
+
+func (drc *dryRunChain) SubmitTicket(
+	ticket *Ticket,
+) *async.EventGroupTicketSubmissionPromise {
+	logger.Infof(
+		"dry run: skipping submission of ticket with value [0x%x] "+
+			"for virtual staker [%v]",
+		ticket.Value,
+		ticket.Proof.VirtualStakerIndex,
+	)
+
+	drc.ticketsMutex.Lock()
+	drc.tickets = append(drc.tickets, binary.BigEndian.Uint64(ticket.Value[:]))
+	drc.ticketsMutex.Unlock()
+
+	promise := &async.EventGroupTicketSubmissionPromise{}
+	blockNumber, err := drc.currentBlock()
+	if err != nil {
+		promise.Fail(err)
+		return promise
+	}
+
+	promise.Fulfill(&event.GroupTicketSubmission{
+		TicketValue: new(big.Int).SetBytes(ticket.Value[:]),
+		BlockNumber: blockNumber,
+	})
+	return promise
+}
+
+// GetSubmittedTickets returns tickets submitted on-chain along with tickets
+// skipped by the node. As the chain does, only the lowest tickets fitting
+// into the group are kept.
+func (drc *dryRunChain) GetSubmittedTickets() ([]uint64, error) {
+	submittedTickets, err := drc.Interface.GetSubmittedTickets()
+	if err != nil {
+		return nil, err
+	}
+
+	drc.ticketsMutex.Lock()
+	skippedTickets := append([]uint64{}, drc.tickets...)
+	drc.ticketsMutex.Unlock()
+
+	if len(skippedTickets) == 0 {
+		return submittedTickets, nil
+	}
+
+	config, err := drc.GetConfig()
+	if err != nil {
+		return nil, err
+	}
+
+	tickets := append(submittedTickets, skippedTickets...)
+	sort.Slice(tickets, func(i, j int) bool {
+		return tickets[i] < tickets[j]
+	})
+	if len(tickets) > config.GroupSize {
+		tickets = tickets[:config.GroupSize]
+	}
+
+	return tickets, nil
+}
+
+// GetSelectedParticipants returns participants selected on-chain with those
+// whose tickets are outranked by tickets skipped by the node replaced with
+// the node's staker, as if the skipped tickets were submitted.
+func (drc *dryRunChain) GetSelectedParticipants() ([]StakerAddress, error) {
+	drc.ticketsMutex.Lock()
+	skippedTickets := append([]uint64{}, drc.tickets...)
+	drc.ticketsMutex.Unlock()
+
+	if len(skippedTickets) == 0 {
+		return drc.Interface.GetSelectedParticipants()
+	}
+
+	config, err := drc.GetConfig()
+	if err != nil {
+		return nil, err
+	}
+
+	type candidate struct {
+		ticket uint64
+		staker StakerAddress
+	}
+
+	candidates := make([]candidate, 0, config.GroupSize+len(skippedTickets))
+	for _, ticket := range skippedTickets {
+		candidates = append(candidates, candidate{ticket, drc.stakerAddress})
+	}
+
+	submittedTickets, err := drc.Interface.GetSubmittedTickets()
+	if err != nil {
+		return nil, err
+	}
+	if len(submittedTickets) > 0 {
+		// The chain selects participants in the ascending order of their
+		// tickets.
+		selectedParticipants, err := drc.Interface.GetSelectedParticipants()
+		if err != nil {
+			return nil, fmt.Errorf(
+				"could not simulate group selection: [%v]",
+				err,
+			)
+		}
+		if len(selectedParticipants) > len(submittedTickets) {
+			return nil, fmt.Errorf(
+				"could not simulate group selection; [%v] tickets "+
+					"submitted but [%v] participants selected",
+				len(submittedTickets),
+				len(selectedParticipants),
+			)
+		}
+
+		sort.Slice(submittedTickets, func(i, j int) bool {
+			return submittedTickets[i] < submittedTickets[j]
+		})
+		for i, staker := range selectedParticipants {
+			candidates = append(
+				candidates,
+				candidate{submittedTickets[i], staker},
+			)
+		}
+	}
+
+	if len(candidates) < config.GroupSize {
+		return nil, fmt.Errorf(
+			"could not simulate group selection; not enough tickets",
+		)
+	}
+
+	sort.SliceStable(candidates, func(i, j int) bool {
+		return candidates[i].ticket < candidates[j].ticket
+	})
+
+	selected := make([]StakerAddress, config.GroupSize)
+	for i := range selected {
+		selected[i] = candidates[i].staker
+	}
+
+	logger.Infof("dry run: simulated group selection with skipped tickets")
+
+	return selected, nil
+}
+
+func (drc *dryRunChain) SubmitRelayEntry(
+	entry []byte,
+) *async.EventEntrySubmittedPromise {
+	logger.Infof("dry run: skipping submission of relay entry [0x%x]", entry)
+
+	promise := &async.EventEntrySubmittedPromise{}
+	blockNumber, err := drc.currentBlock()
+	if err != nil {
+		promise.Fail(err)
+		return promise
+	}
+
+	promise.Fulfill(&event.EntrySubmitted{BlockNumber: blockNumber})
+	return promise
+}
+
+func (drc *dryRunChain) ReportRelayEntryTimeout() error {
+	logger.Infof("dry run: skipping relay entry timeout report")
+
+	return nil
+}
+
+func (drc *dryRunChain) SubmitDKGResult(
+	participantIndex GroupMemberIndex,
+	dkgResult *DKGResult,
+	signatures map[GroupMemberIndex][]byte,
+) *async.EventDKGResultSubmissionPromise {
+	logger.Infof(
+		"dry run: skipping submission of DKG result with group public key "+
+			"[0x%x] by member [%v] with [%v] signatures",
+		dkgResult.GroupPublicKey,
+		participantIndex,
+		len(signatures),
+	)
+
+	promise := &async.EventDKGResultSubmissionPromise{}
+	blockNumber, err := drc.currentBlock()
+	if err != nil {
+		promise.Fail(err)
+		return promise
+	}
+
+	promise.Fulfill(&event.DKGResultSubmission{
+		MemberIndex:    uint32(participantIndex),
+		GroupPublicKey: dkgResult.GroupPublicKey,
+		Misbehaved:     dkgResult.Misbehaved,
+		BlockNumber:    blockNumber,
+	})
+	return promise
+}
@@ -0,0 +1,215 @@
+package chain_test
+
+import (
+	"encoding/binary"
+	"fmt"
+	"math/big"
+	"reflect"
+	"testing"
+
+	relaychain "github.com/keep-network/keep-core/pkg/beacon/relay/chain"
+	"github.com/keep-network/keep-core/pkg/beacon/relay/event"
+	"github.com/keep-network/keep-core/pkg/chain/local"
+)
+
+var dryRunStakerAddress = relaychain.StakerAddress{9}
+
+func dryRunCurrentBlock() (uint64, error) {
+	return 42, nil
+}
+
+func TestDryRunChainSendsNoTransactions(t *testing.T) {
+	chainHandle := local.Connect(5, 3, big.NewInt(200))
+	dryRunChain := relaychain.NewDryRunChain(
+		chainHandle.ThresholdRelay(),
+		dryRunStakerAddress,
+		dryRunCurrentBlock,
+	)
+
+	previousEntry := chainHandle.GetLastRelayEntry()
+
+	assertSkipped := func(submission string, blockNumber uint64, err error) {
+		if err != nil {
+			t.Errorf("unexpected %v error: [%v]", submission, err)
+			return
+		}
+		if blockNumber != 42 {
+			t.Errorf(
+				"unexpected %v block number\nexpected: %v\nactual:   %v\n",
+				submission,
+				42,
+				blockNumber,
+			)
+		}
+	}
+
+	dryRunChain.SubmitTicket(&relaychain.Ticket{
+		Value: [8]byte{1, 2, 3},
+		Proof: &relaychain.TicketProof{
+			StakerValue:        big.NewInt(100),
+			VirtualStakerIndex: big.NewInt(1),
+		},
+	}).OnComplete(
+		func(submission *event.GroupTicketSubmission, err error) {
+			assertSkipped("ticket submission", submission.BlockNumber, err)
+		},
+	)
+	dryRunChain.SubmitRelayEntry([]byte{1, 2, 3}).OnComplete(
+		func(entry *event.EntrySubmitted, err error) {
+			assertSkipped("relay entry submission", entry.BlockNumber, err)
+		},
+	)
+	dryRunChain.SubmitDKGResult(
+		1,
+		&relaychain.DKGResult{GroupPublicKey: []byte{10, 11}},
+		map[relaychain.GroupMemberIndex][]byte{
+			1: []byte{101},
+			2: []byte{102},
+			3: []byte{103},
+		},
+	).OnComplete(
+		func(submission *event.DKGResultSubmission, err error) {
+			assertSkipped("DKG result submission", submission.BlockNumber, err)
+
+			if submission.MemberIndex != 1 {
+				t.Errorf(
+					"unexpected submitting member\n"+
+						"expected: %v\nactual:   %v\n",
+					1,
+					submission.MemberIndex,
+				)
+			}
+		},
+	)
+	if err := dryRunChain.ReportRelayEntryTimeout(); err != nil {
+		t.Errorf("unexpected relay entry timeout report error: [%v]", err)
+	}
+
+	tickets, err := chainHandle.ThresholdRelay().GetSubmittedTickets()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(tickets) != 0 {
+		t.Errorf("unexpected submitted tickets: [%v]", tickets)
+	}
+	if !reflect.DeepEqual(previousEntry, chainHandle.GetLastRelayEntry()) {
+		t.Errorf("unexpected relay entry submitted")
+	}
+	if result, _ := chainHandle.GetLastDKGResult(); result != nil {
+		t.Errorf("unexpected DKG result submitted: [%v]", result)
+	}
+	if reports := chainHandle.GetRelayEntryTimeoutReports(); len(reports) != 0 {
+		t.Errorf("unexpected relay entry timeout reports: [%v]", reports)
+	}
+}
+
+func TestDryRunChainSimulatesGroupSelection(t *testing.T) {
+	var tests = map[string]struct {
+		submittedTickets     map[uint64]int64
+		skippedTickets       []uint64
+		expectedTickets      []uint64
+		expectedParticipants []relaychain.StakerAddress
+		expectedError        error
+	}{
+		"no skipped tickets": {
+			submittedTickets: map[uint64]int64{10: 1, 30: 3, 50: 5},
+			expectedTickets:  []uint64{10, 30, 50},
+			expectedParticipants: []relaychain.StakerAddress{
+				{1}, {3}, {5},
+			},
+		},
+		"skipped ticket outranking submitted ticket": {
+			submittedTickets: map[uint64]int64{10: 1, 30: 3, 50: 5},
+			skippedTickets:   []uint64{20, 60},
+			expectedTickets:  []uint64{10, 20, 30},
+			expectedParticipants: []relaychain.StakerAddress{
+				{1}, dryRunStakerAddress, {3},
+			},
+		},
+		"skipped tickets not outranking submitted tickets": {
+			submittedTickets: map[uint64]int64{10: 1, 30: 3, 50: 5},
+			skippedTickets:   []uint64{60},
+			expectedTickets:  []uint64{10, 30, 50},
+			expectedParticipants: []relaychain.StakerAddress{
+				{1}, {3}, {5},
+			},
+		},
+		"only skipped tickets": {
+			skippedTickets:  []uint64{3, 1, 2},
+			expectedTickets: []uint64{1, 2, 3},
+			expectedParticipants: []relaychain.StakerAddress{
+				dryRunStakerAddress, dryRunStakerAddress, dryRunStakerAddress,
+			},
+		},
+		"not enough tickets": {
+			skippedTickets:  []uint64{1},
+			expectedTickets: []uint64{1},
+			expectedError: fmt.Errorf(
+				"could not simulate group selection; not enough tickets",
+			),
+		},
+	}
+
+	for testName, test := range tests {
+		t.Run(testName, func(t *testing.T) {
+			chainHandle := local.Connect(3, 2, big.NewInt(200))
+			dryRunChain := relaychain.NewDryRunChain(
+				chainHandle.ThresholdRelay(),
+				dryRunStakerAddress,
+				dryRunCurrentBlock,
+			)
+
+			submitTicket := func(
+				relayChain relaychain.Interface,
+				value uint64,
+				staker int64,
+			) {
+				ticket := &relaychain.Ticket{
+					Proof: &relaychain.TicketProof{
+						StakerValue:        big.NewInt(staker),
+						VirtualStakerIndex: big.NewInt(1),
+					},
+				}
+				binary.BigEndian.PutUint64(ticket.Value[:], value)
+				relayChain.SubmitTicket(ticket)
+			}
+
+			for value, staker := range test.submittedTickets {
+				submitTicket(chainHandle.ThresholdRelay(), value, staker)
+			}
+			for _, value := range test.skippedTickets {
+				submitTicket(dryRunChain, value, 9)
+			}
+
+			tickets, err := dryRunChain.GetSubmittedTickets()
+			if err != nil {
+				t.Fatal(err)
+			}
+			if !reflect.DeepEqual(test.expectedTickets, tickets) {
+				t.Errorf(
+					"unexpected submitted tickets\n"+
+						"expected: %v\nactual:   %v\n",
+					test.expectedTickets,
+					tickets,
+				)
+			}
+
+			participants, err := dryRunChain.GetSelectedParticipants()
+			if !reflect.DeepEqual(test.expectedError, err) {
+				t.Fatalf(
+					"unexpected error\nexpected: %v\nactual:   %v\n",
+					test.expectedError,
+					err,
+				)
+			}
+			if !reflect.DeepEqual(test.expectedParticipants, participants) {
+				t.Errorf(
+					"unexpected selected participants\n"+
+						"expected: %v\nactual:   %v\n",
+					test.expectedParticipants,
+					participants,
+				)
+			}
+		})
+	}
+}
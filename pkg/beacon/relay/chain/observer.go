@@ -0,0 +1,53 @@
+package chain
+
+import (
+	"fmt"
+
+	"github.com/keep-network/keep-core/pkg/gen/async"
+)
+
+// errObserverMode is returned for each submission attempted in observer mode.
+var errObserverMode = fmt.Errorf("submissions are disabled in observer mode")
+
+// observerChain is a read-only view of the relay chain. Events and state are
+// read from the underlying chain but no transaction is ever sent to it.
+type observerChain struct {
+	Interface
+}
+
+// NewObserverChain returns a read-only view of the given relay chain for
+// nodes in observer mode. Subscriptions and reads are delegated to the given
+// chain while all submissions fail without sending a transaction.
+func NewObserverChain(chain Interface) Interface {
+	return &observerChain{chain}
+}
+
+func (oc *observerChain) SubmitTicket(
+	ticket *Ticket,
+) *async.EventGroupTicketSubmissionPromise {
+	promise := &async.EventGroupTicketSubmissionPromise{}
+	promise.Fail(errObserverMode)
+	return promise
+}
+
+func (oc *observerChain) SubmitRelayEntry(
+	entry []byte,
+) *async.EventEntrySubmittedPromise {
+	promise := &async.EventEntrySubmittedPromise{}
+	promise.Fail(errObserverMode)
+	return promise
+}
+
+func (oc *observerChain) ReportRelayEntryTimeout() error {
+	return errObserverMode
+}
+
+func (oc *observerChain) SubmitDKGResult(
+	participantIndex GroupMemberIndex,
+	dkgResult *DKGResult,
+	signatures map[GroupMemberIndex][]byte,
+) *async.EventDKGResultSubmissionPromise {
+	promise := &async.EventDKGResultSubmissionPromise{}
+	promise.Fail(errObserverMode)
+	return promise
+}
@@ -0,0 +1,121 @@
+package chain_test
+
+import (
+	"math/big"
+	"reflect"
+	"testing"
+	"time"
+
+	relaychain "github.com/keep-network/keep-core/pkg/beacon/relay/chain"
+	"github.com/keep-network/keep-core/pkg/beacon/relay/event"
+	"github.com/keep-network/keep-core/pkg/chain/local"
+)
+
+func TestObserverChainSendsNoTransactions(t *testing.T) {
+	chainHandle := local.Connect(5, 3, big.NewInt(200))
+	observerChain := relaychain.NewObserverChain(chainHandle.ThresholdRelay())
+
+	previousEntry := chainHandle.GetLastRelayEntry()
+
+	assertFailed := func(submission string, err error) {
+		expectedError := "submissions are disabled in observer mode"
+		if err == nil || err.Error() != expectedError {
+			t.Errorf(
+				"unexpected %v error\nexpected: %v\nactual:   %v\n",
+				submission,
+				expectedError,
+				err,
+			)
+		}
+	}
+
+	observerChain.SubmitTicket(&relaychain.Ticket{}).OnComplete(
+		func(_ *event.GroupTicketSubmission, err error) {
+			assertFailed("ticket submission", err)
+		},
+	)
+	observerChain.SubmitRelayEntry([]byte{1, 2, 3}).OnComplete(
+		func(_ *event.EntrySubmitted, err error) {
+			assertFailed("relay entry submission", err)
+		},
+	)
+	observerChain.SubmitDKGResult(
+		1,
+		&relaychain.DKGResult{GroupPublicKey: []byte{10, 11}},
+		map[relaychain.GroupMemberIndex][]byte{
+			1: []byte{101},
+			2: []byte{102},
+			3: []byte{103},
+		},
+	).OnComplete(
+		func(_ *event.DKGResultSubmission, err error) {
+			assertFailed("DKG result submission", err)
+		},
+	)
+	assertFailed("relay entry timeout report", observerChain.ReportRelayEntryTimeout())
+
+	tickets, err := chainHandle.ThresholdRelay().GetSubmittedTickets()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(tickets) != 0 {
+		t.Errorf("unexpected submitted tickets: [%v]", tickets)
+	}
+	if !reflect.DeepEqual(previousEntry, chainHandle.GetLastRelayEntry()) {
+		t.Errorf("unexpected relay entry submitted")
+	}
+	if result, _ := chainHandle.GetLastDKGResult(); result != nil {
+		t.Errorf("unexpected DKG result submitted: [%v]", result)
+	}
+	if reports := chainHandle.GetRelayEntryTimeoutReports(); len(reports) != 0 {
+		t.Errorf("unexpected relay entry timeout reports: [%v]", reports)
+	}
+}
+
+func TestObserverChainTracksEvents(t *testing.T) {
+	chainHandle := local.Connect(5, 3, big.NewInt(200))
+	observerChain := relaychain.NewObserverChain(chainHandle.ThresholdRelay())
+
+	submissions := make(chan *event.DKGResultSubmission, 1)
+	subscription, err := observerChain.OnDKGResultSubmitted(
+		func(submission *event.DKGResultSubmission) {
+			submissions <- submission
+		},
+	)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer subscription.Unsubscribe()
+
+	// result submitted by other operator
+	chainHandle.ThresholdRelay().SubmitDKGResult(
+		3,
+		&relaychain.DKGResult{GroupPublicKey: []byte{10, 11}},
+		map[relaychain.GroupMemberIndex][]byte{
+			1: []byte{101},
+			2: []byte{102},
+			3: []byte{103},
+		},
+	)
+
+	select {
+	case submission := <-submissions:
+		if submission.MemberIndex != 3 {
+			t.Errorf(
+				"unexpected submitting member\nexpected: %v\nactual:   %v\n",
+				3,
+				submission.MemberIndex,
+			)
+		}
+	case <-time.After(5 * time.Second):
+		t.Fatal("DKG result submission not observed")
+	}
+
+	registered, err := observerChain.IsGroupRegistered([]byte{10, 11})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !registered {
+		t.Errorf("group registered by other operator not observed")
+	}
+}
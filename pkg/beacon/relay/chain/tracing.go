@@ -0,0 +1,405 @@
+package chain
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/keep-network/keep-core/pkg/beacon/relay/config"
+	"github.com/keep-network/keep-core/pkg/beacon/relay/event"
+	"github.com/keep-network/keep-core/pkg/gen/async"
+	"github.com/keep-network/keep-core/pkg/subscription"
+)
+
+// ChainCall describes a single call the relay made to the chain or a single
+// event delivered to the relay by a chain subscription.
+type ChainCall struct {
+	Method string
+	// Parameters are the call parameters with sensitive data redacted.
+	Parameters string
+	// Response is the value returned by the chain; empty if the call failed.
+	Response string
+	Err      error
+	// Duration is the time the chain took to respond; zero for events.
+	Duration time.Duration
+}
+
+// ChainCallTracer is notified about each traced chain call. It is called
+// synchronously, so it must not block.
+type ChainCallTracer func(call *ChainCall)
+
+// LogChainCall logs the chain call at the debug level.
+func LogChainCall(call *ChainCall) {
+	if call.Err != nil {
+		logger.Debugf(
+			"[rpc] %v(%v) failed after [%v]: [%v]",
+			call.Method,
+			call.Parameters,
+			call.Duration,
+			call.Err,
+		)
+		return
+	}
+
+	logger.Debugf(
+		"[rpc] %v(%v) returned [%v] after [%v]",
+		call.Method,
+		call.Parameters,
+		call.Response,
+		call.Duration,
+	)
+}
+
+// tracingChain is a view of the relay chain reporting every call made to the
+// underlying chain, along with its response and latency, to a tracer.
+type tracingChain struct {
+	Interface
+	tracer ChainCallTracer
+}
+
+// NewTracingChain returns a view of the given relay chain reporting each call
+// made to it and each event delivered by its subscriptions to the given
+// tracer. DKG result signatures are redacted from traced parameters. Calls
+// not reaching the chain, such as reading the operator keys or calculating
+// the DKG result hash, are not traced.
+//
+// The returned chain also provides transaction receipts and past DKG result
+// submissions if the given chain provides them.
+func NewTracingChain(chain Interface, tracer ChainCallTracer) Interface {
+	return &tracingChain{chain, tracer}
+}
+
+func (tc *tracingChain) trace(
+	method string,
+	parameters string,
+	start time.Time,
+	response interface{},
+	err error,
+) {
+	call := &ChainCall{
+		Method:     method,
+		Parameters: parameters,
+		Err:        err,
+		Duration:   time.Since(start),
+	}
+	if err == nil {
+		call.Response = fmt.Sprintf("%+v", response)
+	}
+
+	tc.tracer(call)
+}
+
+func (tc *tracingChain) traceEvent(method string, event interface{}) {
+	tc.tracer(&ChainCall{
+		Method:   method + " event",
+		Response: fmt.Sprintf("%+v", event),
+	})
+}
+
+func (tc *tracingChain) GetConfig() (*config.Chain, error) {
+	start := time.Now()
+	chainConfig, err := tc.Interface.GetConfig()
+	tc.trace("GetConfig", "", start, chainConfig, err)
+	return chainConfig, err
+}
+
+func (tc *tracingChain) OnGroupSelectionStarted(
+	handler func(groupSelectionStarted *event.GroupSelectionStart),
+) (subscription.EventSubscription, error) {
+	const method = "OnGroupSelectionStarted"
+
+	start := time.Now()
+	subscription, err := tc.Interface.OnGroupSelectionStarted(
+		func(groupSelectionStarted *event.GroupSelectionStart) {
+			tc.traceEvent(method, groupSelectionStarted)
+			handler(groupSelectionStarted)
+		},
+	)
+	tc.trace(method, "", start, "subscribed", err)
+	return subscription, err
+}
+
+func (tc *tracingChain) SubmitTicket(
+	ticket *Ticket,
+) *async.EventGroupTicketSubmissionPromise {
+	parameters := fmt.Sprintf("ticket: [0x%x]", ticket.Value)
+
+	tracedPromise := &async.EventGroupTicketSubmissionPromise{}
+
+	start := time.Now()
+	tc.Interface.SubmitTicket(ticket).OnComplete(
+		func(submission *event.GroupTicketSubmission, err error) {
+			tc.trace("SubmitTicket", parameters, start, submission, err)
+
+			if err != nil {
+				tracedPromise.Fail(err)
+				return
+			}
+			tracedPromise.Fulfill(submission)
+		},
+	)
+
+	return tracedPromise
+}
+
+func (tc *tracingChain) GetSubmittedTickets() ([]uint64, error) {
+	start := time.Now()
+	tickets, err := tc.Interface.GetSubmittedTickets()
+	tc.trace("GetSubmittedTickets", "", start, tickets, err)
+	return tickets, err
+}
+
+func (tc *tracingChain) GetSelectedParticipants() ([]StakerAddress, error) {
+	start := time.Now()
+	participants, err := tc.Interface.GetSelectedParticipants()
+	tc.trace("GetSelectedParticipants", "", start, participants, err)
+	return participants, err
+}
+
+func (tc *tracingChain) OnGroupRegistered(
+	handler func(groupRegistration *event.GroupRegistration),
+) (subscription.EventSubscription, error) {
+	const method = "OnGroupRegistered"
+
+	start := time.Now()
+	subscription, err := tc.Interface.OnGroupRegistered(
+		func(groupRegistration *event.GroupRegistration) {
+			tc.traceEvent(method, groupRegistration)
+			handler(groupRegistration)
+		},
+	)
+	tc.trace(method, "", start, "subscribed", err)
+	return subscription, err
+}
+
+func (tc *tracingChain) IsStaleGroup(groupPublicKey []byte) (bool, error) {
+	start := time.Now()
+	stale, err := tc.Interface.IsStaleGroup(groupPublicKey)
+	tc.trace(
+		"IsStaleGroup",
+		fmt.Sprintf("groupPublicKey: [0x%x]", groupPublicKey),
+		start,
+		stale,
+		err,
+	)
+	return stale, err
+}
+
+func (tc *tracingChain) GetGroupMembers(
+	groupPublicKey []byte,
+) ([]StakerAddress, error) {
+	start := time.Now()
+	members, err := tc.Interface.GetGroupMembers(groupPublicKey)
+	tc.trace(
+		"GetGroupMembers",
+		fmt.Sprintf("groupPublicKey: [0x%x]", groupPublicKey),
+		start,
+		members,
+		err,
+	)
+	return members, err
+}
+
+func (tc *tracingChain) SubmitRelayEntry(
+	entry []byte,
+) *async.EventEntrySubmittedPromise {
+	parameters := fmt.Sprintf("entry: [0x%x]", entry)
+
+	tracedPromise := &async.EventEntrySubmittedPromise{}
+
+	start := time.Now()
+	tc.Interface.SubmitRelayEntry(entry).OnComplete(
+		func(submitted *event.EntrySubmitted, err error) {
+			tc.trace("SubmitRelayEntry", parameters, start, submitted, err)
+
+			if err != nil {
+				tracedPromise.Fail(err)
+				return
+			}
+			tracedPromise.Fulfill(submitted)
+		},
+	)
+
+	return tracedPromise
+}
+
+func (tc *tracingChain) OnRelayEntrySubmitted(
+	handler func(entry *event.EntrySubmitted),
+) (subscription.EventSubscription, error) {
+	const method = "OnRelayEntrySubmitted"
+
+	start := time.Now()
+	subscription, err := tc.Interface.OnRelayEntrySubmitted(
+		func(entry *event.EntrySubmitted) {
+			tc.traceEvent(method, entry)
+			handler(entry)
+		},
+	)
+	tc.trace(method, "", start, "subscribed", err)
+	return subscription, err
+}
+
+func (tc *tracingChain) OnRelayEntryRequested(
+	handler func(request *event.Request),
+) (subscription.EventSubscription, error) {
+	const method = "OnRelayEntryRequested"
+
+	start := time.Now()
+	subscription, err := tc.Interface.OnRelayEntryRequested(
+		func(request *event.Request) {
+			tc.traceEvent(method, request)
+			handler(request)
+		},
+	)
+	tc.trace(method, "", start, "subscribed", err)
+	return subscription, err
+}
+
+func (tc *tracingChain) ReportRelayEntryTimeout() error {
+	start := time.Now()
+	err := tc.Interface.ReportRelayEntryTimeout()
+	tc.trace("ReportRelayEntryTimeout", "", start, "reported", err)
+	return err
+}
+
+func (tc *tracingChain) SubmitDKGResult(
+	participantIndex GroupMemberIndex,
+	dkgResult *DKGResult,
+	signatures map[GroupMemberIndex][]byte,
+) *async.EventDKGResultSubmissionPromise {
+	parameters := fmt.Sprintf(
+		"participantIndex: [%v], groupPublicKey: [0x%x], misbehaved: [%v], "+
+			"signatures: [%v redacted]",
+		participantIndex,
+		dkgResult.GroupPublicKey,
+		dkgResult.Misbehaved,
+		len(signatures),
+	)
+
+	tracedPromise := &async.EventDKGResultSubmissionPromise{}
+
+	start := time.Now()
+	tc.Interface.SubmitDKGResult(
+		participantIndex,
+		dkgResult,
+		signatures,
+	).OnComplete(
+		func(submission *event.DKGResultSubmission, err error) {
+			tc.trace("SubmitDKGResult", parameters, start, submission, err)
+
+			if err != nil {
+				tracedPromise.Fail(err)
+				return
+			}
+			tracedPromise.Fulfill(submission)
+		},
+	)
+
+	return tracedPromise
+}
+
+func (tc *tracingChain) OnDKGResultSubmitted(
+	handler func(event *event.DKGResultSubmission),
+) (subscription.EventSubscription, error) {
+	const method = "OnDKGResultSubmitted"
+
+	start := time.Now()
+	subscription, err := tc.Interface.OnDKGResultSubmitted(
+		func(submission *event.DKGResultSubmission) {
+			tc.traceEvent(method, submission)
+			handler(submission)
+		},
+	)
+	tc.trace(method, "", start, "subscribed", err)
+	return subscription, err
+}
+
+func (tc *tracingChain) IsGroupRegistered(groupPublicKey []byte) (bool, error) {
+	start := time.Now()
+	registered, err := tc.Interface.IsGroupRegistered(groupPublicKey)
+	tc.trace(
+		"IsGroupRegistered",
+		fmt.Sprintf("groupPublicKey: [0x%x]", groupPublicKey),
+		start,
+		registered,
+		err,
+	)
+	return registered, err
+}
+
+// IsTransactionSuccessful delegates to the underlying chain if it provides
+// transaction receipts.
+func (tc *tracingChain) IsTransactionSuccessful(
+	transactionHash string,
+) (bool, error) {
+	receipts, ok := tc.Interface.(interface {
+		IsTransactionSuccessful(transactionHash string) (bool, error)
+	})
+	if !ok {
+		return false, fmt.Errorf("chain does not provide transaction receipts")
+	}
+
+	start := time.Now()
+	successful, err := receipts.IsTransactionSuccessful(transactionHash)
+	tc.trace(
+		"IsTransactionSuccessful",
+		fmt.Sprintf("transactionHash: [%v]", transactionHash),
+		start,
+		successful,
+		err,
+	)
+	return successful, err
+}
+
+// TransactionGasUsed delegates to the underlying chain if it provides
+// transaction receipts.
+func (tc *tracingChain) TransactionGasUsed(
+	transactionHash string,
+) (uint64, error) {
+	receipts, ok := tc.Interface.(interface {
+		TransactionGasUsed(transactionHash string) (uint64, error)
+	})
+	if !ok {
+		return 0, fmt.Errorf("chain does not provide transaction receipts")
+	}
+
+	start := time.Now()
+	gasUsed, err := receipts.TransactionGasUsed(transactionHash)
+	tc.trace(
+		"TransactionGasUsed",
+		fmt.Sprintf("transactionHash: [%v]", transactionHash),
+		start,
+		gasUsed,
+		err,
+	)
+	return gasUsed, err
+}
+
+// PastDKGResultSubmissions delegates to the underlying chain if it provides
+// past DKG result submissions.
+func (tc *tracingChain) PastDKGResultSubmissions(
+	startBlock uint64,
+	endBlock uint64,
+) ([]*event.DKGResultSubmission, error) {
+	history, ok := tc.Interface.(interface {
+		PastDKGResultSubmissions(
+			startBlock uint64,
+			endBlock uint64,
+		) ([]*event.DKGResultSubmission, error)
+	})
+	if !ok {
+		return nil, fmt.Errorf(
+			"chain does not provide past DKG result submissions",
+		)
+	}
+
+	start := time.Now()
+	submissions, err := history.PastDKGResultSubmissions(startBlock, endBlock)
+	tc.trace(
+		"PastDKGResultSubmissions",
+		fmt.Sprintf("startBlock: [%v], endBlock: [%v]", startBlock, endBlock),
+		start,
+		submissions,
+		err,
+	)
+	return submissions, err
+}
@@ -0,0 +1,223 @@
+package chain_test
+
+import (
+	"math/big"
+	"reflect"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	relaychain "github.com/keep-network/keep-core/pkg/beacon/relay/chain"
+	"github.com/keep-network/keep-core/pkg/beacon/relay/event"
+	"github.com/keep-network/keep-core/pkg/chain/local"
+)
+
+func TestTracingChainTracesSubmissionFlow(t *testing.T) {
+	chainHandle := local.Connect(5, 3, big.NewInt(200))
+	tracer := &chainCallRecorder{}
+	tracingChain := relaychain.NewTracingChain(
+		chainHandle.ThresholdRelay(),
+		tracer.record,
+	)
+
+	if _, err := tracingChain.GetConfig(); err != nil {
+		t.Fatal(err)
+	}
+
+	submissions := make(chan *event.DKGResultSubmission, 1)
+	subscription, err := tracingChain.OnDKGResultSubmitted(
+		func(submission *event.DKGResultSubmission) {
+			submissions <- submission
+		},
+	)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer subscription.Unsubscribe()
+
+	dkgResult := &relaychain.DKGResult{GroupPublicKey: []byte{10, 11}}
+
+	if _, err := tracingChain.IsGroupRegistered(dkgResult.GroupPublicKey); err != nil {
+		t.Fatal(err)
+	}
+
+	signature := []byte{0xaa, 0xbb, 0xcc}
+	submitted := make(chan error, 1)
+	tracingChain.SubmitDKGResult(
+		1,
+		dkgResult,
+		map[relaychain.GroupMemberIndex][]byte{
+			1: signature,
+			2: signature,
+			3: signature,
+		},
+	).OnComplete(func(_ *event.DKGResultSubmission, err error) {
+		submitted <- err
+	})
+
+	select {
+	case err := <-submitted:
+		if err != nil {
+			t.Fatalf("unexpected submission error: [%v]", err)
+		}
+	case <-time.After(5 * time.Second):
+		t.Fatal("DKG result submission not completed")
+	}
+
+	select {
+	case <-submissions:
+	case <-time.After(5 * time.Second):
+		t.Fatal("DKG result submission event not delivered")
+	}
+
+	// the submission event and the submission completion are delivered
+	// asynchronously, so only the order of synchronous calls is asserted
+	expectedMethods := []string{
+		"GetConfig",
+		"OnDKGResultSubmitted",
+		"IsGroupRegistered",
+		"OnDKGResultSubmitted event",
+		"SubmitDKGResult",
+	}
+	calls := tracer.calls()
+	methods := make([]string, len(calls))
+	for i, call := range calls {
+		methods[i] = call.Method
+	}
+	if !sameMethods(expectedMethods, methods) ||
+		!reflect.DeepEqual(expectedMethods[:3], methods[:3]) {
+		t.Errorf(
+			"unexpected traced methods\nexpected: %v\nactual:   %v\n",
+			expectedMethods,
+			methods,
+		)
+	}
+
+	submission := tracer.call("SubmitDKGResult")
+	if submission == nil {
+		t.Fatal("DKG result submission not traced")
+	}
+	expectedParameters := "participantIndex: [1], groupPublicKey: [0x0a0b], " +
+		"misbehaved: [[]], signatures: [3 redacted]"
+	if submission.Parameters != expectedParameters {
+		t.Errorf(
+			"unexpected parameters\nexpected: %v\nactual:   %v\n",
+			expectedParameters,
+			submission.Parameters,
+		)
+	}
+	for _, call := range calls {
+		if strings.Contains(call.Parameters, "aabbcc") {
+			t.Errorf("signature not redacted from call [%v]", call.Method)
+		}
+	}
+	if submission.Err != nil || submission.Response == "" {
+		t.Errorf(
+			"unexpected submission response\nerror:    %v\nresponse: %v\n",
+			submission.Err,
+			submission.Response,
+		)
+	}
+}
+
+func TestTracingChainTracesFailedCall(t *testing.T) {
+	chainHandle := local.Connect(5, 3, big.NewInt(200))
+	tracer := &chainCallRecorder{}
+	tracingChain := relaychain.NewTracingChain(
+		chainHandle.ThresholdRelay(),
+		tracer.record,
+	)
+
+	submitted := make(chan error, 1)
+	tracingChain.SubmitDKGResult(
+		1,
+		&relaychain.DKGResult{GroupPublicKey: []byte{10, 11}},
+		map[relaychain.GroupMemberIndex][]byte{1: []byte{0xaa}},
+	).OnComplete(func(_ *event.DKGResultSubmission, err error) {
+		submitted <- err
+	})
+
+	var err error
+	select {
+	case err = <-submitted:
+	case <-time.After(5 * time.Second):
+		t.Fatal("DKG result submission not completed")
+	}
+
+	expectedError := "failed to submit result with [1] signatures for " +
+		"honest threshold [3]"
+	if err == nil || err.Error() != expectedError {
+		t.Fatalf(
+			"unexpected submission error\nexpected: %v\nactual:   %v\n",
+			expectedError,
+			err,
+		)
+	}
+
+	submission := tracer.call("SubmitDKGResult")
+	if submission == nil {
+		t.Fatal("DKG result submission not traced")
+	}
+	if !reflect.DeepEqual(err, submission.Err) {
+		t.Errorf(
+			"unexpected traced error\nexpected: %v\nactual:   %v\n",
+			err,
+			submission.Err,
+		)
+	}
+	if submission.Response != "" {
+		t.Errorf("unexpected traced response: [%v]", submission.Response)
+	}
+}
+
+type chainCallRecorder struct {
+	mutex    sync.Mutex
+	recorded []*relaychain.ChainCall
+}
+
+func (ccr *chainCallRecorder) record(call *relaychain.ChainCall) {
+	ccr.mutex.Lock()
+	defer ccr.mutex.Unlock()
+
+	ccr.recorded = append(ccr.recorded, call)
+}
+
+func (ccr *chainCallRecorder) calls() []*relaychain.ChainCall {
+	ccr.mutex.Lock()
+	defer ccr.mutex.Unlock()
+
+	calls := make([]*relaychain.ChainCall, len(ccr.recorded))
+	copy(calls, ccr.recorded)
+	return calls
+}
+
+func (ccr *chainCallRecorder) call(method string) *relaychain.ChainCall {
+	for _, call := range ccr.calls() {
+		if call.Method == method {
+			return call
+		}
+	}
+	return nil
+}
+
+func sameMethods(expected []string, actual []string) bool {
+	if len(expected) != len(actual) {
+		return false
+	}
+
+	counts := make(map[string]int)
+	for _, method := range expected {
+		counts[method]++
+	}
+	for _, method := range actual {
+		counts[method]--
+	}
+	for _, count := range counts {
+		if count != 0 {
+			return false
+		}
+	}
+
+	return true
+}
@@ -1,6 +1,12 @@
 package config
 
-import "math/big"
+import (
+	"fmt"
+	"math/big"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+)
 
 // Chain contains the config data needed for the relay to operate.
 type Chain struct {
@@ -35,3 +41,416 @@ type Chain struct {
 func (c *Chain) DishonestThreshold() int {
 	return c.GroupSize - c.HonestThreshold
 }
+
+// Node contains the configuration of the relay node which is set by the
+// operator and is not read from the chain.
+type Node struct {
+	// DKGPhaseBudgets overrides the default block budgets of DKG protocol
+	// phases. The budgets must be the same for all members of the group and
+	// their sum must match the DKG duration expected by the chain. If not
+	// set, the default budgets are used.
+	DKGPhaseBudgets *DKGPhaseBudgets
+	// DKGSubmissionWebhookURL is the URL to which the node posts a JSON
+	// notification each time it completes the DKG result submission. If not
+	// set, no notifications are sent.
+	DKGSubmissionWebhookURL string
+	// DKGSubmissionQueue selects where the node outputs DKG result submission
+	// transactions. If not set, the node submits the result to the chain
+	// itself. If set to "memory", submissions are queued in memory and
+	// submitted by a dedicated submitter running in the node. If set to
+	// "nats", submissions are published to the NATS broker for an external
+	// submitter service.
+	DKGSubmissionQueue string
+	// DKGSubmissionQueueCapacity is the maximum number of submissions held
+	// by the in-memory queue. If not set, DefaultDKGSubmissionQueueCapacity
+	// is used.
+	DKGSubmissionQueueCapacity int
+	// DKGSubmissionBrokerAddress is the host:port address of the NATS broker
+	// to which DKG result submissions are published.
+	DKGSubmissionBrokerAddress string
+	// DKGSubmissionBrokerSubject is the NATS subject on which DKG result
+	// submissions are published.
+	DKGSubmissionBrokerSubject string
+	// VerifyGroupPublicKey enables checking if the group public key generated
+	// by DKG matches the key recovered from public key shares of qualified
+	// members before the result is published. The check is relatively
+	// expensive and is disabled by default.
+	VerifyGroupPublicKey bool
+	// DKGSubmissionMinConnectedFraction is the minimum fraction of the group
+	// members the node needs to be connected to for the DKG result
+	// submission. If the node is connected to fewer members, it considers
+	// itself partitioned from the group and defers the submission. Zero
+	// disables the check.
+	DKGSubmissionMinConnectedFraction float64
+	// DKGSubmissionMaxDeferralBlocks is the maximum number of blocks the node
+	// defers the DKG result submission while partitioned. Once the deadline
+	// is reached, the node submits the result regardless of connectivity.
+	DKGSubmissionMaxDeferralBlocks uint64
+	// ConfirmDKGSubmission enables confirming the node's DKG result
+	// submission by both the transaction receipt and the observed submission
+	// event. The submission is reported as failed unless the transaction
+	// succeeded and the accepted result is the one submitted by the node.
+	ConfirmDKGSubmission bool
+	// DKGSubmissionConfirmationTimeoutSeconds is the maximum number of
+	// seconds the node waits for the outcome of its confirmed DKG result
+	// submission before it reports the submission as failed. If not set,
+	// DefaultDKGSubmissionConfirmationTimeout is used.
+	DKGSubmissionConfirmationTimeoutSeconds uint64
+	// RotateDKGSubmissionOrder is a development option of the local chain.
+	// It enables rotating the order in which group members become eligible
+	// to submit the DKG result by an offset derived from the request ID, so
+	// that submission costs are spread across members. All members of the
+	// group must use the same setting. The operator contract requires members
+	// to submit in the order of their indexes, so the rotation is refused for
+	// the ethereum chain.
+	RotateDKGSubmissionOrder bool
+	// PollDKGSubmissionOnSubscriptionFailure enables falling back to polling
+	// the chain for the DKG result submission on each new block when the
+	// node could not subscribe for DKG result submission events. If not
+	// enabled, the node aborts its DKG result submission in such case.
+	PollDKGSubmissionOnSubscriptionFailure bool
+	// MinResultPublicationBlockStep is the minimum DKG result publication
+	// block step the node uses. If the chain's block step is lower, for
+	// example because of a misconfiguration, the minimum is used instead.
+	// Zero means the chain's block step is used as is.
+	MinResultPublicationBlockStep uint64
+	// DKGSubmissionCooldownSeconds is the number of seconds the node waits
+	// after its on-chain DKG result submission before it sends the next one,
+	// so that the submitting account's nonce settles. If not set,
+	// DefaultDKGSubmissionCooldown is used.
+	DKGSubmissionCooldownSeconds uint64
+	// DKGFailureResultPolicy determines what the node does with a DKG
+	// result reflecting a failed DKG, when too many members have been
+	// disqualified or marked as inactive for the group to be secure. The
+	// result is either submitted on-chain to formally record the failure
+	// (default), its submission is skipped, or its submission is skipped
+	// and the failure is reported as an alert.
+	DKGFailureResultPolicy string
+	// DKGSubmissionFallbackToLastKnownBlock enables determining the
+	// eligibility to submit the DKG result based on the last known block
+	// height, with a warning, when the current block height could not be
+	// fetched from the chain even after retries. If not enabled, the node
+	// waits for its eligibility block in such case.
+	DKGSubmissionFallbackToLastKnownBlock bool
+	// DKGSubmissionMaxSignatures is the maximum number of supporting
+	// signatures included in the DKG result submission, so that submissions
+	// for large groups stay within the block gas limit. Signatures of members
+	// with the lowest indexes are included. The chain's signature threshold
+	// is used if the value is lower. Zero means all collected signatures are
+	// included.
+	DKGSubmissionMaxSignatures int
+	// DKGSubmissionConfirmationBufferBlocks is the number of blocks before
+	// the DKG result publication deadline from which the node no longer
+	// submits the result, so that the submission transaction has time to
+	// confirm before the deadline. Zero disables the check.
+	DKGSubmissionConfirmationBufferBlocks uint64
+	// DKGSubmissionGasBudget is the maximum amount of gas the node spends on
+	// DKG result submissions per gas budget window. Zero disables the budget.
+	DKGSubmissionGasBudget uint64
+	// DKGSubmissionGasBudgetWindowSeconds is the length of the gas budget
+	// window in seconds. Defaults to one day if not set.
+	DKGSubmissionGasBudgetWindowSeconds uint64
+	// DKGSubmissionGasBudgetPolicy determines what happens with submissions
+	// that would exceed the gas budget: they are either declined (default) or
+	// deferred until the budget window resets.
+	DKGSubmissionGasBudgetPolicy string
+	// DKGSubmissionRetryAttempts is the number of times the node retries its
+	// on-chain DKG result submission failed with an error, for example
+	// because of a nonce conflict or a temporary RPC failure. Retries stop
+	// once the result is registered on the chain. Zero disables retries.
+	DKGSubmissionRetryAttempts int
+	// DKGSubmissionRetryBackoffSeconds is the number of seconds the node
+	// waits before the first retry. The backoff doubles with each retry.
+	// If not set, DefaultDKGSubmissionRetryBackoff is used.
+	DKGSubmissionRetryBackoffSeconds uint64
+	// DKGSubmissionRetryMaxBackoffSeconds is the maximum number of seconds
+	// the node waits between retries. If not set,
+	// DefaultDKGSubmissionRetryMaxBackoff is used.
+	DKGSubmissionRetryMaxBackoffSeconds uint64
+	// DKGRejectUndersizedGroups enables declining the participation in DKG
+	// of a group with fewer members than the chain's signature threshold.
+	// Such a group can not produce a valid threshold signature, so the DKG
+	// is not executed instead of producing a failure result at its end.
+	DKGRejectUndersizedGroups bool
+	// RecordDKGTranscripts enables recording a transcript of each DKG
+	// execution the node participates in. Transcripts are stored encrypted
+	// in the data directory and can be replayed with the replay command to
+	// reproduce the node's decisions. Transcripts contain the node's DKG
+	// secrets.
+	RecordDKGTranscripts bool
+	// DKGParticipationRateLimit is the maximum number of new DKG executions
+	// the node starts participating in per rate window. Zero disables the
+	// limit.
+	DKGParticipationRateLimit int
+	// DKGParticipationRateWindowSeconds is the length of the rate window in
+	// seconds. Defaults to one hour if not set.
+	DKGParticipationRateWindowSeconds uint64
+	// DKGParticipationRatePolicy determines what happens with participations
+	// exceeding the rate limit: they are either declined (default) or queued.
+	DKGParticipationRatePolicy string
+	// DKGParticipationMaxQueueWaitSeconds is the maximum time in seconds
+	// a queued participation waits for the rate limit to refill before it is
+	// declined. A participation starting after the DKG has begun is likely to
+	// be considered inactive by other members, so the wait should be short.
+	DKGParticipationMaxQueueWaitSeconds uint64
+	// DKGAllowedOperators is the list of hex-encoded addresses of operators
+	// allowed to participate in DKG. If set, DKG messages from peers whose
+	// operator is not on the list are dropped, even if the operator has been
+	// selected to the group. Meant for test and permissioned networks; all
+	// members selected to the group must be on the list or they are
+	// considered inactive. If not set, all selected operators participate.
+	DKGAllowedOperators []string
+	// ObserverMode makes the node a read-only observer. The node connects to
+	// the network, follows relay requests, group selections, DKG results and
+	// group registrations for monitoring and metrics, but never sends
+	// a transaction: it does not submit tickets, DKG results or relay entries
+	// and does not report relay entry timeouts.
+	ObserverMode bool
+	// DryRun makes the node take part in group selection, DKG and relay entry
+	// signing as usual but skip all transactions: tickets, DKG results and
+	// relay entries are logged instead of submitted and relay entry timeouts
+	// are not reported. DKG results are not handed off to the broker
+	// submission queue either. Meant for validating the setup against
+	// a production network without spending gas. Since tickets are not
+	// submitted, the node simulates the group selection locally as if they
+	// were and takes part in DKG of groups it would be selected to.
+	DryRun bool
+	// TraceChainCalls enables logging of every call the relay makes to the
+	// chain and every event delivered by chain subscriptions, along with
+	// parameters, responses and latencies, at the debug level. Meant for
+	// diagnosing issues with the chain's RPC provider.
+	TraceChainCalls bool
+	// DKGBlockHeightSkewThreshold is the maximum number of blocks the block
+	// height observed by the node may differ from the median block height
+	// observed by other members of the group before a warning is logged.
+	// Members exchange their block heights when joining the group. Zero
+	// disables the check.
+	DKGBlockHeightSkewThreshold uint64
+	// DKGPeerLivenessGraceBlocks is the number of blocks a group member may
+	// stay disconnected from the node during DKG before it is reported in
+	// the logs. The report is a diagnostic only and does not mark the member
+	// as inactive. Zero disables the tracking.
+	DKGPeerLivenessGraceBlocks uint64
+	// DevGroupKeyFile is the path to the JSON file with group key material
+	// imported on startup so that the node can sign relay entries without
+	// executing DKG. FOR TESTING ONLY; must never be set in production.
+	DevGroupKeyFile string
+}
+
+const (
+	// MemoryDKGSubmissionQueue queues DKG result submissions in memory.
+	MemoryDKGSubmissionQueue = "memory"
+	// NatsDKGSubmissionQueue publishes DKG result submissions to NATS.
+	NatsDKGSubmissionQueue = "nats"
+
+	// DefaultDKGSubmissionQueueCapacity is the default number of submissions
+	// held by the in-memory queue.
+	DefaultDKGSubmissionQueueCapacity = 100
+
+	// DeclineDKGParticipation declines DKG participations exceeding the
+	// rate limit.
+	DeclineDKGParticipation = "decline"
+	// QueueDKGParticipation queues DKG participations exceeding the rate
+	// limit until the limit refills.
+	QueueDKGParticipation = "queue"
+
+	// DeclineDKGSubmission declines DKG result submissions exceeding the gas
+	// budget.
+	DeclineDKGSubmission = "decline"
+	// DeferDKGSubmission defers DKG result submissions exceeding the gas
+	// budget until the budget window resets.
+	DeferDKGSubmission = "defer"
+
+	// SubmitDKGFailureResult submits failure results on-chain.
+	SubmitDKGFailureResult = "submit"
+	// SkipDKGFailureResult skips the submission of failure results.
+	SkipDKGFailureResult = "skip"
+	// AlertDKGFailureResult skips the submission of failure results and
+	// reports them as an alert.
+	AlertDKGFailureResult = "alert"
+
+	// DefaultDKGSubmissionCooldown is the default time the node waits after
+	// its on-chain DKG result submission before it sends the next one.
+	DefaultDKGSubmissionCooldown = 5 * time.Second
+
+	// DefaultDKGSubmissionConfirmationTimeout is the default maximum time
+	// the node waits for the outcome of its confirmed DKG result submission.
+	DefaultDKGSubmissionConfirmationTimeout = 5 * time.Minute
+
+	// DefaultDKGParticipationRateWindow is the default length of the DKG
+	// participation rate window.
+	DefaultDKGParticipationRateWindow = time.Hour
+
+	// DefaultDKGSubmissionGasBudgetWindow is the default length of the DKG
+	// result submission gas budget window.
+	DefaultDKGSubmissionGasBudgetWindow = 24 * time.Hour
+
+	// DefaultDKGSubmissionRetryBackoff is the default time the node waits
+	// before the first retry of its failed DKG result submission.
+	DefaultDKGSubmissionRetryBackoff = 2 * time.Second
+
+	// DefaultDKGSubmissionRetryMaxBackoff is the default maximum time the
+	// node waits between retries of its failed DKG result submission.
+	DefaultDKGSubmissionRetryMaxBackoff = 30 * time.Second
+)
+
+// Validate checks if the node configuration is correct.
+func (n *Node) Validate() error {
+	if n.DKGPhaseBudgets != nil {
+		if err := n.DKGPhaseBudgets.Validate(); err != nil {
+			return fmt.Errorf("invalid DKG phase budgets: [%v]", err)
+		}
+	}
+
+	switch n.DKGSubmissionQueue {
+	case "", MemoryDKGSubmissionQueue:
+	case NatsDKGSubmissionQueue:
+		if n.DKGSubmissionBrokerAddress == "" {
+			return fmt.Errorf("DKG submission broker address is required")
+		}
+		if n.DKGSubmissionBrokerSubject == "" {
+			return fmt.Errorf("DKG submission broker subject is required")
+		}
+	default:
+		return fmt.Errorf(
+			"unsupported DKG submission queue [%v]",
+			n.DKGSubmissionQueue,
+		)
+	}
+
+	if n.DKGSubmissionQueueCapacity < 0 {
+		return fmt.Errorf("DKG submission queue capacity must not be negative")
+	}
+
+	if n.DKGSubmissionMaxSignatures < 0 {
+		return fmt.Errorf("DKG submission maximum signatures must not be negative")
+	}
+
+	if n.DKGSubmissionRetryAttempts < 0 {
+		return fmt.Errorf("DKG submission retry attempts must not be negative")
+	}
+
+	if n.DKGParticipationRateLimit < 0 {
+		return fmt.Errorf("DKG participation rate limit must not be negative")
+	}
+
+	switch n.DKGParticipationRatePolicy {
+	case "", DeclineDKGParticipation, QueueDKGParticipation:
+	default:
+		return fmt.Errorf(
+			"unsupported DKG participation rate policy [%v]",
+			n.DKGParticipationRatePolicy,
+		)
+	}
+
+	switch n.DKGSubmissionGasBudgetPolicy {
+	case "", DeclineDKGSubmission, DeferDKGSubmission:
+	default:
+		return fmt.Errorf(
+			"unsupported DKG submission gas budget policy [%v]",
+			n.DKGSubmissionGasBudgetPolicy,
+		)
+	}
+
+	switch n.DKGFailureResultPolicy {
+	case "", SubmitDKGFailureResult, SkipDKGFailureResult, AlertDKGFailureResult:
+	default:
+		return fmt.Errorf(
+			"unsupported DKG failure result policy [%v]",
+			n.DKGFailureResultPolicy,
+		)
+	}
+
+	for _, operator := range n.DKGAllowedOperators {
+		if !common.IsHexAddress(operator) {
+			return fmt.Errorf(
+				"invalid DKG allowed operator address [%v]",
+				operator,
+			)
+		}
+	}
+
+	if n.DKGSubmissionMinConnectedFraction < 0 ||
+		n.DKGSubmissionMinConnectedFraction > 1 {
+		return fmt.Errorf(
+			"DKG submission minimum connected fraction must be in [0, 1] range",
+		)
+	}
+
+	if n.DryRun && n.ObserverMode {
+		return fmt.Errorf("dry run can not be enabled in observer mode")
+	}
+
+	return nil
+}
+
+// PhaseBudget is the number of blocks allotted to a single DKG protocol phase.
+type PhaseBudget struct {
+	// DelayBlocks is the number of blocks the phase waits before it is
+	// initiated, giving all other members a chance to enter the phase.
+	DelayBlocks uint64
+	// ActiveBlocks is the number of blocks during which the phase receives
+	// messages from other members.
+	ActiveBlocks uint64
+}
+
+// Blocks returns the total number of blocks allotted to the phase.
+func (pb PhaseBudget) Blocks() uint64 {
+	return pb.DelayBlocks + pb.ActiveBlocks
+}
+
+// DKGPhaseBudgets contains block budgets of all DKG protocol phases during
+// which members exchange messages. Phases without message exchange take no
+// blocks.
+type DKGPhaseBudgets struct {
+	EphemeralKeyPair       PhaseBudget
+	Commitment             PhaseBudget
+	CommitmentVerification PhaseBudget
+	PointsShare            PhaseBudget
+	PointsValidation       PhaseBudget
+	KeyReveal              PhaseBudget
+	Combination            PhaseBudget
+}
+
+// ProtocolBlocks returns the expected duration of the DKG protocol in blocks,
+// that is the sum of all phase budgets.
+func (b *DKGPhaseBudgets) ProtocolBlocks() uint64 {
+	var total uint64
+	for _, phase := range b.phases() {
+		total += phase.budget.Blocks()
+	}
+
+	return total
+}
+
+// Validate checks if all phases have a non-zero number of active blocks so
+// that members have a chance to receive messages in each phase.
+func (b *DKGPhaseBudgets) Validate() error {
+	for _, phase := range b.phases() {
+		if phase.budget.ActiveBlocks == 0 {
+			return fmt.Errorf(
+				"phase [%v] must have at least one active block",
+				phase.name,
+			)
+		}
+	}
+
+	return nil
+}
+
+type namedPhaseBudget struct {
+	name   string
+	budget PhaseBudget
+}
+
+func (b *DKGPhaseBudgets) phases() []namedPhaseBudget {
+	return []namedPhaseBudget{
+		{"EphemeralKeyPair", b.EphemeralKeyPair},
+		{"Commitment", b.Commitment},
+		{"CommitmentVerification", b.CommitmentVerification},
+		{"PointsShare", b.PointsShare},
+		{"PointsValidation", b.PointsValidation},
+		{"KeyReveal", b.KeyReveal},
+		{"Combination", b.Combination},
+	}
+}
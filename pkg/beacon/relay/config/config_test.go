@@ -0,0 +1,247 @@
+package config
+
+import (
+	"testing"
+)
+
+func TestDKGPhaseBudgetsProtocolBlocks(t *testing.T) {
+	budgets := &DKGPhaseBudgets{
+		EphemeralKeyPair:       PhaseBudget{DelayBlocks: 1, ActiveBlocks: 2},
+		Commitment:             PhaseBudget{DelayBlocks: 1, ActiveBlocks: 3},
+		CommitmentVerification: PhaseBudget{DelayBlocks: 1, ActiveBlocks: 4},
+		PointsShare:            PhaseBudget{DelayBlocks: 1, ActiveBlocks: 5},
+		PointsValidation:       PhaseBudget{DelayBlocks: 1, ActiveBlocks: 6},
+		KeyReveal:              PhaseBudget{DelayBlocks: 1, ActiveBlocks: 7},
+		Combination:            PhaseBudget{DelayBlocks: 0, ActiveBlocks: 8},
+	}
+
+	expectedBlocks := uint64(41)
+
+	if budgets.ProtocolBlocks() != expectedBlocks {
+		t.Errorf(
+			"unexpected protocol blocks\nexpected: %v\nactual:   %v\n",
+			expectedBlocks,
+			budgets.ProtocolBlocks(),
+		)
+	}
+}
+
+func TestDKGPhaseBudgetsValidate(t *testing.T) {
+	validBudget := PhaseBudget{DelayBlocks: 1, ActiveBlocks: 5}
+
+	var tests = map[string]struct {
+		modify        func(budgets *DKGPhaseBudgets)
+		expectedError bool
+	}{
+		"all phases have active blocks": {
+			modify:        func(budgets *DKGPhaseBudgets) {},
+			expectedError: false,
+		},
+		"phase without delay blocks": {
+			modify: func(budgets *DKGPhaseBudgets) {
+				budgets.Combination.DelayBlocks = 0
+			},
+			expectedError: false,
+		},
+		"phase without active blocks": {
+			modify: func(budgets *DKGPhaseBudgets) {
+				budgets.PointsShare.ActiveBlocks = 0
+			},
+			expectedError: true,
+		},
+	}
+
+	for testName, test := range tests {
+		t.Run(testName, func(t *testing.T) {
+			budgets := &DKGPhaseBudgets{
+				EphemeralKeyPair:       validBudget,
+				Commitment:             validBudget,
+				CommitmentVerification: validBudget,
+				PointsShare:            validBudget,
+				PointsValidation:       validBudget,
+				KeyReveal:              validBudget,
+				Combination:            validBudget,
+			}
+			test.modify(budgets)
+
+			err := budgets.Validate()
+			if test.expectedError != (err != nil) {
+				t.Errorf(
+					"unexpected validation result\nexpected error: %v\nactual error:   %v\n",
+					test.expectedError,
+					err,
+				)
+			}
+		})
+	}
+}
+
+func TestNodeValidateDKGSubmission(t *testing.T) {
+	var tests = map[string]struct {
+		node          *Node
+		expectedError bool
+	}{
+		"inline submission": {
+			node:          &Node{},
+			expectedError: false,
+		},
+		"memory queue": {
+			node: &Node{
+				DKGSubmissionQueue:         MemoryDKGSubmissionQueue,
+				DKGSubmissionQueueCapacity: 10,
+			},
+			expectedError: false,
+		},
+		"memory queue with negative capacity": {
+			node: &Node{
+				DKGSubmissionQueue:         MemoryDKGSubmissionQueue,
+				DKGSubmissionQueueCapacity: -1,
+			},
+			expectedError: true,
+		},
+		"nats queue": {
+			node: &Node{
+				DKGSubmissionQueue:         NatsDKGSubmissionQueue,
+				DKGSubmissionBrokerAddress: "localhost:4222",
+				DKGSubmissionBrokerSubject: "keep.dkg.submission",
+			},
+			expectedError: false,
+		},
+		"nats queue without address": {
+			node: &Node{
+				DKGSubmissionQueue:         NatsDKGSubmissionQueue,
+				DKGSubmissionBrokerSubject: "keep.dkg.submission",
+			},
+			expectedError: true,
+		},
+		"nats queue without subject": {
+			node: &Node{
+				DKGSubmissionQueue:         NatsDKGSubmissionQueue,
+				DKGSubmissionBrokerAddress: "localhost:4222",
+			},
+			expectedError: true,
+		},
+		"submission min connected fraction in range": {
+			node: &Node{
+				DKGSubmissionMinConnectedFraction: 0.5,
+			},
+			expectedError: false,
+		},
+		"submission min connected fraction out of range": {
+			node: &Node{
+				DKGSubmissionMinConnectedFraction: 1.5,
+			},
+			expectedError: true,
+		},
+		"participation rate limit with queue policy": {
+			node: &Node{
+				DKGParticipationRateLimit:  2,
+				DKGParticipationRatePolicy: QueueDKGParticipation,
+			},
+			expectedError: false,
+		},
+		"negative submission max signatures": {
+			node: &Node{
+				DKGSubmissionMaxSignatures: -1,
+			},
+			expectedError: true,
+		},
+		"negative participation rate limit": {
+			node: &Node{
+				DKGParticipationRateLimit: -1,
+			},
+			expectedError: true,
+		},
+		"unsupported participation rate policy": {
+			node: &Node{
+				DKGParticipationRateLimit:  2,
+				DKGParticipationRatePolicy: "drop",
+			},
+			expectedError: true,
+		},
+		"submission gas budget with defer policy": {
+			node: &Node{
+				DKGSubmissionGasBudget:       1000000,
+				DKGSubmissionGasBudgetPolicy: DeferDKGSubmission,
+			},
+			expectedError: false,
+		},
+		"unsupported submission gas budget policy": {
+			node: &Node{
+				DKGSubmissionGasBudget:       1000000,
+				DKGSubmissionGasBudgetPolicy: "queue",
+			},
+			expectedError: true,
+		},
+		"submission retry attempts": {
+			node: &Node{
+				DKGSubmissionRetryAttempts: 3,
+			},
+			expectedError: false,
+		},
+		"negative submission retry attempts": {
+			node: &Node{
+				DKGSubmissionRetryAttempts: -1,
+			},
+			expectedError: true,
+		},
+		"alert failure result policy": {
+			node: &Node{
+				DKGFailureResultPolicy: AlertDKGFailureResult,
+			},
+			expectedError: false,
+		},
+		"unsupported failure result policy": {
+			node: &Node{
+				DKGFailureResultPolicy: "ignore",
+			},
+			expectedError: true,
+		},
+		"allowed operators": {
+			node: &Node{
+				DKGAllowedOperators: []string{
+					"0xc2a56884538778bacd91aa5bf343bf882c5fb18b",
+				},
+			},
+			expectedError: false,
+		},
+		"invalid allowed operator": {
+			node: &Node{
+				DKGAllowedOperators: []string{"0xc2a5"},
+			},
+			expectedError: true,
+		},
+		"unsupported queue": {
+			node: &Node{
+				DKGSubmissionQueue: "kafka",
+			},
+			expectedError: true,
+		},
+		"dry run": {
+			node: &Node{
+				DryRun: true,
+			},
+			expectedError: false,
+		},
+		"dry run in observer mode": {
+			node: &Node{
+				DryRun:       true,
+				ObserverMode: true,
+			},
+			expectedError: true,
+		},
+	}
+
+	for testName, test := range tests {
+		t.Run(testName, func(t *testing.T) {
+			err := test.node.Validate()
+			if test.expectedError != (err != nil) {
+				t.Errorf(
+					"unexpected validation result\nexpected error: %v\nactual error:   %v\n",
+					test.expectedError,
+					err,
+				)
+			}
+		})
+	}
+}
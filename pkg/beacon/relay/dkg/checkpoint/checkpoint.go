@@ -0,0 +1,36 @@
+// Package checkpoint persists the state of DKG executions the node
+// participates in. All the state is encrypted at rest since it may contain
+// sensitive information about the group being formed.
+package checkpoint
+
+import (
+	"fmt"
+	"math/big"
+
+	relayChain "github.com/keep-network/keep-core/pkg/beacon/relay/chain"
+	"github.com/keep-network/keep-core/pkg/beacon/relay/group"
+)
+
+// Checkpoint represents the persisted state of the given member participating
+// in DKG for a group created with the given seed.
+type Checkpoint struct {
+	// Seed is the group selection seed identifying the DKG execution.
+	Seed *big.Int
+	// MemberIndex is the index of the member in the group.
+	MemberIndex group.MemberIndex
+	// Result is the DKG result prepared by the member for the publication.
+	// It is nil if the result has not been prepared yet.
+	Result *relayChain.DKGResult
+}
+
+// directory returns the name of the storage directory under which all
+// checkpoints for the DKG execution with the given seed are stored.
+func directory(seed *big.Int) string {
+	return fmt.Sprintf("%x", seed)
+}
+
+// fileName returns the name under which the checkpoint of the given member
+// is stored.
+func fileName(memberIndex group.MemberIndex) string {
+	return fmt.Sprintf("/member_%v", memberIndex)
+}
@@ -0,0 +1,54 @@
+package checkpoint
+
+import (
+	"fmt"
+	"math/big"
+
+	relayChain "github.com/keep-network/keep-core/pkg/beacon/relay/chain"
+	"github.com/keep-network/keep-core/pkg/beacon/relay/dkg/checkpoint/gen/pb"
+	"github.com/keep-network/keep-core/pkg/beacon/relay/group"
+)
+
+// Marshal converts Checkpoint to a byte array.
+func (c *Checkpoint) Marshal() ([]byte, error) {
+	var result *pb.DKGResult
+	if c.Result != nil {
+		result = &pb.DKGResult{
+			GroupPublicKey: c.Result.GroupPublicKey,
+			Misbehaved:     c.Result.Misbehaved,
+		}
+	}
+
+	return (&pb.Checkpoint{
+		Seed:        c.Seed.String(),
+		MemberIndex: uint32(c.MemberIndex),
+		Result:      result,
+	}).Marshal()
+}
+
+// Unmarshal converts a byte array produced by Marshal to Checkpoint.
+func (c *Checkpoint) Unmarshal(bytes []byte) error {
+	pbCheckpoint := pb.Checkpoint{}
+	if err := pbCheckpoint.Unmarshal(bytes); err != nil {
+		return err
+	}
+
+	seed, ok := new(big.Int).SetString(pbCheckpoint.Seed, 10)
+	if !ok {
+		return fmt.Errorf("could not unmarshal seed [%v]", pbCheckpoint.Seed)
+	}
+
+	var result *relayChain.DKGResult
+	if pbCheckpoint.Result != nil {
+		result = &relayChain.DKGResult{
+			GroupPublicKey: pbCheckpoint.Result.GroupPublicKey,
+			Misbehaved:     pbCheckpoint.Result.Misbehaved,
+		}
+	}
+
+	c.Seed = seed
+	c.MemberIndex = group.MemberIndex(pbCheckpoint.MemberIndex)
+	c.Result = result
+
+	return nil
+}
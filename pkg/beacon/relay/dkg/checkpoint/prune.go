@@ -0,0 +1,138 @@
+package checkpoint
+
+import (
+	"fmt"
+	"math/big"
+	"sort"
+
+	"github.com/keep-network/keep-core/pkg/beacon/relay/group"
+	"github.com/keep-network/keep-core/pkg/identifier"
+)
+
+// ResolutionChecker checks on-chain if DKG executions have been resolved.
+type ResolutionChecker interface {
+	// IsGroupSelectionPossible returns true if no group selection and no DKG
+	// is in progress on-chain.
+	IsGroupSelectionPossible() (bool, error)
+	// IsGroupRegistered checks if the group with the given public key has
+	// been registered on-chain.
+	IsGroupRegistered(groupPublicKey []byte) (bool, error)
+}
+
+// State describes all checkpoints persisted for the DKG execution with the
+// given seed.
+type State struct {
+	// Seed is the group selection seed identifying the DKG execution.
+	Seed *big.Int
+	// MemberIndexes are indexes of members with persisted checkpoints,
+	// in ascending order.
+	MemberIndexes []group.MemberIndex
+	// Resolved is true if the DKG execution has ended on-chain, either with
+	// the group registered or with the group selection or DKG timed out.
+	Resolved bool
+}
+
+// States returns the state of all DKG executions with persisted checkpoints
+// ordered by the seed. DKG execution is considered resolved once the group
+// public key from any of its checkpoints is registered on-chain or once no
+// group selection and no DKG is in progress on-chain anymore. While some
+// group selection is in progress, executions without the registered group are
+// considered pending since the chain does not tell which seed the group
+// selection in progress has been started with.
+//
+// Checkpoints which could not be read or decrypted are skipped and the errors
+// are returned along with the states of all the other checkpoints.
+func (s *Storage) States(
+	chain ResolutionChecker,
+) ([]*State, []error, error) {
+	checkpoints, skipped := s.readAll()
+
+	groupSelectionPossible, err := chain.IsGroupSelectionPossible()
+	if err != nil {
+		return nil, skipped, fmt.Errorf(
+			"could not check if group selection is in progress: [%v]",
+			err,
+		)
+	}
+
+	statesBySeed := make(map[string]*State)
+	for _, checkpoint := range checkpoints {
+		seedKey := checkpoint.Seed.String()
+
+		state, ok := statesBySeed[seedKey]
+		if !ok {
+			state = &State{
+				Seed:     checkpoint.Seed,
+				Resolved: groupSelectionPossible,
+			}
+			statesBySeed[seedKey] = state
+		}
+
+		state.MemberIndexes = append(state.MemberIndexes, checkpoint.MemberIndex)
+
+		if state.Resolved || checkpoint.Result == nil {
+			continue
+		}
+
+		registered, err := chain.IsGroupRegistered(
+			checkpoint.Result.GroupPublicKey,
+		)
+		if err != nil {
+			return nil, skipped, fmt.Errorf(
+				"could not check if group for seed [%v] is registered: [%v]",
+				identifier.String(checkpoint.Seed),
+				err,
+			)
+		}
+		state.Resolved = registered
+	}
+
+	states := make([]*State, 0, len(statesBySeed))
+	for _, state := range statesBySeed {
+		sort.Slice(state.MemberIndexes, func(i, j int) bool {
+			return state.MemberIndexes[i] < state.MemberIndexes[j]
+		})
+		states = append(states, state)
+	}
+	sort.Slice(states, func(i, j int) bool {
+		return states[i].Seed.Cmp(states[j].Seed) < 0
+	})
+
+	return states, skipped, nil
+}
+
+// Prune removes checkpoints of all DKG executions already resolved on-chain
+// and returns the states of pruned executions. If dryRun is set, nothing is
+// removed and only the states which would be pruned are returned.
+// Checkpoints which could not be read or decrypted are skipped the same way
+// as by States and the errors are returned along with the pruned states.
+func (s *Storage) Prune(
+	chain ResolutionChecker,
+	dryRun bool,
+) ([]*State, []error, error) {
+	states, skipped, err := s.States(chain)
+	if err != nil {
+		return nil, skipped, err
+	}
+
+	pruned := make([]*State, 0)
+	for _, state := range states {
+		if !state.Resolved {
+			continue
+		}
+
+		if !dryRun {
+			if err := s.Archive(state.Seed); err != nil {
+				return pruned, skipped, fmt.Errorf(
+					"could not prune checkpoints for seed [%v]: [%v]",
+					identifier.String(state.Seed),
+					err,
+				)
+			}
+		}
+
+		pruned = append(pruned, state)
+	}
+
+	return pruned, skipped, nil
+}
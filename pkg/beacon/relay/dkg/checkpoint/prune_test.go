@@ -0,0 +1,252 @@
+package checkpoint
+
+import (
+	"math/big"
+	"reflect"
+	"testing"
+
+	relayChain "github.com/keep-network/keep-core/pkg/beacon/relay/chain"
+	"github.com/keep-network/keep-core/pkg/beacon/relay/group"
+)
+
+var (
+	resolvedGroupPublicKey = []byte{100}
+	pendingGroupPublicKey  = []byte{200}
+)
+
+func TestStates(t *testing.T) {
+	var tests = map[string]struct {
+		groupSelectionPossible bool
+		expectedStates         []*State
+	}{
+		"group selection in progress": {
+			groupSelectionPossible: false,
+			expectedStates: []*State{
+				{
+					Seed:          big.NewInt(1),
+					MemberIndexes: []group.MemberIndex{1, 2},
+					Resolved:      true,
+				},
+				{
+					Seed:          big.NewInt(2),
+					MemberIndexes: []group.MemberIndex{4},
+					Resolved:      false,
+				},
+				{
+					Seed:          big.NewInt(3),
+					MemberIndexes: []group.MemberIndex{3},
+					Resolved:      true,
+				},
+			},
+		},
+		"group selection ended": {
+			groupSelectionPossible: true,
+			expectedStates: []*State{
+				{
+					Seed:          big.NewInt(1),
+					MemberIndexes: []group.MemberIndex{1, 2},
+					Resolved:      true,
+				},
+				{
+					Seed:          big.NewInt(2),
+					MemberIndexes: []group.MemberIndex{4},
+					Resolved:      true,
+				},
+				{
+					Seed:          big.NewInt(3),
+					MemberIndexes: []group.MemberIndex{3},
+					Resolved:      true,
+				},
+			},
+		},
+	}
+
+	for testName, test := range tests {
+		t.Run(testName, func(t *testing.T) {
+			storage := newStorageWithMixedStates(t)
+
+			states, skipped, err := storage.States(&resolutionCheckerMock{
+				groupSelectionPossible: test.groupSelectionPossible,
+			})
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			if len(skipped) != 0 {
+				t.Errorf("unexpected skipped checkpoints: %v", skipped)
+			}
+
+			if !reflect.DeepEqual(test.expectedStates, states) {
+				t.Errorf(
+					"unexpected states\nexpected: %v\nactual:   %v\n",
+					test.expectedStates,
+					states,
+				)
+			}
+		})
+	}
+}
+
+func TestStatesSkipsUnreadableCheckpoints(t *testing.T) {
+	storage := newStorageWithMixedStates(t)
+
+	otherKeyStorage := NewStorage(storage.handle, testOtherKey)
+	err := otherKeyStorage.Save(&Checkpoint{
+		Seed:        big.NewInt(4),
+		MemberIndex: 5,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	states, skipped, err := storage.States(&resolutionCheckerMock{})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(skipped) != 1 {
+		t.Errorf(
+			"unexpected number of skipped checkpoints\n"+
+				"expected: %v\nactual:   %v\n",
+			1,
+			len(skipped),
+		)
+	}
+
+	seeds := make([]*big.Int, 0)
+	for _, state := range states {
+		seeds = append(seeds, state.Seed)
+	}
+	expectedSeeds := []*big.Int{big.NewInt(1), big.NewInt(2), big.NewInt(3)}
+	if !reflect.DeepEqual(expectedSeeds, seeds) {
+		t.Errorf(
+			"unexpected seeds\nexpected: %v\nactual:   %v\n",
+			expectedSeeds,
+			seeds,
+		)
+	}
+}
+
+func TestPrune(t *testing.T) {
+	var tests = map[string]struct {
+		dryRun              bool
+		expectedRemaining   []*big.Int
+		expectedPrunedSeeds []*big.Int
+	}{
+		"prune": {
+			dryRun:              false,
+			expectedRemaining:   []*big.Int{big.NewInt(2)},
+			expectedPrunedSeeds: []*big.Int{big.NewInt(1), big.NewInt(3)},
+		},
+		"dry run": {
+			dryRun: true,
+			expectedRemaining: []*big.Int{
+				big.NewInt(1),
+				big.NewInt(2),
+				big.NewInt(3),
+			},
+			expectedPrunedSeeds: []*big.Int{big.NewInt(1), big.NewInt(3)},
+		},
+	}
+
+	for testName, test := range tests {
+		t.Run(testName, func(t *testing.T) {
+			storage := newStorageWithMixedStates(t)
+			chain := &resolutionCheckerMock{}
+
+			pruned, _, err := storage.Prune(chain, test.dryRun)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			prunedSeeds := make([]*big.Int, 0)
+			for _, state := range pruned {
+				prunedSeeds = append(prunedSeeds, state.Seed)
+			}
+			if !reflect.DeepEqual(test.expectedPrunedSeeds, prunedSeeds) {
+				t.Errorf(
+					"unexpected pruned seeds\nexpected: %v\nactual:   %v\n",
+					test.expectedPrunedSeeds,
+					prunedSeeds,
+				)
+			}
+
+			states, _, err := storage.States(chain)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			remaining := make([]*big.Int, 0)
+			for _, state := range states {
+				remaining = append(remaining, state.Seed)
+			}
+			if !reflect.DeepEqual(test.expectedRemaining, remaining) {
+				t.Errorf(
+					"unexpected remaining seeds\nexpected: %v\nactual:   %v\n",
+					test.expectedRemaining,
+					remaining,
+				)
+			}
+		})
+	}
+}
+
+// newStorageWithMixedStates creates a storage with checkpoints of three DKG
+// executions. Groups of executions with seeds 1 and 3 are registered
+// on-chain, the group of execution with seed 2 is not.
+func newStorageWithMixedStates(t *testing.T) *Storage {
+	storage := NewStorage(newPersistenceHandleMock(), testKey)
+
+	checkpoints := []*Checkpoint{
+		{
+			Seed:        big.NewInt(1),
+			MemberIndex: 2,
+			Result: &relayChain.DKGResult{
+				GroupPublicKey: resolvedGroupPublicKey,
+			},
+		},
+		{
+			Seed:        big.NewInt(1),
+			MemberIndex: 1,
+			Result: &relayChain.DKGResult{
+				GroupPublicKey: resolvedGroupPublicKey,
+			},
+		},
+		{
+			Seed:        big.NewInt(2),
+			MemberIndex: 4,
+			Result: &relayChain.DKGResult{
+				GroupPublicKey: pendingGroupPublicKey,
+			},
+		},
+		{
+			Seed:        big.NewInt(3),
+			MemberIndex: 3,
+			Result: &relayChain.DKGResult{
+				GroupPublicKey: resolvedGroupPublicKey,
+			},
+		},
+	}
+
+	for _, checkpoint := range checkpoints {
+		if err := storage.Save(checkpoint); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	return storage
+}
+
+type resolutionCheckerMock struct {
+	groupSelectionPossible bool
+}
+
+func (rcm *resolutionCheckerMock) IsGroupSelectionPossible() (bool, error) {
+	return rcm.groupSelectionPossible, nil
+}
+
+func (rcm *resolutionCheckerMock) IsGroupRegistered(
+	groupPublicKey []byte,
+) (bool, error) {
+	return reflect.DeepEqual(groupPublicKey, resolvedGroupPublicKey), nil
+}
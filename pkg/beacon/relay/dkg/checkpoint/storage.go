@@ -0,0 +1,194 @@
+package checkpoint
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"math/big"
+	"sync"
+
+	"github.com/keep-network/keep-common/pkg/encryption"
+	"github.com/keep-network/keep-common/pkg/persistence"
+)
+
+// KeyLength represents the byte size of the key used to encrypt checkpoints.
+const KeyLength = encryption.KeyLength
+
+// EncryptionKey returns the key used to encrypt checkpoints. If the
+// hex-encoded data encryption key is configured, it is used directly.
+// Otherwise, the key is derived from the operator's password.
+func EncryptionKey(
+	password string,
+	dataEncryptionKey string,
+) ([KeyLength]byte, error) {
+	var key [KeyLength]byte
+
+	if dataEncryptionKey == "" {
+		if password == "" {
+			return key, fmt.Errorf(
+				"either password or data encryption key is required",
+			)
+		}
+
+		return sha256.Sum256([]byte(password)), nil
+	}
+
+	keyBytes, err := hex.DecodeString(dataEncryptionKey)
+	if err != nil {
+		return key, fmt.Errorf(
+			"data encryption key is not a valid hex string: [%v]",
+			err,
+		)
+	}
+	if len(keyBytes) != KeyLength {
+		return key, fmt.Errorf(
+			"data encryption key must be exactly [%v] bytes long; has [%v]",
+			KeyLength,
+			len(keyBytes),
+		)
+	}
+
+	copy(key[:], keyBytes)
+	return key, nil
+}
+
+// Storage persists DKG checkpoints. Each checkpoint is encrypted and
+// authenticated before it is handed to the underlying persistence handle
+// so that any modification of the persisted data is detected on read.
+type Storage struct {
+	mutex sync.Mutex
+
+	handle persistence.Handle
+	box    encryption.Box
+}
+
+// NewStorage creates a checkpoint storage on top of the provided persistence
+// handle, encrypting checkpoints with the provided key.
+func NewStorage(handle persistence.Handle, key [KeyLength]byte) *Storage {
+	return &Storage{
+		handle: handle,
+		box:    encryption.NewBox(key),
+	}
+}
+
+// Save encrypts and persists the provided checkpoint, overwriting any
+// previous checkpoint of the same member for the same DKG execution.
+func (s *Storage) Save(checkpoint *Checkpoint) error {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	checkpointBytes, err := checkpoint.Marshal()
+	if err != nil {
+		return fmt.Errorf("marshalling of the checkpoint failed: [%v]", err)
+	}
+
+	encrypted, err := s.box.Encrypt(checkpointBytes)
+	if err != nil {
+		return fmt.Errorf("encryption of the checkpoint failed: [%v]", err)
+	}
+
+	return s.handle.Save(
+		encrypted,
+		directory(checkpoint.Seed),
+		fileName(checkpoint.MemberIndex),
+	)
+}
+
+// ReadAll reads, decrypts and verifies all persisted checkpoints. It fails if
+// any of the checkpoints can not be decrypted which means it was either
+// encrypted with a different key or has been tampered with.
+func (s *Storage) ReadAll() ([]*Checkpoint, error) {
+	checkpoints, errors := s.readAll()
+	if len(errors) > 0 {
+		return nil, errors[0]
+	}
+
+	return checkpoints, nil
+}
+
+// readAll reads, decrypts and verifies all persisted checkpoints. Checkpoints
+// which could not be read are skipped and the errors are returned along with
+// all the checkpoints read successfully.
+func (s *Storage) readAll() ([]*Checkpoint, []error) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	checkpoints := make([]*Checkpoint, 0)
+	errors := make([]error, 0)
+
+	dataChannel, errorChannel := s.handle.ReadAll()
+
+	// Both channels are not buffered and we do not know in what order the
+	// producer writes to them so they are read concurrently. Errors of both
+	// are collected separately and merged once both are drained.
+	readErrors := make([]error, 0)
+	var wg sync.WaitGroup
+	wg.Add(1)
+	go func() {
+		for err := range errorChannel {
+			readErrors = append(readErrors, err)
+		}
+		wg.Done()
+	}()
+
+	for descriptor := range dataChannel {
+		checkpoint, err := s.read(descriptor)
+		if err != nil {
+			errors = append(errors, err)
+			continue
+		}
+
+		checkpoints = append(checkpoints, checkpoint)
+	}
+
+	wg.Wait()
+
+	return checkpoints, append(readErrors, errors...)
+}
+
+func (s *Storage) read(
+	descriptor persistence.DataDescriptor,
+) (*Checkpoint, error) {
+	content, err := descriptor.Content()
+	if err != nil {
+		return nil, fmt.Errorf(
+			"could not read checkpoint from file [%v] in directory [%v]: [%v]",
+			descriptor.Name(),
+			descriptor.Directory(),
+			err,
+		)
+	}
+
+	decrypted, err := s.box.Decrypt(content)
+	if err != nil {
+		return nil, fmt.Errorf(
+			"could not decrypt checkpoint from file [%v] in directory [%v]; "+
+				"the file has been tampered with or encrypted with a "+
+				"different key: [%v]",
+			descriptor.Name(),
+			descriptor.Directory(),
+			err,
+		)
+	}
+
+	checkpoint := &Checkpoint{}
+	if err := checkpoint.Unmarshal(decrypted); err != nil {
+		return nil, fmt.Errorf(
+			"could not unmarshal checkpoint from file [%v] in directory [%v]: [%v]",
+			descriptor.Name(),
+			descriptor.Directory(),
+			err,
+		)
+	}
+
+	return checkpoint, nil
+}
+
+// Archive marks all checkpoints of the DKG execution with the given seed as
+// archived so they are no longer returned from ReadAll.
+func (s *Storage) Archive(seed *big.Int) error {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	return s.handle.Archive(directory(seed))
+}
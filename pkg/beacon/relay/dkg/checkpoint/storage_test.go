@@ -0,0 +1,303 @@
+package checkpoint
+
+import (
+	"fmt"
+	"math/big"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/keep-network/keep-common/pkg/persistence"
+	relayChain "github.com/keep-network/keep-core/pkg/beacon/relay/chain"
+)
+
+var (
+	testKey      = [KeyLength]byte{1, 2, 3}
+	testOtherKey = [KeyLength]byte{4, 5, 6}
+
+	testCheckpoint = &Checkpoint{
+		Seed:        big.NewInt(1337),
+		MemberIndex: 3,
+		Result: &relayChain.DKGResult{
+			GroupPublicKey: []byte{10, 11, 12},
+			Misbehaved:     []byte{2},
+		},
+	}
+)
+
+func TestSaveAndReadAll(t *testing.T) {
+	handle := newPersistenceHandleMock()
+	storage := NewStorage(handle, testKey)
+
+	if err := storage.Save(testCheckpoint); err != nil {
+		t.Fatal(err)
+	}
+
+	checkpoints, err := storage.ReadAll()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(checkpoints) != 1 {
+		t.Fatalf(
+			"unexpected number of checkpoints\nexpected: %v\nactual:   %v\n",
+			1,
+			len(checkpoints),
+		)
+	}
+	if !reflect.DeepEqual(testCheckpoint, checkpoints[0]) {
+		t.Errorf(
+			"unexpected checkpoint\nexpected: %+v\nactual:   %+v\n",
+			testCheckpoint,
+			checkpoints[0],
+		)
+	}
+}
+
+func TestSaveEncryptsCheckpoint(t *testing.T) {
+	handle := newPersistenceHandleMock()
+	storage := NewStorage(handle, testKey)
+
+	if err := storage.Save(testCheckpoint); err != nil {
+		t.Fatal(err)
+	}
+
+	plaintext, err := testCheckpoint.Marshal()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for _, file := range handle.files() {
+		if reflect.DeepEqual(file.content, plaintext) {
+			t.Fatalf("checkpoint has been persisted unencrypted")
+		}
+	}
+}
+
+func TestReadAllRejectsInvalidCheckpoint(t *testing.T) {
+	var tests = map[string]struct {
+		modify  func(handle *persistenceHandleMock)
+		readKey [KeyLength]byte
+	}{
+		"tampered checkpoint": {
+			modify: func(handle *persistenceHandleMock) {
+				for _, file := range handle.files() {
+					file.content[len(file.content)-1] ^= 0xff
+				}
+			},
+			readKey: testKey,
+		},
+		"checkpoint encrypted with a different key": {
+			modify:  func(handle *persistenceHandleMock) {},
+			readKey: testOtherKey,
+		},
+	}
+
+	for testName, test := range tests {
+		t.Run(testName, func(t *testing.T) {
+			handle := newPersistenceHandleMock()
+
+			if err := NewStorage(handle, testKey).Save(testCheckpoint); err != nil {
+				t.Fatal(err)
+			}
+
+			test.modify(handle)
+
+			_, err := NewStorage(handle, test.readKey).ReadAll()
+			if err == nil {
+				t.Fatal("expected an error")
+			}
+
+			if !strings.Contains(err.Error(), "has been tampered with") {
+				t.Errorf("unexpected error: [%v]", err)
+			}
+		})
+	}
+}
+
+func TestReadAllWithReadAndDecryptionErrors(t *testing.T) {
+	handle := newPersistenceHandleMock()
+
+	if err := NewStorage(handle, testOtherKey).Save(testCheckpoint); err != nil {
+		t.Fatal(err)
+	}
+
+	readErr := fmt.Errorf("could not read directory")
+	handle.readErrors = []error{readErr, readErr}
+
+	_, err := NewStorage(handle, testKey).ReadAll()
+	if err != readErr {
+		t.Errorf(
+			"unexpected error\nexpected: %v\nactual:   %v\n",
+			readErr,
+			err,
+		)
+	}
+}
+
+func TestArchive(t *testing.T) {
+	handle := newPersistenceHandleMock()
+	storage := NewStorage(handle, testKey)
+
+	if err := storage.Save(testCheckpoint); err != nil {
+		t.Fatal(err)
+	}
+
+	if err := storage.Archive(testCheckpoint.Seed); err != nil {
+		t.Fatal(err)
+	}
+
+	checkpoints, err := storage.ReadAll()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(checkpoints) != 0 {
+		t.Errorf(
+			"unexpected number of checkpoints\nexpected: %v\nactual:   %v\n",
+			0,
+			len(checkpoints),
+		)
+	}
+}
+
+func TestEncryptionKey(t *testing.T) {
+	var tests = map[string]struct {
+		password          string
+		dataEncryptionKey string
+		expectedKey       [KeyLength]byte
+		expectedError     bool
+	}{
+		"data encryption key takes precedence over password": {
+			password:          "password",
+			dataEncryptionKey: "0102030000000000000000000000000000000000000000000000000000000000",
+			expectedKey:       testKey,
+		},
+		"key derived from password": {
+			password: "password",
+			// sha256("password")
+			expectedKey: [KeyLength]byte{
+				0x5e, 0x88, 0x48, 0x98, 0xda, 0x28, 0x04, 0x71,
+				0x51, 0xd0, 0xe5, 0x6f, 0x8d, 0xc6, 0x29, 0x27,
+				0x73, 0x60, 0x3d, 0x0d, 0x6a, 0xab, 0xbd, 0xd6,
+				0x2a, 0x11, 0xef, 0x72, 0x1d, 0x15, 0x42, 0xd8,
+			},
+		},
+		"neither password nor data encryption key": {
+			expectedError: true,
+		},
+		"data encryption key is not a hex string": {
+			dataEncryptionKey: "not a key",
+			expectedError:     true,
+		},
+		"data encryption key is too short": {
+			dataEncryptionKey: "010203",
+			expectedError:     true,
+		},
+	}
+
+	for testName, test := range tests {
+		t.Run(testName, func(t *testing.T) {
+			key, err := EncryptionKey(test.password, test.dataEncryptionKey)
+
+			if test.expectedError {
+				if err == nil {
+					t.Fatal("expected an error")
+				}
+				return
+			}
+
+			if err != nil {
+				t.Fatal(err)
+			}
+			if key != test.expectedKey {
+				t.Errorf(
+					"unexpected key\nexpected: %x\nactual:   %x\n",
+					test.expectedKey,
+					key,
+				)
+			}
+		})
+	}
+}
+
+type persistenceHandleMock struct {
+	data map[string]map[string]*testDataDescriptor
+	// readErrors are reported by ReadAll along with the data.
+	readErrors []error
+}
+
+func newPersistenceHandleMock() *persistenceHandleMock {
+	return &persistenceHandleMock{
+		data: make(map[string]map[string]*testDataDescriptor),
+	}
+}
+
+func (phm *persistenceHandleMock) Save(data []byte, directory string, name string) error {
+	if _, ok := phm.data[directory]; !ok {
+		phm.data[directory] = make(map[string]*testDataDescriptor)
+	}
+
+	phm.data[directory][name] = &testDataDescriptor{name, directory, data}
+
+	return nil
+}
+
+func (phm *persistenceHandleMock) ReadAll() (<-chan persistence.DataDescriptor, <-chan error) {
+	files := phm.files()
+
+	outputData := make(chan persistence.DataDescriptor)
+	outputErrors := make(chan error)
+
+	// Data and errors are produced concurrently, as the disk persistence
+	// handle does.
+	go func() {
+		for _, file := range files {
+			outputData <- file
+		}
+		close(outputData)
+	}()
+	go func() {
+		for _, err := range phm.readErrors {
+			outputErrors <- err
+		}
+		close(outputErrors)
+	}()
+
+	return outputData, outputErrors
+}
+
+func (phm *persistenceHandleMock) Archive(directory string) error {
+	delete(phm.data, directory)
+
+	return nil
+}
+
+func (phm *persistenceHandleMock) files() []*testDataDescriptor {
+	files := make([]*testDataDescriptor, 0)
+	for _, directory := range phm.data {
+		for _, file := range directory {
+			files = append(files, file)
+		}
+	}
+
+	return files
+}
+
+type testDataDescriptor struct {
+	name      string
+	directory string
+	content   []byte
+}
+
+func (tdd *testDataDescriptor) Name() string {
+	return tdd.name
+}
+
+func (tdd *testDataDescriptor) Directory() string {
+	return tdd.directory
+}
+
+func (tdd *testDataDescriptor) Content() ([]byte, error) {
+	return tdd.content, nil
+}
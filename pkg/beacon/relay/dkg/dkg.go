@@ -8,7 +8,10 @@ import (
 	"github.com/ipfs/go-log"
 
 	relayChain "github.com/keep-network/keep-core/pkg/beacon/relay/chain"
+	"github.com/keep-network/keep-core/pkg/beacon/relay/config"
+	"github.com/keep-network/keep-core/pkg/beacon/relay/dkg/checkpoint"
 	dkgResult "github.com/keep-network/keep-core/pkg/beacon/relay/dkg/result"
+	"github.com/keep-network/keep-core/pkg/beacon/relay/dkg/transcript"
 	"github.com/keep-network/keep-core/pkg/beacon/relay/event"
 	"github.com/keep-network/keep-core/pkg/beacon/relay/gjkr"
 	"github.com/keep-network/keep-core/pkg/beacon/relay/group"
@@ -18,7 +21,33 @@ import (
 
 var logger = log.Logger("keep-dkg")
 
-// ExecuteDKG runs the full distributed key generation lifecycle.
+// ExecutionConfig configures the optional behavior of the DKG execution.
+// The zero value executes DKG with none of the optional features enabled.
+type ExecutionConfig struct {
+	// VerifyGroupPublicKey enables checking the group public key against
+	// public key shares before the result is published.
+	VerifyGroupPublicKey bool
+	// Submission configures the submission of the DKG result.
+	Submission dkgResult.SubmissionConfig
+	// FailureResultPolicy determines what happens with the result reflecting
+	// a failed DKG; the result is submitted if empty.
+	FailureResultPolicy string
+	// DisqualificationObserver is notified each time the member disqualifies
+	// another member during the key generation; may be nil.
+	DisqualificationObserver group.DisqualificationHandler
+	// LivenessTracker reports members persistently disconnected during the
+	// key generation in the logs; may be nil.
+	LivenessTracker *group.LivenessTracker
+	// Checkpoints the member's key generation result is saved to before the
+	// result publication starts; may be nil.
+	Checkpoints *checkpoint.Storage
+	// Transcripts the member's execution transcript is saved to once the
+	// result publication completes; may be nil.
+	Transcripts *transcript.Storage
+}
+
+// ExecuteDKG runs the full distributed key generation lifecycle with the
+// optional behavior configured by the given execution config.
 func ExecuteDKG(
 	seed *big.Int,
 	index uint8, // starts with 0
@@ -30,14 +59,30 @@ func ExecuteDKG(
 	relayChain relayChain.Interface,
 	signing chain.Signing,
 	channel net.BroadcastChannel,
+	phaseBudgets *config.DKGPhaseBudgets,
+	executionConfig ExecutionConfig,
 ) (*ThresholdSigner, error) {
 	// The staker index should begin with 1
 	playerIndex := group.MemberIndex(index + 1)
 
+	var recorder *transcript.Recorder
+	if executionConfig.Transcripts != nil {
+		recorder = transcript.NewRecorder(
+			seed,
+			playerIndex,
+			groupSize,
+			dishonestThreshold,
+			startBlockHeight,
+		)
+		membershipValidator = recorder.MembershipValidator(membershipValidator)
+		signing = recorder.Signing(signing)
+		relayChain = recorder.RelayChain(relayChain)
+	}
+
 	gjkr.RegisterUnmarshallers(channel)
 	dkgResult.RegisterUnmarshallers(channel)
 
-	gjkrResult, gjkrEndBlockHeight, err := gjkr.Execute(
+	gjkrResult, _, err := gjkr.Execute(
 		playerIndex,
 		groupSize,
 		blockCounter,
@@ -46,6 +91,10 @@ func ExecuteDKG(
 		seed,
 		membershipValidator,
 		startBlockHeight,
+		phaseBudgets,
+		executionConfig.DisqualificationObserver,
+		executionConfig.LivenessTracker,
+		recorder,
 	)
 	if err != nil {
 		return nil, fmt.Errorf(
@@ -55,7 +104,38 @@ func ExecuteDKG(
 		)
 	}
 
-	startPublicationBlockHeight := gjkrEndBlockHeight
+	if executionConfig.VerifyGroupPublicKey {
+		if err := dkgResult.VerifyGroupPublicKey(
+			playerIndex,
+			gjkrResult,
+		); err != nil {
+			return nil, fmt.Errorf(
+				"[member:%v] group public key verification failed [%v]",
+				playerIndex,
+				err,
+			)
+		}
+	}
+
+	startPublicationBlockHeight := publicationStartBlock(
+		startBlockHeight,
+		phaseBudgets,
+	)
+
+	if executionConfig.Checkpoints != nil {
+		err := executionConfig.Checkpoints.Save(&checkpoint.Checkpoint{
+			Seed:        seed,
+			MemberIndex: playerIndex,
+			Result:      dkgResult.ConvertGjkrResult(gjkrResult),
+		})
+		if err != nil {
+			logger.Warningf(
+				"[member:%v] could not save DKG checkpoint: [%v]",
+				playerIndex,
+				err,
+			)
+		}
+	}
 
 	dkgResultChannel := make(chan *event.DKGResultSubmission)
 	dkgResultSubscription, err := relayChain.OnDKGResultSubmitted(
@@ -82,7 +162,23 @@ func ExecuteDKG(
 		signing,
 		blockCounter,
 		startPublicationBlockHeight,
+		executionConfig.Submission,
+		executionConfig.FailureResultPolicy,
+		recorder,
 	)
+
+	if recorder != nil {
+		if err := executionConfig.Transcripts.Save(
+			recorder.Transcript(),
+		); err != nil {
+			logger.Warningf(
+				"[member:%v] could not save DKG transcript: [%v]",
+				playerIndex,
+				err,
+			)
+		}
+	}
+
 	if err != nil {
 		// Result publication failed. It means that either the result this
 		// member proposed is not supported by the majority of group members or
@@ -103,6 +199,7 @@ func ExecuteDKG(
 			startPublicationBlockHeight,
 			relayChain,
 			blockCounter,
+			executionConfig.Submission.MinBlockStep,
 		); err != nil {
 			return nil, err
 		}
@@ -116,6 +213,33 @@ func ExecuteDKG(
 	}, nil
 }
 
+// Replay replays the DKG execution recorded in the given transcript. It returns
+// the DKG result the member prepared in the recorded execution along with
+// signatures supporting the result, keyed by indexes of supporting members.
+func Replay(
+	t *transcript.Transcript,
+) (*relayChain.DKGResult, map[group.MemberIndex][]byte, error) {
+	gjkrResult, err := gjkr.Replay(t)
+	if err != nil {
+		return nil, nil, fmt.Errorf(
+			"[member:%v] GJKR replay failed [%v]",
+			t.MemberIndex,
+			err,
+		)
+	}
+
+	signatures, err := dkgResult.ReplayPublication(t, gjkrResult)
+	if err != nil {
+		return nil, nil, fmt.Errorf(
+			"[member:%v] DKG result publication replay failed [%v]",
+			t.MemberIndex,
+			err,
+		)
+	}
+
+	return dkgResult.PrepareResult(gjkrResult).Result(), signatures, nil
+}
+
 // decideMemberFate decides what the member will do in case it failed
 // publishing its DKG result. Member can stay in the group if it
 // supports the same group public key as the one registered on-chain and
@@ -127,12 +251,14 @@ func decideMemberFate(
 	startPublicationBlockHeight uint64,
 	relayChain relayChain.Interface,
 	blockCounter chain.BlockCounter,
+	minResultPublicationBlockStep uint64,
 ) error {
 	dkgResultEvent, err := waitForDkgResultEvent(
 		dkgResultChannel,
 		startPublicationBlockHeight,
 		relayChain,
 		blockCounter,
+		minResultPublicationBlockStep,
 	)
 	if err != nil {
 		return err
@@ -172,16 +298,17 @@ func waitForDkgResultEvent(
 	startPublicationBlockHeight uint64,
 	relayChain relayChain.Interface,
 	blockCounter chain.BlockCounter,
+	minResultPublicationBlockStep uint64,
 ) (*event.DKGResultSubmission, error) {
-	config, err := relayChain.GetConfig()
+	timeoutBlock, err := publicationTimeoutBlock(
+		startPublicationBlockHeight,
+		relayChain,
+		minResultPublicationBlockStep,
+	)
 	if err != nil {
 		return nil, err
 	}
 
-	timeoutBlock := startPublicationBlockHeight +
-		dkgResult.PrePublicationBlocks() +
-		(uint64(config.GroupSize) * config.ResultPublicationBlockStep)
-
 	timeoutBlockChannel, err := blockCounter.BlockHeightWaiter(timeoutBlock)
 	if err != nil {
 		return nil, err
@@ -194,3 +321,37 @@ func waitForDkgResultEvent(
 		return nil, fmt.Errorf("DKG result publication timed out")
 	}
 }
+
+// publicationStartBlock returns the block height at which the DKG result
+// publication starts. It is the block at which all protocol phases are
+// expected to complete according to their block budgets.
+func publicationStartBlock(
+	startBlockHeight uint64,
+	phaseBudgets *config.DKGPhaseBudgets,
+) uint64 {
+	return startBlockHeight + phaseBudgets.ProtocolBlocks()
+}
+
+// publicationTimeoutBlock returns the block height at which the DKG result
+// publication times out if no result has been published by any group member.
+// The chain's result publication block step is raised to the minimum block
+// step if it is lower.
+func publicationTimeoutBlock(
+	startPublicationBlockHeight uint64,
+	relayChain relayChain.Interface,
+	minResultPublicationBlockStep uint64,
+) (uint64, error) {
+	config, err := relayChain.GetConfig()
+	if err != nil {
+		return 0, err
+	}
+
+	blockStep := dkgResult.EffectiveBlockStep(
+		config.ResultPublicationBlockStep,
+		minResultPublicationBlockStep,
+	)
+
+	return startPublicationBlockHeight +
+		dkgResult.PrePublicationBlocks() +
+		(uint64(config.GroupSize) * blockStep), nil
+}
@@ -7,6 +7,8 @@ import (
 	"testing"
 
 	bn256 "github.com/ethereum/go-ethereum/crypto/bn256/cloudflare"
+	"github.com/keep-network/keep-core/pkg/beacon/relay/config"
+	dkgResult "github.com/keep-network/keep-core/pkg/beacon/relay/dkg/result"
 	"github.com/keep-network/keep-core/pkg/beacon/relay/event"
 	"github.com/keep-network/keep-core/pkg/beacon/relay/gjkr"
 	"github.com/keep-network/keep-core/pkg/beacon/relay/group"
@@ -49,6 +51,7 @@ func TestDecideMemberFate_HappyPath(t *testing.T) {
 		startPublicationBlockHeight,
 		localChain.ThresholdRelay(),
 		blockCounter,
+		0,
 	)
 
 	if err != nil {
@@ -76,6 +79,7 @@ func TestDecideMemberFate_NotSameGroupPublicKey(t *testing.T) {
 		startPublicationBlockHeight,
 		localChain.ThresholdRelay(),
 		blockCounter,
+		0,
 	)
 
 	expectedError := fmt.Errorf(
@@ -107,6 +111,7 @@ func TestDecideMemberFate_MemberIsMisbehaved(t *testing.T) {
 		startPublicationBlockHeight,
 		localChain.ThresholdRelay(),
 		blockCounter,
+		0,
 	)
 
 	expectedError := fmt.Errorf(
@@ -133,6 +138,7 @@ func TestDecideMemberFate_Timeout(t *testing.T) {
 		startPublicationBlockHeight,
 		localChain.ThresholdRelay(),
 		blockCounter,
+		0,
 	)
 
 	expectedError := fmt.Errorf("DKG result publication timed out")
@@ -144,3 +150,69 @@ func TestDecideMemberFate_Timeout(t *testing.T) {
 		)
 	}
 }
+
+func TestSubmissionEligibilityRamp(t *testing.T) {
+	startBlockHeight := uint64(100)
+	blockStep := uint64(3)
+
+	var tests = map[string]struct {
+		modifyBudgets        func(budgets *config.DKGPhaseBudgets)
+		expectedEligibleFrom []uint64
+	}{
+		// 100 + 66 (DKG phases) + 6 (result signing) = 172
+		"default phase budgets": {
+			modifyBudgets:        func(budgets *config.DKGPhaseBudgets) {},
+			expectedEligibleFrom: []uint64{172, 175, 178},
+		},
+		"longer commitment phase": {
+			modifyBudgets: func(budgets *config.DKGPhaseBudgets) {
+				budgets.Commitment.ActiveBlocks += 4
+			},
+			expectedEligibleFrom: []uint64{176, 179, 182},
+		},
+		"shorter combination phase": {
+			modifyBudgets: func(budgets *config.DKGPhaseBudgets) {
+				budgets.Combination.ActiveBlocks -= 10
+			},
+			expectedEligibleFrom: []uint64{162, 165, 168},
+		},
+		"additional delay in key reveal phase": {
+			modifyBudgets: func(budgets *config.DKGPhaseBudgets) {
+				budgets.KeyReveal.DelayBlocks += 2
+			},
+			expectedEligibleFrom: []uint64{174, 177, 180},
+		},
+	}
+
+	for testName, test := range tests {
+		t.Run(testName, func(t *testing.T) {
+			budgets := gjkr.DefaultPhaseBudgets()
+			test.modifyBudgets(budgets)
+
+			submissionStartBlockHeight := publicationStartBlock(
+				startBlockHeight,
+				budgets,
+			) + dkgResult.PrePublicationBlocks()
+
+			eligibleFrom := make([]uint64, 0)
+			for i := 1; i <= len(test.expectedEligibleFrom); i++ {
+				eligibleFrom = append(
+					eligibleFrom,
+					dkgResult.ExpectedSubmissionBlock(
+						group.MemberIndex(i),
+						submissionStartBlockHeight,
+						int(blockStep),
+					),
+				)
+			}
+
+			if !reflect.DeepEqual(test.expectedEligibleFrom, eligibleFrom) {
+				t.Errorf(
+					"unexpected eligibility ramp\nexpected: %v\nactual:   %v\n",
+					test.expectedEligibleFrom,
+					eligibleFrom,
+				)
+			}
+		})
+	}
+}
@@ -0,0 +1,154 @@
+package dkg
+
+import (
+	"math/big"
+	"math/rand"
+	"sync"
+
+	dkgResult "github.com/keep-network/keep-core/pkg/beacon/relay/dkg/result"
+	"github.com/keep-network/keep-core/pkg/beacon/relay/group"
+	"github.com/keep-network/keep-core/pkg/subscription"
+)
+
+// Types of DKG progress events published by the progress feed.
+const (
+	// ProgressPhaseChanged is published when the member enters a new phase.
+	ProgressPhaseChanged = "phase"
+	// ProgressEligibilityChanged is published when the eligibility of the
+	// member to submit the result is determined or changes.
+	ProgressEligibilityChanged = "eligibility"
+	// ProgressSubmission is published with the outcome of the result
+	// submission.
+	ProgressSubmission = "submission"
+)
+
+// ProgressEvent describes a change in the progress of the DKG execution for
+// the given seed and member.
+type ProgressEvent struct {
+	Type        string            `json:"type"`
+	Seed        *big.Int          `json:"seed"`
+	MemberIndex group.MemberIndex `json:"memberIndex"`
+	BlockHeight uint64            `json:"blockHeight"`
+	// Phase is set for phase changes and is the phase the member entered.
+	Phase string `json:"phase,omitempty"`
+	// EligibleToSubmit and EligibleBlockHeight are set for eligibility
+	// changes.
+	EligibleToSubmit    *bool  `json:"eligibleToSubmit,omitempty"`
+	EligibleBlockHeight uint64 `json:"eligibleBlockHeight,omitempty"`
+	// Outcome is set for submission outcomes; Error is set only if the
+	// submission failed.
+	Outcome string `json:"outcome,omitempty"`
+	Error   string `json:"error,omitempty"`
+}
+
+// ProgressFeed publishes DKG progress events for live consumers, such as
+// dashboards. Phase and eligibility changes are determined by comparing the
+// progress reported by the tracker on consecutive updates; submission
+// outcomes are published by the feed's submission observers.
+type ProgressFeed struct {
+	mutex sync.Mutex
+
+	tracker      *ProgressTracker
+	lastStatuses map[string]*PhaseStatus
+
+	handlers map[int]func(event *ProgressEvent)
+}
+
+// NewProgressFeed creates a feed of progress of DKG executions registered
+// in the given tracker.
+func NewProgressFeed(tracker *ProgressTracker) *ProgressFeed {
+	return &ProgressFeed{
+		tracker:      tracker,
+		lastStatuses: make(map[string]*PhaseStatus),
+		handlers:     make(map[int]func(event *ProgressEvent)),
+	}
+}
+
+// OnProgress registers a handler invoked with each published progress event.
+// Handlers are invoked synchronously in the order in which events occurred,
+// so they must not block.
+func (pf *ProgressFeed) OnProgress(
+	handler func(event *ProgressEvent),
+) subscription.EventSubscription {
+	pf.mutex.Lock()
+	defer pf.mutex.Unlock()
+
+	handlerID := rand.Int()
+	pf.handlers[handlerID] = handler
+
+	return subscription.NewEventSubscription(func() {
+		pf.mutex.Lock()
+		defer pf.mutex.Unlock()
+
+		delete(pf.handlers, handlerID)
+	})
+}
+
+// Update publishes phase and eligibility changes of tracked DKG executions
+// since the previous update, as of the given block height.
+func (pf *ProgressFeed) Update(currentBlockHeight uint64) {
+	pf.mutex.Lock()
+	defer pf.mutex.Unlock()
+
+	statuses := make(map[string]*PhaseStatus)
+	for _, status := range pf.tracker.Status(currentBlockHeight) {
+		key := executionKey(status.Seed, status.MemberIndex)
+		statuses[key] = status
+		last := pf.lastStatuses[key]
+
+		if last == nil || last.Phase != status.Phase {
+			pf.publish(&ProgressEvent{
+				Type:        ProgressPhaseChanged,
+				Seed:        status.Seed,
+				MemberIndex: status.MemberIndex,
+				BlockHeight: currentBlockHeight,
+				Phase:       status.Phase,
+			})
+		}
+
+		if status.EligibleToSubmit != nil &&
+			(last == nil || last.EligibleToSubmit == nil ||
+				*last.EligibleToSubmit != *status.EligibleToSubmit) {
+			pf.publish(&ProgressEvent{
+				Type:                ProgressEligibilityChanged,
+				Seed:                status.Seed,
+				MemberIndex:         status.MemberIndex,
+				BlockHeight:         currentBlockHeight,
+				EligibleToSubmit:    status.EligibleToSubmit,
+				EligibleBlockHeight: status.EligibleBlockHeight,
+			})
+		}
+	}
+
+	pf.lastStatuses = statuses
+}
+
+// Observer returns a submission observer publishing the outcome of the
+// result submission for the given seed.
+func (pf *ProgressFeed) Observer(seed *big.Int) dkgResult.SubmissionObserver {
+	return func(report *dkgResult.SubmissionReport) {
+		pf.mutex.Lock()
+		defer pf.mutex.Unlock()
+
+		event := &ProgressEvent{
+			Type:        ProgressSubmission,
+			Seed:        seed,
+			MemberIndex: report.MemberIndex,
+			BlockHeight: report.BlockHeight,
+			Outcome:     string(report.Outcome),
+		}
+		if report.Err != nil {
+			event.Error = report.Err.Error()
+		}
+
+		pf.publish(event)
+	}
+}
+
+// publish delivers the event to all handlers. It must be called with the
+// feed's mutex held.
+func (pf *ProgressFeed) publish(event *ProgressEvent) {
+	for _, handler := range pf.handlers {
+		handler(event)
+	}
+}
@@ -0,0 +1,95 @@
+package dkg
+
+import (
+	"fmt"
+	"math/big"
+	"reflect"
+	"testing"
+
+	"github.com/keep-network/keep-core/pkg/beacon/relay/config"
+	dkgResult "github.com/keep-network/keep-core/pkg/beacon/relay/dkg/result"
+	"github.com/keep-network/keep-core/pkg/beacon/relay/gjkr"
+)
+
+func TestProgressFeed(t *testing.T) {
+	chainConfig := &config.Chain{
+		GroupSize:                  5,
+		HonestThreshold:            3,
+		ResultPublicationBlockStep: 3,
+	}
+	seed := big.NewInt(10)
+
+	tracker := NewProgressTracker(gjkr.DefaultPhaseBudgets(), chainConfig, false, 0)
+	feed := NewProgressFeed(tracker)
+
+	events := make([]string, 0)
+	subscription := feed.OnProgress(func(event *ProgressEvent) {
+		if event.Seed.Cmp(seed) != 0 || event.MemberIndex != 2 {
+			t.Errorf("unexpected execution of event [%+v]", event)
+		}
+
+		var detail string
+		switch event.Type {
+		case ProgressPhaseChanged:
+			detail = event.Phase
+		case ProgressEligibilityChanged:
+			detail = fmt.Sprintf(
+				"%v from %v",
+				*event.EligibleToSubmit,
+				event.EligibleBlockHeight,
+			)
+		case ProgressSubmission:
+			detail = event.Outcome
+		}
+
+		events = append(
+			events,
+			fmt.Sprintf("%v: %v: %v", event.BlockHeight, event.Type, detail),
+		)
+	})
+
+	// Phases of the execution starting at block 100 are listed in
+	// TestProgressTrackerStatus; the member is eligible from block 175.
+	tracker.Start(seed, 2, 100)
+	for _, blockHeight := range []uint64{
+		98, 100, 105, 106, 112, 123, 129, 140, 146, 166, 172, 174, 175,
+	} {
+		feed.Update(blockHeight)
+	}
+
+	feed.Observer(seed)(&dkgResult.SubmissionReport{
+		Outcome:     dkgResult.SubmissionSubmitted,
+		MemberIndex: 2,
+		BlockHeight: 176,
+	})
+
+	tracker.Finish(seed, 2)
+	feed.Update(177)
+
+	subscription.Unsubscribe()
+	tracker.Start(seed, 2, 200)
+	feed.Update(200)
+
+	expectedEvents := []string{
+		"98: phase: waiting for start",
+		"100: phase: ephemeral key pair generation",
+		"106: phase: commitment",
+		"112: phase: commitment verification",
+		"123: phase: points share",
+		"129: phase: points validation",
+		"140: phase: key reveal",
+		"146: phase: combination",
+		"166: phase: result signing",
+		"172: phase: result submission",
+		"172: eligibility: false from 175",
+		"175: eligibility: true from 175",
+		"176: submission: submitted",
+	}
+	if !reflect.DeepEqual(expectedEvents, events) {
+		t.Errorf(
+			"unexpected events\nexpected: %v\nactual:   %v\n",
+			expectedEvents,
+			events,
+		)
+	}
+}
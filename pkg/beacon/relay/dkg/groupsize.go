@@ -0,0 +1,21 @@
+package dkg
+
+import "fmt"
+
+// ValidateGroupSize checks if the group with the given number of members is
+// able to produce a valid threshold signature. The group needs at least the
+// honest threshold of members for the signature, as well as for the
+// signatures supporting the DKG result, so a smaller group is doomed to fail
+// and there is no point in executing DKG for it.
+func ValidateGroupSize(membersCount int, honestThreshold int) error {
+	if membersCount < honestThreshold {
+		return fmt.Errorf(
+			"group of [%v] members is smaller than the signature "+
+				"threshold of [%v] members",
+			membersCount,
+			honestThreshold,
+		)
+	}
+
+	return nil
+}
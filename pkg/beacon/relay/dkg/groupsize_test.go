@@ -0,0 +1,52 @@
+package dkg
+
+import (
+	"fmt"
+	"reflect"
+	"testing"
+)
+
+func TestValidateGroupSize(t *testing.T) {
+	honestThreshold := 51
+
+	var tests = map[string]struct {
+		membersCount  int
+		expectedError error
+	}{
+		"full group": {
+			membersCount:  64,
+			expectedError: nil,
+		},
+		"group of threshold size": {
+			membersCount:  51,
+			expectedError: nil,
+		},
+		"undersized group": {
+			membersCount: 50,
+			expectedError: fmt.Errorf(
+				"group of [50] members is smaller than the signature " +
+					"threshold of [51] members",
+			),
+		},
+		"empty group": {
+			membersCount: 0,
+			expectedError: fmt.Errorf(
+				"group of [0] members is smaller than the signature " +
+					"threshold of [51] members",
+			),
+		},
+	}
+
+	for testName, test := range tests {
+		t.Run(testName, func(t *testing.T) {
+			err := ValidateGroupSize(test.membersCount, honestThreshold)
+			if !reflect.DeepEqual(test.expectedError, err) {
+				t.Errorf(
+					"unexpected error\nexpected: %v\nactual:   %v\n",
+					test.expectedError,
+					err,
+				)
+			}
+		})
+	}
+}
@@ -0,0 +1,118 @@
+package dkg
+
+import (
+	"bytes"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"math/big"
+	"strings"
+
+	bn256 "github.com/ethereum/go-ethereum/crypto/bn256/cloudflare"
+	"github.com/keep-network/keep-core/pkg/beacon/relay/group"
+)
+
+// ImportedGroupKey is the group key material of a single group member as
+// stored in the group key file. Points are hex-encoded in their marshalled
+// form and the private key share is a decimal number.
+type ImportedGroupKey struct {
+	MemberIndex          group.MemberIndex            `json:"memberIndex"`
+	GroupPublicKey       string                       `json:"groupPublicKey"`
+	GroupPrivateKeyShare string                       `json:"groupPrivateKeyShare"`
+	GroupPublicKeyShares map[group.MemberIndex]string `json:"groupPublicKeyShares"`
+}
+
+// ImportThresholdSigners loads group key material of group members from
+// the JSON file at the given path and creates threshold signers able to
+// participate in signing as if they completed DKG.
+//
+// The import is meant for testing relay entry generation without executing
+// DKG and must never be used in production: the file holds group private key
+// shares in plain text and members importing it skip all DKG guarantees.
+func ImportThresholdSigners(path string) ([]*ThresholdSigner, error) {
+	content, err := ioutil.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("could not read group key file: [%v]", err)
+	}
+
+	var keys []*ImportedGroupKey
+	if err := json.Unmarshal(content, &keys); err != nil {
+		return nil, fmt.Errorf("could not parse group key file: [%v]", err)
+	}
+
+	signers := make([]*ThresholdSigner, 0, len(keys))
+	for _, key := range keys {
+		signer, err := key.thresholdSigner()
+		if err != nil {
+			return nil, fmt.Errorf(
+				"invalid group key of member [%v]: [%v]",
+				key.MemberIndex,
+				err,
+			)
+		}
+
+		signers = append(signers, signer)
+	}
+
+	return signers, nil
+}
+
+func (igk *ImportedGroupKey) thresholdSigner() (*ThresholdSigner, error) {
+	groupPublicKey, err := unmarshalHexG2(igk.GroupPublicKey)
+	if err != nil {
+		return nil, fmt.Errorf("could not parse group public key: [%v]", err)
+	}
+
+	groupPrivateKeyShare, ok := new(big.Int).SetString(
+		igk.GroupPrivateKeyShare,
+		10,
+	)
+	if !ok {
+		return nil, fmt.Errorf("could not parse group private key share")
+	}
+
+	groupPublicKeyShares := make(map[group.MemberIndex]*bn256.G2)
+	for memberIndex, encodedShare := range igk.GroupPublicKeyShares {
+		share, err := unmarshalHexG2(encodedShare)
+		if err != nil {
+			return nil, fmt.Errorf(
+				"could not parse public key share of member [%v]: [%v]",
+				memberIndex,
+				err,
+			)
+		}
+		groupPublicKeyShares[memberIndex] = share
+	}
+
+	// The own public key share, if present, must match the private key share.
+	if ownShare, ok := groupPublicKeyShares[igk.MemberIndex]; ok {
+		expectedShare := new(bn256.G2).ScalarBaseMult(groupPrivateKeyShare)
+		if !bytes.Equal(ownShare.Marshal(), expectedShare.Marshal()) {
+			return nil, fmt.Errorf(
+				"public key share does not match group private key share",
+			)
+		}
+	}
+
+	return NewThresholdSigner(
+		igk.MemberIndex,
+		groupPublicKey,
+		groupPrivateKeyShare,
+		groupPublicKeyShares,
+	), nil
+}
+
+func unmarshalHexG2(encoded string) (*bn256.G2, error) {
+	pointBytes, err := hex.DecodeString(strings.TrimPrefix(encoded, "0x"))
+	if err != nil {
+		return nil, err
+	}
+
+	point := new(bn256.G2)
+	if _, err := point.Unmarshal(pointBytes); err != nil {
+		return nil, err
+	}
+
+	return point, nil
+}
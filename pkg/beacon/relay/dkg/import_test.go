@@ -0,0 +1,129 @@
+package dkg
+
+import (
+	"crypto/rand"
+	"encoding/hex"
+	"encoding/json"
+	"io/ioutil"
+	"math/big"
+	"os"
+	"path/filepath"
+	"testing"
+
+	bn256 "github.com/ethereum/go-ethereum/crypto/bn256/cloudflare"
+	"github.com/keep-network/keep-core/pkg/beacon/relay/group"
+	"github.com/keep-network/keep-core/pkg/bls"
+)
+
+func TestImportThresholdSigners(t *testing.T) {
+	groupSize := 5
+	honestThreshold := 3
+
+	// polynomial coefficients; the first one is the group private key
+	var masterSecretKey []*big.Int
+	for i := 0; i < honestThreshold; i++ {
+		secretKey, _, err := bn256.RandomG2(rand.Reader)
+		if err != nil {
+			t.Fatal(err)
+		}
+		masterSecretKey = append(masterSecretKey, secretKey)
+	}
+	groupPublicKey := new(bn256.G2).ScalarBaseMult(masterSecretKey[0])
+
+	publicKeyShares := make(map[group.MemberIndex]string)
+	privateKeyShares := make(map[group.MemberIndex]*big.Int)
+	for i := 1; i <= groupSize; i++ {
+		secretKeyShare := bls.GetSecretKeyShare(masterSecretKey, i)
+		privateKeyShares[group.MemberIndex(i)] = secretKeyShare.V
+		publicKeyShares[group.MemberIndex(i)] = hex.EncodeToString(
+			secretKeyShare.PublicKeyShare().V.Marshal(),
+		)
+	}
+
+	var keys []*ImportedGroupKey
+	for i := 1; i <= groupSize; i++ {
+		keys = append(keys, &ImportedGroupKey{
+			MemberIndex:          group.MemberIndex(i),
+			GroupPublicKey:       "0x" + hex.EncodeToString(groupPublicKey.Marshal()),
+			GroupPrivateKeyShare: privateKeyShares[group.MemberIndex(i)].String(),
+			GroupPublicKeyShares: publicKeyShares,
+		})
+	}
+
+	path := writeGroupKeyFile(t, keys)
+	defer os.RemoveAll(filepath.Dir(path))
+
+	signers, err := ImportThresholdSigners(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(signers) != groupSize {
+		t.Fatalf(
+			"unexpected number of signers\nexpected: %v\nactual:   %v\n",
+			groupSize,
+			len(signers),
+		)
+	}
+
+	message := new(bn256.G1).ScalarBaseMult(big.NewInt(1337))
+
+	shares := make([]*bls.SignatureShare, 0)
+	for _, signer := range signers[:honestThreshold] {
+		shares = append(shares, &bls.SignatureShare{
+			I: int(signer.MemberID()),
+			V: signer.CalculateSignatureShare(message),
+		})
+	}
+
+	signature, err := signers[0].CompleteSignature(shares, honestThreshold)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if !bls.VerifyG1(groupPublicKey, message, signature) {
+		t.Errorf("imported signers produced invalid group signature")
+	}
+}
+
+func TestImportThresholdSignersMismatchedShare(t *testing.T) {
+	privateKeyShare := big.NewInt(10)
+	otherPublicKeyShare := new(bn256.G2).ScalarBaseMult(big.NewInt(11))
+
+	path := writeGroupKeyFile(t, []*ImportedGroupKey{
+		{
+			MemberIndex: 1,
+			GroupPublicKey: hex.EncodeToString(
+				new(bn256.G2).ScalarBaseMult(big.NewInt(1)).Marshal(),
+			),
+			GroupPrivateKeyShare: privateKeyShare.String(),
+			GroupPublicKeyShares: map[group.MemberIndex]string{
+				1: hex.EncodeToString(otherPublicKeyShare.Marshal()),
+			},
+		},
+	})
+	defer os.RemoveAll(filepath.Dir(path))
+
+	if _, err := ImportThresholdSigners(path); err == nil {
+		t.Errorf("expected import of mismatched key share to fail")
+	}
+}
+
+func writeGroupKeyFile(t *testing.T, keys []*ImportedGroupKey) string {
+	content, err := json.Marshal(keys)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	dir, err := ioutil.TempDir("", "group-keys")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	path := filepath.Join(dir, "group-keys.json")
+	if err := ioutil.WriteFile(path, content, 0600); err != nil {
+		t.Fatal(err)
+	}
+
+	return path
+}
@@ -0,0 +1,379 @@
+package dkg
+
+import (
+	"encoding/json"
+	"fmt"
+	"math/big"
+	"net/url"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/keep-network/keep-common/pkg/persistence"
+	dkgResult "github.com/keep-network/keep-core/pkg/beacon/relay/dkg/result"
+	"github.com/keep-network/keep-core/pkg/beacon/relay/group"
+	"github.com/keep-network/keep-core/pkg/identifier"
+)
+
+// ParticipationFailed is the outcome of a participation for which the result
+// submission has not completed, for example because the DKG failed.
+const ParticipationFailed = string(dkgResult.SubmissionFailed)
+
+// Participation describes a single participation of a member controlled by
+// this node in DKG for the given request.
+type Participation struct {
+	RequestID   *big.Int          `json:"requestId"`
+	MemberIndex group.MemberIndex `json:"memberIndex"`
+	// Outcome is the outcome of the member's result submission: submitted,
+	// yielded or failed.
+	Outcome string `json:"outcome"`
+	// PublishedByNode tells if the result has been published by the member.
+	PublishedByNode bool   `json:"publishedByNode"`
+	TransactionHash string `json:"transactionHash,omitempty"`
+	// GasSpent is the gas used by the member's result submission; zero if
+	// the member did not publish the result or the gas is unknown.
+	GasSpent            uint64              `json:"gasSpent"`
+	DisqualifiedMembers []group.MemberIndex `json:"disqualifiedMembers"`
+	StartedAt           time.Time           `json:"startedAt"`
+	CompletedAt         time.Time           `json:"completedAt"`
+	// Attestation is the operator's signed attestation of the published
+	// result; nil if the result has not been published.
+	Attestation *dkgResult.Attestation `json:"attestation,omitempty"`
+}
+
+// ParticipationFilter selects participations from the history. Zero values
+// of the filter fields do not restrict the selection.
+type ParticipationFilter struct {
+	// From selects participations started at or after the given time.
+	From time.Time
+	// To selects participations started before the given time.
+	To time.Time
+	// Outcome selects participations with the given outcome.
+	Outcome string
+}
+
+// ParseParticipationFilter parses the filter from query parameters. Time
+// range bounds are expected in RFC 3339 format under "from" and "to"
+// parameters and the outcome under the "outcome" parameter.
+func ParseParticipationFilter(parameters url.Values) (*ParticipationFilter, error) {
+	filter := &ParticipationFilter{
+		Outcome: parameters.Get("outcome"),
+	}
+
+	parseTime := func(name string) (time.Time, error) {
+		value := parameters.Get(name)
+		if value == "" {
+			return time.Time{}, nil
+		}
+
+		parsed, err := time.Parse(time.RFC3339, value)
+		if err != nil {
+			return time.Time{}, fmt.Errorf(
+				"invalid value of parameter [%v]: [%v]",
+				name,
+				err,
+			)
+		}
+
+		return parsed, nil
+	}
+
+	var err error
+	if filter.From, err = parseTime("from"); err != nil {
+		return nil, err
+	}
+	if filter.To, err = parseTime("to"); err != nil {
+		return nil, err
+	}
+
+	switch filter.Outcome {
+	case "",
+		string(dkgResult.SubmissionSubmitted),
+		string(dkgResult.SubmissionYielded),
+		ParticipationFailed:
+	default:
+		return nil, fmt.Errorf("unknown outcome [%v]", filter.Outcome)
+	}
+
+	return filter, nil
+}
+
+func (pf *ParticipationFilter) matches(participation *Participation) bool {
+	if !pf.From.IsZero() && participation.StartedAt.Before(pf.From) {
+		return false
+	}
+	if !pf.To.IsZero() && !participation.StartedAt.Before(pf.To) {
+		return false
+	}
+	if pf.Outcome != "" && participation.Outcome != pf.Outcome {
+		return false
+	}
+
+	return true
+}
+
+// ParticipationStore records the history of DKG participations of members
+// controlled by this node. Participations are recorded as they progress and
+// completed participations are persisted so that the history survives node
+// restarts.
+type ParticipationStore struct {
+	mutex sync.Mutex
+
+	handle    persistence.Handle
+	ongoing   map[string]*Participation
+	completed []*Participation
+
+	now func() time.Time
+}
+
+// NewParticipationStore creates a participation store on top of the provided
+// persistence handle and loads participations persisted before. If the handle
+// is nil, participations are kept in memory only.
+func NewParticipationStore(
+	handle persistence.Handle,
+) (*ParticipationStore, error) {
+	store := &ParticipationStore{
+		handle:    handle,
+		ongoing:   make(map[string]*Participation),
+		completed: make([]*Participation, 0),
+		now:       time.Now,
+	}
+
+	if handle == nil {
+		return store, nil
+	}
+
+	completed, err := readParticipations(handle)
+	if err != nil {
+		return nil, err
+	}
+	store.completed = completed
+
+	return store, nil
+}
+
+func readParticipations(
+	handle persistence.Handle,
+) ([]*Participation, error) {
+	participations := make([]*Participation, 0)
+	errors := make([]error, 0)
+
+	dataChannel, errorChannel := handle.ReadAll()
+
+	// Both channels are not buffered and we do not know in what order the
+	// producer writes to them so they are read concurrently.
+	var wg sync.WaitGroup
+	wg.Add(1)
+	go func() {
+		for err := range errorChannel {
+			errors = append(errors, err)
+		}
+		wg.Done()
+	}()
+
+	for descriptor := range dataChannel {
+		content, err := descriptor.Content()
+		if err != nil {
+			errors = append(errors, fmt.Errorf(
+				"could not read participation from file [%v] in "+
+					"directory [%v]: [%v]",
+				descriptor.Name(),
+				descriptor.Directory(),
+				err,
+			))
+			continue
+		}
+
+		participation := &Participation{}
+		if err := json.Unmarshal(content, participation); err != nil {
+			errors = append(errors, fmt.Errorf(
+				"could not unmarshal participation from file [%v] in "+
+					"directory [%v]: [%v]",
+				descriptor.Name(),
+				descriptor.Directory(),
+				err,
+			))
+			continue
+		}
+
+		participations = append(participations, participation)
+	}
+
+	wg.Wait()
+
+	if len(errors) > 0 {
+		return nil, errors[0]
+	}
+
+	return participations, nil
+}
+
+// Start records the start of the member's participation in DKG for the
+// given request. Starting the same participation again, for example on
+// a retry, keeps the original start time.
+func (ps *ParticipationStore) Start(
+	requestID *big.Int,
+	memberIndex group.MemberIndex,
+) {
+	ps.mutex.Lock()
+	defer ps.mutex.Unlock()
+
+	key := executionKey(requestID, memberIndex)
+	if _, ok := ps.ongoing[key]; ok {
+		return
+	}
+
+	ps.ongoing[key] = &Participation{
+		RequestID:           requestID,
+		MemberIndex:         memberIndex,
+		Outcome:             ParticipationFailed,
+		DisqualifiedMembers: make([]group.MemberIndex, 0),
+		StartedAt:           ps.now(),
+	}
+}
+
+// RecordDisqualification records the given member has been disqualified by
+// the member with the given index during DKG for the given request.
+func (ps *ParticipationStore) RecordDisqualification(
+	requestID *big.Int,
+	memberIndex group.MemberIndex,
+	disqualifiedIndex group.MemberIndex,
+) {
+	ps.mutex.Lock()
+	defer ps.mutex.Unlock()
+
+	participation, ok := ps.ongoing[executionKey(requestID, memberIndex)]
+	if !ok {
+		return
+	}
+
+	for _, index := range participation.DisqualifiedMembers {
+		if index == disqualifiedIndex {
+			return
+		}
+	}
+
+	participation.DisqualifiedMembers = append(
+		participation.DisqualifiedMembers,
+		disqualifiedIndex,
+	)
+}
+
+// RecordSubmission records the outcome of the member's result submission
+// for the given request.
+func (ps *ParticipationStore) RecordSubmission(
+	requestID *big.Int,
+	report *dkgResult.SubmissionReport,
+) {
+	ps.mutex.Lock()
+	defer ps.mutex.Unlock()
+
+	participation, ok := ps.ongoing[executionKey(requestID, report.MemberIndex)]
+	if !ok {
+		return
+	}
+
+	participation.Outcome = string(report.Outcome)
+	participation.PublishedByNode =
+		report.Outcome == dkgResult.SubmissionSubmitted
+	participation.TransactionHash = report.TransactionHash
+}
+
+// RecordAttestation records the attestation of the published result signed
+// for the member's participation.
+func (ps *ParticipationStore) RecordAttestation(
+	attestation *dkgResult.Attestation,
+) {
+	ps.mutex.Lock()
+	defer ps.mutex.Unlock()
+
+	participation, ok := ps.ongoing[executionKey(
+		attestation.RequestID,
+		attestation.MemberIndex,
+	)]
+	if !ok {
+		return
+	}
+
+	participation.Attestation = attestation
+}
+
+// Finish completes the member's participation in DKG for the given request
+// and persists it. If the gas usage is provided, the gas spent on the
+// member's result submission is recorded.
+func (ps *ParticipationStore) Finish(
+	requestID *big.Int,
+	memberIndex group.MemberIndex,
+	gasUsage dkgResult.TransactionGasUsage,
+) error {
+	ps.mutex.Lock()
+	defer ps.mutex.Unlock()
+
+	key := executionKey(requestID, memberIndex)
+	participation, ok := ps.ongoing[key]
+	if !ok {
+		return fmt.Errorf(
+			"participation of member [%v] for request [%v] has not started",
+			memberIndex,
+			identifier.String(requestID),
+		)
+	}
+	delete(ps.ongoing, key)
+
+	participation.CompletedAt = ps.now()
+
+	if gasUsage != nil && participation.TransactionHash != "" {
+		gasSpent, err := gasUsage.TransactionGasUsed(
+			participation.TransactionHash,
+		)
+		if err != nil {
+			logger.Warningf(
+				"[member:%v] could not get gas used by transaction [%v]: [%v]",
+				memberIndex,
+				participation.TransactionHash,
+				err,
+			)
+		} else {
+			participation.GasSpent = gasSpent
+		}
+	}
+
+	ps.completed = append(ps.completed, participation)
+
+	if ps.handle == nil {
+		return nil
+	}
+
+	participationBytes, err := json.Marshal(participation)
+	if err != nil {
+		return fmt.Errorf("could not marshal participation: [%v]", err)
+	}
+
+	return ps.handle.Save(
+		participationBytes,
+		fmt.Sprintf("%x", requestID),
+		fmt.Sprintf("/member_%v", memberIndex),
+	)
+}
+
+// Query returns completed participations selected by the filter, ordered by
+// their start time.
+func (ps *ParticipationStore) Query(
+	filter *ParticipationFilter,
+) []*Participation {
+	ps.mutex.Lock()
+	defer ps.mutex.Unlock()
+
+	participations := make([]*Participation, 0)
+	for _, participation := range ps.completed {
+		if filter == nil || filter.matches(participation) {
+			participations = append(participations, participation)
+		}
+	}
+
+	sort.SliceStable(participations, func(i, j int) bool {
+		return participations[i].StartedAt.Before(participations[j].StartedAt)
+	})
+
+	return participations
+}
@@ -0,0 +1,369 @@
+package dkg
+
+import (
+	"fmt"
+	"io/ioutil"
+	"math/big"
+	"net/url"
+	"os"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/keep-network/keep-common/pkg/persistence"
+	dkgResult "github.com/keep-network/keep-core/pkg/beacon/relay/dkg/result"
+	"github.com/keep-network/keep-core/pkg/beacon/relay/group"
+)
+
+var participationsStartTime = time.Date(2020, 5, 1, 12, 0, 0, 0, time.UTC)
+
+func TestParticipationStoreRecordsParticipations(t *testing.T) {
+	store := newTestParticipationStore(t, nil)
+
+	recordTestParticipations(t, store)
+
+	participations := store.Query(&ParticipationFilter{})
+
+	if len(participations) != 3 {
+		t.Fatalf(
+			"unexpected number of participations\nexpected: %v\nactual:   %v\n",
+			3,
+			len(participations),
+		)
+	}
+
+	expectedSubmitted := &Participation{
+		RequestID:           big.NewInt(1),
+		MemberIndex:         2,
+		Outcome:             string(dkgResult.SubmissionSubmitted),
+		PublishedByNode:     true,
+		TransactionHash:     "0x01",
+		GasSpent:            21000,
+		DisqualifiedMembers: []group.MemberIndex{4},
+		StartedAt:           participationsStartTime,
+		CompletedAt:         participationsStartTime.Add(10 * time.Minute),
+	}
+	if !reflect.DeepEqual(expectedSubmitted, participations[0]) {
+		t.Errorf(
+			"unexpected participation\nexpected: %+v\nactual:   %+v\n",
+			expectedSubmitted,
+			participations[0],
+		)
+	}
+
+	expectedOutcomes := []string{
+		string(dkgResult.SubmissionSubmitted),
+		string(dkgResult.SubmissionYielded),
+		ParticipationFailed,
+	}
+	for i, participation := range participations {
+		if participation.Outcome != expectedOutcomes[i] {
+			t.Errorf(
+				"unexpected outcome of participation [%v]\n"+
+					"expected: %v\nactual:   %v\n",
+				i,
+				expectedOutcomes[i],
+				participation.Outcome,
+			)
+		}
+	}
+}
+
+func TestParticipationStoreQueryFilters(t *testing.T) {
+	var tests = map[string]struct {
+		filter             *ParticipationFilter
+		expectedRequestIDs []int64
+	}{
+		"no filter": {
+			filter:             nil,
+			expectedRequestIDs: []int64{1, 2, 3},
+		},
+		"from": {
+			filter: &ParticipationFilter{
+				From: participationsStartTime.Add(time.Hour),
+			},
+			expectedRequestIDs: []int64{2, 3},
+		},
+		"to": {
+			filter: &ParticipationFilter{
+				To: participationsStartTime.Add(time.Hour),
+			},
+			expectedRequestIDs: []int64{1},
+		},
+		"time range": {
+			filter: &ParticipationFilter{
+				From: participationsStartTime.Add(time.Hour),
+				To:   participationsStartTime.Add(2 * time.Hour),
+			},
+			expectedRequestIDs: []int64{2},
+		},
+		"outcome": {
+			filter: &ParticipationFilter{
+				Outcome: ParticipationFailed,
+			},
+			expectedRequestIDs: []int64{3},
+		},
+		"time range and outcome": {
+			filter: &ParticipationFilter{
+				To:      participationsStartTime.Add(2 * time.Hour),
+				Outcome: string(dkgResult.SubmissionYielded),
+			},
+			expectedRequestIDs: []int64{2},
+		},
+		"nothing matching": {
+			filter: &ParticipationFilter{
+				From:    participationsStartTime.Add(time.Hour),
+				Outcome: string(dkgResult.SubmissionSubmitted),
+			},
+			expectedRequestIDs: []int64{},
+		},
+	}
+
+	for testName, test := range tests {
+		t.Run(testName, func(t *testing.T) {
+			store := newTestParticipationStore(t, nil)
+			recordTestParticipations(t, store)
+
+			requestIDs := make([]int64, 0)
+			for _, participation := range store.Query(test.filter) {
+				requestIDs = append(requestIDs, participation.RequestID.Int64())
+			}
+
+			if !reflect.DeepEqual(test.expectedRequestIDs, requestIDs) {
+				t.Errorf(
+					"unexpected request IDs\nexpected: %v\nactual:   %v\n",
+					test.expectedRequestIDs,
+					requestIDs,
+				)
+			}
+		})
+	}
+}
+
+func TestParticipationStorePersistence(t *testing.T) {
+	dir, err := ioutil.TempDir("", "participations")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	handle, err := persistence.NewDiskHandle(dir)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	store := newTestParticipationStore(t, handle)
+	recordTestParticipations(t, store)
+
+	restoredStore := newTestParticipationStore(t, handle)
+
+	expected := store.Query(nil)
+	actual := restoredStore.Query(nil)
+
+	if len(expected) != len(actual) {
+		t.Fatalf(
+			"unexpected number of participations\nexpected: %v\nactual:   %v\n",
+			len(expected),
+			len(actual),
+		)
+	}
+
+	for i := range expected {
+		if expected[i].RequestID.Cmp(actual[i].RequestID) != 0 ||
+			expected[i].Outcome != actual[i].Outcome ||
+			expected[i].GasSpent != actual[i].GasSpent ||
+			!expected[i].StartedAt.Equal(actual[i].StartedAt) ||
+			!reflect.DeepEqual(
+				expected[i].DisqualifiedMembers,
+				actual[i].DisqualifiedMembers,
+			) {
+			t.Errorf(
+				"unexpected participation [%v]\nexpected: %+v\nactual:   %+v\n",
+				i,
+				expected[i],
+				actual[i],
+			)
+		}
+	}
+}
+
+func TestParticipationStoreRecordsAttestation(t *testing.T) {
+	store := newTestParticipationStore(t, nil)
+
+	attestation := &dkgResult.Attestation{
+		RequestID:   big.NewInt(1),
+		MemberIndex: 2,
+		BlockNumber: 100,
+		Signature:   []byte{0x01},
+	}
+
+	store.Start(big.NewInt(1), 2)
+	store.RecordAttestation(attestation)
+	if err := store.Finish(big.NewInt(1), 2, nil); err != nil {
+		t.Fatal(err)
+	}
+
+	participations := store.Query(nil)
+	if len(participations) != 1 {
+		t.Fatalf(
+			"unexpected number of participations\nexpected: %v\nactual:   %v\n",
+			1,
+			len(participations),
+		)
+	}
+	if participations[0].Attestation != attestation {
+		t.Errorf(
+			"unexpected attestation\nexpected: %+v\nactual:   %+v\n",
+			attestation,
+			participations[0].Attestation,
+		)
+	}
+}
+
+func TestParticipationStoreFinishNotStarted(t *testing.T) {
+	store := newTestParticipationStore(t, nil)
+
+	err := store.Finish(big.NewInt(10), 1, nil)
+
+	expectedErr := fmt.Errorf(
+		"participation of member [1] for request [10] has not started",
+	)
+	if !reflect.DeepEqual(expectedErr, err) {
+		t.Errorf(
+			"unexpected error\nexpected: %v\nactual:   %v\n",
+			expectedErr,
+			err,
+		)
+	}
+}
+
+func TestParseParticipationFilter(t *testing.T) {
+	var tests = map[string]struct {
+		parameters     url.Values
+		expectedFilter *ParticipationFilter
+		expectedErr    error
+	}{
+		"no parameters": {
+			parameters:     url.Values{},
+			expectedFilter: &ParticipationFilter{},
+		},
+		"all parameters": {
+			parameters: url.Values{
+				"from":    {"2020-05-01T12:00:00Z"},
+				"to":      {"2020-05-01T13:00:00Z"},
+				"outcome": {"yielded"},
+			},
+			expectedFilter: &ParticipationFilter{
+				From:    participationsStartTime,
+				To:      participationsStartTime.Add(time.Hour),
+				Outcome: "yielded",
+			},
+		},
+		"invalid time": {
+			parameters: url.Values{
+				"from": {"yesterday"},
+			},
+			expectedErr: fmt.Errorf(
+				"invalid value of parameter [from]: [parsing time " +
+					"\"yesterday\" as \"2006-01-02T15:04:05Z07:00\": " +
+					"cannot parse \"yesterday\" as \"2006\"]",
+			),
+		},
+		"unknown outcome": {
+			parameters: url.Values{
+				"outcome": {"won"},
+			},
+			expectedErr: fmt.Errorf("unknown outcome [won]"),
+		},
+	}
+
+	for testName, test := range tests {
+		t.Run(testName, func(t *testing.T) {
+			filter, err := ParseParticipationFilter(test.parameters)
+
+			if !reflect.DeepEqual(test.expectedErr, err) {
+				t.Fatalf(
+					"unexpected error\nexpected: %v\nactual:   %v\n",
+					test.expectedErr,
+					err,
+				)
+			}
+
+			if !reflect.DeepEqual(test.expectedFilter, filter) {
+				t.Errorf(
+					"unexpected filter\nexpected: %+v\nactual:   %+v\n",
+					test.expectedFilter,
+					filter,
+				)
+			}
+		})
+	}
+}
+
+func newTestParticipationStore(
+	t *testing.T,
+	handle persistence.Handle,
+) *ParticipationStore {
+	store, err := NewParticipationStore(handle)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	return store
+}
+
+// recordTestParticipations records three participations started an hour
+// apart: submitted by the node, yielded to another member, and failed.
+func recordTestParticipations(t *testing.T, store *ParticipationStore) {
+	gasUsage := &gasUsageMock{gasUsed: map[string]uint64{"0x01": 21000}}
+
+	var now time.Time
+	store.now = func() time.Time { return now }
+
+	now = participationsStartTime
+	store.Start(big.NewInt(1), 2)
+	store.RecordDisqualification(big.NewInt(1), 2, 4)
+	store.RecordDisqualification(big.NewInt(1), 2, 4)
+	store.RecordSubmission(big.NewInt(1), &dkgResult.SubmissionReport{
+		MemberIndex:     2,
+		Outcome:         dkgResult.SubmissionSubmitted,
+		TransactionHash: "0x01",
+	})
+	now = participationsStartTime.Add(10 * time.Minute)
+	if err := store.Finish(big.NewInt(1), 2, gasUsage); err != nil {
+		t.Fatal(err)
+	}
+
+	now = participationsStartTime.Add(time.Hour)
+	store.Start(big.NewInt(2), 3)
+	store.RecordSubmission(big.NewInt(2), &dkgResult.SubmissionReport{
+		MemberIndex: 3,
+		Outcome:     dkgResult.SubmissionYielded,
+	})
+	now = participationsStartTime.Add(time.Hour + 10*time.Minute)
+	if err := store.Finish(big.NewInt(2), 3, gasUsage); err != nil {
+		t.Fatal(err)
+	}
+
+	now = participationsStartTime.Add(2 * time.Hour)
+	store.Start(big.NewInt(3), 1)
+	now = participationsStartTime.Add(2*time.Hour + 10*time.Minute)
+	if err := store.Finish(big.NewInt(3), 1, gasUsage); err != nil {
+		t.Fatal(err)
+	}
+}
+
+type gasUsageMock struct {
+	gasUsed map[string]uint64
+}
+
+func (gum *gasUsageMock) TransactionGasUsed(
+	transactionHash string,
+) (uint64, error) {
+	gasUsed, ok := gum.gasUsed[transactionHash]
+	if !ok {
+		return 0, fmt.Errorf("unknown transaction [%v]", transactionHash)
+	}
+
+	return gasUsed, nil
+}
@@ -0,0 +1,282 @@
+package dkg
+
+import (
+	"fmt"
+	"math/big"
+	"sort"
+	"sync"
+
+	"github.com/keep-network/keep-core/pkg/beacon/relay/config"
+	dkgResult "github.com/keep-network/keep-core/pkg/beacon/relay/dkg/result"
+	"github.com/keep-network/keep-core/pkg/beacon/relay/group"
+)
+
+// Names of DKG phases reported by the progress tracker.
+const (
+	PhaseWaitingForStart        = "waiting for start"
+	PhaseEphemeralKeyPair       = "ephemeral key pair generation"
+	PhaseCommitment             = "commitment"
+	PhaseCommitmentVerification = "commitment verification"
+	PhasePointsShare            = "points share"
+	PhasePointsValidation       = "points validation"
+	PhaseKeyReveal              = "key reveal"
+	PhaseCombination            = "combination"
+	PhaseResultSigning          = "result signing"
+	PhaseResultSubmission       = "result submission"
+)
+
+// PhaseStatus describes the phase in which the given member currently is in
+// the DKG execution for the given seed.
+type PhaseStatus struct {
+	Seed        *big.Int          `json:"seed"`
+	MemberIndex group.MemberIndex `json:"memberIndex"`
+	Phase       string            `json:"phase"`
+	// ElapsedBlocks is the number of blocks elapsed since the DKG execution
+	// started.
+	ElapsedBlocks uint64 `json:"elapsedBlocks"`
+	// PhaseElapsedBlocks is the number of blocks elapsed since the current
+	// phase started.
+	PhaseElapsedBlocks uint64 `json:"phaseElapsedBlocks"`
+	// EligibleToSubmit is set only in the result submission phase and tells
+	// if the member is already eligible to submit the result.
+	EligibleToSubmit *bool `json:"eligibleToSubmit,omitempty"`
+	// EligibleBlockHeight is set only in the result submission phase and is
+	// the block height from which the member is eligible to submit the result.
+	EligibleBlockHeight uint64 `json:"eligibleBlockHeight,omitempty"`
+}
+
+type trackedExecution struct {
+	seed             *big.Int
+	memberIndex      group.MemberIndex
+	startBlockHeight uint64
+}
+
+// ProgressTracker keeps track of DKG executions the node currently
+// participates in and reports their progress. Since all phases have a fixed
+// block budget, the current phase is determined based on the block height at
+// which the execution started.
+type ProgressTracker struct {
+	mutex sync.Mutex
+
+	phaseBudgets *config.DKGPhaseBudgets
+	chainConfig  *config.Chain
+	// whether the result submission order is rotated per seed
+	rotateSubmissionOrder bool
+	// minimum result publication block step; zero if the chain's one is used
+	minResultPublicationBlockStep uint64
+
+	executions map[string]*trackedExecution
+}
+
+// NewProgressTracker creates a new tracker for DKG executions using the given
+// phase budgets and chain config. If rotateSubmissionOrder is true, the
+// eligibility to submit the result is reported according to the submission
+// order rotated by the seed. The eligibility is reported using the chain's
+// result publication block step raised to the given minimum if it is lower.
+func NewProgressTracker(
+	phaseBudgets *config.DKGPhaseBudgets,
+	chainConfig *config.Chain,
+	rotateSubmissionOrder bool,
+	minResultPublicationBlockStep uint64,
+) *ProgressTracker {
+	return &ProgressTracker{
+		phaseBudgets:                  phaseBudgets,
+		chainConfig:                   chainConfig,
+		rotateSubmissionOrder:         rotateSubmissionOrder,
+		minResultPublicationBlockStep: minResultPublicationBlockStep,
+		executions:                    make(map[string]*trackedExecution),
+	}
+}
+
+func executionKey(seed *big.Int, memberIndex group.MemberIndex) string {
+	return fmt.Sprintf("%x-%v", seed, memberIndex)
+}
+
+// Start registers the DKG execution for the given seed and member starting at
+// the given block height. Registering the same execution again replaces the
+// previous start block height.
+func (pt *ProgressTracker) Start(
+	seed *big.Int,
+	memberIndex group.MemberIndex,
+	startBlockHeight uint64,
+) {
+	pt.mutex.Lock()
+	defer pt.mutex.Unlock()
+
+	pt.executions[executionKey(seed, memberIndex)] = &trackedExecution{
+		seed:             seed,
+		memberIndex:      memberIndex,
+		startBlockHeight: startBlockHeight,
+	}
+}
+
+// Finish removes the DKG execution for the given seed and member from the
+// tracker.
+func (pt *ProgressTracker) Finish(seed *big.Int, memberIndex group.MemberIndex) {
+	pt.mutex.Lock()
+	defer pt.mutex.Unlock()
+
+	delete(pt.executions, executionKey(seed, memberIndex))
+}
+
+// Status reports the current phase of all tracked DKG executions at the given
+// block height, ordered by the seed and member index.
+func (pt *ProgressTracker) Status(currentBlockHeight uint64) []*PhaseStatus {
+	pt.mutex.Lock()
+	defer pt.mutex.Unlock()
+
+	statuses := make([]*PhaseStatus, 0, len(pt.executions))
+	for _, execution := range pt.executions {
+		statuses = append(
+			statuses,
+			pt.executionStatus(execution, currentBlockHeight),
+		)
+	}
+
+	sort.Slice(statuses, func(i, j int) bool {
+		if seedCmp := statuses[i].Seed.Cmp(statuses[j].Seed); seedCmp != 0 {
+			return seedCmp < 0
+		}
+		return statuses[i].MemberIndex < statuses[j].MemberIndex
+	})
+
+	return statuses
+}
+
+// EligibleBlockHeight returns the earliest block height at which any of the
+// tracked members participating in the DKG execution for the given seed
+// becomes eligible to submit the result, along with the index of that member.
+// It returns false if no tracked member participates in the DKG execution for
+// the given seed.
+func (pt *ProgressTracker) EligibleBlockHeight(
+	seed *big.Int,
+) (uint64, group.MemberIndex, bool) {
+	pt.mutex.Lock()
+	defer pt.mutex.Unlock()
+
+	var (
+		earliestBlockHeight uint64
+		earliestMember      group.MemberIndex
+		found               bool
+	)
+	for _, execution := range pt.executions {
+		if execution.seed.Cmp(seed) != 0 {
+			continue
+		}
+
+		eligibleBlockHeight := pt.eligibleBlockHeight(
+			execution,
+			pt.submissionStartBlockHeight(execution),
+		)
+		if !found || eligibleBlockHeight < earliestBlockHeight ||
+			(eligibleBlockHeight == earliestBlockHeight &&
+				execution.memberIndex < earliestMember) {
+			earliestBlockHeight = eligibleBlockHeight
+			earliestMember = execution.memberIndex
+			found = true
+		}
+	}
+
+	return earliestBlockHeight, earliestMember, found
+}
+
+func (pt *ProgressTracker) executionStatus(
+	execution *trackedExecution,
+	currentBlockHeight uint64,
+) *PhaseStatus {
+	status := &PhaseStatus{
+		Seed:        execution.seed,
+		MemberIndex: execution.memberIndex,
+	}
+
+	if currentBlockHeight < execution.startBlockHeight {
+		status.Phase = PhaseWaitingForStart
+		return status
+	}
+
+	status.ElapsedBlocks = currentBlockHeight - execution.startBlockHeight
+
+	phases := pt.phases()
+
+	phaseStartBlockHeight := execution.startBlockHeight
+	for _, phase := range phases {
+		if currentBlockHeight < phaseStartBlockHeight+phase.blocks {
+			status.Phase = phase.name
+			status.PhaseElapsedBlocks = currentBlockHeight - phaseStartBlockHeight
+			return status
+		}
+		phaseStartBlockHeight += phase.blocks
+	}
+
+	eligibleBlockHeight := pt.eligibleBlockHeight(
+		execution,
+		phaseStartBlockHeight,
+	)
+	eligibleToSubmit := currentBlockHeight >= eligibleBlockHeight
+
+	status.Phase = PhaseResultSubmission
+	status.PhaseElapsedBlocks = currentBlockHeight - phaseStartBlockHeight
+	status.EligibleToSubmit = &eligibleToSubmit
+	status.EligibleBlockHeight = eligibleBlockHeight
+
+	return status
+}
+
+type trackedPhase struct {
+	name   string
+	blocks uint64
+}
+
+// phases returns DKG phases preceding the result submission along with their
+// block budgets, in the order of execution.
+func (pt *ProgressTracker) phases() []trackedPhase {
+	return []trackedPhase{
+		{PhaseEphemeralKeyPair, pt.phaseBudgets.EphemeralKeyPair.Blocks()},
+		{PhaseCommitment, pt.phaseBudgets.Commitment.Blocks()},
+		{PhaseCommitmentVerification, pt.phaseBudgets.CommitmentVerification.Blocks()},
+		{PhasePointsShare, pt.phaseBudgets.PointsShare.Blocks()},
+		{PhasePointsValidation, pt.phaseBudgets.PointsValidation.Blocks()},
+		{PhaseKeyReveal, pt.phaseBudgets.KeyReveal.Blocks()},
+		{PhaseCombination, pt.phaseBudgets.Combination.Blocks()},
+		{PhaseResultSigning, dkgResult.PrePublicationBlocks()},
+	}
+}
+
+// submissionStartBlockHeight returns the block height at which the result
+// submission of the given execution starts.
+func (pt *ProgressTracker) submissionStartBlockHeight(
+	execution *trackedExecution,
+) uint64 {
+	startBlockHeight := execution.startBlockHeight
+	for _, phase := range pt.phases() {
+		startBlockHeight += phase.blocks
+	}
+
+	return startBlockHeight
+}
+
+// eligibleBlockHeight returns the block height at which the member of the
+// given execution becomes eligible to submit the result if the submission
+// starts at the given block height.
+func (pt *ProgressTracker) eligibleBlockHeight(
+	execution *trackedExecution,
+	submissionStartBlockHeight uint64,
+) uint64 {
+	var rotation *dkgResult.SubmissionRotation
+	if pt.rotateSubmissionOrder {
+		rotation = dkgResult.NewSubmissionRotation(
+			execution.seed,
+			pt.chainConfig.GroupSize,
+		)
+	}
+
+	return dkgResult.RotatedEligibleBlockHeight(
+		rotation,
+		execution.memberIndex,
+		submissionStartBlockHeight,
+		dkgResult.EffectiveBlockStep(
+			pt.chainConfig.ResultPublicationBlockStep,
+			pt.minResultPublicationBlockStep,
+		),
+	)
+}
@@ -0,0 +1,223 @@
+package dkg
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/keep-network/keep-core/pkg/beacon/relay/config"
+	"github.com/keep-network/keep-core/pkg/beacon/relay/gjkr"
+	"github.com/keep-network/keep-core/pkg/beacon/relay/group"
+)
+
+func TestProgressTrackerStatus(t *testing.T) {
+	chainConfig := &config.Chain{
+		GroupSize:                  5,
+		HonestThreshold:            3,
+		ResultPublicationBlockStep: 3,
+	}
+
+	// Default phase budgets, execution starting at block 100:
+	// ephemeral key pair:      [100, 106)
+	// commitment:              [106, 112)
+	// commitment verification: [112, 123)
+	// points share:            [123, 129)
+	// points validation:       [129, 140)
+	// key reveal:              [140, 146)
+	// combination:             [146, 166)
+	// result signing:          [166, 172)
+	// result submission:       [172, ...)
+	startBlockHeight := uint64(100)
+
+	var tests = map[string]struct {
+		memberIndex                group.MemberIndex
+		currentBlockHeight         uint64
+		expectedPhase              string
+		expectedElapsedBlocks      uint64
+		expectedPhaseElapsedBlocks uint64
+		expectedEligibleToSubmit   *bool
+		expectedEligibleBlock      uint64
+	}{
+		"before start": {
+			memberIndex:        1,
+			currentBlockHeight: 90,
+			expectedPhase:      PhaseWaitingForStart,
+		},
+		"first block of the protocol": {
+			memberIndex:           1,
+			currentBlockHeight:    100,
+			expectedPhase:         PhaseEphemeralKeyPair,
+			expectedElapsedBlocks: 0,
+		},
+		"in the middle of commitment verification": {
+			memberIndex:                1,
+			currentBlockHeight:         117,
+			expectedPhase:              PhaseCommitmentVerification,
+			expectedElapsedBlocks:      17,
+			expectedPhaseElapsedBlocks: 5,
+		},
+		"last block of combination": {
+			memberIndex:                1,
+			currentBlockHeight:         165,
+			expectedPhase:              PhaseCombination,
+			expectedElapsedBlocks:      65,
+			expectedPhaseElapsedBlocks: 19,
+		},
+		"result signing": {
+			memberIndex:                1,
+			currentBlockHeight:         166,
+			expectedPhase:              PhaseResultSigning,
+			expectedElapsedBlocks:      66,
+			expectedPhaseElapsedBlocks: 0,
+		},
+		"first member eligible to submit": {
+			memberIndex:                1,
+			currentBlockHeight:         172,
+			expectedPhase:              PhaseResultSubmission,
+			expectedElapsedBlocks:      72,
+			expectedPhaseElapsedBlocks: 0,
+			expectedEligibleToSubmit:   boolPointer(true),
+			expectedEligibleBlock:      172,
+		},
+		"third member not yet eligible to submit": {
+			memberIndex:                3,
+			currentBlockHeight:         177,
+			expectedPhase:              PhaseResultSubmission,
+			expectedElapsedBlocks:      77,
+			expectedPhaseElapsedBlocks: 5,
+			expectedEligibleToSubmit:   boolPointer(false),
+			expectedEligibleBlock:      178,
+		},
+		"third member eligible to submit": {
+			memberIndex:                3,
+			currentBlockHeight:         178,
+			expectedPhase:              PhaseResultSubmission,
+			expectedElapsedBlocks:      78,
+			expectedPhaseElapsedBlocks: 6,
+			expectedEligibleToSubmit:   boolPointer(true),
+			expectedEligibleBlock:      178,
+		},
+	}
+
+	for testName, test := range tests {
+		t.Run(testName, func(t *testing.T) {
+			tracker := NewProgressTracker(gjkr.DefaultPhaseBudgets(), chainConfig, false, 0)
+			tracker.Start(big.NewInt(10), test.memberIndex, startBlockHeight)
+
+			statuses := tracker.Status(test.currentBlockHeight)
+			if len(statuses) != 1 {
+				t.Fatalf(
+					"unexpected number of statuses\nexpected: %v\nactual:   %v\n",
+					1,
+					len(statuses),
+				)
+			}
+			status := statuses[0]
+
+			if status.Phase != test.expectedPhase {
+				t.Errorf(
+					"unexpected phase\nexpected: %v\nactual:   %v\n",
+					test.expectedPhase,
+					status.Phase,
+				)
+			}
+			if status.ElapsedBlocks != test.expectedElapsedBlocks {
+				t.Errorf(
+					"unexpected elapsed blocks\nexpected: %v\nactual:   %v\n",
+					test.expectedElapsedBlocks,
+					status.ElapsedBlocks,
+				)
+			}
+			if status.PhaseElapsedBlocks != test.expectedPhaseElapsedBlocks {
+				t.Errorf(
+					"unexpected phase elapsed blocks\nexpected: %v\nactual:   %v\n",
+					test.expectedPhaseElapsedBlocks,
+					status.PhaseElapsedBlocks,
+				)
+			}
+			if (status.EligibleToSubmit == nil) != (test.expectedEligibleToSubmit == nil) ||
+				(status.EligibleToSubmit != nil &&
+					*status.EligibleToSubmit != *test.expectedEligibleToSubmit) {
+				t.Errorf(
+					"unexpected submission eligibility\nexpected: %v\nactual:   %v\n",
+					test.expectedEligibleToSubmit,
+					status.EligibleToSubmit,
+				)
+			}
+			if status.EligibleBlockHeight != test.expectedEligibleBlock {
+				t.Errorf(
+					"unexpected eligible block height\nexpected: %v\nactual:   %v\n",
+					test.expectedEligibleBlock,
+					status.EligibleBlockHeight,
+				)
+			}
+		})
+	}
+}
+
+func TestProgressTrackerEligibleBlockHeight(t *testing.T) {
+	chainConfig := &config.Chain{
+		GroupSize:                  5,
+		HonestThreshold:            3,
+		ResultPublicationBlockStep: 3,
+	}
+
+	tracker := NewProgressTracker(gjkr.DefaultPhaseBudgets(), chainConfig, false, 0)
+	tracker.Start(big.NewInt(10), 5, 100)
+	tracker.Start(big.NewInt(10), 3, 100)
+	tracker.Start(big.NewInt(20), 1, 100)
+
+	// result submission starts at block 172; the third member is eligible
+	// two block steps later
+	blockHeight, memberIndex, ok := tracker.EligibleBlockHeight(big.NewInt(10))
+	if !ok {
+		t.Fatal("expected tracked execution")
+	}
+	if blockHeight != 178 {
+		t.Errorf(
+			"unexpected eligible block height\nexpected: %v\nactual:   %v\n",
+			178,
+			blockHeight,
+		)
+	}
+	if memberIndex != 3 {
+		t.Errorf(
+			"unexpected member index\nexpected: %v\nactual:   %v\n",
+			3,
+			memberIndex,
+		)
+	}
+
+	if _, _, ok := tracker.EligibleBlockHeight(big.NewInt(30)); ok {
+		t.Errorf("expected no tracked execution")
+	}
+}
+
+func TestProgressTrackerFinish(t *testing.T) {
+	tracker := NewProgressTracker(gjkr.DefaultPhaseBudgets(), &config.Chain{}, false, 0)
+
+	tracker.Start(big.NewInt(20), 2, 100)
+	tracker.Start(big.NewInt(10), 3, 100)
+	tracker.Start(big.NewInt(10), 1, 100)
+
+	tracker.Finish(big.NewInt(10), 3)
+
+	statuses := tracker.Status(110)
+	if len(statuses) != 2 {
+		t.Fatalf(
+			"unexpected number of statuses\nexpected: %v\nactual:   %v\n",
+			2,
+			len(statuses),
+		)
+	}
+
+	if statuses[0].Seed.Cmp(big.NewInt(10)) != 0 || statuses[0].MemberIndex != 1 {
+		t.Errorf("unexpected first status: [%+v]", statuses[0])
+	}
+	if statuses[1].Seed.Cmp(big.NewInt(20)) != 0 || statuses[1].MemberIndex != 2 {
+		t.Errorf("unexpected second status: [%+v]", statuses[1])
+	}
+}
+
+func boolPointer(value bool) *bool {
+	return &value
+}
@@ -0,0 +1,171 @@
+package dkg
+
+import (
+	"fmt"
+	"math/big"
+	"sync"
+
+	relayChain "github.com/keep-network/keep-core/pkg/beacon/relay/chain"
+	"github.com/keep-network/keep-core/pkg/beacon/relay/event"
+	"github.com/keep-network/keep-core/pkg/beacon/relay/group"
+	"github.com/keep-network/keep-core/pkg/identifier"
+)
+
+// maxTrackedPublications is the number of the most recent publications kept
+// by the publisher tracker.
+const maxTrackedPublications = 1000
+
+// Publication describes which group member published the DKG result accepted
+// by the chain for the DKG executed with the given seed.
+type Publication struct {
+	Seed           *big.Int          `json:"seed"`
+	PublisherIndex group.MemberIndex `json:"publisherIndex"`
+	// PublishedByNode tells if the publisher is one of the members
+	// controlled by this node.
+	PublishedByNode bool   `json:"publishedByNode"`
+	GroupPublicKey  string `json:"groupPublicKey"`
+	BlockNumber     uint64 `json:"blockNumber"`
+	TransactionHash string `json:"transactionHash,omitempty"`
+}
+
+// PublisherStatus lists the most recent DKG result publications along with
+// the number of publications per publisher index.
+type PublisherStatus struct {
+	Publications []*Publication            `json:"publications"`
+	Distribution map[group.MemberIndex]int `json:"distribution"`
+}
+
+// PublisherTracker records which member published the accepted DKG result
+// for each DKG the node participated in, so that operators can see how
+// publications are distributed across the group over time.
+type PublisherTracker struct {
+	mutex        sync.Mutex
+	publications []*Publication
+}
+
+// NewPublisherTracker creates a new tracker with no publications recorded.
+func NewPublisherTracker() *PublisherTracker {
+	return &PublisherTracker{
+		publications: make([]*Publication, 0),
+	}
+}
+
+// Watch observes the chain for the first DKG result submission at or after
+// the given block height and records its publisher for the given seed.
+// The given member indexes are indexes of members controlled by this node.
+// Watching ends once the submission is recorded or the returned function is
+// called, whichever comes first.
+func (pt *PublisherTracker) Watch(
+	chain relayChain.Interface,
+	seed *big.Int,
+	memberIndexes []group.MemberIndex,
+	startBlockHeight uint64,
+) (func(), error) {
+	var once sync.Once
+	done := make(chan struct{})
+	stop := func() { once.Do(func() { close(done) }) }
+
+	submissions := make(chan *event.DKGResultSubmission)
+	subscription, err := chain.OnDKGResultSubmitted(
+		func(submission *event.DKGResultSubmission) {
+			select {
+			case submissions <- submission:
+			case <-done:
+			}
+		},
+	)
+	if err != nil {
+		return nil, fmt.Errorf(
+			"could not subscribe for DKG result submissions: [%v]",
+			err,
+		)
+	}
+
+	go func() {
+		defer subscription.Unsubscribe()
+
+		for {
+			select {
+			case submission := <-submissions:
+				if submission.BlockNumber < startBlockHeight {
+					continue
+				}
+
+				pt.Record(seed, memberIndexes, submission)
+				stop()
+				return
+			case <-done:
+				return
+			}
+		}
+	}()
+
+	return stop, nil
+}
+
+// Record records the publisher of the given DKG result submission for the
+// given seed. The given member indexes are indexes of members controlled by
+// this node.
+func (pt *PublisherTracker) Record(
+	seed *big.Int,
+	memberIndexes []group.MemberIndex,
+	submission *event.DKGResultSubmission,
+) *Publication {
+	publisherIndex := group.MemberIndex(submission.MemberIndex)
+
+	publishedByNode := false
+	for _, memberIndex := range memberIndexes {
+		if memberIndex == publisherIndex {
+			publishedByNode = true
+			break
+		}
+	}
+
+	publication := &Publication{
+		Seed:            seed,
+		PublisherIndex:  publisherIndex,
+		PublishedByNode: publishedByNode,
+		GroupPublicKey:  fmt.Sprintf("0x%x", submission.GroupPublicKey),
+		BlockNumber:     submission.BlockNumber,
+		TransactionHash: submission.TransactionHash,
+	}
+
+	logger.Infof(
+		"[audit] DKG result for seed [%v] published by member [%v] at "+
+			"block [%v]; published by this node: [%v]",
+		identifier.String(seed),
+		publisherIndex,
+		submission.BlockNumber,
+		publishedByNode,
+	)
+
+	pt.mutex.Lock()
+	defer pt.mutex.Unlock()
+
+	pt.publications = append(pt.publications, publication)
+	if len(pt.publications) > maxTrackedPublications {
+		pt.publications = pt.publications[1:]
+	}
+
+	return publication
+}
+
+// Status returns the recorded publications, from the oldest to the most
+// recent one, along with the number of publications per publisher index.
+func (pt *PublisherTracker) Status() *PublisherStatus {
+	pt.mutex.Lock()
+	defer pt.mutex.Unlock()
+
+	publications := make([]*Publication, len(pt.publications))
+	copy(publications, pt.publications)
+
+	distribution := make(map[group.MemberIndex]int)
+	for _, publication := range publications {
+		distribution[publication.PublisherIndex]++
+	}
+
+	return &PublisherStatus{
+		Publications: publications,
+		Distribution: distribution,
+	}
+}
@@ -0,0 +1,155 @@
+package dkg
+
+import (
+	"math/big"
+	"testing"
+	"time"
+
+	relayChain "github.com/keep-network/keep-core/pkg/beacon/relay/chain"
+	"github.com/keep-network/keep-core/pkg/beacon/relay/event"
+	"github.com/keep-network/keep-core/pkg/beacon/relay/group"
+	"github.com/keep-network/keep-core/pkg/chain/local"
+)
+
+func TestPublisherTrackerWatch(t *testing.T) {
+	nodeMembers := []group.MemberIndex{2, 4}
+
+	var tests = map[string]struct {
+		submittingMember        group.MemberIndex
+		expectedPublishedByNode bool
+	}{
+		"published by this node": {
+			submittingMember:        4,
+			expectedPublishedByNode: true,
+		},
+		"published by other node": {
+			submittingMember:        3,
+			expectedPublishedByNode: false,
+		},
+	}
+
+	for testName, test := range tests {
+		t.Run(testName, func(t *testing.T) {
+			chainHandle := local.Connect(5, 3, big.NewInt(10))
+			seed := big.NewInt(1234)
+
+			tracker := NewPublisherTracker()
+			stop, err := tracker.Watch(
+				chainHandle.ThresholdRelay(),
+				seed,
+				nodeMembers,
+				0,
+			)
+			if err != nil {
+				t.Fatal(err)
+			}
+			defer stop()
+
+			chainHandle.ThresholdRelay().SubmitDKGResult(
+				test.submittingMember,
+				&relayChain.DKGResult{GroupPublicKey: []byte{10, 11}},
+				map[group.MemberIndex][]byte{
+					1: []byte{101},
+					2: []byte{102},
+					3: []byte{103},
+				},
+			)
+
+			var status *PublisherStatus
+			for i := 0; i < 100; i++ {
+				status = tracker.Status()
+				if len(status.Publications) > 0 {
+					break
+				}
+				time.Sleep(10 * time.Millisecond)
+			}
+
+			if len(status.Publications) != 1 {
+				t.Fatalf(
+					"unexpected number of publications\nexpected: %v\nactual:   %v\n",
+					1,
+					len(status.Publications),
+				)
+			}
+
+			publication := status.Publications[0]
+			if publication.Seed.Cmp(seed) != 0 {
+				t.Errorf(
+					"unexpected seed\nexpected: %v\nactual:   %v\n",
+					seed,
+					publication.Seed,
+				)
+			}
+			if publication.PublisherIndex != test.submittingMember {
+				t.Errorf(
+					"unexpected publisher\nexpected: %v\nactual:   %v\n",
+					test.submittingMember,
+					publication.PublisherIndex,
+				)
+			}
+			if publication.PublishedByNode != test.expectedPublishedByNode {
+				t.Errorf(
+					"unexpected published by node\nexpected: %v\nactual:   %v\n",
+					test.expectedPublishedByNode,
+					publication.PublishedByNode,
+				)
+			}
+			if status.Distribution[test.submittingMember] != 1 {
+				t.Errorf(
+					"unexpected distribution\nexpected: %v\nactual:   %v\n",
+					map[group.MemberIndex]int{test.submittingMember: 1},
+					status.Distribution,
+				)
+			}
+		})
+	}
+}
+
+func TestPublisherTrackerDistribution(t *testing.T) {
+	tracker := NewPublisherTracker()
+
+	for i, publisher := range []uint32{1, 3, 1, 5, 1} {
+		tracker.Record(
+			big.NewInt(int64(i)),
+			[]group.MemberIndex{1},
+			&event.DKGResultSubmission{
+				MemberIndex: publisher,
+				BlockNumber: uint64(i),
+			},
+		)
+	}
+
+	status := tracker.Status()
+
+	expectedDistribution := map[group.MemberIndex]int{1: 3, 3: 1, 5: 1}
+	if len(status.Distribution) != len(expectedDistribution) {
+		t.Fatalf(
+			"unexpected distribution\nexpected: %v\nactual:   %v\n",
+			expectedDistribution,
+			status.Distribution,
+		)
+	}
+	for publisher, count := range expectedDistribution {
+		if status.Distribution[publisher] != count {
+			t.Errorf(
+				"unexpected distribution\nexpected: %v\nactual:   %v\n",
+				expectedDistribution,
+				status.Distribution,
+			)
+		}
+	}
+
+	selfPublications := 0
+	for _, publication := range status.Publications {
+		if publication.PublishedByNode {
+			selfPublications++
+		}
+	}
+	if selfPublications != 3 {
+		t.Errorf(
+			"unexpected number of self publications\nexpected: %v\nactual:   %v\n",
+			3,
+			selfPublications,
+		)
+	}
+}
@@ -0,0 +1,131 @@
+package dkg
+
+import (
+	"sync"
+	"time"
+
+	"github.com/keep-network/keep-core/pkg/beacon/relay/config"
+)
+
+// ParticipationLimiter limits the rate at which the operator starts
+// participating in new DKG executions. It allows at most the configured number
+// of new participations per window and refills the allowance evenly over the
+// window. Participations exceeding the rate are either declined immediately
+// or queued until the allowance refills, depending on the policy.
+//
+// The limiter bounds the rate of starts, not the number of DKG executions in
+// flight at the same time.
+type ParticipationLimiter struct {
+	maxParticipations int
+	window            time.Duration
+	policy            string
+	maxQueueWait      time.Duration
+
+	mutex      sync.Mutex
+	allowance  float64
+	lastRefill time.Time
+
+	now func() time.Time
+}
+
+// NewParticipationLimiter creates a DKG participation limiter using values
+// from the provided node configuration. It returns nil if the rate limit is
+// not configured.
+func NewParticipationLimiter(nodeConfig *config.Node) *ParticipationLimiter {
+	if nodeConfig == nil || nodeConfig.DKGParticipationRateLimit == 0 {
+		return nil
+	}
+
+	window := time.Duration(nodeConfig.DKGParticipationRateWindowSeconds) *
+		time.Second
+	if window == 0 {
+		window = config.DefaultDKGParticipationRateWindow
+	}
+
+	policy := nodeConfig.DKGParticipationRatePolicy
+	if policy == "" {
+		policy = config.DeclineDKGParticipation
+	}
+
+	return newParticipationLimiter(
+		nodeConfig.DKGParticipationRateLimit,
+		window,
+		policy,
+		time.Duration(nodeConfig.DKGParticipationMaxQueueWaitSeconds)*
+			time.Second,
+		time.Now,
+	)
+}
+
+func newParticipationLimiter(
+	maxParticipations int,
+	window time.Duration,
+	policy string,
+	maxQueueWait time.Duration,
+	now func() time.Time,
+) *ParticipationLimiter {
+	return &ParticipationLimiter{
+		maxParticipations: maxParticipations,
+		window:            window,
+		policy:            policy,
+		maxQueueWait:      maxQueueWait,
+		allowance:         float64(maxParticipations),
+		lastRefill:        now(),
+		now:               now,
+	}
+}
+
+// Admit returns true if the operator may start participating in a new DKG
+// execution. If the rate has been exceeded and the policy is to queue excess
+// participations, Admit blocks until the allowance refills, but no longer
+// than the maximum queue wait. Otherwise, it returns false immediately.
+func (pl *ParticipationLimiter) Admit() bool {
+	deadline := pl.now().Add(pl.maxQueueWait)
+
+	for {
+		wait, admitted := pl.tryAdmit()
+		if admitted {
+			return true
+		}
+
+		if pl.policy != config.QueueDKGParticipation ||
+			pl.now().Add(wait).After(deadline) {
+			return false
+		}
+
+		time.Sleep(wait)
+	}
+}
+
+// tryAdmit consumes a single participation from the allowance if available.
+// If not, it returns the time after which the next participation becomes
+// available.
+func (pl *ParticipationLimiter) tryAdmit() (time.Duration, bool) {
+	pl.mutex.Lock()
+	defer pl.mutex.Unlock()
+
+	pl.refill()
+
+	if pl.allowance >= 1 {
+		pl.allowance--
+		return 0, true
+	}
+
+	refillInterval := pl.window / time.Duration(pl.maxParticipations)
+	return time.Duration((1 - pl.allowance) * float64(refillInterval)), false
+}
+
+// refill adds participations accrued since the last refill, up to the
+// maximum number of participations per window. Must be called with the mutex
+// held.
+func (pl *ParticipationLimiter) refill() {
+	now := pl.now()
+	elapsed := now.Sub(pl.lastRefill)
+	pl.lastRefill = now
+
+	pl.allowance += float64(pl.maxParticipations) *
+		float64(elapsed) / float64(pl.window)
+	if pl.allowance > float64(pl.maxParticipations) {
+		pl.allowance = float64(pl.maxParticipations)
+	}
+}
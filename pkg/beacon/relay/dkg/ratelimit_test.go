@@ -0,0 +1,112 @@
+package dkg
+
+import (
+	"testing"
+	"time"
+
+	"github.com/keep-network/keep-core/pkg/beacon/relay/config"
+)
+
+func TestParticipationLimiterDeclinesExcessParticipations(t *testing.T) {
+	now := time.Now()
+	limiter := newParticipationLimiter(
+		2,
+		time.Hour,
+		config.DeclineDKGParticipation,
+		0,
+		func() time.Time { return now },
+	)
+
+	var tests = []struct {
+		elapsed          time.Duration
+		expectedAdmitted bool
+	}{
+		{elapsed: 0, expectedAdmitted: true},
+		{elapsed: 0, expectedAdmitted: true},
+		{elapsed: 0, expectedAdmitted: false},
+		// half of the window refills one participation
+		{elapsed: 29 * time.Minute, expectedAdmitted: false},
+		{elapsed: 2 * time.Minute, expectedAdmitted: true},
+		{elapsed: 0, expectedAdmitted: false},
+		// allowance does not grow over the maximum
+		{elapsed: 5 * time.Hour, expectedAdmitted: true},
+		{elapsed: 0, expectedAdmitted: true},
+		{elapsed: 0, expectedAdmitted: false},
+	}
+
+	for i, test := range tests {
+		now = now.Add(test.elapsed)
+
+		admitted := limiter.Admit()
+		if admitted != test.expectedAdmitted {
+			t.Errorf(
+				"unexpected admission of participation [%v]\n"+
+					"expected: %v\nactual:   %v\n",
+				i,
+				test.expectedAdmitted,
+				admitted,
+			)
+		}
+	}
+}
+
+func TestParticipationLimiterQueuesExcessParticipations(t *testing.T) {
+	window := 200 * time.Millisecond
+
+	var tests = map[string]struct {
+		maxQueueWait     time.Duration
+		expectedAdmitted bool
+	}{
+		"refilled within queue wait": {
+			maxQueueWait:     time.Second,
+			expectedAdmitted: true,
+		},
+		"not refilled within queue wait": {
+			maxQueueWait:     10 * time.Millisecond,
+			expectedAdmitted: false,
+		},
+	}
+
+	for testName, test := range tests {
+		t.Run(testName, func(t *testing.T) {
+			limiter := newParticipationLimiter(
+				1,
+				window,
+				config.QueueDKGParticipation,
+				test.maxQueueWait,
+				time.Now,
+			)
+
+			if !limiter.Admit() {
+				t.Fatal("expected first participation to be admitted")
+			}
+
+			start := time.Now()
+			admitted := limiter.Admit()
+			waited := time.Since(start)
+
+			if admitted != test.expectedAdmitted {
+				t.Fatalf(
+					"unexpected admission\nexpected: %v\nactual:   %v\n",
+					test.expectedAdmitted,
+					admitted,
+				)
+			}
+
+			if admitted && waited < window/2 {
+				t.Errorf(
+					"queued participation admitted before refill\n"+
+						"expected wait: ~%v\nactual wait:   %v\n",
+					window,
+					waited,
+				)
+			}
+		})
+	}
+}
+
+func TestNewParticipationLimiterDisabled(t *testing.T) {
+	if limiter := NewParticipationLimiter(&config.Node{}); limiter != nil {
+		t.Errorf("expected no limiter when rate limit is not configured")
+	}
+}
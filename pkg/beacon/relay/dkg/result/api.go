@@ -0,0 +1,80 @@
+package result
+
+import (
+	"errors"
+	"fmt"
+
+	relayChain "github.com/keep-network/keep-core/pkg/beacon/relay/chain"
+	"github.com/keep-network/keep-core/pkg/beacon/relay/dkg/result/gen/pb"
+	"github.com/keep-network/keep-core/pkg/beacon/relay/group"
+)
+
+// DKGResultToProto converts the DKG result to its protobuf form exposed by
+// management APIs.
+func DKGResultToProto(result *relayChain.DKGResult) *pb.DKGResult {
+	return &pb.DKGResult{
+		GroupPublicKey: result.GroupPublicKey,
+		Misbehaved:     result.Misbehaved,
+	}
+}
+
+// DKGResultFromProto converts the protobuf form of the DKG result produced by
+// DKGResultToProto back to the DKG result.
+func DKGResultFromProto(pbResult *pb.DKGResult) *relayChain.DKGResult {
+	return &relayChain.DKGResult{
+		GroupPublicKey: pbResult.GroupPublicKey,
+		Misbehaved:     pbResult.Misbehaved,
+	}
+}
+
+// SubmissionReportToProto converts the DKG result submission report to its
+// protobuf form exposed by management APIs. The failure reason is represented
+// by its message.
+func SubmissionReportToProto(report *SubmissionReport) *pb.SubmissionReport {
+	pbReport := &pb.SubmissionReport{
+		Outcome:         string(report.Outcome),
+		MemberIndex:     uint32(report.MemberIndex),
+		BlockHeight:     report.BlockHeight,
+		GroupPublicKey:  report.GroupPublicKey,
+		TransactionHash: report.TransactionHash,
+	}
+	if report.Err != nil {
+		pbReport.Error = report.Err.Error()
+	}
+
+	return pbReport
+}
+
+// SubmissionReportFromProto converts the protobuf form of the DKG result
+// submission report produced by SubmissionReportToProto back to the submission
+// report. It fails if the outcome is unknown or the member index overflows.
+func SubmissionReportFromProto(
+	pbReport *pb.SubmissionReport,
+) (*SubmissionReport, error) {
+	outcome := SubmissionOutcome(pbReport.Outcome)
+	switch outcome {
+	case SubmissionSubmitted, SubmissionYielded, SubmissionFailed:
+	default:
+		return nil, fmt.Errorf(
+			"unknown submission outcome: [%v]",
+			pbReport.Outcome,
+		)
+	}
+
+	if err := validateMemberIndex(pbReport.MemberIndex); err != nil {
+		return nil, err
+	}
+
+	report := &SubmissionReport{
+		Outcome:         outcome,
+		MemberIndex:     group.MemberIndex(pbReport.MemberIndex),
+		BlockHeight:     pbReport.BlockHeight,
+		GroupPublicKey:  pbReport.GroupPublicKey,
+		TransactionHash: pbReport.TransactionHash,
+	}
+	if pbReport.Error != "" {
+		report.Err = errors.New(pbReport.Error)
+	}
+
+	return report, nil
+}
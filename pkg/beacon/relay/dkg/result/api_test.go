@@ -0,0 +1,130 @@
+package result
+
+import (
+	"fmt"
+	"reflect"
+	"testing"
+
+	relayChain "github.com/keep-network/keep-core/pkg/beacon/relay/chain"
+	"github.com/keep-network/keep-core/pkg/beacon/relay/dkg/result/gen/pb"
+)
+
+func TestDKGResultProtoRoundtrip(t *testing.T) {
+	result := &relayChain.DKGResult{
+		GroupPublicKey: []byte{10, 11, 12},
+		Misbehaved:     []byte{2, 5},
+	}
+
+	bytes, err := DKGResultToProto(result).Marshal()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	pbResult := &pb.DKGResult{}
+	if err := pbResult.Unmarshal(bytes); err != nil {
+		t.Fatal(err)
+	}
+
+	roundtripped := DKGResultFromProto(pbResult)
+	if !reflect.DeepEqual(result, roundtripped) {
+		t.Errorf(
+			"unexpected result\nexpected: %v\nactual:   %v\n",
+			result,
+			roundtripped,
+		)
+	}
+}
+
+func TestSubmissionReportProtoRoundtrip(t *testing.T) {
+	var tests = map[string]struct {
+		report *SubmissionReport
+	}{
+		"submitted": {
+			report: &SubmissionReport{
+				Outcome:         SubmissionSubmitted,
+				MemberIndex:     3,
+				BlockHeight:     120,
+				GroupPublicKey:  []byte{10, 11, 12},
+				TransactionHash: "0x01",
+			},
+		},
+		"yielded": {
+			report: &SubmissionReport{
+				Outcome:        SubmissionYielded,
+				MemberIndex:    4,
+				BlockHeight:    121,
+				GroupPublicKey: []byte{10, 11, 12},
+			},
+		},
+		"failed": {
+			report: &SubmissionReport{
+				Outcome:        SubmissionFailed,
+				MemberIndex:    255,
+				GroupPublicKey: []byte{10, 11, 12},
+				Err:            fmt.Errorf("submission failed"),
+			},
+		},
+	}
+
+	for testName, test := range tests {
+		t.Run(testName, func(t *testing.T) {
+			bytes, err := SubmissionReportToProto(test.report).Marshal()
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			pbReport := &pb.SubmissionReport{}
+			if err := pbReport.Unmarshal(bytes); err != nil {
+				t.Fatal(err)
+			}
+
+			roundtripped, err := SubmissionReportFromProto(pbReport)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			if !reflect.DeepEqual(test.report, roundtripped) {
+				t.Errorf(
+					"unexpected report\nexpected: %+v\nactual:   %+v\n",
+					test.report,
+					roundtripped,
+				)
+			}
+		})
+	}
+}
+
+func TestSubmissionReportFromInvalidProto(t *testing.T) {
+	var tests = map[string]struct {
+		pbReport      *pb.SubmissionReport
+		expectedError error
+	}{
+		"unknown outcome": {
+			pbReport: &pb.SubmissionReport{
+				Outcome:     "lost",
+				MemberIndex: 1,
+			},
+			expectedError: fmt.Errorf("unknown submission outcome: [lost]"),
+		},
+		"member index overflow": {
+			pbReport: &pb.SubmissionReport{
+				Outcome:     string(SubmissionSubmitted),
+				MemberIndex: 256,
+			},
+			expectedError: fmt.Errorf("Invalid member index value: [256]"),
+		},
+	}
+
+	for testName, test := range tests {
+		t.Run(testName, func(t *testing.T) {
+			_, err := SubmissionReportFromProto(test.pbReport)
+			if !reflect.DeepEqual(test.expectedError, err) {
+				t.Errorf(
+					"unexpected error\nexpected: %v\nactual:   %v\n",
+					test.expectedError,
+					err,
+				)
+			}
+		})
+	}
+}
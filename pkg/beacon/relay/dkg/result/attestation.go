@@ -0,0 +1,132 @@
+package result
+
+import (
+	"fmt"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/keep-network/keep-core/pkg/beacon/relay/group"
+	"github.com/keep-network/keep-core/pkg/chain"
+)
+
+// attestationDomain separates signatures over attestations from signatures
+// the operator key produces for other purposes.
+const attestationDomain = "keep-dkg-completion-attestation"
+
+// Attestation is a statement signed with the operator key that the member
+// controlled by the operator participated in DKG for the given request and
+// observed the result with the given group public key published on-chain at
+// the given block. It can be presented off-chain as a proof of participation.
+//
+// Attestations serialize to JSON with byte fields encoded in base64.
+type Attestation struct {
+	RequestID      *big.Int          `json:"requestId"`
+	MemberIndex    group.MemberIndex `json:"memberIndex"`
+	GroupPublicKey []byte            `json:"groupPublicKey"`
+	BlockNumber    uint64            `json:"blockNumber"`
+	// OperatorPublicKey is the serialized public key of the operator who
+	// signed the attestation.
+	OperatorPublicKey []byte `json:"operatorPublicKey"`
+	// Signature is the operator's signature over the attestation digest.
+	Signature []byte `json:"signature"`
+}
+
+// NewAttestation creates an attestation of the published DKG result for the
+// given request signed with the operator key. The result must have been
+// submitted by the member or by another member the member yielded to.
+func NewAttestation(
+	requestID *big.Int,
+	report *SubmissionReport,
+	signing chain.Signing,
+) (*Attestation, error) {
+	if report.Outcome != SubmissionSubmitted &&
+		report.Outcome != SubmissionYielded {
+		return nil, fmt.Errorf(
+			"DKG result has not been published; submission outcome [%v]",
+			report.Outcome,
+		)
+	}
+
+	attestation := &Attestation{
+		RequestID:         requestID,
+		MemberIndex:       report.MemberIndex,
+		GroupPublicKey:    report.GroupPublicKey,
+		BlockNumber:       report.BlockHeight,
+		OperatorPublicKey: signing.PublicKey(),
+	}
+
+	signature, err := signing.Sign(attestation.Digest())
+	if err != nil {
+		return nil, fmt.Errorf("could not sign attestation: [%v]", err)
+	}
+	attestation.Signature = signature
+
+	return attestation, nil
+}
+
+// Digest returns the digest of the attested statement the signature is
+// calculated over. It covers all attestation fields except the operator
+// public key and the signature itself.
+func (a *Attestation) Digest() []byte {
+	return crypto.Keccak256(
+		[]byte(attestationDomain),
+		common.LeftPadBytes(a.RequestID.Bytes(), 32),
+		common.LeftPadBytes([]byte{a.MemberIndex}, 32),
+		common.LeftPadBytes(new(big.Int).SetUint64(a.BlockNumber).Bytes(), 32),
+		a.GroupPublicKey,
+	)
+}
+
+// Verify checks the attestation has been signed with the operator key it
+// declares. It returns an error if the signature is not valid, which is the
+// case if any of the attestation fields has been altered after signing.
+// The given signing must be of the chain the operator key belongs to.
+func (a *Attestation) Verify(signing chain.Signing) error {
+	if a.RequestID == nil {
+		return fmt.Errorf("attestation has no request ID")
+	}
+
+	valid, err := signing.VerifyWithPublicKey(
+		a.Digest(),
+		a.Signature,
+		a.OperatorPublicKey,
+	)
+	if err != nil {
+		return fmt.Errorf("could not verify attestation signature: [%v]", err)
+	}
+	if !valid {
+		return fmt.Errorf("invalid attestation signature")
+	}
+
+	return nil
+}
+
+// AttestationObserver returns a submission observer generating an attestation
+// for the given request each time the DKG result is published, that is, when
+// the member submitted the result or yielded to a result submitted by another
+// member. Generated attestations are passed to the handler.
+func AttestationObserver(
+	requestID *big.Int,
+	signing chain.Signing,
+	handler func(attestation *Attestation),
+) SubmissionObserver {
+	return func(report *SubmissionReport) {
+		if report.Outcome != SubmissionSubmitted &&
+			report.Outcome != SubmissionYielded {
+			return
+		}
+
+		attestation, err := NewAttestation(requestID, report, signing)
+		if err != nil {
+			logger.Errorf(
+				"[member:%v] could not attest DKG completion: [%v]",
+				report.MemberIndex,
+				err,
+			)
+			return
+		}
+
+		handler(attestation)
+	}
+}
@@ -0,0 +1,242 @@
+package result
+
+import (
+	"encoding/json"
+	"fmt"
+	"math/big"
+	"reflect"
+	"testing"
+
+	"github.com/keep-network/keep-core/pkg/chain"
+	"github.com/keep-network/keep-core/pkg/chain/local"
+	"github.com/keep-network/keep-core/pkg/operator"
+)
+
+func TestAttestationSignedAndVerified(t *testing.T) {
+	signing := newAttestationSigning(t)
+
+	attestation, err := NewAttestation(
+		big.NewInt(1234),
+		&SubmissionReport{
+			Outcome:        SubmissionYielded,
+			MemberIndex:    3,
+			BlockHeight:    872,
+			GroupPublicKey: []byte{0x01, 0x02, 0x03},
+		},
+		signing,
+	)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if !reflect.DeepEqual(signing.PublicKey(), attestation.OperatorPublicKey) {
+		t.Errorf(
+			"unexpected operator public key\nexpected: %x\nactual:   %x\n",
+			signing.PublicKey(),
+			attestation.OperatorPublicKey,
+		)
+	}
+
+	if err := attestation.Verify(signing); err != nil {
+		t.Errorf("unexpected verification error: [%v]", err)
+	}
+
+	// the attestation is verified by anyone knowing the chain's signature
+	// format, not only by the operator who signed it
+	if err := attestation.Verify(newAttestationSigning(t)); err != nil {
+		t.Errorf("unexpected verification error: [%v]", err)
+	}
+}
+
+func TestAttestationSerializationRoundTrip(t *testing.T) {
+	signing := newAttestationSigning(t)
+
+	attestation, err := NewAttestation(
+		big.NewInt(1234),
+		&SubmissionReport{
+			Outcome:        SubmissionSubmitted,
+			MemberIndex:    1,
+			BlockHeight:    850,
+			GroupPublicKey: []byte{0x01, 0x02, 0x03},
+		},
+		signing,
+	)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	serialized, err := json.Marshal(attestation)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	deserialized := &Attestation{}
+	if err := json.Unmarshal(serialized, deserialized); err != nil {
+		t.Fatal(err)
+	}
+
+	if !reflect.DeepEqual(attestation, deserialized) {
+		t.Errorf(
+			"unexpected attestation\nexpected: %+v\nactual:   %+v\n",
+			attestation,
+			deserialized,
+		)
+	}
+
+	if err := deserialized.Verify(signing); err != nil {
+		t.Errorf("unexpected verification error: [%v]", err)
+	}
+}
+
+func TestTamperedAttestationNotVerified(t *testing.T) {
+	signing := newAttestationSigning(t)
+	otherSigning := newAttestationSigning(t)
+
+	var tests = map[string]struct {
+		tamper func(attestation *Attestation)
+	}{
+		"request ID": {
+			tamper: func(attestation *Attestation) {
+				attestation.RequestID = big.NewInt(1235)
+			},
+		},
+		"member index": {
+			tamper: func(attestation *Attestation) {
+				attestation.MemberIndex = 4
+			},
+		},
+		"group public key": {
+			tamper: func(attestation *Attestation) {
+				attestation.GroupPublicKey = []byte{0x01, 0x02, 0x04}
+			},
+		},
+		"block number": {
+			tamper: func(attestation *Attestation) {
+				attestation.BlockNumber = 873
+			},
+		},
+		"operator public key": {
+			tamper: func(attestation *Attestation) {
+				attestation.OperatorPublicKey = otherSigning.PublicKey()
+			},
+		},
+		"signature": {
+			tamper: func(attestation *Attestation) {
+				signature, err := otherSigning.Sign(attestation.Digest())
+				if err != nil {
+					t.Fatal(err)
+				}
+				attestation.Signature = signature
+			},
+		},
+	}
+
+	for testName, test := range tests {
+		t.Run(testName, func(t *testing.T) {
+			attestation, err := NewAttestation(
+				big.NewInt(1234),
+				&SubmissionReport{
+					Outcome:        SubmissionYielded,
+					MemberIndex:    3,
+					BlockHeight:    872,
+					GroupPublicKey: []byte{0x01, 0x02, 0x03},
+				},
+				signing,
+			)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			test.tamper(attestation)
+
+			if err := attestation.Verify(signing); err == nil {
+				t.Errorf("expected verification of tampered attestation to fail")
+			}
+		})
+	}
+}
+
+func TestAttestationOfFailedSubmission(t *testing.T) {
+	_, err := NewAttestation(
+		big.NewInt(1234),
+		&SubmissionReport{
+			Outcome:     SubmissionFailed,
+			MemberIndex: 3,
+			Err:         fmt.Errorf("out of gas"),
+		},
+		newAttestationSigning(t),
+	)
+
+	expectedErr := fmt.Errorf(
+		"DKG result has not been published; submission outcome [failed]",
+	)
+	if !reflect.DeepEqual(expectedErr, err) {
+		t.Errorf(
+			"unexpected error\nexpected: %v\nactual:   %v\n",
+			expectedErr,
+			err,
+		)
+	}
+}
+
+func TestAttestationObserver(t *testing.T) {
+	signing := newAttestationSigning(t)
+
+	var tests = map[string]struct {
+		outcome             SubmissionOutcome
+		expectedAttestation bool
+	}{
+		"submitted": {
+			outcome:             SubmissionSubmitted,
+			expectedAttestation: true,
+		},
+		"yielded": {
+			outcome:             SubmissionYielded,
+			expectedAttestation: true,
+		},
+		"failed": {
+			outcome:             SubmissionFailed,
+			expectedAttestation: false,
+		},
+	}
+
+	for testName, test := range tests {
+		t.Run(testName, func(t *testing.T) {
+			var attestation *Attestation
+			observer := AttestationObserver(
+				big.NewInt(1234),
+				signing,
+				func(a *Attestation) { attestation = a },
+			)
+
+			observer(&SubmissionReport{
+				Outcome:        test.outcome,
+				MemberIndex:    2,
+				BlockHeight:    860,
+				GroupPublicKey: []byte{0x01},
+			})
+
+			if (attestation != nil) != test.expectedAttestation {
+				t.Fatalf(
+					"unexpected attestation generated\nexpected: %v\nactual:   %v\n",
+					test.expectedAttestation,
+					attestation != nil,
+				)
+			}
+			if attestation != nil {
+				if err := attestation.Verify(signing); err != nil {
+					t.Errorf("unexpected verification error: [%v]", err)
+				}
+			}
+		})
+	}
+}
+
+func newAttestationSigning(t *testing.T) chain.Signing {
+	privateKey, _, err := operator.GenerateKeyPair()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	return local.ConnectWithKey(5, 3, big.NewInt(200), privateKey).Signing()
+}
@@ -0,0 +1,76 @@
+package result
+
+import (
+	"math/big"
+	"math/rand"
+	"sync"
+
+	"github.com/keep-network/keep-core/pkg/beacon/relay/event"
+	"github.com/keep-network/keep-core/pkg/subscription"
+)
+
+// CompletionBus delivers DKG completion events to components of the node
+// interested in them, such as relay entry logic, webhooks or metrics.
+type CompletionBus struct {
+	mutex    sync.Mutex
+	handlers map[int]func(completion *event.DKGCompleted)
+}
+
+// NewCompletionBus creates a new bus with no subscribers.
+func NewCompletionBus() *CompletionBus {
+	return &CompletionBus{
+		handlers: make(map[int]func(completion *event.DKGCompleted)),
+	}
+}
+
+// OnDKGCompleted registers a handler invoked each time a DKG completion
+// event is published.
+func (cb *CompletionBus) OnDKGCompleted(
+	handler func(completion *event.DKGCompleted),
+) subscription.EventSubscription {
+	cb.mutex.Lock()
+	defer cb.mutex.Unlock()
+
+	handlerID := rand.Int()
+	cb.handlers[handlerID] = handler
+
+	return subscription.NewEventSubscription(func() {
+		cb.mutex.Lock()
+		defer cb.mutex.Unlock()
+
+		delete(cb.handlers, handlerID)
+	})
+}
+
+// Publish delivers the completion event to all subscribed handlers. Handlers
+// are invoked asynchronously so that a slow subscriber does not block the
+// publisher.
+func (cb *CompletionBus) Publish(completion *event.DKGCompleted) {
+	cb.mutex.Lock()
+	defer cb.mutex.Unlock()
+
+	for _, handler := range cb.handlers {
+		go handler(completion)
+	}
+}
+
+// Observer returns a submission observer publishing a DKG completion event
+// for the given request each time the submission succeeds, that is, when the
+// member submitted the result or yielded to a result submitted by another
+// member. If the member hands off results to a submission queue, the result
+// is considered submitted once it is enqueued.
+func (cb *CompletionBus) Observer(requestID *big.Int) SubmissionObserver {
+	return func(report *SubmissionReport) {
+		if report.Outcome != SubmissionSubmitted &&
+			report.Outcome != SubmissionYielded {
+			return
+		}
+
+		cb.Publish(&event.DKGCompleted{
+			RequestID:      requestID,
+			GroupPublicKey: report.GroupPublicKey,
+			Outcome:        string(report.Outcome),
+			BlockNumber:    report.BlockHeight,
+		})
+	}
+}
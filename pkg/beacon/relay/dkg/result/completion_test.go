@@ -0,0 +1,176 @@
+package result
+
+import (
+	"bytes"
+	"math/big"
+	"testing"
+	"time"
+
+	relayChain "github.com/keep-network/keep-core/pkg/beacon/relay/chain"
+	"github.com/keep-network/keep-core/pkg/beacon/relay/event"
+	"github.com/keep-network/keep-core/pkg/beacon/relay/group"
+)
+
+func TestSubmitDKGResultPublishesCompletion(t *testing.T) {
+	requestID := big.NewInt(1337)
+	result := &relayChain.DKGResult{
+		GroupPublicKey: []byte{123, 45},
+	}
+	allSignatures := map[group.MemberIndex][]byte{
+		1: []byte{101},
+		2: []byte{102},
+		3: []byte{103},
+		4: []byte{104},
+	}
+
+	var tests = map[string]struct {
+		memberIndex        group.MemberIndex
+		signatures         map[group.MemberIndex][]byte
+		alreadySubmitted   bool
+		expectedCompletion bool
+		expectedOutcome    string
+	}{
+		"result submitted by the member": {
+			memberIndex:        1,
+			signatures:         allSignatures,
+			expectedCompletion: true,
+			expectedOutcome:    "submitted",
+		},
+		"result already submitted by another member": {
+			memberIndex:        2,
+			signatures:         allSignatures,
+			alreadySubmitted:   true,
+			expectedCompletion: true,
+			expectedOutcome:    "yielded",
+		},
+		"result submission failed": {
+			memberIndex: 1,
+			signatures: map[group.MemberIndex][]byte{
+				1: []byte{101},
+			},
+			expectedCompletion: false,
+		},
+	}
+
+	for testName, test := range tests {
+		t.Run(testName, func(t *testing.T) {
+			chainHandle, initialBlockHeight, err := initChainHandle(3, 5)
+			if err != nil {
+				t.Fatal(err)
+			}
+			blockCounter, err := chainHandle.BlockCounter()
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			if test.alreadySubmitted {
+				err := NewSubmittingMember(1, SubmissionConfig{}).SubmitDKGResult(
+					result,
+					allSignatures,
+					chainHandle.ThresholdRelay(),
+					blockCounter,
+					initialBlockHeight,
+				)
+				if err != nil {
+					t.Fatal(err)
+				}
+			}
+
+			bus := NewCompletionBus()
+
+			completions := make(chan *event.DKGCompleted, 1)
+			subscription := bus.OnDKGCompleted(
+				func(completion *event.DKGCompleted) {
+					completions <- completion
+				},
+			)
+			defer subscription.Unsubscribe()
+
+			member := NewSubmittingMember(
+				test.memberIndex,
+				SubmissionConfig{
+					Observer: bus.Observer(requestID),
+				},
+			)
+
+			err = member.SubmitDKGResult(
+				result,
+				test.signatures,
+				chainHandle.ThresholdRelay(),
+				blockCounter,
+				initialBlockHeight,
+			)
+			if test.expectedCompletion != (err == nil) {
+				t.Fatalf("unexpected submission error: [%v]", err)
+			}
+
+			select {
+			case completion := <-completions:
+				if !test.expectedCompletion {
+					t.Fatalf("unexpected completion event: [%+v]", completion)
+				}
+
+				if completion.RequestID.Cmp(requestID) != 0 {
+					t.Errorf(
+						"unexpected request ID\nexpected: %v\nactual:   %v\n",
+						requestID,
+						completion.RequestID,
+					)
+				}
+				if !bytes.Equal(completion.GroupPublicKey, result.GroupPublicKey) {
+					t.Errorf(
+						"unexpected group public key\nexpected: %v\nactual:   %v\n",
+						result.GroupPublicKey,
+						completion.GroupPublicKey,
+					)
+				}
+				if completion.Outcome != test.expectedOutcome {
+					t.Errorf(
+						"unexpected outcome\nexpected: %v\nactual:   %v\n",
+						test.expectedOutcome,
+						completion.Outcome,
+					)
+				}
+			case <-time.After(time.Second):
+				if test.expectedCompletion {
+					t.Fatal("completion event has not been published")
+				}
+			}
+		})
+	}
+}
+
+func TestCompletionBusDeliversToAllSubscribers(t *testing.T) {
+	bus := NewCompletionBus()
+
+	first := make(chan *event.DKGCompleted, 1)
+	second := make(chan *event.DKGCompleted, 1)
+	bus.OnDKGCompleted(func(completion *event.DKGCompleted) {
+		first <- completion
+	})
+	unsubscribed := bus.OnDKGCompleted(func(completion *event.DKGCompleted) {
+		second <- completion
+	})
+	unsubscribed.Unsubscribe()
+
+	completion := &event.DKGCompleted{
+		RequestID: big.NewInt(1),
+		Outcome:   string(SubmissionSubmitted),
+	}
+	bus.Publish(completion)
+
+	select {
+	case received := <-first:
+		if received != completion {
+			t.Errorf("unexpected completion event: [%+v]", received)
+		}
+	case <-time.After(time.Second):
+		t.Fatal("subscriber has not received the completion event")
+	}
+
+	select {
+	case received := <-second:
+		t.Errorf("unsubscribed handler received event: [%+v]", received)
+	case <-time.After(100 * time.Millisecond):
+	}
+}
@@ -0,0 +1,93 @@
+package result
+
+import (
+	"bytes"
+	"fmt"
+	"time"
+
+	relayChain "github.com/keep-network/keep-core/pkg/beacon/relay/chain"
+	"github.com/keep-network/keep-core/pkg/beacon/relay/event"
+	"github.com/keep-network/keep-core/pkg/beacon/relay/group"
+)
+
+// TransactionReceipts provides the execution status of transactions
+// submitted to the chain.
+type TransactionReceipts interface {
+	// IsTransactionSuccessful returns true if the transaction with the given
+	// hash has been mined and executed successfully.
+	IsTransactionSuccessful(transactionHash string) (bool, error)
+}
+
+// SubmissionConfirmation confirms the member's DKG result submission
+// accepted only if both the receipt of the submission transaction shows
+// success and the observed submission event is for the member's result.
+// Otherwise, the submission is considered rejected even though the chain
+// completed the submission without an error. The chain fails the submission
+// once the receipt shows the submission transaction reverted; if neither
+// the receipt nor the event is observed within the timeout, the submission
+// is considered not confirmed.
+type SubmissionConfirmation struct {
+	receipts TransactionReceipts
+	timeout  time.Duration
+}
+
+// NewSubmissionConfirmation creates a confirmation checking transaction
+// receipts with the given source and waiting for the outcome of the
+// submission for at most the given timeout.
+func NewSubmissionConfirmation(
+	receipts TransactionReceipts,
+	timeout time.Duration,
+) *SubmissionConfirmation {
+	return &SubmissionConfirmation{
+		receipts: receipts,
+		timeout:  timeout,
+	}
+}
+
+// confirm checks the submission event observed after the member submitted
+// the result. It returns an error if the submission could not be confirmed
+// as accepted.
+func (sc *SubmissionConfirmation) confirm(
+	memberIndex group.MemberIndex,
+	result *relayChain.DKGResult,
+	submission *event.DKGResultSubmission,
+) error {
+	if !bytes.Equal(submission.GroupPublicKey, result.GroupPublicKey) {
+		return fmt.Errorf(
+			"different DKG result with group public key [0x%x] submitted "+
+				"by member [%v] has been accepted",
+			submission.GroupPublicKey,
+			submission.MemberIndex,
+		)
+	}
+
+	if submission.MemberIndex != uint32(memberIndex) {
+		return fmt.Errorf(
+			"DKG result has been accepted from member [%v] submission",
+			submission.MemberIndex,
+		)
+	}
+
+	if submission.TransactionHash == "" {
+		return fmt.Errorf("submission transaction hash is unknown")
+	}
+
+	successful, err := sc.receipts.IsTransactionSuccessful(
+		submission.TransactionHash,
+	)
+	if err != nil {
+		return fmt.Errorf(
+			"could not check receipt of submission transaction [%v]: [%v]",
+			submission.TransactionHash,
+			err,
+		)
+	}
+	if !successful {
+		return fmt.Errorf(
+			"submission transaction [%v] failed",
+			submission.TransactionHash,
+		)
+	}
+
+	return nil
+}
@@ -0,0 +1,201 @@
+package result
+
+import (
+	"fmt"
+	"reflect"
+	"testing"
+	"time"
+
+	relayChain "github.com/keep-network/keep-core/pkg/beacon/relay/chain"
+	"github.com/keep-network/keep-core/pkg/beacon/relay/event"
+	"github.com/keep-network/keep-core/pkg/beacon/relay/group"
+	"github.com/keep-network/keep-core/pkg/gen/async"
+)
+
+type mockTransactionReceipts struct {
+	successful bool
+	err        error
+}
+
+func (mtr *mockTransactionReceipts) IsTransactionSuccessful(
+	transactionHash string,
+) (bool, error) {
+	return mtr.successful, mtr.err
+}
+
+func TestConfirmSubmission(t *testing.T) {
+	memberIndex := group.MemberIndex(2)
+	result := &relayChain.DKGResult{GroupPublicKey: []byte{123, 45}}
+
+	var tests = map[string]struct {
+		receipts      *mockTransactionReceipts
+		submission    *event.DKGResultSubmission
+		expectedError error
+	}{
+		"transaction succeeded and event matches": {
+			receipts: &mockTransactionReceipts{successful: true},
+			submission: &event.DKGResultSubmission{
+				MemberIndex:     2,
+				GroupPublicKey:  []byte{123, 45},
+				TransactionHash: "0x01",
+			},
+		},
+		"transaction succeeded but different result accepted": {
+			receipts: &mockTransactionReceipts{successful: true},
+			submission: &event.DKGResultSubmission{
+				MemberIndex:     3,
+				GroupPublicKey:  []byte{67, 89},
+				TransactionHash: "0x01",
+			},
+			expectedError: fmt.Errorf(
+				"different DKG result with group public key [0x4359] " +
+					"submitted by member [3] has been accepted",
+			),
+		},
+		"transaction succeeded but result accepted from other member": {
+			receipts: &mockTransactionReceipts{successful: true},
+			submission: &event.DKGResultSubmission{
+				MemberIndex:     3,
+				GroupPublicKey:  []byte{123, 45},
+				TransactionHash: "0x01",
+			},
+			expectedError: fmt.Errorf(
+				"DKG result has been accepted from member [3] submission",
+			),
+		},
+		"event matches but transaction failed": {
+			receipts: &mockTransactionReceipts{successful: false},
+			submission: &event.DKGResultSubmission{
+				MemberIndex:     2,
+				GroupPublicKey:  []byte{123, 45},
+				TransactionHash: "0x01",
+			},
+			expectedError: fmt.Errorf("submission transaction [0x01] failed"),
+		},
+		"event matches but receipt unavailable": {
+			receipts: &mockTransactionReceipts{err: fmt.Errorf("not found")},
+			submission: &event.DKGResultSubmission{
+				MemberIndex:     2,
+				GroupPublicKey:  []byte{123, 45},
+				TransactionHash: "0x01",
+			},
+			expectedError: fmt.Errorf(
+				"could not check receipt of submission transaction " +
+					"[0x01]: [not found]",
+			),
+		},
+		"event matches but transaction hash unknown": {
+			receipts: &mockTransactionReceipts{successful: true},
+			submission: &event.DKGResultSubmission{
+				MemberIndex:    2,
+				GroupPublicKey: []byte{123, 45},
+			},
+			expectedError: fmt.Errorf("submission transaction hash is unknown"),
+		},
+	}
+
+	for testName, test := range tests {
+		t.Run(testName, func(t *testing.T) {
+			confirmation := NewSubmissionConfirmation(test.receipts, time.Second)
+
+			err := confirmation.confirm(memberIndex, result, test.submission)
+			if !reflect.DeepEqual(test.expectedError, err) {
+				t.Errorf(
+					"unexpected error\nexpected: %v\nactual:   %v\n",
+					test.expectedError,
+					err,
+				)
+			}
+		})
+	}
+}
+
+// silentRelayChain never completes DKG result submissions, as if the
+// submission transaction has not been mined.
+type silentRelayChain struct {
+	relayChain.Interface
+}
+
+func (src *silentRelayChain) SubmitDKGResult(
+	participantIndex relayChain.GroupMemberIndex,
+	dkgResult *relayChain.DKGResult,
+	signatures map[relayChain.GroupMemberIndex][]byte,
+) *async.EventDKGResultSubmissionPromise {
+	return &async.EventDKGResultSubmissionPromise{}
+}
+
+func TestSubmitDKGResultWithConfirmation(t *testing.T) {
+	var tests = map[string]struct {
+		receipts        *mockTransactionReceipts
+		silentChain     bool
+		expectedOutcome SubmissionOutcome
+	}{
+		"confirmed": {
+			receipts:        &mockTransactionReceipts{successful: true},
+			expectedOutcome: SubmissionSubmitted,
+		},
+		"transaction failed": {
+			receipts:        &mockTransactionReceipts{successful: false},
+			expectedOutcome: SubmissionFailed,
+		},
+		"outcome not observed": {
+			receipts:        &mockTransactionReceipts{successful: true},
+			silentChain:     true,
+			expectedOutcome: SubmissionFailed,
+		},
+	}
+
+	for testName, test := range tests {
+		t.Run(testName, func(t *testing.T) {
+			chainHandle, startBlockHeight, err := initChainHandle(3, 5)
+			if err != nil {
+				t.Fatal(err)
+			}
+			blockCounter, err := chainHandle.BlockCounter()
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			chainRelay := chainHandle.ThresholdRelay()
+			if test.silentChain {
+				chainRelay = &silentRelayChain{chainRelay}
+			}
+
+			var report *SubmissionReport
+			member := NewSubmittingMember(
+				1,
+				SubmissionConfig{
+					Observer:     func(r *SubmissionReport) { report = r },
+					Confirmation: NewSubmissionConfirmation(test.receipts, time.Second),
+				},
+			)
+
+			err = member.SubmitDKGResult(
+				&relayChain.DKGResult{GroupPublicKey: []byte{123, 45}},
+				map[group.MemberIndex][]byte{
+					1: []byte{101},
+					2: []byte{102},
+					3: []byte{103},
+					4: []byte{104},
+				},
+				chainRelay,
+				blockCounter,
+				startBlockHeight,
+			)
+			if test.expectedOutcome == SubmissionSubmitted && err != nil {
+				t.Fatal(err)
+			}
+			if test.expectedOutcome == SubmissionFailed && err == nil {
+				t.Fatal("expected unconfirmed submission to fail")
+			}
+
+			if report.Outcome != test.expectedOutcome {
+				t.Errorf(
+					"unexpected outcome\nexpected: %v\nactual:   %v\n",
+					test.expectedOutcome,
+					report.Outcome,
+				)
+			}
+		})
+	}
+}
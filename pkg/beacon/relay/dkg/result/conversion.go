@@ -8,11 +8,14 @@ import (
 	"github.com/keep-network/keep-core/pkg/beacon/relay/group"
 )
 
-// convertGjkrResult transforms GJKR protocol execution result to a chain
+// ConvertGjkrResult transforms GJKR protocol execution result to a chain
 // specific DKG result form. It serializes a group public key to bytes and
 // converts disqualified and inactive members lists to one list of misbehaving
 // participants where each byte represents misbehaving member index.
-func convertGjkrResult(gjkrResult *gjkr.Result) *relayChain.DKGResult {
+// Misbehaving members are always sorted in ascending order, regardless of the
+// order in which they were marked, so that all members supporting the same
+// result compute the same result hash.
+func ConvertGjkrResult(gjkrResult *gjkr.Result) *relayChain.DKGResult {
 	groupPublicKey := make([]byte, 0)
 
 	// We convert the point G2, to compress the point correctly
@@ -8,6 +8,7 @@ import (
 	relayChain "github.com/keep-network/keep-core/pkg/beacon/relay/chain"
 	"github.com/keep-network/keep-core/pkg/beacon/relay/gjkr"
 	"github.com/keep-network/keep-core/pkg/beacon/relay/group"
+	"github.com/keep-network/keep-core/pkg/chain/local"
 )
 
 func TestConvertResult(t *testing.T) {
@@ -83,17 +84,88 @@ func TestConvertResult(t *testing.T) {
 	}
 	for _, test := range tests {
 		for _, disqualifiedMember := range test.disqualifiedMemberIDs {
-			test.gjkrResult.Group.MarkMemberAsDisqualified(disqualifiedMember)
+			test.gjkrResult.Group.MarkMemberAsDisqualified(disqualifiedMember, "misbehaviour")
 		}
 
 		for _, inactiveMember := range test.inactiveMemberIDs {
 			test.gjkrResult.Group.MarkMemberAsInactive(inactiveMember)
 		}
 
-		convertedResult := convertGjkrResult(test.gjkrResult)
+		convertedResult := ConvertGjkrResult(test.gjkrResult)
 
 		if !test.expectedResult.Equals(convertedResult) {
 			t.Errorf("\nexpected: %v\nactual:   %v\n", test.expectedResult, convertedResult)
 		}
 	}
 }
+
+func TestConvertResultHashIsIndependentOfMembersOrder(t *testing.T) {
+	publicKey := new(bn256.G2).ScalarBaseMult(big.NewInt(2))
+	chain := local.Connect(64, 32, big.NewInt(200)).ThresholdRelay()
+
+	var tests = map[string]struct {
+		firstDisqualified  []group.MemberIndex
+		firstInactive      []group.MemberIndex
+		secondDisqualified []group.MemberIndex
+		secondInactive     []group.MemberIndex
+	}{
+		"disqualified members in different order": {
+			firstDisqualified:  []group.MemberIndex{1, 4, 3, 50},
+			secondDisqualified: []group.MemberIndex{50, 3, 1, 4},
+		},
+		"inactive members in different order": {
+			firstInactive:  []group.MemberIndex{7, 2, 9},
+			secondInactive: []group.MemberIndex{9, 7, 2},
+		},
+		"disqualified and inactive members in different order": {
+			firstDisqualified:  []group.MemberIndex{12, 5},
+			firstInactive:      []group.MemberIndex{30, 1},
+			secondDisqualified: []group.MemberIndex{5, 12},
+			secondInactive:     []group.MemberIndex{1, 30},
+		},
+	}
+
+	for testName, test := range tests {
+		t.Run(testName, func(t *testing.T) {
+			buildResult := func(
+				disqualified []group.MemberIndex,
+				inactive []group.MemberIndex,
+			) *relayChain.DKGResult {
+				gjkrResult := &gjkr.Result{
+					GroupPublicKey: publicKey,
+					Group:          group.NewDkgGroup(32, 64),
+				}
+				for _, member := range disqualified {
+					gjkrResult.Group.MarkMemberAsDisqualified(member, "misbehaviour")
+				}
+				for _, member := range inactive {
+					gjkrResult.Group.MarkMemberAsInactive(member)
+				}
+
+				return ConvertGjkrResult(gjkrResult)
+			}
+
+			firstHash, err := chain.CalculateDKGResultHash(
+				buildResult(test.firstDisqualified, test.firstInactive),
+			)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			secondHash, err := chain.CalculateDKGResultHash(
+				buildResult(test.secondDisqualified, test.secondInactive),
+			)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			if firstHash != secondHash {
+				t.Errorf(
+					"unexpected result hash\nexpected: %x\nactual:   %x\n",
+					firstHash,
+					secondHash,
+				)
+			}
+		})
+	}
+}
@@ -0,0 +1,63 @@
+package result
+
+import (
+	"sync"
+	"time"
+)
+
+// SubmissionCooldown spaces out on-chain DKG result submissions of the node.
+// Right after a submission transaction is sent, the nonce and balance of the
+// submitting account are in flux and sending another transaction straight
+// away may lead to a nonce conflict. The cooldown serializes submissions of
+// all members controlled by the node and makes each of them wait until the
+// cooldown period passes since the previous submission completed.
+type SubmissionCooldown struct {
+	period time.Duration
+
+	// Held for the entire time of the submission so that submissions of
+	// different members are serialized.
+	submissionMutex sync.Mutex
+
+	lastSubmission time.Time
+
+	now   func() time.Time
+	sleep func(time.Duration)
+}
+
+// NewSubmissionCooldown creates a cooldown making each on-chain submission
+// wait until the given period passes since the previous submission.
+func NewSubmissionCooldown(period time.Duration) *SubmissionCooldown {
+	return &SubmissionCooldown{
+		period: period,
+		now:    time.Now,
+		sleep:  time.Sleep,
+	}
+}
+
+// begin blocks until no other submission is in progress and the cooldown
+// period since the previous submission passed. Each call to begin has to be
+// followed by a call to end once the submission completes.
+func (sc *SubmissionCooldown) begin() {
+	sc.submissionMutex.Lock()
+
+	if sc.lastSubmission.IsZero() {
+		return
+	}
+
+	if remaining := sc.period - sc.now().Sub(sc.lastSubmission); remaining > 0 {
+		logger.Infof(
+			"waiting [%v] for the cooldown after the previous DKG result "+
+				"submission",
+			remaining,
+		)
+		sc.sleep(remaining)
+	}
+}
+
+// end marks the submission as completed and starts the cooldown period.
+// The period starts even if the submission failed as the transaction might
+// have been sent anyway.
+func (sc *SubmissionCooldown) end() {
+	sc.lastSubmission = sc.now()
+	sc.submissionMutex.Unlock()
+}
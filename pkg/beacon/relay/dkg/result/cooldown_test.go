@@ -0,0 +1,141 @@
+package result
+
+import (
+	"sync"
+	"testing"
+	"time"
+
+	relayChain "github.com/keep-network/keep-core/pkg/beacon/relay/chain"
+	"github.com/keep-network/keep-core/pkg/beacon/relay/group"
+	"github.com/keep-network/keep-core/pkg/gen/async"
+)
+
+// timedRelayChain records the time of each DKG result submission.
+type timedRelayChain struct {
+	relayChain.Interface
+
+	mutex           *sync.Mutex
+	submissionTimes *[]time.Time
+}
+
+func (trc *timedRelayChain) SubmitDKGResult(
+	participantIndex relayChain.GroupMemberIndex,
+	dkgResult *relayChain.DKGResult,
+	signatures map[relayChain.GroupMemberIndex][]byte,
+) *async.EventDKGResultSubmissionPromise {
+	trc.mutex.Lock()
+	*trc.submissionTimes = append(*trc.submissionTimes, time.Now())
+	trc.mutex.Unlock()
+
+	return trc.Interface.SubmitDKGResult(participantIndex, dkgResult, signatures)
+}
+
+func TestSubmitDKGResultRespectsCooldown(t *testing.T) {
+	period := 500 * time.Millisecond
+	cooldown := NewSubmissionCooldown(period)
+
+	signatures := map[group.MemberIndex][]byte{
+		1: []byte{101},
+		2: []byte{102},
+		3: []byte{103},
+		4: []byte{104},
+	}
+
+	mutex := &sync.Mutex{}
+	submissionTimes := make([]time.Time, 0)
+
+	// Members of two different groups controlled by the same node submit
+	// their results back to back.
+	for _, groupPublicKey := range [][]byte{{101, 1}, {102, 2}} {
+		chainHandle, startBlockHeight, err := initChainHandle(3, 5)
+		if err != nil {
+			t.Fatal(err)
+		}
+		blockCounter, err := chainHandle.BlockCounter()
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		member := NewSubmittingMember(
+			1,
+			SubmissionConfig{
+				Cooldown: cooldown,
+			},
+		)
+
+		err = member.SubmitDKGResult(
+			&relayChain.DKGResult{GroupPublicKey: groupPublicKey},
+			signatures,
+			&timedRelayChain{
+				chainHandle.ThresholdRelay(),
+				mutex,
+				&submissionTimes,
+			},
+			blockCounter,
+			startBlockHeight,
+		)
+		if err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	if len(submissionTimes) != 2 {
+		t.Fatalf(
+			"unexpected number of submissions\nexpected: %v\nactual:   %v\n",
+			2,
+			len(submissionTimes),
+		)
+	}
+
+	elapsed := submissionTimes[1].Sub(submissionTimes[0])
+	if elapsed < period {
+		t.Errorf(
+			"submissions not spaced by the cooldown\n"+
+				"expected at least: %v\nactual:            %v\n",
+			period,
+			elapsed,
+		)
+	}
+}
+
+func TestSubmissionCooldownFirstSubmission(t *testing.T) {
+	cooldown := NewSubmissionCooldown(time.Hour)
+
+	slept := false
+	cooldown.sleep = func(time.Duration) { slept = true }
+
+	cooldown.begin()
+	cooldown.end()
+
+	if slept {
+		t.Errorf("first submission should not wait for the cooldown")
+	}
+}
+
+func TestSubmissionCooldownWaitsForRemainingPeriod(t *testing.T) {
+	period := 10 * time.Second
+	cooldown := NewSubmissionCooldown(period)
+
+	now := time.Unix(1000, 0)
+	cooldown.now = func() time.Time { return now }
+
+	var slept time.Duration
+	cooldown.sleep = func(duration time.Duration) { slept = duration }
+
+	cooldown.begin()
+	cooldown.end()
+
+	now = now.Add(4 * time.Second)
+
+	cooldown.begin()
+	cooldown.end()
+
+	expectedSleep := 6 * time.Second
+	if slept != expectedSleep {
+		t.Errorf(
+			"unexpected cooldown wait\nexpected: %v\nactual:   %v\n",
+			expectedSleep,
+			slept,
+		)
+	}
+}
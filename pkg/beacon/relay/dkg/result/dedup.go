@@ -0,0 +1,155 @@
+package result
+
+import (
+	"fmt"
+	"math/big"
+	"sync"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
+	relayChain "github.com/keep-network/keep-core/pkg/beacon/relay/chain"
+	"github.com/keep-network/keep-core/pkg/beacon/relay/group"
+)
+
+// IdempotencyKey derives the key identifying the on-chain submission of the
+// DKG result with the given hash for the request with the given ID. All
+// submission attempts of the same result for the same request share the key.
+func IdempotencyKey(
+	requestID *big.Int,
+	resultHash relayChain.DKGResultHash,
+) string {
+	digest := crypto.Keccak256(
+		common.LeftPadBytes(requestID.Bytes(), 32),
+		resultHash[:],
+	)
+
+	return fmt.Sprintf("0x%x", digest)
+}
+
+// submissionStatus is the status of the submission with the given
+// idempotency key tracked by the ledger.
+type submissionStatus int
+
+const (
+	submissionPending submissionStatus = iota + 1
+	submissionConfirmed
+)
+
+// SubmissionLedger tracks on-chain DKG result submissions of the node by their
+// idempotency keys, so that the same result is not sent to the chain again
+// while a transaction submitting it is pending or once it has been confirmed,
+// no matter whether the attempt comes from a retry of the DKG or from another
+// member controlled by the node. A submission handed off to the submission
+// queue stays pending until its outcome is known on the chain or the queue
+// fails to submit it. The ledger is kept in memory; after a restart,
+// confirmed submissions are recognized by the group registration check done
+// before each submission.
+type SubmissionLedger struct {
+	mutex       sync.Mutex
+	submissions map[string]submissionStatus
+}
+
+// NewSubmissionLedger creates a ledger with no submissions tracked.
+func NewSubmissionLedger() *SubmissionLedger {
+	return &SubmissionLedger{
+		submissions: make(map[string]submissionStatus),
+	}
+}
+
+// ForRequest returns the deduplication of submissions of DKG results for the
+// request with the given ID.
+func (sl *SubmissionLedger) ForRequest(
+	requestID *big.Int,
+) *SubmissionDeduplication {
+	return &SubmissionDeduplication{
+		ledger:    sl,
+		requestID: requestID,
+	}
+}
+
+// begin marks the submission with the given key as pending. It returns false
+// if the submission with the key is already pending or has been confirmed,
+// in which case no new transaction should be sent.
+func (sl *SubmissionLedger) begin(key string) bool {
+	sl.mutex.Lock()
+	defer sl.mutex.Unlock()
+
+	if _, ok := sl.submissions[key]; ok {
+		return false
+	}
+
+	sl.submissions[key] = submissionPending
+	return true
+}
+
+// end completes the pending submission with the given key. A successful
+// submission is marked as confirmed; a failed one is forgotten so that
+// it can be attempted again.
+func (sl *SubmissionLedger) end(key string, submitted bool) {
+	sl.mutex.Lock()
+	defer sl.mutex.Unlock()
+
+	if submitted {
+		sl.submissions[key] = submissionConfirmed
+	} else {
+		delete(sl.submissions, key)
+	}
+}
+
+// SubmissionDeduplication dedupes on-chain submissions of DKG results for
+// a single request using the node's submission ledger.
+type SubmissionDeduplication struct {
+	ledger    *SubmissionLedger
+	requestID *big.Int
+}
+
+// submit executes the submission of the result unless a submission with the
+// same idempotency key is already pending or has been confirmed, in which
+// case the submission is yielded. The submission is given a function settling
+// it in the ledger. If the submission has been queued, it stays pending until
+// settled; otherwise it is settled by its outcome once executed.
+func (sd *SubmissionDeduplication) submit(
+	memberIndex group.MemberIndex,
+	result *relayChain.DKGResult,
+	chainRelay relayChain.Interface,
+	blockNumber uint64,
+	submit func(settle func(submitted bool)) (SubmissionOutcome, uint64, error),
+) (SubmissionOutcome, uint64, error) {
+	resultHash, err := chainRelay.CalculateDKGResultHash(result)
+	if err != nil {
+		return SubmissionFailed, blockNumber, fmt.Errorf(
+			"could not calculate DKG result hash: [%v]",
+			err,
+		)
+	}
+
+	key := IdempotencyKey(sd.requestID, resultHash)
+
+	if !sd.ledger.begin(key) {
+		logger.Infof(
+			"[member:%v] not submitting DKG result with public key [0x%x]; "+
+				"submission with idempotency key [%v] is already pending "+
+				"or confirmed",
+			memberIndex,
+			result.GroupPublicKey,
+			key,
+		)
+		return SubmissionYielded, blockNumber, nil
+	}
+
+	// The queue and the member watching the chain for the queued result
+	// may both settle the submission; only the first one counts.
+	var once sync.Once
+	settle := func(submitted bool) {
+		once.Do(func() {
+			sd.ledger.end(key, submitted)
+		})
+	}
+
+	outcome, blockHeight, err := submit(settle)
+	if outcome != SubmissionQueued {
+		settle(outcome == SubmissionSubmitted)
+	}
+
+	return outcome, blockHeight, err
+}
@@ -0,0 +1,234 @@
+package result
+
+import (
+	"math/big"
+	"sync"
+	"testing"
+
+	relayChain "github.com/keep-network/keep-core/pkg/beacon/relay/chain"
+	"github.com/keep-network/keep-core/pkg/beacon/relay/group"
+	"github.com/keep-network/keep-core/pkg/gen/async"
+)
+
+// countingRelayChain counts DKG result submissions and never reports the
+// group as registered, as if the chain had not caught up with submissions yet.
+type countingRelayChain struct {
+	relayChain.Interface
+
+	mutex       sync.Mutex
+	submissions int
+}
+
+func (crc *countingRelayChain) SubmitDKGResult(
+	participantIndex relayChain.GroupMemberIndex,
+	dkgResult *relayChain.DKGResult,
+	signatures map[relayChain.GroupMemberIndex][]byte,
+) *async.EventDKGResultSubmissionPromise {
+	crc.mutex.Lock()
+	crc.submissions++
+	crc.mutex.Unlock()
+
+	return crc.Interface.SubmitDKGResult(participantIndex, dkgResult, signatures)
+}
+
+func (crc *countingRelayChain) IsGroupRegistered(
+	groupPublicKey []byte,
+) (bool, error) {
+	return false, nil
+}
+
+func TestIdempotencyKey(t *testing.T) {
+	requestID := big.NewInt(1001)
+	resultHash := relayChain.DKGResultHash{1, 2, 3}
+
+	key := IdempotencyKey(requestID, resultHash)
+
+	if key != IdempotencyKey(big.NewInt(1001), relayChain.DKGResultHash{1, 2, 3}) {
+		t.Errorf("key is not deterministic")
+	}
+	if key == IdempotencyKey(big.NewInt(1002), resultHash) {
+		t.Errorf("key does not depend on the request ID")
+	}
+	if key == IdempotencyKey(requestID, relayChain.DKGResultHash{1, 2, 4}) {
+		t.Errorf("key does not depend on the result hash")
+	}
+}
+
+func TestSubmissionLedger(t *testing.T) {
+	ledger := NewSubmissionLedger()
+
+	if !ledger.begin("0x01") {
+		t.Fatalf("first submission should begin")
+	}
+	if ledger.begin("0x01") {
+		t.Errorf("submission should not begin while pending")
+	}
+	if !ledger.begin("0x02") {
+		t.Errorf("submission with other key should begin")
+	}
+
+	ledger.end("0x01", false)
+	if !ledger.begin("0x01") {
+		t.Fatalf("submission should begin again after failure")
+	}
+
+	ledger.end("0x01", true)
+	if ledger.begin("0x01") {
+		t.Errorf("submission should not begin once confirmed")
+	}
+}
+
+func TestSubmitDKGResultDeduplicated(t *testing.T) {
+	var tests = map[string]struct {
+		concurrent bool
+	}{
+		"sequential attempts": {
+			concurrent: false,
+		},
+		"concurrent attempts": {
+			concurrent: true,
+		},
+	}
+
+	for testName, test := range tests {
+		t.Run(testName, func(t *testing.T) {
+			chainHandle, startBlockHeight, err := initChainHandle(3, 5)
+			if err != nil {
+				t.Fatal(err)
+			}
+			blockCounter, err := chainHandle.BlockCounter()
+			if err != nil {
+				t.Fatal(err)
+			}
+			chainRelay := &countingRelayChain{
+				Interface: chainHandle.ThresholdRelay(),
+			}
+
+			deduplication := NewSubmissionLedger().ForRequest(big.NewInt(1001))
+
+			result := &relayChain.DKGResult{GroupPublicKey: []byte{123, 45}}
+			signatures := map[group.MemberIndex][]byte{
+				1: []byte{101},
+				2: []byte{102},
+				3: []byte{103},
+				4: []byte{104},
+			}
+
+			attempts := 3
+			errors := make(chan error, attempts)
+			submit := func() {
+				errors <- NewSubmittingMember(
+					1,
+					SubmissionConfig{
+						Deduplication: deduplication,
+					},
+				).SubmitDKGResult(
+					result,
+					signatures,
+					chainRelay,
+					blockCounter,
+					startBlockHeight,
+				)
+			}
+
+			for i := 0; i < attempts; i++ {
+				if test.concurrent {
+					go submit()
+				} else {
+					submit()
+				}
+			}
+
+			for i := 0; i < attempts; i++ {
+				if err := <-errors; err != nil {
+					t.Fatal(err)
+				}
+			}
+
+			if chainRelay.submissions != 1 {
+				t.Errorf(
+					"unexpected number of submissions\nexpected: %v\nactual:   %v\n",
+					1,
+					chainRelay.submissions,
+				)
+			}
+		})
+	}
+}
+
+func TestSubmitDKGResultQueuedDeduplication(t *testing.T) {
+	var tests = map[string]struct {
+		submitted      bool
+		expectedStatus submissionStatus
+	}{
+		"queued result submitted": {
+			submitted:      true,
+			expectedStatus: submissionConfirmed,
+		},
+		"queued result submission failed": {
+			submitted:      false,
+			expectedStatus: 0,
+		},
+	}
+
+	for testName, test := range tests {
+		t.Run(testName, func(t *testing.T) {
+			chainHandle, startBlockHeight, err := initChainHandle(3, 5)
+			if err != nil {
+				t.Fatal(err)
+			}
+			blockCounter, err := chainHandle.BlockCounter()
+			if err != nil {
+				t.Fatal(err)
+			}
+			chainRelay := chainHandle.ThresholdRelay()
+
+			requestID := big.NewInt(1001)
+			ledger := NewSubmissionLedger()
+			queue := NewMemoryQueue(1)
+
+			request := newTestSubmissionRequest()
+			resultHash, err := chainRelay.CalculateDKGResultHash(request.Result)
+			if err != nil {
+				t.Fatal(err)
+			}
+			key := IdempotencyKey(requestID, resultHash)
+
+			go NewSubmittingMember(
+				1,
+				SubmissionConfig{
+					Queue:         queue,
+					Deduplication: ledger.ForRequest(requestID),
+				},
+			).SubmitDKGResult(
+				request.Result,
+				request.Signatures,
+				chainRelay,
+				blockCounter,
+				startBlockHeight,
+			)
+
+			queued := <-queue.requests
+
+			if status := ledger.submissions[key]; status != submissionPending {
+				t.Fatalf(
+					"unexpected status of the queued submission\n"+
+						"expected: %v\nactual:   %v\n",
+					submissionPending,
+					status,
+				)
+			}
+
+			queued.complete(test.submitted)
+
+			if status := ledger.submissions[key]; status != test.expectedStatus {
+				t.Errorf(
+					"unexpected status of the settled submission\n"+
+						"expected: %v\nactual:   %v\n",
+					test.expectedStatus,
+					status,
+				)
+			}
+		})
+	}
+}
@@ -0,0 +1,108 @@
+package result
+
+import (
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/keep-network/keep-core/pkg/beacon/relay/group"
+)
+
+// SubmissionRotation rotates the order in which members become eligible to
+// submit the DKG result so that the duty of paying for the submission is
+// spread across members over many requests. The rotation offset is derived
+// from the request ID, so all members of the group compute the same order
+// without any additional communication.
+//
+// The rotated order has to be accepted by the chain. The operator contract
+// requires members to submit in the order of their indexes, so the rotation is
+// a development option of the local chain only.
+type SubmissionRotation struct {
+	groupSize int
+	offset    int
+}
+
+// NewSubmissionRotation creates a rotation of the submission order for the
+// request with the given ID and the group of the given size.
+func NewSubmissionRotation(
+	requestID *big.Int,
+	groupSize int,
+) *SubmissionRotation {
+	digest := new(big.Int).SetBytes(crypto.Keccak256(requestID.Bytes()))
+	offset := new(big.Int).Mod(digest, big.NewInt(int64(groupSize)))
+
+	return &SubmissionRotation{
+		groupSize: groupSize,
+		offset:    int(offset.Int64()),
+	}
+}
+
+// Position returns the zero-based position of the member with the given index
+// in the rotated submission order.
+func (sr *SubmissionRotation) Position(memberIndex group.MemberIndex) int {
+	return (int(memberIndex) - 1 - sr.offset + sr.groupSize) % sr.groupSize
+}
+
+// Order returns indexes of all group members in the rotated submission order.
+func (sr *SubmissionRotation) Order() []group.MemberIndex {
+	order := make([]group.MemberIndex, sr.groupSize)
+	for i := 1; i <= sr.groupSize; i++ {
+		memberIndex := group.MemberIndex(i)
+		order[sr.Position(memberIndex)] = memberIndex
+	}
+
+	return order
+}
+
+// RotatedEligibleBlockHeight returns the block height at which the member with
+// the given index becomes eligible to submit the result in the given rotated
+// submission order. If the rotation is nil, members become eligible in the
+// order of their indexes, as determined by ExpectedSubmissionBlock.
+func RotatedEligibleBlockHeight(
+	rotation *SubmissionRotation,
+	memberIndex group.MemberIndex,
+	startBlockHeight uint64,
+	blockStep uint64,
+) uint64 {
+	if rotation != nil {
+		// the member is eligible as the one at its position in the order
+		memberIndex = group.MemberIndex(rotation.Position(memberIndex) + 1)
+	}
+
+	return ExpectedSubmissionBlock(memberIndex, startBlockHeight, int(blockStep))
+}
+
+// PublicationDeadlineBlockHeight returns the block height at which the
+// publication deadline passes for the result submission starting at the given
+// block height, the group of the given size and the result publication block
+// step.
+func PublicationDeadlineBlockHeight(
+	startBlockHeight uint64,
+	groupSize int,
+	blockStep uint64,
+) uint64 {
+	return startBlockHeight + uint64(groupSize)*blockStep
+}
+
+// SubmissionDeadlineBlockHeight returns the block height from which the
+// result is no longer submitted because the submission transaction would not
+// confirm before the publication deadline. It is the publication deadline
+// moved earlier by the given confirmation buffer, but not earlier than the
+// block height at which the result submission starts.
+func SubmissionDeadlineBlockHeight(
+	startBlockHeight uint64,
+	groupSize int,
+	blockStep uint64,
+	confirmationBufferBlocks uint64,
+) uint64 {
+	deadlineBlockHeight := PublicationDeadlineBlockHeight(
+		startBlockHeight,
+		groupSize,
+		blockStep,
+	)
+
+	if deadlineBlockHeight-startBlockHeight <= confirmationBufferBlocks {
+		return startBlockHeight
+	}
+
+	return deadlineBlockHeight - confirmationBufferBlocks
+}
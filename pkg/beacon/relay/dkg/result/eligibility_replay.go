@@ -0,0 +1,183 @@
+package result
+
+import (
+	"encoding/json"
+	"fmt"
+	"math/big"
+	"sort"
+	"time"
+
+	"github.com/keep-network/keep-core/pkg/beacon/relay/group"
+)
+
+// BlockObservation is a block height observed at the given time, e.g.
+// recorded from the node's logs during a real DKG result submission.
+type BlockObservation struct {
+	Time        time.Time `json:"time"`
+	BlockHeight uint64    `json:"blockHeight"`
+}
+
+// ParseBlockTimeline parses a JSON array of block observations, e.g.
+// [{"time": "2020-06-01T10:00:00Z", "blockHeight": 100}].
+func ParseBlockTimeline(content []byte) ([]*BlockObservation, error) {
+	timeline := make([]*BlockObservation, 0)
+	if err := json.Unmarshal(content, &timeline); err != nil {
+		return nil, fmt.Errorf("could not parse block timeline: [%v]", err)
+	}
+
+	return timeline, nil
+}
+
+// EligibilityReplayConfig is the group configuration the submission
+// eligibility is replayed for.
+type EligibilityReplayConfig struct {
+	GroupSize int
+	// StartBlockHeight is the block height at which the result submission
+	// started.
+	StartBlockHeight uint64
+	// BlockStep is the chain's result publication block step.
+	BlockStep uint64
+	// MinBlockStep is the minimum result publication block step configured
+	// for members; zero if the chain's block step is used as is.
+	MinBlockStep uint64
+	// RotationSeed is the request ID the submission order was rotated for;
+	// nil if the order was not rotated.
+	RotationSeed *big.Int
+	// ActiveMembers are indexes of members able to publish the result;
+	// all members of the group if empty.
+	ActiveMembers []group.MemberIndex
+}
+
+// EligibilityStep lists members that became eligible to submit the result at
+// the given observed block.
+type EligibilityStep struct {
+	Observation   *BlockObservation
+	NewlyEligible []group.MemberIndex
+}
+
+// EligibilityReplay is the outcome of replaying the submission eligibility
+// against a block timeline.
+type EligibilityReplay struct {
+	// EligibleBlockHeights are block heights at which members become eligible
+	// to submit the result.
+	EligibleBlockHeights map[group.MemberIndex]uint64
+	// DeadlineBlockHeight is the block height at which the publication
+	// deadline passes.
+	DeadlineBlockHeight uint64
+	// Steps lists, for each observation of the timeline, members which
+	// became eligible since the previous observation.
+	Steps []*EligibilityStep
+	// Publisher is the index of the first active member that became eligible
+	// before the deadline; zero if no such member.
+	Publisher group.MemberIndex
+	// PublishedAt is the observation at which the publisher became eligible;
+	// nil if there is no publisher.
+	PublishedAt *BlockObservation
+}
+
+// ReplayEligibility replays the DKG result submission eligibility logic of
+// all members against the given block timeline. For each observation, it
+// determines which members became eligible to submit the result and which
+// active member would have published it first. Observations must be ordered
+// by block height.
+func ReplayEligibility(
+	config *EligibilityReplayConfig,
+	timeline []*BlockObservation,
+) (*EligibilityReplay, error) {
+	if config.GroupSize < 1 || config.GroupSize > maxMemberIndex {
+		return nil, fmt.Errorf("invalid group size [%v]", config.GroupSize)
+	}
+
+	blockStep := EffectiveBlockStep(config.BlockStep, config.MinBlockStep)
+
+	var rotation *SubmissionRotation
+	if config.RotationSeed != nil {
+		rotation = NewSubmissionRotation(config.RotationSeed, config.GroupSize)
+	}
+
+	eligibleBlockHeights := make(map[group.MemberIndex]uint64)
+	for i := 1; i <= config.GroupSize; i++ {
+		memberIndex := group.MemberIndex(i)
+		eligibleBlockHeights[memberIndex] = RotatedEligibleBlockHeight(
+			rotation,
+			memberIndex,
+			config.StartBlockHeight,
+			blockStep,
+		)
+	}
+
+	active := make(map[group.MemberIndex]bool)
+	for memberIndex := range eligibleBlockHeights {
+		active[memberIndex] = len(config.ActiveMembers) == 0
+	}
+	for _, memberIndex := range config.ActiveMembers {
+		if _, ok := eligibleBlockHeights[memberIndex]; !ok {
+			return nil, fmt.Errorf(
+				"active member [%v] is not a member of the group",
+				memberIndex,
+			)
+		}
+		active[memberIndex] = true
+	}
+
+	replay := &EligibilityReplay{
+		EligibleBlockHeights: eligibleBlockHeights,
+		DeadlineBlockHeight: PublicationDeadlineBlockHeight(
+			config.StartBlockHeight,
+			config.GroupSize,
+			blockStep,
+		),
+		Steps: make([]*EligibilityStep, 0, len(timeline)),
+	}
+
+	eligible := make(map[group.MemberIndex]bool)
+	for i, observation := range timeline {
+		if i > 0 && observation.BlockHeight < timeline[i-1].BlockHeight {
+			return nil, fmt.Errorf(
+				"block timeline is not ordered; block [%v] observed "+
+					"after block [%v]",
+				observation.BlockHeight,
+				timeline[i-1].BlockHeight,
+			)
+		}
+
+		step := &EligibilityStep{
+			Observation:   observation,
+			NewlyEligible: make([]group.MemberIndex, 0),
+		}
+
+		if observation.BlockHeight < replay.DeadlineBlockHeight {
+			for memberIndex, eligibleBlockHeight := range eligibleBlockHeights {
+				if !eligible[memberIndex] &&
+					eligibleBlockHeight <= observation.BlockHeight {
+					eligible[memberIndex] = true
+					step.NewlyEligible = append(step.NewlyEligible, memberIndex)
+				}
+			}
+		}
+
+		// members becoming eligible at the same observation are ordered by
+		// their eligibility block, as the earliest one submits first
+		sort.Slice(step.NewlyEligible, func(i, j int) bool {
+			left, right := step.NewlyEligible[i], step.NewlyEligible[j]
+			if eligibleBlockHeights[left] != eligibleBlockHeights[right] {
+				return eligibleBlockHeights[left] < eligibleBlockHeights[right]
+			}
+			return left < right
+		})
+
+		if replay.Publisher == 0 {
+			for _, memberIndex := range step.NewlyEligible {
+				if active[memberIndex] {
+					replay.Publisher = memberIndex
+					replay.PublishedAt = observation
+					break
+				}
+			}
+		}
+
+		replay.Steps = append(replay.Steps, step)
+	}
+
+	return replay, nil
+}
@@ -0,0 +1,261 @@
+package result
+
+import (
+	"fmt"
+	"math/big"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/keep-network/keep-core/pkg/beacon/relay/group"
+)
+
+func TestReplayEligibility(t *testing.T) {
+	timeline, err := ParseBlockTimeline([]byte(`[
+		{"time": "2020-06-01T10:00:00Z", "blockHeight": 100},
+		{"time": "2020-06-01T10:00:15Z", "blockHeight": 101},
+		{"time": "2020-06-01T10:01:00Z", "blockHeight": 104},
+		{"time": "2020-06-01T10:01:15Z", "blockHeight": 105},
+		{"time": "2020-06-01T10:02:30Z", "blockHeight": 110},
+		{"time": "2020-06-01T10:04:00Z", "blockHeight": 116}
+	]`))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var tests = map[string]struct {
+		config                       *EligibilityReplayConfig
+		expectedEligibleBlockHeights map[group.MemberIndex]uint64
+		expectedNewlyEligible        [][]group.MemberIndex
+		expectedPublisher            group.MemberIndex
+		expectedPublishedAtBlock     uint64
+	}{
+		"all members active": {
+			config: &EligibilityReplayConfig{
+				GroupSize:        5,
+				StartBlockHeight: 100,
+				BlockStep:        3,
+			},
+			expectedEligibleBlockHeights: map[group.MemberIndex]uint64{
+				1: 100, 2: 103, 3: 106, 4: 109, 5: 112,
+			},
+			expectedNewlyEligible: [][]group.MemberIndex{
+				{1}, {}, {2}, {}, {3, 4}, {},
+			},
+			expectedPublisher:        1,
+			expectedPublishedAtBlock: 100,
+		},
+		"first members inactive": {
+			config: &EligibilityReplayConfig{
+				GroupSize:        5,
+				StartBlockHeight: 100,
+				BlockStep:        3,
+				ActiveMembers:    []group.MemberIndex{4, 5, 3},
+			},
+			expectedEligibleBlockHeights: map[group.MemberIndex]uint64{
+				1: 100, 2: 103, 3: 106, 4: 109, 5: 112,
+			},
+			expectedNewlyEligible: [][]group.MemberIndex{
+				{1}, {}, {2}, {}, {3, 4}, {},
+			},
+			expectedPublisher:        3,
+			expectedPublishedAtBlock: 110,
+		},
+		"no active member eligible before deadline": {
+			config: &EligibilityReplayConfig{
+				GroupSize:        5,
+				StartBlockHeight: 100,
+				BlockStep:        3,
+				ActiveMembers:    []group.MemberIndex{5},
+			},
+			expectedEligibleBlockHeights: map[group.MemberIndex]uint64{
+				1: 100, 2: 103, 3: 106, 4: 109, 5: 112,
+			},
+			expectedNewlyEligible: [][]group.MemberIndex{
+				{1}, {}, {2}, {}, {3, 4}, {},
+			},
+			expectedPublisher: 0,
+		},
+		"minimum block step applied": {
+			config: &EligibilityReplayConfig{
+				GroupSize:        5,
+				StartBlockHeight: 100,
+				BlockStep:        3,
+				MinBlockStep:     5,
+				ActiveMembers:    []group.MemberIndex{2, 3},
+			},
+			expectedEligibleBlockHeights: map[group.MemberIndex]uint64{
+				1: 100, 2: 105, 3: 110, 4: 115, 5: 120,
+			},
+			expectedNewlyEligible: [][]group.MemberIndex{
+				{1}, {}, {}, {2}, {3}, {4},
+			},
+			expectedPublisher:        2,
+			expectedPublishedAtBlock: 105,
+		},
+	}
+
+	for testName, test := range tests {
+		t.Run(testName, func(t *testing.T) {
+			replay, err := ReplayEligibility(test.config, timeline)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			if !reflect.DeepEqual(
+				test.expectedEligibleBlockHeights,
+				replay.EligibleBlockHeights,
+			) {
+				t.Errorf(
+					"unexpected eligible block heights\n"+
+						"expected: %v\nactual:   %v\n",
+					test.expectedEligibleBlockHeights,
+					replay.EligibleBlockHeights,
+				)
+			}
+
+			newlyEligible := make([][]group.MemberIndex, len(replay.Steps))
+			for i, step := range replay.Steps {
+				newlyEligible[i] = step.NewlyEligible
+			}
+			if !reflect.DeepEqual(test.expectedNewlyEligible, newlyEligible) {
+				t.Errorf(
+					"unexpected newly eligible members\n"+
+						"expected: %v\nactual:   %v\n",
+					test.expectedNewlyEligible,
+					newlyEligible,
+				)
+			}
+
+			if replay.Publisher != test.expectedPublisher {
+				t.Errorf(
+					"unexpected publisher\nexpected: %v\nactual:   %v\n",
+					test.expectedPublisher,
+					replay.Publisher,
+				)
+			}
+
+			if test.expectedPublisher == 0 {
+				if replay.PublishedAt != nil {
+					t.Errorf(
+						"unexpected publication block\nexpected: %v\nactual:   %v\n",
+						nil,
+						replay.PublishedAt.BlockHeight,
+					)
+				}
+			} else if replay.PublishedAt == nil ||
+				replay.PublishedAt.BlockHeight != test.expectedPublishedAtBlock {
+				t.Errorf(
+					"unexpected publication block\nexpected: %v\nactual:   %v\n",
+					test.expectedPublishedAtBlock,
+					replay.PublishedAt,
+				)
+			}
+		})
+	}
+}
+
+func TestReplayEligibilityWithRotation(t *testing.T) {
+	seed := big.NewInt(12345)
+	groupSize := 5
+
+	timeline := make([]*BlockObservation, 0)
+	for blockHeight := uint64(200); blockHeight < 210; blockHeight++ {
+		timeline = append(timeline, &BlockObservation{
+			Time:        time.Unix(int64(blockHeight)*15, 0),
+			BlockHeight: blockHeight,
+		})
+	}
+
+	replay, err := ReplayEligibility(
+		&EligibilityReplayConfig{
+			GroupSize:        groupSize,
+			StartBlockHeight: 200,
+			BlockStep:        2,
+			RotationSeed:     seed,
+		},
+		timeline,
+	)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	actualOrder := make([]group.MemberIndex, 0)
+	for _, step := range replay.Steps {
+		actualOrder = append(actualOrder, step.NewlyEligible...)
+	}
+
+	expectedOrder := NewSubmissionRotation(seed, groupSize).Order()
+	if !reflect.DeepEqual(expectedOrder, actualOrder) {
+		t.Errorf(
+			"unexpected eligibility order\nexpected: %v\nactual:   %v\n",
+			expectedOrder,
+			actualOrder,
+		)
+	}
+
+	if replay.Publisher != expectedOrder[0] {
+		t.Errorf(
+			"unexpected publisher\nexpected: %v\nactual:   %v\n",
+			expectedOrder[0],
+			replay.Publisher,
+		)
+	}
+}
+
+func TestReplayEligibilityFailure(t *testing.T) {
+	var tests = map[string]struct {
+		config        *EligibilityReplayConfig
+		timeline      []*BlockObservation
+		expectedError error
+	}{
+		"unordered timeline": {
+			config: &EligibilityReplayConfig{
+				GroupSize:        3,
+				StartBlockHeight: 100,
+				BlockStep:        2,
+			},
+			timeline: []*BlockObservation{
+				{BlockHeight: 101},
+				{BlockHeight: 100},
+			},
+			expectedError: fmt.Errorf(
+				"block timeline is not ordered; block [100] observed " +
+					"after block [101]",
+			),
+		},
+		"active member out of group": {
+			config: &EligibilityReplayConfig{
+				GroupSize:        3,
+				StartBlockHeight: 100,
+				BlockStep:        2,
+				ActiveMembers:    []group.MemberIndex{4},
+			},
+			timeline: []*BlockObservation{},
+			expectedError: fmt.Errorf(
+				"active member [4] is not a member of the group",
+			),
+		},
+		"empty group": {
+			config: &EligibilityReplayConfig{
+				StartBlockHeight: 100,
+				BlockStep:        2,
+			},
+			timeline:      []*BlockObservation{},
+			expectedError: fmt.Errorf("invalid group size [0]"),
+		},
+	}
+
+	for testName, test := range tests {
+		t.Run(testName, func(t *testing.T) {
+			_, err := ReplayEligibility(test.config, test.timeline)
+			if !reflect.DeepEqual(test.expectedError, err) {
+				t.Errorf(
+					"unexpected error\nexpected: %v\nactual:   %v\n",
+					test.expectedError,
+					err,
+				)
+			}
+		})
+	}
+}
@@ -0,0 +1,180 @@
+package result
+
+import (
+	"math/big"
+	"reflect"
+	"testing"
+
+	"github.com/keep-network/keep-core/pkg/beacon/relay/group"
+)
+
+func TestSubmissionRotationOrder(t *testing.T) {
+	groupSize := 64
+
+	requestIDs := []*big.Int{
+		big.NewInt(1),
+		big.NewInt(2),
+		new(big.Int).Lsh(big.NewInt(1), 255),
+	}
+
+	orders := make([][]group.MemberIndex, 0)
+	for _, requestID := range requestIDs {
+		order := NewSubmissionRotation(requestID, groupSize).Order()
+
+		// each member computes its position on its own, the positions have
+		// to form the same order
+		for i := 1; i <= groupSize; i++ {
+			memberIndex := group.MemberIndex(i)
+			position := NewSubmissionRotation(
+				new(big.Int).Set(requestID),
+				groupSize,
+			).Position(memberIndex)
+
+			if order[position] != memberIndex {
+				t.Fatalf(
+					"member [%v] computed inconsistent position for request [%v]\n"+
+						"expected: %v\nactual:   %v\n",
+					memberIndex,
+					requestID,
+					memberIndex,
+					order[position],
+				)
+			}
+		}
+
+		orders = append(orders, order)
+	}
+
+	for i := 0; i < len(orders); i++ {
+		for j := i + 1; j < len(orders); j++ {
+			if reflect.DeepEqual(orders[i], orders[j]) {
+				t.Errorf(
+					"requests [%v] and [%v] have the same submission order",
+					requestIDs[i],
+					requestIDs[j],
+				)
+			}
+		}
+	}
+}
+
+func TestSubmissionRotationOrderIsRotation(t *testing.T) {
+	groupSize := 5
+	order := NewSubmissionRotation(big.NewInt(1337), groupSize).Order()
+
+	for i := 1; i < groupSize; i++ {
+		expectedNext := group.MemberIndex(int(order[i-1])%groupSize + 1)
+		if order[i] != expectedNext {
+			t.Fatalf(
+				"unexpected member at position [%v] of order %v\n"+
+					"expected: %v\nactual:   %v\n",
+				i,
+				order,
+				expectedNext,
+				order[i],
+			)
+		}
+	}
+}
+
+func TestRotatedEligibleBlockHeight(t *testing.T) {
+	startBlockHeight := uint64(100)
+	blockStep := uint64(3)
+	groupSize := 5
+
+	rotation := NewSubmissionRotation(big.NewInt(1337), groupSize)
+	first := rotation.Order()[0]
+
+	var tests = map[string]struct {
+		rotation            *SubmissionRotation
+		memberIndex         group.MemberIndex
+		expectedBlockHeight uint64
+	}{
+		"no rotation, first member": {
+			memberIndex:         1,
+			expectedBlockHeight: 100,
+		},
+		"no rotation, third member": {
+			memberIndex:         3,
+			expectedBlockHeight: 106,
+		},
+		"rotation, first member in order": {
+			rotation:            rotation,
+			memberIndex:         first,
+			expectedBlockHeight: 100,
+		},
+		"rotation, member preceding the first one in order": {
+			rotation:            rotation,
+			memberIndex:         group.MemberIndex((int(first)+groupSize-2)%groupSize + 1),
+			expectedBlockHeight: 112,
+		},
+	}
+
+	for testName, test := range tests {
+		t.Run(testName, func(t *testing.T) {
+			blockHeight := RotatedEligibleBlockHeight(
+				test.rotation,
+				test.memberIndex,
+				startBlockHeight,
+				blockStep,
+			)
+			if blockHeight != test.expectedBlockHeight {
+				t.Errorf(
+					"unexpected eligible block height\n"+
+						"expected: %v\nactual:   %v\n",
+					test.expectedBlockHeight,
+					blockHeight,
+				)
+			}
+		})
+	}
+}
+
+func TestSubmissionDeadlineBlockHeight(t *testing.T) {
+	startBlockHeight := uint64(100)
+	blockStep := uint64(10)
+	groupSize := 10
+	// the publication deadline is at block 200
+
+	var tests = map[string]struct {
+		confirmationBufferBlocks uint64
+		expectedBlockHeight      uint64
+	}{
+		"no confirmation buffer": {
+			confirmationBufferBlocks: 0,
+			expectedBlockHeight:      200,
+		},
+		"confirmation buffer shortens the submission window": {
+			confirmationBufferBlocks: 15,
+			expectedBlockHeight:      185,
+		},
+		"confirmation buffer as long as the submission window": {
+			confirmationBufferBlocks: 100,
+			expectedBlockHeight:      100,
+		},
+		"confirmation buffer longer than the submission window": {
+			confirmationBufferBlocks: 150,
+			expectedBlockHeight:      100,
+		},
+	}
+
+	for testName, test := range tests {
+		t.Run(testName, func(t *testing.T) {
+			blockHeight := SubmissionDeadlineBlockHeight(
+				startBlockHeight,
+				groupSize,
+				blockStep,
+				test.confirmationBufferBlocks,
+			)
+
+			if blockHeight != test.expectedBlockHeight {
+				t.Errorf(
+					"unexpected submission deadline\n"+
+						"expected: %v\nactual:   %v\n",
+					test.expectedBlockHeight,
+					blockHeight,
+				)
+			}
+		})
+	}
+}
@@ -0,0 +1,213 @@
+package result
+
+import (
+	"sync"
+	"time"
+
+	relayChain "github.com/keep-network/keep-core/pkg/beacon/relay/chain"
+	"github.com/keep-network/keep-core/pkg/beacon/relay/config"
+	"github.com/keep-network/keep-core/pkg/beacon/relay/group"
+)
+
+// TransactionGasUsage provides the amount of gas used by transactions
+// submitted to the chain.
+type TransactionGasUsage interface {
+	// TransactionGasUsed returns the amount of gas used by the mined
+	// transaction with the given hash.
+	TransactionGasUsed(transactionHash string) (uint64, error)
+}
+
+// SubmissionGasBudget caps the gas the node spends on on-chain DKG result
+// submissions per window. The budget is shared by all members controlled by
+// the node. Once the gas spent in the current window, along with the gas
+// expected to be used by the next submission, exceeds the budget, further
+// submissions are either declined or deferred until the window resets,
+// depending on the policy. Windows are consecutive and start with the
+// creation of the budget.
+//
+// Submissions of members checked against the budget at the same time may
+// together exceed the budget by the gas used by each of them.
+type SubmissionGasBudget struct {
+	budget uint64
+	window time.Duration
+	policy string
+
+	mutex       sync.Mutex
+	windowStart time.Time
+	spent       uint64
+
+	now func() time.Time
+}
+
+// NewSubmissionGasBudget creates a DKG result submission gas budget using
+// values from the provided node configuration. It returns nil if the budget
+// is not configured.
+func NewSubmissionGasBudget(nodeConfig *config.Node) *SubmissionGasBudget {
+	if nodeConfig == nil || nodeConfig.DKGSubmissionGasBudget == 0 {
+		return nil
+	}
+
+	window := time.Duration(nodeConfig.DKGSubmissionGasBudgetWindowSeconds) *
+		time.Second
+	if window == 0 {
+		window = config.DefaultDKGSubmissionGasBudgetWindow
+	}
+
+	policy := nodeConfig.DKGSubmissionGasBudgetPolicy
+	if policy == "" {
+		policy = config.DeclineDKGSubmission
+	}
+
+	return newSubmissionGasBudget(
+		nodeConfig.DKGSubmissionGasBudget,
+		window,
+		policy,
+		time.Now,
+	)
+}
+
+func newSubmissionGasBudget(
+	budget uint64,
+	window time.Duration,
+	policy string,
+	now func() time.Time,
+) *SubmissionGasBudget {
+	return &SubmissionGasBudget{
+		budget:      budget,
+		window:      window,
+		policy:      policy,
+		windowStart: now(),
+		now:         now,
+	}
+}
+
+// admit returns true if a submission expected to use the given amount of gas
+// fits within the budget of the current window. It also returns the gas left
+// in the current window and the time at which the window resets.
+func (sgb *SubmissionGasBudget) admit(
+	expectedGas uint64,
+) (bool, uint64, time.Time) {
+	sgb.mutex.Lock()
+	defer sgb.mutex.Unlock()
+
+	sgb.resetExpiredWindow()
+
+	remaining := sgb.remaining()
+	windowEnd := sgb.windowStart.Add(sgb.window)
+
+	if sgb.spent >= sgb.budget || expectedGas > remaining {
+		return false, remaining, windowEnd
+	}
+
+	return true, remaining, windowEnd
+}
+
+// spend records the given amount of gas as spent in the current window and
+// returns the gas left in the window.
+func (sgb *SubmissionGasBudget) spend(gas uint64) uint64 {
+	sgb.mutex.Lock()
+	defer sgb.mutex.Unlock()
+
+	sgb.resetExpiredWindow()
+
+	sgb.spent += gas
+
+	return sgb.remaining()
+}
+
+// defers returns true if submissions exceeding the budget are deferred until
+// the window resets instead of being declined.
+func (sgb *SubmissionGasBudget) defers() bool {
+	return sgb.policy == config.DeferDKGSubmission
+}
+
+// resetExpiredWindow starts a new window if the current one has passed.
+// It must be called with the mutex held.
+func (sgb *SubmissionGasBudget) resetExpiredWindow() {
+	elapsed := sgb.now().Sub(sgb.windowStart)
+	if elapsed < sgb.window {
+		return
+	}
+
+	sgb.windowStart = sgb.windowStart.Add(elapsed / sgb.window * sgb.window)
+	sgb.spent = 0
+}
+
+// remaining returns the gas left in the current window. It must be called
+// with the mutex held.
+func (sgb *SubmissionGasBudget) remaining() uint64 {
+	if sgb.spent >= sgb.budget {
+		return 0
+	}
+	return sgb.budget - sgb.spent
+}
+
+// expectedSubmissionGas returns the gas the member's submission of the given
+// result is expected to use. It is zero if the chain does not estimate the
+// gas of submissions or the estimation failed, in which case the submission
+// is admitted as long as the budget is not exhausted.
+func (sm *SubmittingMember) expectedSubmissionGas(
+	result *relayChain.DKGResult,
+	signatures map[group.MemberIndex][]byte,
+	chainRelay relayChain.Interface,
+) uint64 {
+	estimator, ok := chainRelay.(SubmissionGasEstimator)
+	if !ok {
+		return 0
+	}
+
+	gas, err := estimator.EstimateDKGResultSubmissionGas(
+		sm.index,
+		result,
+		signatures,
+	)
+	if err != nil {
+		logger.Warningf(
+			"[member:%v] could not estimate the gas of the DKG result "+
+				"submission; checking only if the gas budget is exhausted: [%v]",
+			sm.index,
+			err,
+		)
+		return 0
+	}
+
+	return gas
+}
+
+// spendSubmissionGas records the gas used by the member's submission in the
+// gas budget. The gas used is read from the transaction receipt if the chain
+// provides it. Otherwise, or if the submission failed after the transaction
+// might have been sent, the expected gas is recorded.
+func (sm *SubmittingMember) spendSubmissionGas(
+	chainRelay relayChain.Interface,
+) {
+	gas := sm.expectedGas
+
+	if gasUsage, ok := chainRelay.(TransactionGasUsage); ok &&
+		sm.transactionHash != "" {
+		gasUsed, err := gasUsage.TransactionGasUsed(sm.transactionHash)
+		if err != nil {
+			logger.Warningf(
+				"[member:%v] could not get the gas used by DKG result "+
+					"submission transaction [%v]; recording expected gas "+
+					"[%v] in the gas budget: [%v]",
+				sm.index,
+				sm.transactionHash,
+				sm.expectedGas,
+				err,
+			)
+		} else {
+			gas = gasUsed
+		}
+	}
+
+	remaining := sm.gasBudget.spend(gas)
+
+	logger.Infof(
+		"[member:%v] DKG result submission used [%v] gas; [%v] gas left in "+
+			"the submission gas budget",
+		sm.index,
+		gas,
+		remaining,
+	)
+}
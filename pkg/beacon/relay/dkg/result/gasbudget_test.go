@@ -0,0 +1,281 @@
+package result
+
+import (
+	"sync"
+	"testing"
+	"time"
+
+	relayChain "github.com/keep-network/keep-core/pkg/beacon/relay/chain"
+	"github.com/keep-network/keep-core/pkg/beacon/relay/config"
+	"github.com/keep-network/keep-core/pkg/beacon/relay/group"
+	"github.com/keep-network/keep-core/pkg/chain"
+)
+
+// gasBudgetClock is a manually advanced clock of the gas budget.
+type gasBudgetClock struct {
+	mutex   sync.Mutex
+	current time.Time
+}
+
+func (gbc *gasBudgetClock) now() time.Time {
+	gbc.mutex.Lock()
+	defer gbc.mutex.Unlock()
+
+	return gbc.current
+}
+
+func (gbc *gasBudgetClock) advance(duration time.Duration) {
+	gbc.mutex.Lock()
+	defer gbc.mutex.Unlock()
+
+	gbc.current = gbc.current.Add(duration)
+}
+
+// gasMeteredRelayChain estimates and reports the same amount of gas for each
+// DKG result submission.
+type gasMeteredRelayChain struct {
+	relayChain.Interface
+
+	estimatedGas uint64
+	usedGas      uint64
+}
+
+func (gmrc *gasMeteredRelayChain) EstimateDKGResultSubmissionGas(
+	memberIndex group.MemberIndex,
+	result *relayChain.DKGResult,
+	signatures map[group.MemberIndex][]byte,
+) (uint64, error) {
+	return gmrc.estimatedGas, nil
+}
+
+func (gmrc *gasMeteredRelayChain) TransactionGasUsed(
+	transactionHash string,
+) (uint64, error) {
+	return gmrc.usedGas, nil
+}
+
+func TestSubmissionGasBudget(t *testing.T) {
+	window := time.Hour
+	clock := &gasBudgetClock{current: time.Unix(1000, 0)}
+	budget := newSubmissionGasBudget(
+		1000,
+		window,
+		config.DeclineDKGSubmission,
+		clock.now,
+	)
+
+	assertAdmitted := func(expectedGas uint64, expectedAdmitted bool) {
+		admitted, _, _ := budget.admit(expectedGas)
+		if admitted != expectedAdmitted {
+			t.Fatalf(
+				"unexpected admission of [%v] gas\nexpected: %v\nactual:   %v\n",
+				expectedGas,
+				expectedAdmitted,
+				admitted,
+			)
+		}
+	}
+
+	assertAdmitted(1000, true)
+	assertAdmitted(1001, false)
+
+	budget.spend(600)
+	assertAdmitted(400, true)
+	assertAdmitted(401, false)
+
+	budget.spend(400)
+	// an exhausted budget declines even submissions of unknown gas
+	assertAdmitted(0, false)
+
+	clock.advance(window - time.Second)
+	assertAdmitted(0, false)
+
+	// the window resets one window after the budget has been created
+	clock.advance(time.Second)
+	assertAdmitted(1000, true)
+
+	budget.spend(1200)
+	assertAdmitted(0, false)
+
+	// skipped windows do not shift the following windows
+	clock.advance(2*window + 30*time.Minute)
+	admitted, remaining, windowEnd := budget.admit(1000)
+	if !admitted {
+		t.Fatal("expected submission to be admitted after the window reset")
+	}
+	if remaining != 1000 {
+		t.Errorf(
+			"unexpected remaining gas\nexpected: %v\nactual:   %v\n",
+			1000,
+			remaining,
+		)
+	}
+	expectedWindowEnd := time.Unix(1000, 0).Add(4 * window)
+	if !windowEnd.Equal(expectedWindowEnd) {
+		t.Errorf(
+			"unexpected window end\nexpected: %v\nactual:   %v\n",
+			expectedWindowEnd,
+			windowEnd,
+		)
+	}
+}
+
+func TestSubmitDKGResultWithinGasBudget(t *testing.T) {
+	window := time.Hour
+	usedGas := uint64(600)
+
+	var tests = map[string]struct {
+		policy string
+		// number of blocks after the start of the second submission after
+		// which the budget window resets; zero if it does not reset
+		resetBlocks       uint64
+		expectedOutcome   SubmissionOutcome
+		expectedSubmitted bool
+	}{
+		"declined once the budget is spent": {
+			policy:            config.DeclineDKGSubmission,
+			expectedOutcome:   SubmissionFailed,
+			expectedSubmitted: false,
+		},
+		"deferred until the window resets": {
+			policy:            config.DeferDKGSubmission,
+			resetBlocks:       2,
+			expectedOutcome:   SubmissionSubmitted,
+			expectedSubmitted: true,
+		},
+		"deferred until the publication deadline": {
+			policy:            config.DeferDKGSubmission,
+			expectedOutcome:   SubmissionFailed,
+			expectedSubmitted: false,
+		},
+	}
+
+	signatures := map[group.MemberIndex][]byte{
+		1: []byte{101},
+		2: []byte{102},
+		3: []byte{103},
+		4: []byte{104},
+	}
+
+	for testName, test := range tests {
+		t.Run(testName, func(t *testing.T) {
+			clock := &gasBudgetClock{current: time.Unix(1000, 0)}
+			budget := newSubmissionGasBudget(
+				1000,
+				window,
+				test.policy,
+				clock.now,
+			)
+
+			submit := func(
+				groupPublicKey []byte,
+				beforeSubmission func(
+					blockCounter chain.BlockCounter,
+					startBlockHeight uint64,
+				),
+			) (*SubmissionReport, relayChain.Interface) {
+				chainHandle, startBlockHeight, err := initChainHandle(3, 5)
+				if err != nil {
+					t.Fatal(err)
+				}
+				blockCounter, err := chainHandle.BlockCounter()
+				if err != nil {
+					t.Fatal(err)
+				}
+				chainRelay := &gasMeteredRelayChain{
+					Interface:    chainHandle.ThresholdRelay(),
+					estimatedGas: 500,
+					usedGas:      usedGas,
+				}
+
+				if beforeSubmission != nil {
+					beforeSubmission(blockCounter, startBlockHeight)
+				}
+
+				var report *SubmissionReport
+				member := NewSubmittingMember(
+					1,
+					SubmissionConfig{
+						Observer:  func(r *SubmissionReport) { report = r },
+						GasBudget: budget,
+					},
+				)
+
+				member.SubmitDKGResult(
+					&relayChain.DKGResult{GroupPublicKey: groupPublicKey},
+					signatures,
+					chainRelay,
+					blockCounter,
+					startBlockHeight,
+				)
+
+				return report, chainRelay
+			}
+
+			// The first submission fits within the budget and spends more
+			// than half of it. The gas used is taken from the transaction
+			// receipt; had the estimate been spent, the second submission
+			// would fit as well.
+			report, _ := submit([]byte{101, 1}, nil)
+			if report.Outcome != SubmissionSubmitted {
+				t.Fatalf(
+					"unexpected outcome of the first submission\n"+
+						"expected: %v\nactual:   %v\n",
+					SubmissionSubmitted,
+					report.Outcome,
+				)
+			}
+
+			var resetBlockHeight uint64
+			report, chainRelay := submit(
+				[]byte{102, 2},
+				func(blockCounter chain.BlockCounter, startBlockHeight uint64) {
+					if test.resetBlocks == 0 {
+						return
+					}
+
+					resetBlockHeight = startBlockHeight + test.resetBlocks
+					resetWaiter, err := blockCounter.BlockHeightWaiter(
+						resetBlockHeight,
+					)
+					if err != nil {
+						t.Fatal(err)
+					}
+					go func() {
+						<-resetWaiter
+						clock.advance(window)
+					}()
+				},
+			)
+
+			if report.Outcome != test.expectedOutcome {
+				t.Errorf(
+					"unexpected outcome of the second submission\n"+
+						"expected: %v\nactual:   %v\n",
+					test.expectedOutcome,
+					report.Outcome,
+				)
+			}
+			if test.expectedSubmitted && report.BlockHeight < resetBlockHeight {
+				t.Errorf(
+					"submission at block [%v] before the window reset at "+
+						"block [%v]",
+					report.BlockHeight,
+					resetBlockHeight,
+				)
+			}
+
+			submitted, err := chainRelay.IsGroupRegistered([]byte{102, 2})
+			if err != nil {
+				t.Fatal(err)
+			}
+			if submitted != test.expectedSubmitted {
+				t.Errorf(
+					"unexpected submission\nexpected: %v\nactual:   %v\n",
+					test.expectedSubmitted,
+					submitted,
+				)
+			}
+		})
+	}
+}
@@ -0,0 +1,100 @@
+package result
+
+import (
+	"math/big"
+
+	bn256 "github.com/ethereum/go-ethereum/crypto/bn256/cloudflare"
+	relayChain "github.com/keep-network/keep-core/pkg/beacon/relay/chain"
+	"github.com/keep-network/keep-core/pkg/beacon/relay/config"
+	"github.com/keep-network/keep-core/pkg/beacon/relay/group"
+)
+
+// estimationSignatureSize is the size of a single member signature in the
+// payload used for the gas estimation; it matches the size of an ECDSA
+// signature with the recovery ID.
+const estimationSignatureSize = 65
+
+// SubmissionGasEstimator estimates the gas cost of submitting the given
+// DKG result along with supporting signatures to the chain.
+type SubmissionGasEstimator interface {
+	EstimateDKGResultSubmissionGas(
+		memberIndex group.MemberIndex,
+		result *relayChain.DKGResult,
+		signatures map[group.MemberIndex][]byte,
+	) (uint64, error)
+}
+
+// GasEstimate is the estimated gas cost of the DKG result submission for
+// a group of the given size and honest threshold.
+type GasEstimate struct {
+	GroupSize       int
+	HonestThreshold int
+	// Signatures is the number of supporting signatures in the submission,
+	// that is the minimum number of signatures accepted by the chain.
+	Signatures int
+	Gas        uint64
+	// Err is the reason the estimation failed; Gas is zero if set.
+	Err error
+}
+
+// EstimateSubmissionGasTable estimates the gas cost of the DKG result
+// submission for all combinations of the given group sizes and honest
+// thresholds. Combinations with a threshold greater than the group size are
+// skipped. Each submission carries the minimum number of signatures accepted
+// by the chain for the given combination.
+func EstimateSubmissionGasTable(
+	estimator SubmissionGasEstimator,
+	groupSizes []int,
+	honestThresholds []int,
+) []*GasEstimate {
+	estimates := make([]*GasEstimate, 0)
+
+	for _, groupSize := range groupSizes {
+		for _, honestThreshold := range honestThresholds {
+			if honestThreshold < 1 || honestThreshold > groupSize {
+				continue
+			}
+
+			signatureThreshold := SignatureThreshold(&config.Chain{
+				GroupSize:       groupSize,
+				HonestThreshold: honestThreshold,
+			})
+
+			result, signatures := estimationPayload(signatureThreshold)
+			gas, err := estimator.EstimateDKGResultSubmissionGas(
+				1,
+				result,
+				signatures,
+			)
+
+			estimates = append(estimates, &GasEstimate{
+				GroupSize:       groupSize,
+				HonestThreshold: honestThreshold,
+				Signatures:      signatureThreshold,
+				Gas:             gas,
+				Err:             err,
+			})
+		}
+	}
+
+	return estimates
+}
+
+// estimationPayload returns a DKG result with a group public key of the
+// real size and no misbehaved members, along with the given number of
+// placeholder signatures.
+func estimationPayload(
+	signatureCount int,
+) (*relayChain.DKGResult, map[group.MemberIndex][]byte) {
+	result := &relayChain.DKGResult{
+		GroupPublicKey: new(bn256.G2).ScalarBaseMult(big.NewInt(1)).Marshal(),
+		Misbehaved:     []byte{},
+	}
+
+	signatures := make(map[group.MemberIndex][]byte, signatureCount)
+	for i := 1; i <= signatureCount; i++ {
+		signatures[group.MemberIndex(i)] = make([]byte, estimationSignatureSize)
+	}
+
+	return result, signatures
+}
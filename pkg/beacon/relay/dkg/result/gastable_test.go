@@ -0,0 +1,78 @@
+package result
+
+import (
+	"fmt"
+	"reflect"
+	"testing"
+
+	relayChain "github.com/keep-network/keep-core/pkg/beacon/relay/chain"
+	"github.com/keep-network/keep-core/pkg/beacon/relay/group"
+)
+
+// mockGasEstimator estimates the gas cost as a base cost plus a cost per
+// signature; it fails for submissions with more than maxSignatures.
+type mockGasEstimator struct {
+	maxSignatures int
+}
+
+func (mge *mockGasEstimator) EstimateDKGResultSubmissionGas(
+	memberIndex group.MemberIndex,
+	result *relayChain.DKGResult,
+	signatures map[group.MemberIndex][]byte,
+) (uint64, error) {
+	if len(signatures) > mge.maxSignatures {
+		return 0, fmt.Errorf("too many signatures")
+	}
+
+	for _, signature := range signatures {
+		if len(signature) != estimationSignatureSize {
+			return 0, fmt.Errorf("invalid signature size")
+		}
+	}
+
+	return 100000 + uint64(len(result.GroupPublicKey)) +
+		uint64(len(signatures))*5000, nil
+}
+
+func TestEstimateSubmissionGasTable(t *testing.T) {
+	estimates := EstimateSubmissionGasTable(
+		&mockGasEstimator{maxSignatures: 60},
+		[]int{5, 64},
+		[]int{3, 33, 64},
+	)
+
+	type row struct {
+		groupSize       int
+		honestThreshold int
+		signatures      int
+		gas             uint64
+		failed          bool
+	}
+
+	// group public key is 128 bytes long
+	expectedRows := []row{
+		{5, 3, 4, 100000 + 128 + 4*5000, false},
+		{64, 3, 33, 100000 + 128 + 33*5000, false},
+		{64, 33, 48, 100000 + 128 + 48*5000, false},
+		{64, 64, 64, 0, true},
+	}
+
+	actualRows := make([]row, 0)
+	for _, estimate := range estimates {
+		actualRows = append(actualRows, row{
+			estimate.GroupSize,
+			estimate.HonestThreshold,
+			estimate.Signatures,
+			estimate.Gas,
+			estimate.Err != nil,
+		})
+	}
+
+	if !reflect.DeepEqual(expectedRows, actualRows) {
+		t.Errorf(
+			"unexpected gas table\nexpected: %v\nactual:   %v\n",
+			expectedRows,
+			actualRows,
+		)
+	}
+}
@@ -0,0 +1,921 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: pb/api.proto
+
+package pb
+
+import (
+	bytes "bytes"
+	fmt "fmt"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+	reflect "reflect"
+	strings "strings"
+
+	proto "github.com/gogo/protobuf/proto"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// DKGResult is the DKG result exposed by management APIs. It contains
+// the group public key generated by the protocol execution and indexes of
+// all inactive or disqualified members, each represented by one byte.
+type DKGResult struct {
+	GroupPublicKey []byte `protobuf:"bytes,1,opt,name=groupPublicKey,proto3" json:"groupPublicKey,omitempty"`
+	Misbehaved     []byte `protobuf:"bytes,2,opt,name=misbehaved,proto3" json:"misbehaved,omitempty"`
+}
+
+func (m *DKGResult) Reset()      { *m = DKGResult{} }
+func (*DKGResult) ProtoMessage() {}
+func (*DKGResult) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b0bcca62df7aeb4c, []int{0}
+}
+func (m *DKGResult) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DKGResult) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DKGResult.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *DKGResult) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DKGResult.Merge(m, src)
+}
+func (m *DKGResult) XXX_Size() int {
+	return m.Size()
+}
+func (m *DKGResult) XXX_DiscardUnknown() {
+	xxx_messageInfo_DKGResult.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DKGResult proto.InternalMessageInfo
+
+func (m *DKGResult) GetGroupPublicKey() []byte {
+	if m != nil {
+		return m.GroupPublicKey
+	}
+	return nil
+}
+
+func (m *DKGResult) GetMisbehaved() []byte {
+	if m != nil {
+		return m.Misbehaved
+	}
+	return nil
+}
+
+// SubmissionReport is the outcome of the DKG result submission by a member
+// exposed by management APIs. The outcome is one of "submitted", "yielded"
+// or "failed". The transaction hash is set only if the member submitted
+// the result itself and the error only for the failed outcome.
+type SubmissionReport struct {
+	Outcome         string `protobuf:"bytes,1,opt,name=outcome,proto3" json:"outcome,omitempty"`
+	MemberIndex     uint32 `protobuf:"varint,2,opt,name=memberIndex,proto3" json:"memberIndex,omitempty"`
+	BlockHeight     uint64 `protobuf:"varint,3,opt,name=blockHeight,proto3" json:"blockHeight,omitempty"`
+	GroupPublicKey  []byte `protobuf:"bytes,4,opt,name=groupPublicKey,proto3" json:"groupPublicKey,omitempty"`
+	TransactionHash string `protobuf:"bytes,5,opt,name=transactionHash,proto3" json:"transactionHash,omitempty"`
+	Error           string `protobuf:"bytes,6,opt,name=error,proto3" json:"error,omitempty"`
+}
+
+func (m *SubmissionReport) Reset()      { *m = SubmissionReport{} }
+func (*SubmissionReport) ProtoMessage() {}
+func (*SubmissionReport) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b0bcca62df7aeb4c, []int{1}
+}
+func (m *SubmissionReport) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SubmissionReport) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SubmissionReport.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *SubmissionReport) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SubmissionReport.Merge(m, src)
+}
+func (m *SubmissionReport) XXX_Size() int {
+	return m.Size()
+}
+func (m *SubmissionReport) XXX_DiscardUnknown() {
+	xxx_messageInfo_SubmissionReport.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SubmissionReport proto.InternalMessageInfo
+
+func (m *SubmissionReport) GetOutcome() string {
+	if m != nil {
+		return m.Outcome
+	}
+	return ""
+}
+
+func (m *SubmissionReport) GetMemberIndex() uint32 {
+	if m != nil {
+		return m.MemberIndex
+	}
+	return 0
+}
+
+func (m *SubmissionReport) GetBlockHeight() uint64 {
+	if m != nil {
+		return m.BlockHeight
+	}
+	return 0
+}
+
+func (m *SubmissionReport) GetGroupPublicKey() []byte {
+	if m != nil {
+		return m.GroupPublicKey
+	}
+	return nil
+}
+
+func (m *SubmissionReport) GetTransactionHash() string {
+	if m != nil {
+		return m.TransactionHash
+	}
+	return ""
+}
+
+func (m *SubmissionReport) GetError() string {
+	if m != nil {
+		return m.Error
+	}
+	return ""
+}
+
+func init() {
+	proto.RegisterType((*DKGResult)(nil), "result.DKGResult")
+	proto.RegisterType((*SubmissionReport)(nil), "result.SubmissionReport")
+}
+
+func init() { proto.RegisterFile("pb/api.proto", fileDescriptor_b0bcca62df7aeb4c) }
+
+var fileDescriptor_b0bcca62df7aeb4c = []byte{
+	// 286 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x6c, 0x90, 0xbf, 0x4e, 0xf3, 0x30,
+	0x14, 0xc5, 0xed, 0x7e, 0x6d, 0x3f, 0xd5, 0x94, 0x3f, 0xb2, 0x18, 0x3c, 0x5d, 0x55, 0x1d, 0x50,
+	0x27, 0x18, 0x58, 0x98, 0x11, 0x12, 0x45, 0x5d, 0x90, 0xbb, 0xb1, 0xc5, 0xa9, 0xd5, 0x5a, 0x24,
+	0xb1, 0x65, 0x3b, 0x08, 0x36, 0x1e, 0x81, 0xc7, 0xe0, 0x51, 0x18, 0x33, 0x66, 0x24, 0xce, 0xc2,
+	0xd8, 0x47, 0x40, 0x18, 0x21, 0x45, 0x55, 0xc7, 0xf3, 0xbb, 0x47, 0x47, 0x3f, 0x5d, 0x32, 0x36,
+	0xe2, 0x22, 0x31, 0xea, 0xdc, 0x58, 0xed, 0x35, 0x1d, 0x5a, 0xe9, 0xca, 0xcc, 0x4f, 0x97, 0x64,
+	0x74, 0xb3, 0xb8, 0xe5, 0x31, 0xd0, 0x33, 0x72, 0xb4, 0xb6, 0xba, 0x34, 0xf7, 0xa5, 0xc8, 0x54,
+	0xba, 0x90, 0x2f, 0x0c, 0x4f, 0xf0, 0x6c, 0xcc, 0x77, 0x28, 0x05, 0x42, 0x72, 0xe5, 0x84, 0xdc,
+	0x24, 0x4f, 0x72, 0xc5, 0x7a, 0xb1, 0xd3, 0x21, 0xd3, 0x1a, 0x93, 0x93, 0x65, 0x29, 0x72, 0xe5,
+	0x9c, 0xd2, 0x05, 0x97, 0x46, 0x5b, 0x4f, 0x19, 0xf9, 0xaf, 0x4b, 0x9f, 0xea, 0x5c, 0xc6, 0xd5,
+	0x11, 0xff, 0x8b, 0x74, 0x42, 0x0e, 0x72, 0x99, 0x0b, 0x69, 0xef, 0x8a, 0x95, 0x7c, 0x8e, 0x7b,
+	0x87, 0xbc, 0x8b, 0x7e, 0x1a, 0x22, 0xd3, 0xe9, 0xe3, 0x5c, 0xaa, 0xf5, 0xc6, 0xb3, 0x7f, 0x13,
+	0x3c, 0xeb, 0xf3, 0x2e, 0xda, 0xa3, 0xde, 0xdf, 0xab, 0x3e, 0x23, 0xc7, 0xde, 0x26, 0x85, 0x4b,
+	0x52, 0xaf, 0x74, 0x31, 0x4f, 0xdc, 0x86, 0x0d, 0xa2, 0xcd, 0x2e, 0xa6, 0xa7, 0x64, 0x20, 0xad,
+	0xd5, 0x96, 0x0d, 0xe3, 0xfd, 0x37, 0x5c, 0x5f, 0x55, 0x0d, 0xa0, 0xba, 0x01, 0xb4, 0x6d, 0x00,
+	0xbf, 0x06, 0xc0, 0xef, 0x01, 0xf0, 0x47, 0x00, 0x5c, 0x05, 0xc0, 0x9f, 0x01, 0xf0, 0x57, 0x00,
+	0xb4, 0x0d, 0x80, 0xdf, 0x5a, 0x40, 0x55, 0x0b, 0xa8, 0x6e, 0x01, 0x3d, 0xf4, 0x8c, 0x10, 0xc3,
+	0xf8, 0xf8, 0xcb, 0xef, 0x01, 0x00, 0x27, 0xb2, 0xfe, 0xa8, 0x88, 0x01, 0x00, 0x00,
+}
+
+func (this *DKGResult) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*DKGResult)
+	if !ok {
+		that2, ok := that.(DKGResult)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if !bytes.Equal(this.GroupPublicKey, that1.GroupPublicKey) {
+		return false
+	}
+	if !bytes.Equal(this.Misbehaved, that1.Misbehaved) {
+		return false
+	}
+	return true
+}
+func (this *SubmissionReport) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*SubmissionReport)
+	if !ok {
+		that2, ok := that.(SubmissionReport)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Outcome != that1.Outcome {
+		return false
+	}
+	if this.MemberIndex != that1.MemberIndex {
+		return false
+	}
+	if this.BlockHeight != that1.BlockHeight {
+		return false
+	}
+	if !bytes.Equal(this.GroupPublicKey, that1.GroupPublicKey) {
+		return false
+	}
+	if this.TransactionHash != that1.TransactionHash {
+		return false
+	}
+	if this.Error != that1.Error {
+		return false
+	}
+	return true
+}
+func (this *DKGResult) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 6)
+	s = append(s, "&pb.DKGResult{")
+	s = append(s, "GroupPublicKey: "+fmt.Sprintf("%#v", this.GroupPublicKey)+",\n")
+	s = append(s, "Misbehaved: "+fmt.Sprintf("%#v", this.Misbehaved)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *SubmissionReport) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 10)
+	s = append(s, "&pb.SubmissionReport{")
+	s = append(s, "Outcome: "+fmt.Sprintf("%#v", this.Outcome)+",\n")
+	s = append(s, "MemberIndex: "+fmt.Sprintf("%#v", this.MemberIndex)+",\n")
+	s = append(s, "BlockHeight: "+fmt.Sprintf("%#v", this.BlockHeight)+",\n")
+	s = append(s, "GroupPublicKey: "+fmt.Sprintf("%#v", this.GroupPublicKey)+",\n")
+	s = append(s, "TransactionHash: "+fmt.Sprintf("%#v", this.TransactionHash)+",\n")
+	s = append(s, "Error: "+fmt.Sprintf("%#v", this.Error)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func valueToGoStringApi(v interface{}, typ string) string {
+	rv := reflect.ValueOf(v)
+	if rv.IsNil() {
+		return "nil"
+	}
+	pv := reflect.Indirect(rv).Interface()
+	return fmt.Sprintf("func(v %v) *%v { return &v } ( %#v )", typ, typ, pv)
+}
+func (m *DKGResult) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DKGResult) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DKGResult) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Misbehaved) > 0 {
+		i -= len(m.Misbehaved)
+		copy(dAtA[i:], m.Misbehaved)
+		i = encodeVarintApi(dAtA, i, uint64(len(m.Misbehaved)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.GroupPublicKey) > 0 {
+		i -= len(m.GroupPublicKey)
+		copy(dAtA[i:], m.GroupPublicKey)
+		i = encodeVarintApi(dAtA, i, uint64(len(m.GroupPublicKey)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *SubmissionReport) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SubmissionReport) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SubmissionReport) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Error) > 0 {
+		i -= len(m.Error)
+		copy(dAtA[i:], m.Error)
+		i = encodeVarintApi(dAtA, i, uint64(len(m.Error)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if len(m.TransactionHash) > 0 {
+		i -= len(m.TransactionHash)
+		copy(dAtA[i:], m.TransactionHash)
+		i = encodeVarintApi(dAtA, i, uint64(len(m.TransactionHash)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.GroupPublicKey) > 0 {
+		i -= len(m.GroupPublicKey)
+		copy(dAtA[i:], m.GroupPublicKey)
+		i = encodeVarintApi(dAtA, i, uint64(len(m.GroupPublicKey)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.BlockHeight != 0 {
+		i = encodeVarintApi(dAtA, i, uint64(m.BlockHeight))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.MemberIndex != 0 {
+		i = encodeVarintApi(dAtA, i, uint64(m.MemberIndex))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Outcome) > 0 {
+		i -= len(m.Outcome)
+		copy(dAtA[i:], m.Outcome)
+		i = encodeVarintApi(dAtA, i, uint64(len(m.Outcome)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintApi(dAtA []byte, offset int, v uint64) int {
+	offset -= sovApi(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *DKGResult) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.GroupPublicKey)
+	if l > 0 {
+		n += 1 + l + sovApi(uint64(l))
+	}
+	l = len(m.Misbehaved)
+	if l > 0 {
+		n += 1 + l + sovApi(uint64(l))
+	}
+	return n
+}
+
+func (m *SubmissionReport) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Outcome)
+	if l > 0 {
+		n += 1 + l + sovApi(uint64(l))
+	}
+	if m.MemberIndex != 0 {
+		n += 1 + sovApi(uint64(m.MemberIndex))
+	}
+	if m.BlockHeight != 0 {
+		n += 1 + sovApi(uint64(m.BlockHeight))
+	}
+	l = len(m.GroupPublicKey)
+	if l > 0 {
+		n += 1 + l + sovApi(uint64(l))
+	}
+	l = len(m.TransactionHash)
+	if l > 0 {
+		n += 1 + l + sovApi(uint64(l))
+	}
+	l = len(m.Error)
+	if l > 0 {
+		n += 1 + l + sovApi(uint64(l))
+	}
+	return n
+}
+
+func sovApi(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozApi(x uint64) (n int) {
+	return sovApi(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (this *DKGResult) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&DKGResult{`,
+		`GroupPublicKey:` + fmt.Sprintf("%v", this.GroupPublicKey) + `,`,
+		`Misbehaved:` + fmt.Sprintf("%v", this.Misbehaved) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *SubmissionReport) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&SubmissionReport{`,
+		`Outcome:` + fmt.Sprintf("%v", this.Outcome) + `,`,
+		`MemberIndex:` + fmt.Sprintf("%v", this.MemberIndex) + `,`,
+		`BlockHeight:` + fmt.Sprintf("%v", this.BlockHeight) + `,`,
+		`GroupPublicKey:` + fmt.Sprintf("%v", this.GroupPublicKey) + `,`,
+		`TransactionHash:` + fmt.Sprintf("%v", this.TransactionHash) + `,`,
+		`Error:` + fmt.Sprintf("%v", this.Error) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func valueToStringApi(v interface{}) string {
+	rv := reflect.ValueOf(v)
+	if rv.IsNil() {
+		return "nil"
+	}
+	pv := reflect.Indirect(rv).Interface()
+	return fmt.Sprintf("*%v", pv)
+}
+func (m *DKGResult) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowApi
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DKGResult: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DKGResult: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GroupPublicKey", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthApi
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthApi
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.GroupPublicKey = append(m.GroupPublicKey[:0], dAtA[iNdEx:postIndex]...)
+			if m.GroupPublicKey == nil {
+				m.GroupPublicKey = []byte{}
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Misbehaved", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthApi
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthApi
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Misbehaved = append(m.Misbehaved[:0], dAtA[iNdEx:postIndex]...)
+			if m.Misbehaved == nil {
+				m.Misbehaved = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipApi(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthApi
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthApi
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SubmissionReport) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowApi
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SubmissionReport: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SubmissionReport: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Outcome", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthApi
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthApi
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Outcome = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MemberIndex", wireType)
+			}
+			m.MemberIndex = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MemberIndex |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockHeight", wireType)
+			}
+			m.BlockHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BlockHeight |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GroupPublicKey", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthApi
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthApi
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.GroupPublicKey = append(m.GroupPublicKey[:0], dAtA[iNdEx:postIndex]...)
+			if m.GroupPublicKey == nil {
+				m.GroupPublicKey = []byte{}
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TransactionHash", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthApi
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthApi
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TransactionHash = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Error", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthApi
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthApi
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Error = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipApi(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthApi
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthApi
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipApi(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowApi
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowApi
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowApi
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthApi
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupApi
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthApi
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthApi        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowApi          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupApi = fmt.Errorf("proto: unexpected end of group")
+)
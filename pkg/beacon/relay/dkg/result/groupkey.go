@@ -0,0 +1,84 @@
+package result
+
+import (
+	"bytes"
+	"fmt"
+	"sort"
+
+	bn256 "github.com/ethereum/go-ethereum/crypto/bn256/cloudflare"
+	"github.com/keep-network/keep-core/pkg/beacon/relay/gjkr"
+	"github.com/keep-network/keep-core/pkg/beacon/relay/group"
+	"github.com/keep-network/keep-core/pkg/bls"
+)
+
+// VerifyGroupPublicKey checks if the group public key of the GJKR result
+// corresponds to the combination of the public key shares of all operating
+// members of the group, including the share of the given member derived from
+// its group private key share. It is meant to catch group public key
+// reconstruction errors before the result is published.
+//
+// The verification requires interpolating the group public key from
+// the shares and is relatively expensive.
+func VerifyGroupPublicKey(
+	memberIndex group.MemberIndex,
+	gjkrResult *gjkr.Result,
+) error {
+	publicKeyShares := make(map[group.MemberIndex]*bn256.G2)
+	for index, share := range gjkrResult.GroupPublicKeyShares() {
+		publicKeyShares[index] = share
+	}
+	publicKeyShares[memberIndex] = new(bn256.G2).ScalarBaseMult(
+		gjkrResult.GroupPrivateKeyShare,
+	)
+
+	return verifyGroupPublicKey(
+		gjkrResult.GroupPublicKey,
+		publicKeyShares,
+		gjkrResult.Group.DishonestThreshold()+1,
+	)
+}
+
+// verifyGroupPublicKey recovers the public key from the given public key
+// shares and compares it with the expected group public key. The threshold
+// is the number of shares needed to recover the key.
+func verifyGroupPublicKey(
+	groupPublicKey *bn256.G2,
+	publicKeyShares map[group.MemberIndex]*bn256.G2,
+	threshold int,
+) error {
+	if groupPublicKey == nil {
+		return fmt.Errorf("group public key is nil")
+	}
+
+	memberIndexes := make([]group.MemberIndex, 0, len(publicKeyShares))
+	for memberIndex := range publicKeyShares {
+		memberIndexes = append(memberIndexes, memberIndex)
+	}
+	sort.Slice(memberIndexes, func(i, j int) bool {
+		return memberIndexes[i] < memberIndexes[j]
+	})
+
+	shares := make([]*bls.PublicKeyShare, 0, len(memberIndexes))
+	for _, memberIndex := range memberIndexes {
+		shares = append(shares, &bls.PublicKeyShare{
+			I: int(memberIndex),
+			V: publicKeyShares[memberIndex],
+		})
+	}
+
+	recoveredKey, err := bls.RecoverPublicKey(shares, threshold)
+	if err != nil {
+		return fmt.Errorf("could not recover group public key: [%v]", err)
+	}
+
+	if !bytes.Equal(recoveredKey.Marshal(), groupPublicKey.Marshal()) {
+		return fmt.Errorf(
+			"group public key [0x%x] does not match the key [0x%x] recovered "+
+				"from public key shares of qualified members",
+			groupPublicKey.Marshal(),
+			recoveredKey.Marshal(),
+		)
+	}
+
+	return nil
+}
@@ -0,0 +1,87 @@
+package result
+
+import (
+	"crypto/rand"
+	"math/big"
+	"testing"
+
+	bn256 "github.com/ethereum/go-ethereum/crypto/bn256/cloudflare"
+	"github.com/keep-network/keep-core/pkg/beacon/relay/group"
+	"github.com/keep-network/keep-core/pkg/bls"
+)
+
+func TestVerifyGroupPublicKey(t *testing.T) {
+	groupSize := 5
+	threshold := 3
+
+	// polynomial coefficients; the first one is the group private key
+	var masterSecretKey []*big.Int
+	for i := 0; i < threshold; i++ {
+		secretKey, _, err := bn256.RandomG2(rand.Reader)
+		if err != nil {
+			t.Fatal(err)
+		}
+		masterSecretKey = append(masterSecretKey, secretKey)
+	}
+
+	groupPublicKey := new(bn256.G2).ScalarBaseMult(masterSecretKey[0])
+
+	publicKeyShares := make(map[group.MemberIndex]*bn256.G2)
+	for i := 1; i <= groupSize; i++ {
+		publicKeyShares[group.MemberIndex(i)] = bls.GetSecretKeyShare(
+			masterSecretKey,
+			i,
+		).PublicKeyShare().V
+	}
+
+	var tests = map[string]struct {
+		groupPublicKey *bn256.G2
+		shares         map[group.MemberIndex]*bn256.G2
+		expectedError  bool
+	}{
+		"correct group public key": {
+			groupPublicKey: groupPublicKey,
+			shares:         publicKeyShares,
+			expectedError:  false,
+		},
+		"correct group public key recovered from threshold shares": {
+			groupPublicKey: groupPublicKey,
+			shares: map[group.MemberIndex]*bn256.G2{
+				2: publicKeyShares[2],
+				4: publicKeyShares[4],
+				5: publicKeyShares[5],
+			},
+			expectedError: false,
+		},
+		"wrong group public key": {
+			groupPublicKey: new(bn256.G2).ScalarBaseMult(big.NewInt(2)),
+			shares:         publicKeyShares,
+			expectedError:  true,
+		},
+		"not enough shares": {
+			groupPublicKey: groupPublicKey,
+			shares: map[group.MemberIndex]*bn256.G2{
+				1: publicKeyShares[1],
+				3: publicKeyShares[3],
+			},
+			expectedError: true,
+		},
+	}
+
+	for testName, test := range tests {
+		t.Run(testName, func(t *testing.T) {
+			err := verifyGroupPublicKey(
+				test.groupPublicKey,
+				test.shares,
+				threshold,
+			)
+			if test.expectedError != (err != nil) {
+				t.Errorf(
+					"unexpected verification result\nexpected error: %v\nactual error:   %v\n",
+					test.expectedError,
+					err,
+				)
+			}
+		})
+	}
+}
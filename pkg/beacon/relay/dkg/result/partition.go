@@ -0,0 +1,44 @@
+package result
+
+import (
+	"math"
+)
+
+// PartitionGuard detects if the member is partitioned from most of the group
+// by observing the number of group members it is currently connected to.
+// Submitting the result based on local eligibility while partitioned could
+// mean submitting a result the majority of the group disagrees with, so the
+// submitting member defers its submission until connectivity recovers or
+// the deferral deadline forces the submission.
+type PartitionGuard struct {
+	// Returns the number of group members the node is currently connected
+	// to, including members controlled by the node itself.
+	connectedMembers func() int
+
+	minConnectedMembers int
+	maxDeferralBlocks   uint64
+}
+
+// NewPartitionGuard creates a guard considering the member partitioned when
+// it is connected to less than the given fraction of the group members.
+// Submission is deferred for at most the given number of blocks since the
+// member became eligible to submit the result.
+func NewPartitionGuard(
+	groupSize int,
+	minConnectedFraction float64,
+	maxDeferralBlocks uint64,
+	connectedMembers func() int,
+) *PartitionGuard {
+	return &PartitionGuard{
+		connectedMembers:    connectedMembers,
+		minConnectedMembers: int(math.Ceil(float64(groupSize) * minConnectedFraction)),
+		maxDeferralBlocks:   maxDeferralBlocks,
+	}
+}
+
+// isPartitioned returns true along with the current number of connected
+// group members if the number is below the required minimum.
+func (pg *PartitionGuard) isPartitioned() (bool, int) {
+	connected := pg.connectedMembers()
+	return connected < pg.minConnectedMembers, connected
+}
@@ -0,0 +1,122 @@
+package result
+
+import (
+	"sync/atomic"
+	"testing"
+
+	relayChain "github.com/keep-network/keep-core/pkg/beacon/relay/chain"
+	"github.com/keep-network/keep-core/pkg/beacon/relay/group"
+)
+
+func TestSubmitDKGResultDuringPartition(t *testing.T) {
+	groupSize := 5
+	maxDeferralBlocks := uint64(4)
+
+	var tests = map[string]struct {
+		initiallyConnected int32
+		// number of blocks after which the connectivity recovers; zero if it
+		// does not recover
+		recoveryBlocks uint64
+		// expected submission block relative to the start block
+		expectedMinSubmissionDelay uint64
+		expectedMaxSubmissionDelay uint64
+	}{
+		"not partitioned": {
+			initiallyConnected:         5,
+			expectedMinSubmissionDelay: 0,
+			expectedMaxSubmissionDelay: 0,
+		},
+		"partitioned until connectivity recovers": {
+			initiallyConnected:         1,
+			recoveryBlocks:             2,
+			expectedMinSubmissionDelay: 2,
+			expectedMaxSubmissionDelay: maxDeferralBlocks - 1,
+		},
+		"partitioned until deferral deadline": {
+			initiallyConnected:         1,
+			expectedMinSubmissionDelay: maxDeferralBlocks,
+			expectedMaxSubmissionDelay: maxDeferralBlocks,
+		},
+	}
+
+	for testName, test := range tests {
+		t.Run(testName, func(t *testing.T) {
+			chainHandle, startBlockHeight, err := initChainHandle(3, groupSize)
+			if err != nil {
+				t.Fatal(err)
+			}
+			blockCounter, err := chainHandle.BlockCounter()
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			connected := test.initiallyConnected
+			if test.recoveryBlocks != 0 {
+				recoveryWaiter, err := blockCounter.BlockHeightWaiter(
+					startBlockHeight + test.recoveryBlocks,
+				)
+				if err != nil {
+					t.Fatal(err)
+				}
+				go func() {
+					<-recoveryWaiter
+					atomic.StoreInt32(&connected, int32(groupSize))
+				}()
+			}
+
+			partitionGuard := NewPartitionGuard(
+				groupSize,
+				0.6,
+				maxDeferralBlocks,
+				func() int {
+					return int(atomic.LoadInt32(&connected))
+				},
+			)
+
+			var report *SubmissionReport
+			member := NewSubmittingMember(
+				1,
+				SubmissionConfig{
+					Observer:       func(r *SubmissionReport) { report = r },
+					PartitionGuard: partitionGuard,
+				},
+			)
+
+			err = member.SubmitDKGResult(
+				&relayChain.DKGResult{GroupPublicKey: []byte{123, 45}},
+				map[group.MemberIndex][]byte{
+					1: []byte{101},
+					2: []byte{102},
+					3: []byte{103},
+					4: []byte{104},
+				},
+				chainHandle.ThresholdRelay(),
+				blockCounter,
+				startBlockHeight,
+			)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			if report.Outcome != SubmissionSubmitted {
+				t.Fatalf(
+					"unexpected outcome\nexpected: %v\nactual:   %v\n",
+					SubmissionSubmitted,
+					report.Outcome,
+				)
+			}
+
+			submissionDelay := report.BlockHeight - startBlockHeight
+			if submissionDelay < test.expectedMinSubmissionDelay ||
+				submissionDelay > test.expectedMaxSubmissionDelay {
+				t.Errorf(
+					"unexpected submission delay\n"+
+						"expected: [%v, %v]\nactual:   %v\n",
+					test.expectedMinSubmissionDelay,
+					test.expectedMaxSubmissionDelay,
+					submissionDelay,
+				)
+			}
+		})
+	}
+}
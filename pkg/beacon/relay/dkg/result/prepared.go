@@ -0,0 +1,88 @@
+package result
+
+import (
+	"sync"
+
+	relayChain "github.com/keep-network/keep-core/pkg/beacon/relay/chain"
+	"github.com/keep-network/keep-core/pkg/beacon/relay/gjkr"
+)
+
+// PreparedResult is the GJKR result converted to the chain specific form
+// ready for the publication. The converted result is memoized until the state
+// of the group changes, e.g. when a member gets disqualified late. Then, the
+// result is converted again the next time it is requested.
+//
+// If the group changes after the member signed the result, the signed result
+// no longer reflects the group state and is considered stale.
+type PreparedResult struct {
+	mutex sync.Mutex
+
+	gjkrResult *gjkr.Result
+	result     *relayChain.DKGResult
+
+	signed bool
+	stale  bool
+}
+
+// PrepareResult prepares the given GJKR result for the publication and
+// observes the result's group for changes invalidating the prepared result.
+func PrepareResult(gjkrResult *gjkr.Result) *PreparedResult {
+	preparedResult := &PreparedResult{gjkrResult: gjkrResult}
+	gjkrResult.Group.OnChange(preparedResult.invalidate)
+
+	return preparedResult
+}
+
+// Result returns the chain specific form of the result reflecting the current
+// state of the group.
+func (pr *PreparedResult) Result() *relayChain.DKGResult {
+	pr.mutex.Lock()
+	defer pr.mutex.Unlock()
+
+	if pr.result == nil {
+		pr.result = ConvertGjkrResult(pr.gjkrResult)
+	}
+
+	return pr.result
+}
+
+// IsFailure returns true if the result reflects a failed DKG, that is, too
+// many members have been disqualified or marked as inactive for the group to
+// be secure (DQ+IA > T/2).
+func (pr *PreparedResult) IsFailure() bool {
+	return !pr.gjkrResult.Group.IsThresholdSatisfied()
+}
+
+// MarkSigned records that the member signed the current result.
+func (pr *PreparedResult) MarkSigned() {
+	pr.mutex.Lock()
+	defer pr.mutex.Unlock()
+
+	pr.signed = true
+}
+
+// IsStale returns true if the group changed after the member signed
+// the result.
+func (pr *PreparedResult) IsStale() bool {
+	pr.mutex.Lock()
+	defer pr.mutex.Unlock()
+
+	return pr.stale
+}
+
+func (pr *PreparedResult) invalidate() {
+	pr.mutex.Lock()
+	defer pr.mutex.Unlock()
+
+	if pr.signed && !pr.stale {
+		logger.Warningf(
+			"group state changed after the DKG result with group public key "+
+				"[0x%x] has been signed; signed result no longer reflects "+
+				"the group state",
+			pr.result.GroupPublicKey,
+		)
+		pr.stale = true
+	}
+
+	pr.result = nil
+}
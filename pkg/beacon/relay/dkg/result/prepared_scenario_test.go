@@ -0,0 +1,170 @@
+package result_test
+
+import (
+	"fmt"
+	"reflect"
+	"sort"
+	"testing"
+
+	"github.com/keep-network/keep-core/pkg/beacon/relay/group"
+	"github.com/keep-network/keep-core/pkg/internal/dqtest"
+)
+
+// memberRange returns indexes of members from the first to the last, inclusive.
+func memberRange(first, last group.MemberIndex) []group.MemberIndex {
+	indexes := make([]group.MemberIndex, 0)
+	for i := first; i <= last; i++ {
+		indexes = append(indexes, i)
+	}
+	return indexes
+}
+
+// The group is too weak to be registered if DQ+IA > T/2, where T is the
+// dishonest threshold. With T = 32, up to 16 eliminated members are accepted.
+func TestPrepareResultEliminatedMembersBoundary(t *testing.T) {
+	var tests = map[string]struct {
+		scenario        *dqtest.Scenario
+		expectedFailure bool
+	}{
+		"DQ+IA equal to T/2": {
+			scenario: (&dqtest.Scenario{GroupSize: 64, DishonestThreshold: 32}).
+				Inactivate(1, memberRange(1, 6)...).
+				Disqualify(4, memberRange(7, 12)...).
+				Disqualify(9, memberRange(13, 16)...),
+			expectedFailure: false,
+		},
+		"DQ+IA one more than T/2": {
+			scenario: (&dqtest.Scenario{GroupSize: 64, DishonestThreshold: 32}).
+				Inactivate(1, memberRange(1, 6)...).
+				Disqualify(4, memberRange(7, 12)...).
+				Disqualify(9, memberRange(13, 17)...),
+			expectedFailure: true,
+		},
+		"only DQ equal to T/2": {
+			scenario: (&dqtest.Scenario{GroupSize: 64, DishonestThreshold: 32}).
+				Disqualify(5, memberRange(49, 64)...),
+			expectedFailure: false,
+		},
+		"only IA one more than T/2": {
+			scenario: (&dqtest.Scenario{GroupSize: 64, DishonestThreshold: 32}).
+				Inactivate(2, memberRange(48, 64)...),
+			expectedFailure: true,
+		},
+		"odd T, DQ+IA equal to floor of T/2": {
+			scenario: (&dqtest.Scenario{GroupSize: 11, DishonestThreshold: 5}).
+				Inactivate(1, 3).
+				Disqualify(6, 7),
+			expectedFailure: false,
+		},
+		"odd T, DQ+IA one more than floor of T/2": {
+			scenario: (&dqtest.Scenario{GroupSize: 11, DishonestThreshold: 5}).
+				Inactivate(1, 3).
+				Disqualify(6, 7, 9),
+			expectedFailure: true,
+		},
+		"member both IA and DQ counted once": {
+			scenario: (&dqtest.Scenario{GroupSize: 64, DishonestThreshold: 32}).
+				Inactivate(1, memberRange(1, 16)...).
+				Disqualify(5, 16),
+			expectedFailure: false,
+		},
+		"late disqualification over T/2": {
+			scenario: (&dqtest.Scenario{GroupSize: 64, DishonestThreshold: 32}).
+				Disqualify(4, memberRange(1, 16)...).
+				Disqualify(dqtest.ResultPreparationPhase+1, 17),
+			expectedFailure: true,
+		},
+	}
+
+	for testName, test := range tests {
+		t.Run(testName, func(t *testing.T) {
+			outcome, err := dqtest.Run(test.scenario)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			if outcome.IsFailure() != test.expectedFailure {
+				t.Errorf(
+					"unexpected failure\nexpected: %v\nactual:   %v\n",
+					test.expectedFailure,
+					outcome.IsFailure(),
+				)
+			}
+
+			expectedMisbehaved := make([]byte, 0)
+			for _, change := range test.scenario.Changes {
+				if !containsMember(expectedMisbehaved, change.Member) {
+					expectedMisbehaved = append(expectedMisbehaved, change.Member)
+				}
+			}
+			sort.Slice(expectedMisbehaved, func(i, j int) bool {
+				return expectedMisbehaved[i] < expectedMisbehaved[j]
+			})
+			if !reflect.DeepEqual(expectedMisbehaved, outcome.Result().Misbehaved) {
+				t.Errorf(
+					"unexpected misbehaved members\nexpected: %v\nactual:   %v\n",
+					expectedMisbehaved,
+					outcome.Result().Misbehaved,
+				)
+			}
+		})
+	}
+}
+
+func TestPrepareResultThresholdPerPhase(t *testing.T) {
+	scenario := (&dqtest.Scenario{GroupSize: 11, DishonestThreshold: 5}).
+		Inactivate(1, 2).
+		Disqualify(5, 4).
+		Disqualify(8, 6).
+		Disqualify(dqtest.ResultPreparationPhase+1, 8)
+
+	outcome, err := dqtest.Run(scenario)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	expectedThresholdSatisfied := map[int]bool{
+		1:                                 true,
+		5:                                 true,
+		8:                                 false,
+		dqtest.ResultPreparationPhase + 1: false,
+	}
+	if !reflect.DeepEqual(
+		expectedThresholdSatisfied,
+		outcome.ThresholdSatisfied,
+	) {
+		t.Errorf(
+			"unexpected threshold satisfaction per phase\n"+
+				"expected: %v\nactual:   %v\n",
+			expectedThresholdSatisfied,
+			outcome.ThresholdSatisfied,
+		)
+	}
+}
+
+func TestPrepareResultScenarioMemberOutsideGroup(t *testing.T) {
+	scenario := (&dqtest.Scenario{GroupSize: 5, DishonestThreshold: 2}).
+		Disqualify(3, 6)
+
+	_, err := dqtest.Run(scenario)
+
+	expectedErr := fmt.Errorf(
+		"member [6] disqualified in phase [3] is not in the group of size [5]",
+	)
+	if !reflect.DeepEqual(expectedErr, err) {
+		t.Errorf(
+			"unexpected error\nexpected: %v\nactual:   %v\n",
+			expectedErr,
+			err,
+		)
+	}
+}
+
+func containsMember(indexes []byte, index group.MemberIndex) bool {
+	for _, i := range indexes {
+		if i == index {
+			return true
+		}
+	}
+	return false
+}
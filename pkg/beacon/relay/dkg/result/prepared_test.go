@@ -0,0 +1,335 @@
+package result
+
+import (
+	"context"
+	"math/big"
+	"reflect"
+	"testing"
+
+	bn256 "github.com/ethereum/go-ethereum/crypto/bn256/cloudflare"
+	"github.com/keep-network/keep-core/pkg/beacon/relay/config"
+	"github.com/keep-network/keep-core/pkg/beacon/relay/gjkr"
+	"github.com/keep-network/keep-core/pkg/beacon/relay/group"
+)
+
+func TestPreparedResultMemoization(t *testing.T) {
+	gjkrResult := &gjkr.Result{
+		GroupPublicKey: new(bn256.G2).ScalarBaseMult(big.NewInt(2)),
+		Group:          group.NewDkgGroup(2, 5),
+	}
+
+	preparedResult := PrepareResult(gjkrResult)
+
+	result := preparedResult.Result()
+	if result != preparedResult.Result() {
+		t.Errorf("expected result to be memoized")
+	}
+
+	gjkrResult.Group.MarkMemberAsDisqualified(4, "misbehaviour")
+
+	updatedResult := preparedResult.Result()
+	if updatedResult == result {
+		t.Errorf("expected cached result to be invalidated on group change")
+	}
+
+	expectedMisbehaved := []byte{4}
+	if !reflect.DeepEqual(expectedMisbehaved, updatedResult.Misbehaved) {
+		t.Errorf(
+			"unexpected misbehaved members\nexpected: %v\nactual:   %v\n",
+			expectedMisbehaved,
+			updatedResult.Misbehaved,
+		)
+	}
+}
+
+func TestPreparedResultStaleness(t *testing.T) {
+	var tests = map[string]struct {
+		signed        bool
+		groupChange   func(g *group.Group)
+		expectedStale bool
+	}{
+		"group not changed after signing": {
+			signed:        true,
+			groupChange:   func(g *group.Group) {},
+			expectedStale: false,
+		},
+		"group changed after signing": {
+			signed: true,
+			groupChange: func(g *group.Group) {
+				g.MarkMemberAsInactive(3)
+			},
+			expectedStale: true,
+		},
+		"group changed before signing": {
+			signed: false,
+			groupChange: func(g *group.Group) {
+				g.MarkMemberAsInactive(3)
+			},
+			expectedStale: false,
+		},
+	}
+
+	for testName, test := range tests {
+		t.Run(testName, func(t *testing.T) {
+			gjkrResult := &gjkr.Result{
+				GroupPublicKey: new(bn256.G2).ScalarBaseMult(big.NewInt(2)),
+				Group:          group.NewDkgGroup(2, 5),
+			}
+
+			preparedResult := PrepareResult(gjkrResult)
+			preparedResult.Result()
+			if test.signed {
+				preparedResult.MarkSigned()
+			}
+
+			test.groupChange(gjkrResult.Group)
+
+			if test.expectedStale != preparedResult.IsStale() {
+				t.Errorf(
+					"unexpected staleness\nexpected: %v\nactual:   %v\n",
+					test.expectedStale,
+					preparedResult.IsStale(),
+				)
+			}
+		})
+	}
+}
+
+func TestPreparedResultIgnoresDisconnectedMember(t *testing.T) {
+	gjkrResult := &gjkr.Result{
+		GroupPublicKey: new(bn256.G2).ScalarBaseMult(big.NewInt(2)),
+		Group:          group.NewDkgGroup(2, 5),
+	}
+
+	// member 3 disconnects at block 102 and stays gone
+	disconnectedMember := group.MemberIndex(3)
+	disconnected := false
+	tracker := group.NewLivenessTracker(
+		5,
+		func(memberID group.MemberIndex) bool {
+			return memberID != disconnectedMember || !disconnected
+		},
+	)
+	gjkrResult.Group.TrackLiveness(tracker)
+
+	preparedResult := PrepareResult(gjkrResult)
+
+	for blockHeight := uint64(100); blockHeight <= 110; blockHeight++ {
+		disconnected = blockHeight >= 102
+		tracker.Observe(gjkrResult.Group.MemberIDs(), blockHeight)
+
+		// messages of all members are received in each phase, the ones of
+		// the disconnected member relayed by other peers
+		filter := group.NewInactiveMemberFilter(1, gjkrResult.Group)
+		for _, memberID := range gjkrResult.Group.MemberIDs() {
+			filter.MarkMemberAsActive(memberID)
+		}
+		filter.FlushInactiveMembers()
+	}
+
+	if len(gjkrResult.Group.InactiveMemberIDs()) != 0 {
+		t.Errorf(
+			"unexpected inactive members\nexpected: %v\nactual:   %v\n",
+			[]group.MemberIndex{},
+			gjkrResult.Group.InactiveMemberIDs(),
+		)
+	}
+
+	if len(preparedResult.Result().Misbehaved) != 0 {
+		t.Errorf(
+			"unexpected misbehaved members\nexpected: %v\nactual:   %v\n",
+			[]byte{},
+			preparedResult.Result().Misbehaved,
+		)
+	}
+}
+
+func TestStaleResultIsNotSubmitted(t *testing.T) {
+	chainHandle, initialBlockHeight, err := initChainHandle(3, 5)
+	if err != nil {
+		t.Fatal(err)
+	}
+	blockCounter, err := chainHandle.BlockCounter()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	gjkrResult := &gjkr.Result{
+		GroupPublicKey: new(bn256.G2).ScalarBaseMult(big.NewInt(2)),
+		Group:          group.NewDkgGroup(2, 5),
+	}
+	preparedResult := PrepareResult(gjkrResult)
+	result := preparedResult.Result()
+	preparedResult.MarkSigned()
+
+	// late disqualification after the result has been signed
+	gjkrResult.Group.MarkMemberAsDisqualified(5, "misbehaviour")
+
+	state := &resultSubmissionState{
+		relayChain:     chainHandle.ThresholdRelay(),
+		blockCounter:   blockCounter,
+		member:         NewSubmittingMember(1, SubmissionConfig{}),
+		result:         result,
+		preparedResult: preparedResult,
+		signatures: map[group.MemberIndex][]byte{
+			1: []byte{101},
+			2: []byte{102},
+			3: []byte{103},
+			4: []byte{104},
+		},
+		submissionStartBlockHeight: initialBlockHeight,
+	}
+
+	if err := state.Initiate(context.Background()); err == nil {
+		t.Fatal("expected stale result submission to fail")
+	}
+
+	isRegistered, err := chainHandle.ThresholdRelay().IsGroupRegistered(
+		result.GroupPublicKey,
+	)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if isRegistered {
+		t.Errorf("stale result should not be submitted")
+	}
+}
+
+func TestPreparedResultIsFailure(t *testing.T) {
+	gjkrResult := &gjkr.Result{
+		GroupPublicKey: new(bn256.G2).ScalarBaseMult(big.NewInt(2)),
+		Group:          group.NewDkgGroup(2, 5),
+	}
+
+	preparedResult := PrepareResult(gjkrResult)
+
+	gjkrResult.Group.MarkMemberAsDisqualified(4, "misbehaviour")
+	if preparedResult.IsFailure() {
+		t.Errorf("result with threshold satisfied should not be a failure")
+	}
+
+	gjkrResult.Group.MarkMemberAsInactive(5)
+	if !preparedResult.IsFailure() {
+		t.Errorf("result with too weak group should be a failure")
+	}
+}
+
+func TestFailureResultPolicy(t *testing.T) {
+	var tests = map[string]struct {
+		policy            string
+		expectedSubmitted bool
+		expectedAlert     bool
+	}{
+		"default policy": {
+			policy:            "",
+			expectedSubmitted: true,
+		},
+		"submit policy": {
+			policy:            config.SubmitDKGFailureResult,
+			expectedSubmitted: true,
+		},
+		"skip policy": {
+			policy:            config.SkipDKGFailureResult,
+			expectedSubmitted: false,
+		},
+		"alert policy": {
+			policy:            config.AlertDKGFailureResult,
+			expectedSubmitted: false,
+			expectedAlert:     true,
+		},
+	}
+
+	for testName, test := range tests {
+		t.Run(testName, func(t *testing.T) {
+			chainHandle, initialBlockHeight, err := initChainHandle(3, 5)
+			if err != nil {
+				t.Fatal(err)
+			}
+			blockCounter, err := chainHandle.BlockCounter()
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			gjkrResult := &gjkr.Result{
+				GroupPublicKey: new(bn256.G2).ScalarBaseMult(big.NewInt(2)),
+				Group:          group.NewDkgGroup(2, 5),
+			}
+			gjkrResult.Group.MarkMemberAsDisqualified(4, "misbehaviour")
+			gjkrResult.Group.MarkMemberAsInactive(5)
+
+			preparedResult := PrepareResult(gjkrResult)
+			if !preparedResult.IsFailure() {
+				t.Fatal("expected failure result")
+			}
+			result := preparedResult.Result()
+
+			var reports []*SubmissionReport
+			observer := func(report *SubmissionReport) {
+				reports = append(reports, report)
+			}
+
+			state := &resultSubmissionState{
+				relayChain:   chainHandle.ThresholdRelay(),
+				blockCounter: blockCounter,
+				member: NewSubmittingMember(
+					1,
+					SubmissionConfig{
+						Observer: observer,
+					},
+				),
+				result:         result,
+				preparedResult: preparedResult,
+				signatures: map[group.MemberIndex][]byte{
+					1: []byte{101},
+					2: []byte{102},
+					3: []byte{103},
+					4: []byte{104},
+				},
+				submissionStartBlockHeight: initialBlockHeight,
+				failureResultPolicy:        test.policy,
+			}
+
+			err = state.Initiate(context.Background())
+			if test.expectedSubmitted != (err == nil) {
+				t.Fatalf(
+					"unexpected submission result\nexpected submitted: %v\nactual error:       %v\n",
+					test.expectedSubmitted,
+					err,
+				)
+			}
+
+			isRegistered, err := chainHandle.ThresholdRelay().IsGroupRegistered(
+				result.GroupPublicKey,
+			)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if test.expectedSubmitted != isRegistered {
+				t.Errorf(
+					"unexpected result registration\nexpected: %v\nactual:   %v\n",
+					test.expectedSubmitted,
+					isRegistered,
+				)
+			}
+
+			if test.expectedAlert {
+				if len(reports) != 1 {
+					t.Fatalf(
+						"unexpected number of reports\nexpected: %v\nactual:   %v\n",
+						1,
+						len(reports),
+					)
+				}
+				if reports[0].Outcome != SubmissionFailed {
+					t.Errorf(
+						"unexpected alert outcome\nexpected: %v\nactual:   %v\n",
+						SubmissionFailed,
+						reports[0].Outcome,
+					)
+				}
+			} else if !test.expectedSubmitted && len(reports) != 0 {
+				t.Errorf("skipped failure result should not be reported")
+			}
+		})
+	}
+}
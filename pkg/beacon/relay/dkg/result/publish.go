@@ -4,6 +4,7 @@ import (
 	"fmt"
 
 	relayChain "github.com/keep-network/keep-core/pkg/beacon/relay/chain"
+	"github.com/keep-network/keep-core/pkg/beacon/relay/dkg/transcript"
 	"github.com/keep-network/keep-core/pkg/beacon/relay/gjkr"
 	"github.com/keep-network/keep-core/pkg/beacon/relay/group"
 	"github.com/keep-network/keep-core/pkg/beacon/relay/state"
@@ -25,7 +26,10 @@ func RegisterUnmarshallers(channel net.BroadcastChannel) {
 // chosen result is hashed, signed, and sent over a broadcast channel. Then, all
 // other signatures and results are received and accounted for. Those that match
 // our own result and added to the list of votes. Finally, we submit the result
-// along with everyone's votes.
+// along with everyone's votes according to the given submission config. The
+// failure result policy determines what happens with the result if the group
+// is too weak; the result is submitted if the policy is empty. If the recorder
+// is set, the member's execution is recorded in its transcript.
 func Publish(
 	memberIndex group.MemberIndex,
 	dkgGroup *group.Group,
@@ -36,16 +40,28 @@ func Publish(
 	signing chain.Signing,
 	blockCounter chain.BlockCounter,
 	startBlockHeight uint64,
+	submissionConfig SubmissionConfig,
+	failureResultPolicy string,
+	recorder *transcript.Recorder,
 ) error {
-	initialState := &resultSigningState{
+	preparedResult := PrepareResult(result)
+
+	var initialState signingState = &resultSigningState{
 		channel:                 channel,
 		relayChain:              relayChain,
 		signing:                 signing,
 		blockCounter:            blockCounter,
 		member:                  NewSigningMember(memberIndex, dkgGroup, membershipValidator),
-		result:                  convertGjkrResult(result),
+		result:                  preparedResult.Result(),
+		preparedResult:          preparedResult,
 		signatureMessages:       make([]*DKGResultHashSignatureMessage, 0),
 		signingStartBlockHeight: startBlockHeight,
+		submissionConfig:        submissionConfig,
+		failureResultPolicy:     failureResultPolicy,
+	}
+
+	if recorder != nil {
+		initialState = recorder.State(transcript.ResultPublication, initialState)
 	}
 
 	stateMachine := state.NewMachine(channel, blockCounter, initialState)
@@ -54,6 +70,7 @@ func Publish(
 	if err != nil {
 		return err
 	}
+	lastState = transcript.Unwrap(lastState)
 
 	_, ok := lastState.(*resultSubmissionState)
 	if !ok {
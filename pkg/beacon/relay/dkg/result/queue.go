@@ -0,0 +1,157 @@
+package result
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	relayChain "github.com/keep-network/keep-core/pkg/beacon/relay/chain"
+	"github.com/keep-network/keep-core/pkg/beacon/relay/event"
+	"github.com/keep-network/keep-core/pkg/beacon/relay/group"
+)
+
+// SubmissionRequest is a request to submit the DKG result supported by the
+// given signatures to the chain in the name of the given member.
+type SubmissionRequest struct {
+	MemberIndex group.MemberIndex            `json:"memberIndex"`
+	Result      *relayChain.DKGResult        `json:"result"`
+	Signatures  map[group.MemberIndex][]byte `json:"signatures"`
+
+	// Notified whether the request has been submitted to the chain by
+	// a consumer running in the same process; may be nil.
+	onComplete func(submitted bool)
+}
+
+// complete notifies the member the request comes from whether the result
+// has been submitted to the chain.
+func (sr *SubmissionRequest) complete(submitted bool) {
+	if sr.onComplete != nil {
+		sr.onComplete(submitted)
+	}
+}
+
+// SubmissionQueue accepts DKG result submission requests which are submitted
+// to the chain by a dedicated submitter draining the queue instead of being
+// submitted inline by the submitting member.
+type SubmissionQueue interface {
+	// Enqueue adds the request to the queue. It returns an error if the
+	// request could not be accepted by the queue.
+	Enqueue(request *SubmissionRequest) error
+}
+
+// SubmitRequest submits the DKG result from the request to the chain and
+// waits until the submission completes.
+func SubmitRequest(
+	request *SubmissionRequest,
+	chainRelay relayChain.Interface,
+) error {
+	errorChannel := make(chan error, 1)
+
+	chainRelay.SubmitDKGResult(
+		request.MemberIndex,
+		request.Result,
+		request.Signatures,
+	).OnComplete(func(
+		dkgResultPublishedEvent *event.DKGResultSubmission,
+		err error,
+	) {
+		errorChannel <- err
+	})
+
+	return <-errorChannel
+}
+
+// MemoryQueue is a submission queue kept in memory and drained by a consumer
+// running in the same process.
+type MemoryQueue struct {
+	requests chan *SubmissionRequest
+}
+
+// NewMemoryQueue creates an in-memory submission queue holding up to the
+// given number of requests.
+func NewMemoryQueue(capacity int) *MemoryQueue {
+	return &MemoryQueue{
+		requests: make(chan *SubmissionRequest, capacity),
+	}
+}
+
+// Enqueue adds the request to the queue. It fails if the queue is full.
+func (mq *MemoryQueue) Enqueue(request *SubmissionRequest) error {
+	select {
+	case mq.requests <- request:
+		return nil
+	default:
+		return fmt.Errorf("submission queue is full")
+	}
+}
+
+// Consume drains the queue submitting requests to the chain one by one until
+// the context is done. The member each request comes from is notified whether
+// the request has been submitted.
+func (mq *MemoryQueue) Consume(
+	ctx context.Context,
+	chainRelay relayChain.Interface,
+) {
+	for {
+		select {
+		case request := <-mq.requests:
+			err := SubmitRequest(request, chainRelay)
+			request.complete(err == nil)
+			if err != nil {
+				logger.Errorf(
+					"[member:%v] could not submit queued DKG result with "+
+						"group public key [0x%x]: [%v]",
+					request.MemberIndex,
+					request.Result.GroupPublicKey,
+					err,
+				)
+				continue
+			}
+
+			logger.Infof(
+				"[member:%v] submitted queued DKG result with "+
+					"group public key [0x%x]",
+				request.MemberIndex,
+				request.Result.GroupPublicKey,
+			)
+		case <-ctx.Done():
+			return
+		}
+	}
+}
+
+// Publisher publishes messages on the given subject of a message broker.
+type Publisher interface {
+	Publish(subject string, payload []byte) error
+}
+
+// BrokerQueue is a submission queue backed by a message broker. Requests are
+// published as JSON messages on the configured subject for an external
+// submitter service to drain.
+type BrokerQueue struct {
+	publisher Publisher
+	subject   string
+}
+
+// NewBrokerQueue creates a submission queue publishing requests on the
+// given subject with the given publisher.
+func NewBrokerQueue(publisher Publisher, subject string) *BrokerQueue {
+	return &BrokerQueue{
+		publisher: publisher,
+		subject:   subject,
+	}
+}
+
+// Enqueue publishes the JSON-encoded request on the queue subject.
+func (bq *BrokerQueue) Enqueue(request *SubmissionRequest) error {
+	payload, err := json.Marshal(request)
+	if err != nil {
+		return fmt.Errorf("could not marshal submission request: [%v]", err)
+	}
+
+	if err := bq.publisher.Publish(bq.subject, payload); err != nil {
+		return fmt.Errorf("could not publish submission request: [%v]", err)
+	}
+
+	return nil
+}
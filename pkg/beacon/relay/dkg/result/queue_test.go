@@ -0,0 +1,293 @@
+package result
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"testing"
+	"time"
+
+	relayChain "github.com/keep-network/keep-core/pkg/beacon/relay/chain"
+	"github.com/keep-network/keep-core/pkg/beacon/relay/group"
+)
+
+type publishedMessage struct {
+	subject string
+	payload []byte
+}
+
+type recordingPublisher struct {
+	messages []*publishedMessage
+	err      error
+}
+
+func (rp *recordingPublisher) Publish(subject string, payload []byte) error {
+	if rp.err != nil {
+		return rp.err
+	}
+
+	rp.messages = append(rp.messages, &publishedMessage{subject, payload})
+	return nil
+}
+
+func newTestSubmissionRequest() *SubmissionRequest {
+	return &SubmissionRequest{
+		MemberIndex: 2,
+		Result: &relayChain.DKGResult{
+			GroupPublicKey: []byte{123, 45},
+			Misbehaved:     []byte{5},
+		},
+		Signatures: map[group.MemberIndex][]byte{
+			1: []byte{101},
+			2: []byte{102},
+			3: []byte{103},
+			4: []byte{104},
+		},
+	}
+}
+
+func TestBrokerQueueEnqueue(t *testing.T) {
+	publisher := &recordingPublisher{}
+	queue := NewBrokerQueue(publisher, "keep.dkg.submission")
+
+	request := newTestSubmissionRequest()
+	if err := queue.Enqueue(request); err != nil {
+		t.Fatal(err)
+	}
+
+	if len(publisher.messages) != 1 {
+		t.Fatalf(
+			"unexpected number of published messages\nexpected: %v\nactual:   %v\n",
+			1,
+			len(publisher.messages),
+		)
+	}
+
+	message := publisher.messages[0]
+	if message.subject != "keep.dkg.submission" {
+		t.Errorf(
+			"unexpected subject\nexpected: %v\nactual:   %v\n",
+			"keep.dkg.submission",
+			message.subject,
+		)
+	}
+
+	published := &SubmissionRequest{}
+	if err := json.Unmarshal(message.payload, published); err != nil {
+		t.Fatal(err)
+	}
+	if !reflect.DeepEqual(request, published) {
+		t.Errorf(
+			"unexpected published request\nexpected: %+v\nactual:   %+v\n",
+			request,
+			published,
+		)
+	}
+}
+
+func TestBrokerQueuePublicationFailure(t *testing.T) {
+	publisher := &recordingPublisher{err: fmt.Errorf("broker unavailable")}
+	queue := NewBrokerQueue(publisher, "keep.dkg.submission")
+
+	if err := queue.Enqueue(newTestSubmissionRequest()); err == nil {
+		t.Errorf("expected enqueue to fail")
+	}
+}
+
+func TestMemoryQueueFull(t *testing.T) {
+	queue := NewMemoryQueue(1)
+
+	if err := queue.Enqueue(newTestSubmissionRequest()); err != nil {
+		t.Fatal(err)
+	}
+	if err := queue.Enqueue(newTestSubmissionRequest()); err == nil {
+		t.Errorf("expected enqueue to fail for a full queue")
+	}
+}
+
+func TestSubmitDKGResultToMemoryQueue(t *testing.T) {
+	chainHandle, initialBlockHeight, err := initChainHandle(3, 5)
+	if err != nil {
+		t.Fatal(err)
+	}
+	blockCounter, err := chainHandle.BlockCounter()
+	if err != nil {
+		t.Fatal(err)
+	}
+	chainRelay := chainHandle.ThresholdRelay()
+
+	request := newTestSubmissionRequest()
+	queue := NewMemoryQueue(1)
+
+	reports := make(chan *SubmissionReport, 1)
+	observer := func(report *SubmissionReport) {
+		reports <- report
+	}
+
+	member := NewSubmittingMember(
+		1,
+		SubmissionConfig{
+			Observer: observer,
+			Queue:    queue,
+		},
+	)
+	go member.SubmitDKGResult(
+		request.Result,
+		request.Signatures,
+		chainRelay,
+		blockCounter,
+		initialBlockHeight,
+	)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	// The member keeps watching the chain once the result is accepted by
+	// the queue so no report is expected until the queue is drained.
+	select {
+	case report := <-reports:
+		t.Fatalf("unexpected report before the queue is drained: [%+v]", report)
+	case <-time.After(time.Second):
+	}
+
+	go queue.Consume(ctx, chainRelay)
+
+	select {
+	case report := <-reports:
+		if report.Outcome != SubmissionSubmitted {
+			t.Errorf(
+				"unexpected outcome\nexpected: %v\nactual:   %v\n",
+				SubmissionSubmitted,
+				report.Outcome,
+			)
+		}
+		if report.TransactionHash == "" {
+			t.Errorf("expected transaction hash of the queued submission")
+		}
+	case <-ctx.Done():
+		t.Fatalf("queued result has not been submitted")
+	}
+}
+
+func TestSubmitDKGResultToQueueNotPublished(t *testing.T) {
+	chainHandle, initialBlockHeight, err := initChainHandle(3, 5)
+	if err != nil {
+		t.Fatal(err)
+	}
+	blockCounter, err := chainHandle.BlockCounter()
+	if err != nil {
+		t.Fatal(err)
+	}
+	chainRelay := chainHandle.ThresholdRelay()
+
+	config, err := chainRelay.GetConfig()
+	if err != nil {
+		t.Fatal(err)
+	}
+	publicationDeadline := PublicationDeadlineBlockHeight(
+		initialBlockHeight,
+		config.GroupSize,
+		config.ResultPublicationBlockStep,
+	)
+
+	request := newTestSubmissionRequest()
+	// Nothing drains the broker so the result never gets published.
+	queue := NewBrokerQueue(&recordingPublisher{}, "keep.dkg.submission")
+
+	var report *SubmissionReport
+	observer := func(r *SubmissionReport) {
+		report = r
+	}
+
+	member := NewSubmittingMember(
+		1,
+		SubmissionConfig{
+			Observer: observer,
+			Queue:    queue,
+		},
+	)
+	err = member.SubmitDKGResult(
+		request.Result,
+		request.Signatures,
+		chainRelay,
+		blockCounter,
+		initialBlockHeight,
+	)
+	if err == nil {
+		t.Fatalf("expected submission to fail")
+	}
+
+	if report.Outcome != SubmissionFailed {
+		t.Errorf(
+			"unexpected outcome\nexpected: %v\nactual:   %v\n",
+			SubmissionFailed,
+			report.Outcome,
+		)
+	}
+	if report.BlockHeight < publicationDeadline {
+		t.Errorf(
+			"submission failed before the publication deadline\n"+
+				"expected: >= %v\nactual:   %v\n",
+			publicationDeadline,
+			report.BlockHeight,
+		)
+	}
+}
+
+func TestMemoryQueueConsumeCompletesRequest(t *testing.T) {
+	var tests = map[string]struct {
+		signatures        map[group.MemberIndex][]byte
+		expectedSubmitted bool
+	}{
+		"request submitted": {
+			signatures:        newTestSubmissionRequest().Signatures,
+			expectedSubmitted: true,
+		},
+		"request submission failed": {
+			signatures: map[group.MemberIndex][]byte{
+				1: []byte{101},
+			},
+			expectedSubmitted: false,
+		},
+	}
+
+	for testName, test := range tests {
+		t.Run(testName, func(t *testing.T) {
+			chainHandle, _, err := initChainHandle(3, 5)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			completions := make(chan bool, 1)
+			request := newTestSubmissionRequest()
+			request.Signatures = test.signatures
+			request.onComplete = func(submitted bool) {
+				completions <- submitted
+			}
+
+			queue := NewMemoryQueue(1)
+			if err := queue.Enqueue(request); err != nil {
+				t.Fatal(err)
+			}
+
+			ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+			defer cancel()
+
+			go queue.Consume(ctx, chainHandle.ThresholdRelay())
+
+			select {
+			case submitted := <-completions:
+				if submitted != test.expectedSubmitted {
+					t.Errorf(
+						"unexpected completion\nexpected: %v\nactual:   %v\n",
+						test.expectedSubmitted,
+						submitted,
+					)
+				}
+			case <-ctx.Done():
+				t.Fatalf("request has not been completed")
+			}
+		})
+	}
+}
@@ -0,0 +1,131 @@
+package result
+
+import (
+	"sort"
+
+	"github.com/keep-network/keep-core/pkg/beacon/relay/config"
+	"github.com/keep-network/keep-core/pkg/beacon/relay/group"
+)
+
+// QuorumStatus describes how the set of signatures supporting a DKG result
+// relates to the number of signatures required by the chain to accept the
+// result. For the weighted quorum, numbers of signatures are total weights
+// of signing members.
+type QuorumStatus struct {
+	// Have is the number of signatures supporting the result.
+	Have int
+	// Required is the minimum number of supporting signatures accepted
+	// by the chain.
+	Required int
+	// Missing contains indexes of all group members who did not provide
+	// a signature supporting the result, in ascending order.
+	Missing []group.MemberIndex
+	// Met is true if the number of supporting signatures is enough to
+	// submit the result.
+	Met bool
+}
+
+// SignatureThreshold returns the minimum number of signatures supporting the
+// DKG result which is accepted by the chain. Chain rejects the result if it
+// has less than 25% safety margin.
+func SignatureThreshold(chainConfig *config.Chain) int {
+	return chainConfig.HonestThreshold +
+		(chainConfig.GroupSize-chainConfig.HonestThreshold)/2
+}
+
+// MemberWeights maps indexes of group members to their weights in the
+// weighted signature quorum, for example, based on their stake. Members not
+// present in the map have no weight.
+type MemberWeights map[group.MemberIndex]int
+
+// weight returns the weight of the member with the given index. If weights
+// are not set, all members have equal weights of one.
+func (mw MemberWeights) weight(memberIndex group.MemberIndex) int {
+	if mw == nil {
+		return 1
+	}
+
+	return mw[memberIndex]
+}
+
+// DetermineQuorumStatus evaluates the provided signatures map against the
+// signature threshold computed from the chain config and reports the number
+// of signatures, the number of required signatures as well as indexes of
+// members who did not sign the result.
+func DetermineQuorumStatus(
+	signatures map[group.MemberIndex][]byte,
+	chainConfig *config.Chain,
+) *QuorumStatus {
+	return DetermineWeightedQuorumStatus(signatures, chainConfig, nil)
+}
+
+// DetermineWeightedQuorumStatus evaluates the provided signatures map the same
+// way as DetermineQuorumStatus but the quorum is met when the total weight of
+// signing members reaches the required weight. The required weight is the
+// share of the total weight of all group members equal to the share of the
+// signature threshold in the group size, rounded up. If weights are not set,
+// all members have equal weights and the quorum is count-based.
+func DetermineWeightedQuorumStatus(
+	signatures map[group.MemberIndex][]byte,
+	chainConfig *config.Chain,
+	weights MemberWeights,
+) *QuorumStatus {
+	threshold := SignatureThreshold(chainConfig)
+
+	totalWeight := 0
+	missing := make([]group.MemberIndex, 0)
+	for i := 1; i <= chainConfig.GroupSize; i++ {
+		memberIndex := group.MemberIndex(i)
+		totalWeight += weights.weight(memberIndex)
+		if _, ok := signatures[memberIndex]; !ok {
+			missing = append(missing, memberIndex)
+		}
+	}
+
+	have := 0
+	for memberIndex := range signatures {
+		have += weights.weight(memberIndex)
+	}
+
+	required := threshold
+	if weights != nil && chainConfig.GroupSize > 0 {
+		required = (totalWeight*threshold + chainConfig.GroupSize - 1) /
+			chainConfig.GroupSize
+	}
+
+	return &QuorumStatus{
+		Have:     have,
+		Required: required,
+		Missing:  missing,
+		Met:      have >= required,
+	}
+}
+
+// SelectSignatures returns signatures of at most the given number of members
+// with the lowest indexes. The selection depends only on the set of signing
+// members, so all members holding the same signatures select the same subset.
+// If there are no more signatures than the given number, all of them are
+// returned.
+func SelectSignatures(
+	signatures map[group.MemberIndex][]byte,
+	count int,
+) map[group.MemberIndex][]byte {
+	if len(signatures) <= count {
+		return signatures
+	}
+
+	memberIndexes := make([]group.MemberIndex, 0, len(signatures))
+	for memberIndex := range signatures {
+		memberIndexes = append(memberIndexes, memberIndex)
+	}
+	sort.Slice(memberIndexes, func(i, j int) bool {
+		return memberIndexes[i] < memberIndexes[j]
+	})
+
+	selected := make(map[group.MemberIndex][]byte, count)
+	for _, memberIndex := range memberIndexes[:count] {
+		selected[memberIndex] = signatures[memberIndex]
+	}
+
+	return selected
+}
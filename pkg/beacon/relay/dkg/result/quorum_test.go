@@ -0,0 +1,362 @@
+package result
+
+import (
+	"reflect"
+	"testing"
+
+	relayChain "github.com/keep-network/keep-core/pkg/beacon/relay/chain"
+	"github.com/keep-network/keep-core/pkg/beacon/relay/config"
+	"github.com/keep-network/keep-core/pkg/beacon/relay/group"
+)
+
+func TestDetermineQuorumStatus(t *testing.T) {
+	// signature threshold = 3 + (5 - 3) / 2 = 4
+	chainConfig := &config.Chain{
+		GroupSize:       5,
+		HonestThreshold: 3,
+	}
+
+	var tests = map[string]struct {
+		signatures       map[group.MemberIndex][]byte
+		expectedHave     int
+		expectedRequired int
+		expectedMissing  []group.MemberIndex
+		expectedMet      bool
+	}{
+		"all members signed": {
+			signatures: map[group.MemberIndex][]byte{
+				1: []byte{101},
+				2: []byte{102},
+				3: []byte{103},
+				4: []byte{104},
+				5: []byte{105},
+			},
+			expectedHave:     5,
+			expectedRequired: 4,
+			expectedMissing:  []group.MemberIndex{},
+			expectedMet:      true,
+		},
+		"just enough members signed": {
+			signatures: map[group.MemberIndex][]byte{
+				1: []byte{101},
+				2: []byte{102},
+				4: []byte{104},
+				5: []byte{105},
+			},
+			expectedHave:     4,
+			expectedRequired: 4,
+			expectedMissing:  []group.MemberIndex{3},
+			expectedMet:      true,
+		},
+		"not enough members signed": {
+			signatures: map[group.MemberIndex][]byte{
+				2: []byte{102},
+				3: []byte{103},
+				5: []byte{105},
+			},
+			expectedHave:     3,
+			expectedRequired: 4,
+			expectedMissing:  []group.MemberIndex{1, 4},
+			expectedMet:      false,
+		},
+	}
+
+	for testName, test := range tests {
+		t.Run(testName, func(t *testing.T) {
+			quorum := DetermineQuorumStatus(test.signatures, chainConfig)
+
+			if quorum.Have != test.expectedHave {
+				t.Errorf(
+					"unexpected number of signatures\nexpected: %v\nactual:   %v\n",
+					test.expectedHave,
+					quorum.Have,
+				)
+			}
+			if quorum.Required != test.expectedRequired {
+				t.Errorf(
+					"unexpected number of required signatures\nexpected: %v\nactual:   %v\n",
+					test.expectedRequired,
+					quorum.Required,
+				)
+			}
+			if !reflect.DeepEqual(quorum.Missing, test.expectedMissing) {
+				t.Errorf(
+					"unexpected missing members\nexpected: %v\nactual:   %v\n",
+					test.expectedMissing,
+					quorum.Missing,
+				)
+			}
+			if quorum.Met != test.expectedMet {
+				t.Errorf(
+					"unexpected quorum decision\nexpected: %v\nactual:   %v\n",
+					test.expectedMet,
+					quorum.Met,
+				)
+			}
+		})
+	}
+}
+
+func TestDetermineWeightedQuorumStatus(t *testing.T) {
+	// signature threshold = 3 + (5 - 3) / 2 = 4
+	chainConfig := &config.Chain{
+		GroupSize:       5,
+		HonestThreshold: 3,
+	}
+
+	// required weight = 100 * 4 / 5 = 80
+	stakeWeights := MemberWeights{1: 10, 2: 10, 3: 40, 4: 20, 5: 20}
+
+	var tests = map[string]struct {
+		signatures            map[group.MemberIndex][]byte
+		weights               MemberWeights
+		expectedHave          int
+		expectedRequired      int
+		expectedMet           bool
+		expectedUnweightedMet bool
+	}{
+		"minority of heavy members signed": {
+			signatures: map[group.MemberIndex][]byte{
+				3: []byte{103},
+				4: []byte{104},
+				5: []byte{105},
+			},
+			weights:               stakeWeights,
+			expectedHave:          80,
+			expectedRequired:      80,
+			expectedMet:           true,
+			expectedUnweightedMet: false,
+		},
+		"majority of light members signed": {
+			signatures: map[group.MemberIndex][]byte{
+				1: []byte{101},
+				2: []byte{102},
+				4: []byte{104},
+				5: []byte{105},
+			},
+			weights:               stakeWeights,
+			expectedHave:          60,
+			expectedRequired:      80,
+			expectedMet:           false,
+			expectedUnweightedMet: true,
+		},
+		"required weight rounded up": {
+			signatures: map[group.MemberIndex][]byte{
+				1: []byte{101},
+				3: []byte{103},
+			},
+			// required weight = 6 * 4 / 5 = 4.8
+			weights:               MemberWeights{1: 1, 3: 4, 5: 1},
+			expectedHave:          5,
+			expectedRequired:      5,
+			expectedMet:           true,
+			expectedUnweightedMet: false,
+		},
+		"equal weights": {
+			signatures: map[group.MemberIndex][]byte{
+				1: []byte{101},
+				2: []byte{102},
+				4: []byte{104},
+				5: []byte{105},
+			},
+			weights:               MemberWeights{1: 7, 2: 7, 3: 7, 4: 7, 5: 7},
+			expectedHave:          28,
+			expectedRequired:      28,
+			expectedMet:           true,
+			expectedUnweightedMet: true,
+		},
+	}
+
+	for testName, test := range tests {
+		t.Run(testName, func(t *testing.T) {
+			quorum := DetermineWeightedQuorumStatus(
+				test.signatures,
+				chainConfig,
+				test.weights,
+			)
+			unweightedQuorum := DetermineQuorumStatus(
+				test.signatures,
+				chainConfig,
+			)
+
+			if quorum.Have != test.expectedHave {
+				t.Errorf(
+					"unexpected signing weight\nexpected: %v\nactual:   %v\n",
+					test.expectedHave,
+					quorum.Have,
+				)
+			}
+			if quorum.Required != test.expectedRequired {
+				t.Errorf(
+					"unexpected required weight\nexpected: %v\nactual:   %v\n",
+					test.expectedRequired,
+					quorum.Required,
+				)
+			}
+			if quorum.Met != test.expectedMet {
+				t.Errorf(
+					"unexpected weighted quorum decision\nexpected: %v\nactual:   %v\n",
+					test.expectedMet,
+					quorum.Met,
+				)
+			}
+			if unweightedQuorum.Met != test.expectedUnweightedMet {
+				t.Errorf(
+					"unexpected unweighted quorum decision\nexpected: %v\nactual:   %v\n",
+					test.expectedUnweightedMet,
+					unweightedQuorum.Met,
+				)
+			}
+			if !reflect.DeepEqual(unweightedQuorum.Missing, quorum.Missing) {
+				t.Errorf(
+					"unexpected missing members\nexpected: %v\nactual:   %v\n",
+					unweightedQuorum.Missing,
+					quorum.Missing,
+				)
+			}
+		})
+	}
+}
+
+func TestSelectSignatures(t *testing.T) {
+	signatures := map[group.MemberIndex][]byte{
+		2: []byte{102},
+		7: []byte{107},
+		3: []byte{103},
+		9: []byte{109},
+		5: []byte{105},
+		1: []byte{101},
+	}
+
+	var tests = map[string]struct {
+		count              int
+		expectedSignatures map[group.MemberIndex][]byte
+	}{
+		"fewer signatures than available": {
+			count: 4,
+			expectedSignatures: map[group.MemberIndex][]byte{
+				1: []byte{101},
+				2: []byte{102},
+				3: []byte{103},
+				5: []byte{105},
+			},
+		},
+		"as many signatures as available": {
+			count:              6,
+			expectedSignatures: signatures,
+		},
+		"more signatures than available": {
+			count:              10,
+			expectedSignatures: signatures,
+		},
+	}
+
+	for testName, test := range tests {
+		t.Run(testName, func(t *testing.T) {
+			// Map iteration order is random, so a non-deterministic
+			// selection would show up across repeated selections.
+			for i := 0; i < 20; i++ {
+				selected := SelectSignatures(signatures, test.count)
+
+				if !reflect.DeepEqual(test.expectedSignatures, selected) {
+					t.Fatalf(
+						"unexpected signatures\nexpected: %v\nactual:   %v\n",
+						test.expectedSignatures,
+						selected,
+					)
+				}
+			}
+		})
+	}
+}
+
+func TestSubmitDKGResultSelectsSignatures(t *testing.T) {
+	// signature threshold = 3 + (5 - 3) / 2 = 4
+	groupSize := 5
+	honestThreshold := 3
+
+	signatures := map[group.MemberIndex][]byte{
+		1: []byte{101},
+		2: []byte{102},
+		3: []byte{103},
+		4: []byte{104},
+		5: []byte{105},
+	}
+
+	var tests = map[string]struct {
+		maxSignatures         int
+		expectedMemberIndexes []group.MemberIndex
+	}{
+		"no maximum": {
+			maxSignatures:         0,
+			expectedMemberIndexes: []group.MemberIndex{1, 2, 3, 4, 5},
+		},
+		"maximum equal to threshold": {
+			maxSignatures:         4,
+			expectedMemberIndexes: []group.MemberIndex{1, 2, 3, 4},
+		},
+		"maximum below threshold": {
+			maxSignatures:         2,
+			expectedMemberIndexes: []group.MemberIndex{1, 2, 3, 4},
+		},
+		"maximum above available signatures": {
+			maxSignatures:         10,
+			expectedMemberIndexes: []group.MemberIndex{1, 2, 3, 4, 5},
+		},
+	}
+
+	for testName, test := range tests {
+		t.Run(testName, func(t *testing.T) {
+			chainHandle, initialBlockHeight, err := initChainHandle(
+				honestThreshold,
+				groupSize,
+			)
+			if err != nil {
+				t.Fatal(err)
+			}
+			blockCounter, err := chainHandle.BlockCounter()
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			queue := NewMemoryQueue(1)
+
+			member := NewSubmittingMember(
+				1,
+				SubmissionConfig{
+					Queue:         queue,
+					MaxSignatures: test.maxSignatures,
+				},
+			)
+			// The member watches the chain for the queued result so only
+			// the enqueued request is checked.
+			go member.SubmitDKGResult(
+				&relayChain.DKGResult{GroupPublicKey: []byte{123, 45}},
+				signatures,
+				chainHandle.ThresholdRelay(),
+				blockCounter,
+				initialBlockHeight,
+			)
+
+			request := <-queue.requests
+
+			memberIndexes := make([]group.MemberIndex, 0)
+			for memberIndex := 1; memberIndex <= groupSize; memberIndex++ {
+				if _, ok := request.Signatures[group.MemberIndex(memberIndex)]; ok {
+					memberIndexes = append(
+						memberIndexes,
+						group.MemberIndex(memberIndex),
+					)
+				}
+			}
+
+			if !reflect.DeepEqual(test.expectedMemberIndexes, memberIndexes) {
+				t.Errorf(
+					"unexpected signing members\nexpected: %v\nactual:   %v\n",
+					test.expectedMemberIndexes,
+					memberIndexes,
+				)
+			}
+		})
+	}
+}
@@ -0,0 +1,58 @@
+package result
+
+import (
+	"fmt"
+
+	"github.com/keep-network/keep-core/pkg/beacon/relay/dkg/transcript"
+	"github.com/keep-network/keep-core/pkg/beacon/relay/gjkr"
+	"github.com/keep-network/keep-core/pkg/beacon/relay/group"
+	"github.com/keep-network/keep-core/pkg/beacon/relay/state"
+)
+
+// ReplayPublication replays the DKG result signing recorded in the given
+// transcript for the given GJKR result. Replay stops before the result
+// submission and returns signatures supporting the result the member would
+// submit, keyed by indexes of supporting members.
+func ReplayPublication(
+	t *transcript.Transcript,
+	result *gjkr.Result,
+) (map[group.MemberIndex][]byte, error) {
+	channel := transcript.NewChannel()
+	RegisterUnmarshallers(channel)
+
+	preparedResult := PrepareResult(result)
+
+	initialState := &resultSigningState{
+		channel:    channel,
+		relayChain: t.RelayChain(),
+		signing:    t.Signing(),
+		member: NewSigningMember(
+			t.MemberIndex,
+			result.Group,
+			t.MembershipValidator(),
+		),
+		result:            preparedResult.Result(),
+		preparedResult:    preparedResult,
+		signatureMessages: make([]*DKGResultHashSignatureMessage, 0),
+	}
+
+	lastState, err := t.Replay(
+		transcript.ResultPublication,
+		channel,
+		initialState,
+		func(s state.State) bool {
+			_, ok := s.(*resultSubmissionState)
+			return ok
+		},
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	submissionState, ok := lastState.(*resultSubmissionState)
+	if !ok {
+		return nil, fmt.Errorf("replay ended on state %T", lastState)
+	}
+
+	return submissionState.signatures, nil
+}
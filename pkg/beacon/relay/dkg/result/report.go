@@ -0,0 +1,79 @@
+package result
+
+import (
+	"fmt"
+	"math/big"
+
+	"github.com/keep-network/keep-core/pkg/beacon/relay/group"
+	"github.com/keep-network/keep-core/pkg/webhook"
+)
+
+// SubmissionOutcome is the terminal outcome of the DKG result submission.
+type SubmissionOutcome string
+
+const (
+	// SubmissionSubmitted means the member has submitted the result.
+	SubmissionSubmitted SubmissionOutcome = "submitted"
+	// SubmissionYielded means the result has been submitted by another member.
+	SubmissionYielded SubmissionOutcome = "yielded"
+	// SubmissionFailed means the member could not submit the result.
+	SubmissionFailed SubmissionOutcome = "failed"
+	// SubmissionQueued means the member has handed the result off to the
+	// submission queue. It is never reported; the member keeps watching the
+	// chain until the result is published or the publication deadline passes.
+	SubmissionQueued SubmissionOutcome = "queued"
+)
+
+// SubmissionReport describes the outcome of the DKG result submission by
+// the given member.
+type SubmissionReport struct {
+	Outcome        SubmissionOutcome
+	MemberIndex    group.MemberIndex
+	BlockHeight    uint64
+	GroupPublicKey []byte
+	// TransactionHash is the hash of the submission transaction, set only
+	// if the member submitted the result itself.
+	TransactionHash string
+	// Err is the reason of the failure, set only for the failed outcome.
+	Err error
+}
+
+// SubmissionObserver is notified about the outcome of the DKG result
+// submission. It is called synchronously from the submission flow so it must
+// not block.
+type SubmissionObserver func(report *SubmissionReport)
+
+// submissionWebhookPayload is the JSON payload posted to the submission
+// webhook.
+type submissionWebhookPayload struct {
+	RequestID       string            `json:"requestId"`
+	Outcome         string            `json:"outcome"`
+	MemberIndex     group.MemberIndex `json:"memberIndex"`
+	BlockHeight     uint64            `json:"blockHeight"`
+	GroupPublicKey  string            `json:"groupPublicKey"`
+	TransactionHash string            `json:"transactionHash,omitempty"`
+	Error           string            `json:"error,omitempty"`
+}
+
+// NewWebhookObserver returns a submission observer posting reports for DKG
+// executed with the given seed to the webhook.
+func NewWebhookObserver(
+	notifier *webhook.Notifier,
+	seed *big.Int,
+) SubmissionObserver {
+	return func(report *SubmissionReport) {
+		payload := &submissionWebhookPayload{
+			RequestID:       fmt.Sprintf("0x%x", seed),
+			Outcome:         string(report.Outcome),
+			MemberIndex:     report.MemberIndex,
+			BlockHeight:     report.BlockHeight,
+			GroupPublicKey:  fmt.Sprintf("0x%x", report.GroupPublicKey),
+			TransactionHash: report.TransactionHash,
+		}
+		if report.Err != nil {
+			payload.Error = report.Err.Error()
+		}
+
+		notifier.Notify(payload)
+	}
+}
@@ -0,0 +1,227 @@
+package result
+
+import (
+	"encoding/json"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"testing"
+	"time"
+
+	relayChain "github.com/keep-network/keep-core/pkg/beacon/relay/chain"
+	"github.com/keep-network/keep-core/pkg/beacon/relay/group"
+	"github.com/keep-network/keep-core/pkg/webhook"
+)
+
+func TestSubmitDKGResultNotifiesWebhook(t *testing.T) {
+	honestThreshold := 3
+	groupSize := 5
+
+	result := &relayChain.DKGResult{
+		GroupPublicKey: []byte{123, 45},
+	}
+	allSignatures := map[group.MemberIndex][]byte{
+		1: []byte{101},
+		2: []byte{102},
+		3: []byte{103},
+		4: []byte{104},
+	}
+	notEnoughSignatures := map[group.MemberIndex][]byte{
+		1: []byte{101},
+		2: []byte{102},
+	}
+
+	var tests = map[string]struct {
+		memberIndex       group.MemberIndex
+		signatures        map[group.MemberIndex][]byte
+		alreadySubmitted  bool
+		expectedOutcome   string
+		expectedError     string
+		expectedSubmitErr bool
+	}{
+		"result submitted by the member": {
+			memberIndex:     1,
+			signatures:      allSignatures,
+			expectedOutcome: "submitted",
+		},
+		"result already submitted by another member": {
+			memberIndex:      2,
+			signatures:       allSignatures,
+			alreadySubmitted: true,
+			expectedOutcome:  "yielded",
+		},
+		"not enough signatures to submit the result": {
+			memberIndex:     1,
+			signatures:      notEnoughSignatures,
+			expectedOutcome: "failed",
+			expectedError: "could not submit result with [2] signatures for " +
+				"signature threshold [4]; missing signatures from members [[3 4 5]]",
+			expectedSubmitErr: true,
+		},
+	}
+
+	for testName, test := range tests {
+		t.Run(testName, func(t *testing.T) {
+			payloads := make(chan *submissionWebhookPayload, 1)
+			server := httptest.NewServer(http.HandlerFunc(
+				func(writer http.ResponseWriter, request *http.Request) {
+					payload := &submissionWebhookPayload{}
+					if err := json.NewDecoder(request.Body).Decode(payload); err != nil {
+						t.Errorf("could not decode payload: [%v]", err)
+					}
+					payloads <- payload
+				},
+			))
+			defer server.Close()
+
+			chainHandle, initialBlockHeight, err := initChainHandle(
+				honestThreshold,
+				groupSize,
+			)
+			if err != nil {
+				t.Fatal(err)
+			}
+			relayChain := chainHandle.ThresholdRelay()
+			blockCounter, err := chainHandle.BlockCounter()
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			if test.alreadySubmitted {
+				err := NewSubmittingMember(1, SubmissionConfig{}).SubmitDKGResult(
+					result,
+					allSignatures,
+					relayChain,
+					blockCounter,
+					initialBlockHeight,
+				)
+				if err != nil {
+					t.Fatal(err)
+				}
+			}
+
+			member := NewSubmittingMember(
+				test.memberIndex,
+				SubmissionConfig{
+					Observer: NewWebhookObserver(
+						webhook.NewNotifier(server.URL),
+						big.NewInt(1337),
+					),
+				},
+			)
+
+			err = member.SubmitDKGResult(
+				result,
+				test.signatures,
+				relayChain,
+				blockCounter,
+				initialBlockHeight,
+			)
+			if test.expectedSubmitErr != (err != nil) {
+				t.Fatalf("unexpected submission error: [%v]", err)
+			}
+
+			var payload *submissionWebhookPayload
+			select {
+			case payload = <-payloads:
+			case <-time.After(5 * time.Second):
+				t.Fatal("webhook has not been called")
+			}
+
+			currentBlockHeight, err := blockCounter.CurrentBlock()
+			if err != nil {
+				t.Fatal(err)
+			}
+			if payload.BlockHeight < initialBlockHeight ||
+				payload.BlockHeight > currentBlockHeight {
+				t.Errorf(
+					"unexpected block height\nexpected: [%v, %v]\nactual:   %v\n",
+					initialBlockHeight,
+					currentBlockHeight,
+					payload.BlockHeight,
+				)
+			}
+
+			// Only the member's own submission has a transaction hash.
+			if (test.expectedOutcome == "submitted") !=
+				(payload.TransactionHash != "") {
+				t.Errorf(
+					"unexpected transaction hash [%v] for outcome [%v]",
+					payload.TransactionHash,
+					test.expectedOutcome,
+				)
+			}
+
+			expectedPayload := &submissionWebhookPayload{
+				RequestID:       "0x539",
+				Outcome:         test.expectedOutcome,
+				MemberIndex:     test.memberIndex,
+				BlockHeight:     payload.BlockHeight,
+				GroupPublicKey:  "0x7b2d",
+				TransactionHash: payload.TransactionHash,
+				Error:           test.expectedError,
+			}
+			if !reflect.DeepEqual(expectedPayload, payload) {
+				t.Errorf(
+					"unexpected payload\nexpected: %+v\nactual:   %+v\n",
+					expectedPayload,
+					payload,
+				)
+			}
+		})
+	}
+}
+
+func TestSubmitDKGResultNotBlockedByWebhook(t *testing.T) {
+	release := make(chan struct{})
+	server := httptest.NewServer(http.HandlerFunc(
+		func(writer http.ResponseWriter, request *http.Request) {
+			<-release
+			writer.WriteHeader(http.StatusInternalServerError)
+		},
+	))
+	defer server.Close()
+	defer close(release)
+
+	chainHandle, initialBlockHeight, err := initChainHandle(3, 5)
+	if err != nil {
+		t.Fatal(err)
+	}
+	blockCounter, err := chainHandle.BlockCounter()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	member := NewSubmittingMember(
+		1,
+		SubmissionConfig{
+			Observer: NewWebhookObserver(webhook.NewNotifier(server.URL), big.NewInt(1)),
+		},
+	)
+
+	done := make(chan error, 1)
+	go func() {
+		done <- member.SubmitDKGResult(
+			&relayChain.DKGResult{GroupPublicKey: []byte{10}},
+			map[group.MemberIndex][]byte{
+				1: []byte{101},
+				2: []byte{102},
+				3: []byte{103},
+				4: []byte{104},
+			},
+			chainHandle.ThresholdRelay(),
+			blockCounter,
+			initialBlockHeight,
+		)
+	}()
+
+	select {
+	case err := <-done:
+		if err != nil {
+			t.Fatal(err)
+		}
+	case <-time.After(webhook.DefaultTimeout):
+		t.Fatal("submission blocked by the webhook")
+	}
+}
@@ -0,0 +1,82 @@
+package result
+
+import (
+	"time"
+
+	"github.com/keep-network/keep-core/pkg/beacon/relay/config"
+)
+
+// SubmissionRetry retries on-chain DKG result submissions failed with an
+// error, for example because of a nonce conflict or a temporary failure of
+// the RPC provider. The member waits for a backoff between attempts, which
+// doubles with each attempt up to the maximum backoff. The member aborts the
+// retries as soon as a result submission is observed on the chain while it
+// waits for the backoff; before each retry, it also checks if the result has
+// been meanwhile registered on the chain.
+type SubmissionRetry struct {
+	attempts   int
+	backoff    time.Duration
+	maxBackoff time.Duration
+
+	after func(time.Duration) <-chan time.Time
+}
+
+// NewSubmissionRetry creates a DKG result submission retry policy using
+// values from the provided node configuration. It returns nil if retries are
+// not configured.
+func NewSubmissionRetry(nodeConfig *config.Node) *SubmissionRetry {
+	if nodeConfig == nil || nodeConfig.DKGSubmissionRetryAttempts == 0 {
+		return nil
+	}
+
+	backoff := time.Duration(nodeConfig.DKGSubmissionRetryBackoffSeconds) *
+		time.Second
+	if backoff == 0 {
+		backoff = config.DefaultDKGSubmissionRetryBackoff
+	}
+
+	maxBackoff := time.Duration(
+		nodeConfig.DKGSubmissionRetryMaxBackoffSeconds,
+	) * time.Second
+	if maxBackoff == 0 {
+		maxBackoff = config.DefaultDKGSubmissionRetryMaxBackoff
+	}
+	if maxBackoff < backoff {
+		maxBackoff = backoff
+	}
+
+	return newSubmissionRetry(
+		nodeConfig.DKGSubmissionRetryAttempts,
+		backoff,
+		maxBackoff,
+		time.After,
+	)
+}
+
+func newSubmissionRetry(
+	attempts int,
+	backoff time.Duration,
+	maxBackoff time.Duration,
+	after func(time.Duration) <-chan time.Time,
+) *SubmissionRetry {
+	return &SubmissionRetry{
+		attempts:   attempts,
+		backoff:    backoff,
+		maxBackoff: maxBackoff,
+		after:      after,
+	}
+}
+
+// backoffFor returns the time to wait before the given retry, counted from
+// one.
+func (sr *SubmissionRetry) backoffFor(retry int) time.Duration {
+	backoff := sr.backoff
+	for i := 1; i < retry; i++ {
+		backoff *= 2
+		if backoff >= sr.maxBackoff {
+			return sr.maxBackoff
+		}
+	}
+
+	return backoff
+}
@@ -0,0 +1,237 @@
+package result
+
+import (
+	"fmt"
+	"sync"
+	"testing"
+	"time"
+
+	relayChain "github.com/keep-network/keep-core/pkg/beacon/relay/chain"
+	"github.com/keep-network/keep-core/pkg/beacon/relay/config"
+	"github.com/keep-network/keep-core/pkg/beacon/relay/group"
+	"github.com/keep-network/keep-core/pkg/gen/async"
+)
+
+// failingRelayChain fails the given number of DKG result submissions before
+// passing them to the wrapped chain. If the rival member is set, the result is
+// submitted by that member on the first failure.
+type failingRelayChain struct {
+	relayChain.Interface
+
+	mutex       sync.Mutex
+	failures    int
+	rival       group.MemberIndex
+	submissions int
+}
+
+func (frc *failingRelayChain) SubmitDKGResult(
+	participantIndex relayChain.GroupMemberIndex,
+	dkgResult *relayChain.DKGResult,
+	signatures map[relayChain.GroupMemberIndex][]byte,
+) *async.EventDKGResultSubmissionPromise {
+	frc.mutex.Lock()
+	defer frc.mutex.Unlock()
+
+	frc.submissions++
+
+	if frc.submissions > frc.failures {
+		return frc.Interface.SubmitDKGResult(
+			participantIndex,
+			dkgResult,
+			signatures,
+		)
+	}
+
+	if frc.rival != 0 && frc.submissions == 1 {
+		frc.Interface.SubmitDKGResult(frc.rival, dkgResult, signatures)
+	}
+
+	promise := &async.EventDKGResultSubmissionPromise{}
+	promise.Fail(fmt.Errorf("nonce too low"))
+	return promise
+}
+
+func TestSubmitDKGResultWithRetry(t *testing.T) {
+	signatures := map[group.MemberIndex][]byte{
+		1: []byte{101},
+		2: []byte{102},
+		3: []byte{103},
+		4: []byte{104},
+	}
+
+	var tests = map[string]struct {
+		attempts            int
+		failures            int
+		rival               group.MemberIndex
+		backoffNeverElapses bool
+		expectedOutcome     SubmissionOutcome
+		expectedSubmissions int
+		expectedBackoffs    []time.Duration
+	}{
+		"no retries": {
+			attempts:            0,
+			failures:            1,
+			expectedOutcome:     SubmissionFailed,
+			expectedSubmissions: 1,
+			expectedBackoffs:    []time.Duration{},
+		},
+		"succeeds on retry": {
+			attempts:            3,
+			failures:            2,
+			expectedOutcome:     SubmissionSubmitted,
+			expectedSubmissions: 3,
+			expectedBackoffs:    []time.Duration{time.Second, 2 * time.Second},
+		},
+		"retries exhausted": {
+			attempts:            2,
+			failures:            3,
+			expectedOutcome:     SubmissionFailed,
+			expectedSubmissions: 3,
+			expectedBackoffs:    []time.Duration{time.Second, 2 * time.Second},
+		},
+		"other member submits before retry": {
+			attempts:            3,
+			failures:            1,
+			rival:               2,
+			expectedOutcome:     SubmissionYielded,
+			expectedSubmissions: 1,
+			expectedBackoffs:    []time.Duration{time.Second},
+		},
+		"other member submits during backoff": {
+			attempts:            3,
+			failures:            1,
+			rival:               2,
+			backoffNeverElapses: true,
+			expectedOutcome:     SubmissionYielded,
+			expectedSubmissions: 1,
+			expectedBackoffs:    []time.Duration{time.Second},
+		},
+	}
+
+	for testName, test := range tests {
+		t.Run(testName, func(t *testing.T) {
+			chainHandle, startBlockHeight, err := initChainHandle(3, 5)
+			if err != nil {
+				t.Fatal(err)
+			}
+			blockCounter, err := chainHandle.BlockCounter()
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			backoffs := make([]time.Duration, 0)
+
+			var retry *SubmissionRetry
+			if test.attempts > 0 {
+				retry = newSubmissionRetry(
+					test.attempts,
+					time.Second,
+					time.Minute,
+					func(backoff time.Duration) <-chan time.Time {
+						backoffs = append(backoffs, backoff)
+						if test.backoffNeverElapses {
+							// Only the observed submission can end the wait.
+							return nil
+						}
+
+						elapsed := make(chan time.Time, 1)
+						elapsed <- time.Now()
+						return elapsed
+					},
+				)
+			}
+
+			failingChain := &failingRelayChain{
+				Interface: chainHandle.ThresholdRelay(),
+				failures:  test.failures,
+				rival:     test.rival,
+			}
+
+			var report *SubmissionReport
+			member := NewSubmittingMember(
+				1,
+				SubmissionConfig{
+					Observer: func(r *SubmissionReport) { report = r },
+					Retry:    retry,
+				},
+			)
+
+			member.SubmitDKGResult(
+				&relayChain.DKGResult{GroupPublicKey: []byte{101, 1}},
+				signatures,
+				failingChain,
+				blockCounter,
+				startBlockHeight,
+			)
+
+			if report.Outcome != test.expectedOutcome {
+				t.Errorf(
+					"unexpected outcome\nexpected: %v\nactual:   %v\n",
+					test.expectedOutcome,
+					report.Outcome,
+				)
+			}
+			if failingChain.submissions != test.expectedSubmissions {
+				t.Errorf(
+					"unexpected number of submissions\n"+
+						"expected: %v\nactual:   %v\n",
+					test.expectedSubmissions,
+					failingChain.submissions,
+				)
+			}
+			if fmt.Sprint(backoffs) != fmt.Sprint(test.expectedBackoffs) {
+				t.Errorf(
+					"unexpected backoffs\nexpected: %v\nactual:   %v\n",
+					test.expectedBackoffs,
+					backoffs,
+				)
+			}
+		})
+	}
+}
+
+func TestSubmissionRetryBackoff(t *testing.T) {
+	retry := newSubmissionRetry(10, 2*time.Second, 30*time.Second, nil)
+
+	var tests = map[int]time.Duration{
+		1: 2 * time.Second,
+		2: 4 * time.Second,
+		4: 16 * time.Second,
+		5: 30 * time.Second,
+		9: 30 * time.Second,
+	}
+
+	for retryNumber, expectedBackoff := range tests {
+		if backoff := retry.backoffFor(retryNumber); backoff != expectedBackoff {
+			t.Errorf(
+				"unexpected backoff for retry [%v]\n"+
+					"expected: %v\nactual:   %v\n",
+				retryNumber,
+				expectedBackoff,
+				backoff,
+			)
+		}
+	}
+}
+
+func TestNewSubmissionRetry(t *testing.T) {
+	if retry := NewSubmissionRetry(&config.Node{}); retry != nil {
+		t.Errorf("retry should not be created if not configured")
+	}
+
+	retry := NewSubmissionRetry(&config.Node{DKGSubmissionRetryAttempts: 3})
+	if retry.backoff != config.DefaultDKGSubmissionRetryBackoff {
+		t.Errorf(
+			"unexpected backoff\nexpected: %v\nactual:   %v\n",
+			config.DefaultDKGSubmissionRetryBackoff,
+			retry.backoff,
+		)
+	}
+	if retry.maxBackoff != config.DefaultDKGSubmissionRetryMaxBackoff {
+		t.Errorf(
+			"unexpected max backoff\nexpected: %v\nactual:   %v\n",
+			config.DefaultDKGSubmissionRetryMaxBackoff,
+			retry.maxBackoff,
+		)
+	}
+}
@@ -3,8 +3,10 @@ package result
 import (
 	"bytes"
 	"context"
+	"fmt"
 
 	relayChain "github.com/keep-network/keep-core/pkg/beacon/relay/chain"
+	"github.com/keep-network/keep-core/pkg/beacon/relay/config"
 	"github.com/keep-network/keep-core/pkg/beacon/relay/group"
 	"github.com/keep-network/keep-core/pkg/beacon/relay/state"
 	"github.com/keep-network/keep-core/pkg/chain"
@@ -39,10 +41,15 @@ type resultSigningState struct {
 	member *SigningMember
 
 	result *relayChain.DKGResult
+	// preparedResult the result has been prepared from; may be nil.
+	preparedResult *PreparedResult
 
 	signatureMessages []*DKGResultHashSignatureMessage
 
 	signingStartBlockHeight uint64
+
+	submissionConfig    SubmissionConfig
+	failureResultPolicy string
 }
 
 func (rss *resultSigningState) DelayBlocks() uint64 {
@@ -58,6 +65,9 @@ func (rss *resultSigningState) Initiate(ctx context.Context) error {
 	if err != nil {
 		return err
 	}
+	if rss.preparedResult != nil {
+		rss.preparedResult.MarkSigned()
+	}
 	if err := rss.channel.Send(ctx, message); err != nil {
 		return err
 	}
@@ -106,11 +116,14 @@ func (rss *resultSigningState) Next() signingState {
 		blockCounter:      rss.blockCounter,
 		member:            rss.member,
 		result:            rss.result,
+		preparedResult:    rss.preparedResult,
 		signatureMessages: rss.signatureMessages,
 		validSignatures:   make(map[group.MemberIndex][]byte),
 		verificationStartBlockHeight: rss.signingStartBlockHeight +
 			rss.DelayBlocks() +
 			rss.ActiveBlocks(),
+		submissionConfig:    rss.submissionConfig,
+		failureResultPolicy: rss.failureResultPolicy,
 	}
 
 }
@@ -132,12 +145,16 @@ type signaturesVerificationState struct {
 
 	member *SigningMember
 
-	result *relayChain.DKGResult
+	result         *relayChain.DKGResult
+	preparedResult *PreparedResult
 
 	signatureMessages []*DKGResultHashSignatureMessage
 	validSignatures   map[group.MemberIndex][]byte
 
 	verificationStartBlockHeight uint64
+
+	submissionConfig    SubmissionConfig
+	failureResultPolicy string
 }
 
 func (svs *signaturesVerificationState) DelayBlocks() uint64 {
@@ -170,12 +187,17 @@ func (svs *signaturesVerificationState) Next() signingState {
 		channel:      svs.channel,
 		relayChain:   svs.relayChain,
 		blockCounter: svs.blockCounter,
-		member:       NewSubmittingMember(svs.member.index),
-		result:       svs.result,
-		signatures:   svs.validSignatures,
+		member: NewSubmittingMember(
+			svs.member.index,
+			svs.submissionConfig,
+		),
+		result:         svs.result,
+		preparedResult: svs.preparedResult,
+		signatures:     svs.validSignatures,
 		submissionStartBlockHeight: svs.verificationStartBlockHeight +
 			svs.DelayBlocks() +
 			svs.ActiveBlocks(),
+		failureResultPolicy: svs.failureResultPolicy,
 	}
 
 }
@@ -195,10 +217,15 @@ type resultSubmissionState struct {
 
 	member *SubmittingMember
 
-	result     *relayChain.DKGResult
-	signatures map[group.MemberIndex][]byte
+	result         *relayChain.DKGResult
+	preparedResult *PreparedResult
+	signatures     map[group.MemberIndex][]byte
 
 	submissionStartBlockHeight uint64
+
+	// failureResultPolicy determines what happens with the result if it
+	// reflects a failed DKG; the result is submitted if empty.
+	failureResultPolicy string
 }
 
 func (rss *resultSubmissionState) DelayBlocks() uint64 {
@@ -214,6 +241,23 @@ func (rss *resultSubmissionState) ActiveBlocks() uint64 {
 }
 
 func (rss *resultSubmissionState) Initiate(ctx context.Context) error {
+	// Do not submit the result if the group changed after it has been signed.
+	// Such result no longer reflects the group state.
+	if rss.preparedResult != nil && rss.preparedResult.IsStale() {
+		return fmt.Errorf(
+			"[member:%v] not submitting DKG result with group public key "+
+				"[0x%x]; group state changed after the result has been signed",
+			rss.member.index,
+			rss.result.GroupPublicKey,
+		)
+	}
+
+	if rss.preparedResult != nil && rss.preparedResult.IsFailure() {
+		if err := rss.handleFailureResult(); err != nil {
+			return err
+		}
+	}
+
 	return rss.member.SubmitDKGResult(
 		rss.result,
 		rss.signatures,
@@ -223,6 +267,63 @@ func (rss *resultSubmissionState) Initiate(ctx context.Context) error {
 	)
 }
 
+// handleFailureResult applies the failure result policy to the result
+// reflecting a failed DKG. It returns an error if the result should not be
+// submitted.
+func (rss *resultSubmissionState) handleFailureResult() error {
+	misbehavedCount := len(rss.result.Misbehaved)
+
+	switch rss.failureResultPolicy {
+	case "", config.SubmitDKGFailureResult:
+		logger.Warningf(
+			"[member:%v] group is too weak with [%v] misbehaved members; "+
+				"submitting failure result to record the failure on-chain",
+			rss.member.index,
+			misbehavedCount,
+		)
+		return nil
+	case config.SkipDKGFailureResult:
+		logger.Warningf(
+			"[member:%v] group is too weak with [%v] misbehaved members; "+
+				"skipping failure result submission",
+			rss.member.index,
+			misbehavedCount,
+		)
+	case config.AlertDKGFailureResult:
+		logger.Errorf(
+			"[member:%v] ALERT: DKG failed; group is too weak with [%v] "+
+				"misbehaved members; skipping failure result submission",
+			rss.member.index,
+			misbehavedCount,
+		)
+
+		if rss.member.observer != nil {
+			blockHeight, _ := rss.blockCounter.CurrentBlock()
+			rss.member.observer(&SubmissionReport{
+				Outcome:        SubmissionFailed,
+				MemberIndex:    rss.member.index,
+				BlockHeight:    blockHeight,
+				GroupPublicKey: rss.result.GroupPublicKey,
+				Err: fmt.Errorf(
+					"group is too weak with [%v] misbehaved members",
+					misbehavedCount,
+				),
+			})
+		}
+	default:
+		return fmt.Errorf(
+			"[member:%v] unsupported DKG failure result policy [%v]",
+			rss.member.index,
+			rss.failureResultPolicy,
+		)
+	}
+
+	return fmt.Errorf(
+		"[member:%v] not submitting DKG failure result",
+		rss.member.index,
+	)
+}
+
 func (rss *resultSubmissionState) Receive(msg net.Message) error {
 	return nil
 }
@@ -1,28 +1,179 @@
 package result
 
 import (
+	"bytes"
+	"context"
 	"fmt"
+	"sync"
+	"time"
 
 	relayChain "github.com/keep-network/keep-core/pkg/beacon/relay/chain"
+	"github.com/keep-network/keep-core/pkg/beacon/relay/config"
 	"github.com/keep-network/keep-core/pkg/beacon/relay/event"
 	"github.com/keep-network/keep-core/pkg/beacon/relay/group"
 	"github.com/keep-network/keep-core/pkg/chain"
 )
 
+// SubmissionHistory provides DKG result submissions accepted by the chain in
+// the past.
+type SubmissionHistory interface {
+	// PastDKGResultSubmissions returns DKG result submissions accepted
+	// between the given blocks, both inclusive, ordered by block number.
+	PastDKGResultSubmissions(
+		startBlock uint64,
+		endBlock uint64,
+	) ([]*event.DKGResultSubmission, error)
+}
+
 // SubmittingMember represents a member submitting a DKG result to the
 // blockchain along with signatures received from other group members supporting
 // the result.
 type SubmittingMember struct {
 	// Represents the member's position for submission.
 	index group.MemberIndex
+
+	// Notified about the outcome of the submission; may be nil.
+	observer SubmissionObserver
+
+	// Queue the result is handed off to for the submission; if nil, the
+	// result is submitted to the chain by the member directly.
+	queue SubmissionQueue
+
+	// Defers the submission while the member is partitioned from most of
+	// the group; if nil, the member submits as soon as it is eligible.
+	partitionGuard *PartitionGuard
+
+	// Confirms the submission by the transaction receipt and the submission
+	// event; if nil, the submission completed without an error is accepted.
+	confirmation *SubmissionConfirmation
+
+	// Rotates the order in which members become eligible to submit; if nil,
+	// members become eligible in the order of their indexes.
+	rotation *SubmissionRotation
+
+	// If set, the member polls the chain for the result submission when
+	// the chain's DKG result submission subscription could not be
+	// established instead of aborting the submission.
+	pollOnSubscriptionFailure bool
+
+	// Minimum result publication block step the member uses if the chain's
+	// block step is lower; zero means the chain's block step is used as is.
+	minBlockStep uint64
+
+	// Spaces out on-chain submissions of the node; if nil, the member
+	// submits without waiting for other submissions of the node.
+	cooldown *SubmissionCooldown
+
+	// If set and the current block height could not be determined, the
+	// member determines its eligibility based on the last known block
+	// height instead of waiting for its eligibility block.
+	fallbackToLastKnownBlock bool
+
+	// Maximum number of signatures included in the submission; never less
+	// than the chain's signature threshold. Zero means all signatures are
+	// included.
+	maxSignatures int
+
+	// Dedupes on-chain submissions of the result by their idempotency key;
+	// if nil, the member submits regardless of other submissions of the node.
+	deduplication *SubmissionDeduplication
+
+	// Number of blocks before the publication deadline from which the member
+	// no longer submits the result as the submission would not confirm in
+	// time; zero means the member submits regardless of the deadline.
+	confirmationBufferBlocks uint64
+
+	// Caps the gas the node spends on on-chain submissions per window; if
+	// nil, the member submits regardless of the gas spent by the node.
+	gasBudget *SubmissionGasBudget
+
+	// Retries the on-chain submission failed with an error; if nil, the
+	// member gives up on the first failure.
+	retry *SubmissionRetry
+
+	// Gas the member's submission is expected to use; zero until the member
+	// becomes eligible or if it could not be estimated.
+	expectedGas uint64
+
+	// Hash of the transaction submitting the result to the chain; empty
+	// until the member submits the result.
+	transactionHash string
 }
 
-// NewSubmittingMember creates a member to execute submitting the DKG result hash.
+// SubmissionConfig configures the optional behavior of the DKG result
+// submission. The zero value makes the member submit the result to the chain
+// directly as soon as it is eligible.
+type SubmissionConfig struct {
+	// Observer is notified about the outcome of the submission; may be nil.
+	Observer SubmissionObserver
+	// Queue the result is enqueued to instead of being submitted to the chain
+	// directly; the member watches the chain for the result until the
+	// publication deadline. May be nil.
+	Queue SubmissionQueue
+	// PartitionGuard defers the submission while the member is partitioned
+	// from most of the group; may be nil.
+	PartitionGuard *PartitionGuard
+	// Confirmation makes the member's submission accepted only once it is
+	// confirmed; may be nil.
+	Confirmation *SubmissionConfirmation
+	// Rotation makes members eligible to submit according to their position
+	// in the rotated order; may be nil.
+	Rotation *SubmissionRotation
+	// PollOnSubscriptionFailure makes the member poll the chain for the result
+	// submission when it could not subscribe for result submission events.
+	PollOnSubscriptionFailure bool
+	// MinBlockStep is used as a floor for the chain's block step if non-zero.
+	MinBlockStep uint64
+	// Cooldown makes the member's on-chain submission wait for the cooldown
+	// after the previous submission of the node; may be nil.
+	Cooldown *SubmissionCooldown
+	// FallbackToLastKnownBlock makes the member determine its eligibility
+	// based on the last known block height when the current block height
+	// could not be determined.
+	FallbackToLastKnownBlock bool
+	// MaxSignatures is the maximum number of signatures, but not less than
+	// the chain's signature threshold, included in the submission if
+	// non-zero.
+	MaxSignatures int
+	// Deduplication makes the member not submit the result if a submission
+	// with the same idempotency key is already pending or has been confirmed;
+	// may be nil.
+	Deduplication *SubmissionDeduplication
+	// ConfirmationBufferBlocks is the number of blocks before the publication
+	// deadline within which the member does not submit the result, if
+	// non-zero.
+	ConfirmationBufferBlocks uint64
+	// GasBudget makes the member decline or defer its on-chain submission
+	// once the gas spent by the node in the budget window would exceed the
+	// budget; may be nil.
+	GasBudget *SubmissionGasBudget
+	// Retry makes the member retry its on-chain submission failed with an
+	// error unless the result has been meanwhile submitted; may be nil.
+	Retry *SubmissionRetry
+}
+
+// NewSubmittingMember creates a member to execute submitting the DKG result
+// hash with the given submission config.
 func NewSubmittingMember(
 	memberIndex group.MemberIndex,
+	submissionConfig SubmissionConfig,
 ) *SubmittingMember {
 	return &SubmittingMember{
-		index: memberIndex,
+		index:                     memberIndex,
+		observer:                  submissionConfig.Observer,
+		queue:                     submissionConfig.Queue,
+		partitionGuard:            submissionConfig.PartitionGuard,
+		confirmation:              submissionConfig.Confirmation,
+		rotation:                  submissionConfig.Rotation,
+		pollOnSubscriptionFailure: submissionConfig.PollOnSubscriptionFailure,
+		minBlockStep:              submissionConfig.MinBlockStep,
+		cooldown:                  submissionConfig.Cooldown,
+		fallbackToLastKnownBlock:  submissionConfig.FallbackToLastKnownBlock,
+		maxSignatures:             submissionConfig.MaxSignatures,
+		deduplication:             submissionConfig.Deduplication,
+		confirmationBufferBlocks:  submissionConfig.ConfirmationBufferBlocks,
+		gasBudget:                 submissionConfig.GasBudget,
+		retry:                     submissionConfig.Retry,
 	}
 }
 
@@ -39,11 +190,21 @@ func NewSubmittingMember(
 //
 // If a result is submitted by another member and it's accepted by the chain,
 // the current member finishes the phase immediately, without submitting
-// their own result.
+// their own result. If the result has been already submitted by the member
+// itself, for example before a retry, the submission is considered done by
+// the member, provided the chain exposes past submissions.
+//
+// If the chain's block step or group size changes while the member waits
+// for its turn, the member's eligibility is re-evaluated under the new chain
+// config. The already prepared result and signatures are kept as they are.
+//
+// If the result has been handed off to the submission queue, the member keeps
+// watching the chain and the submission fails if the result is not published
+// before the publication deadline.
 //
-// It returns the on-chain block height of the moment when the result was
-// successfully submitted on chain by the member. In case of failure or result
-// already submitted by another member it returns `0`.
+// Once the submission is complete, the member's observer is notified whether
+// the result has been submitted by the member, the member yielded to another
+// member, or the submission failed.
 //
 // See Phase 14 of the protocol specification.
 func (sm *SubmittingMember) SubmitDKGResult(
@@ -53,9 +214,60 @@ func (sm *SubmittingMember) SubmitDKGResult(
 	blockCounter chain.BlockCounter,
 	startBlockHeight uint64,
 ) error {
+	outcome, blockHeight, err := sm.submitDKGResult(
+		result,
+		signatures,
+		chainRelay,
+		blockCounter,
+		startBlockHeight,
+	)
+
+	if sm.observer != nil {
+		if blockHeight == 0 {
+			// The outcome is not bound to any specific block so we report
+			// the block at which it has been determined.
+			blockHeight, _ = blockCounter.CurrentBlock()
+		}
+
+		sm.observer(&SubmissionReport{
+			Outcome:         outcome,
+			MemberIndex:     sm.index,
+			BlockHeight:     blockHeight,
+			GroupPublicKey:  result.GroupPublicKey,
+			TransactionHash: sm.transactionHash,
+			Err:             err,
+		})
+	}
+
+	return err
+}
+
+// TransactionHash returns the hash of the transaction in which the member
+// submitted the result to the chain. It is empty if the member has not
+// submitted the result itself or the chain does not report the hash.
+func (sm *SubmittingMember) TransactionHash() string {
+	return sm.transactionHash
+}
+
+// submitDKGResult executes the submission and returns its outcome along with
+// the block height at which the outcome occurred. The block height is zero if
+// the outcome is not bound to any specific block.
+func (sm *SubmittingMember) submitDKGResult(
+	result *relayChain.DKGResult,
+	signatures map[group.MemberIndex][]byte,
+	chainRelay relayChain.Interface,
+	blockCounter chain.BlockCounter,
+	startBlockHeight uint64,
+) (SubmissionOutcome, uint64, error) {
+	if len(signatures) == 0 {
+		return SubmissionFailed, 0, fmt.Errorf(
+			"no signatures provided for result submission",
+		)
+	}
+
 	config, err := chainRelay.GetConfig()
 	if err != nil {
-		return fmt.Errorf(
+		return SubmissionFailed, 0, fmt.Errorf(
 			"could not fetch chain's config: [%v]",
 			err,
 		)
@@ -64,39 +276,51 @@ func (sm *SubmittingMember) SubmitDKGResult(
 	// Chain rejects the result if it has less than 25% safety margin.
 	// If there are not enough signatures to preserve the margin, it does not
 	// make sense to submit the result.
-	signatureThreshold := config.HonestThreshold + (config.GroupSize-config.HonestThreshold)/2
-	if len(signatures) < signatureThreshold {
-		return fmt.Errorf(
-			"could not submit result with [%v] signatures for signature threshold [%v]",
-			len(signatures),
-			signatureThreshold,
+	quorum := DetermineQuorumStatus(signatures, config)
+	if !quorum.Met {
+		return SubmissionFailed, 0, fmt.Errorf(
+			"could not submit result with [%v] signatures for signature "+
+				"threshold [%v]; missing signatures from members [%v]",
+			quorum.Have,
+			quorum.Required,
+			quorum.Missing,
 		)
 	}
 
-	onSubmittedResultChan := make(chan uint64)
+	// Including signatures above the threshold only increases the gas cost
+	// of the submission. Signatures of members with the lowest indexes are
+	// selected so that all members submit the same subset.
+	if sm.maxSignatures > 0 {
+		limit := sm.maxSignatures
+		if limit < quorum.Required {
+			limit = quorum.Required
+		}
+		signatures = SelectSignatures(signatures, limit)
+	}
 
-	subscription, err := chainRelay.OnDKGResultSubmitted(
-		func(event *event.DKGResultSubmission) {
-			onSubmittedResultChan <- event.BlockNumber
-		},
+	onSubmittedResultChan, stopWatching, err := sm.watchForSubmissions(
+		result,
+		chainRelay,
+		blockCounter,
 	)
 	if err != nil {
-		close(onSubmittedResultChan)
-		return fmt.Errorf(
-			"could not watch for DKG result publications: [%v]",
-			err,
-		)
+		return SubmissionFailed, 0, err
 	}
 
-	returnWithError := func(err error) error {
-		subscription.Unsubscribe()
-		close(onSubmittedResultChan)
-		return err
+	returnWithError := func(
+		outcome SubmissionOutcome,
+		blockHeight uint64,
+		err error,
+	) (SubmissionOutcome, uint64, error) {
+		stopWatching()
+		return outcome, blockHeight, err
 	}
 
 	alreadySubmitted, err := chainRelay.IsGroupRegistered(result.GroupPublicKey)
 	if err != nil {
 		return returnWithError(
+			SubmissionFailed,
+			0,
 			fmt.Errorf(
 				"could not check if the result is already submitted: [%v]",
 				err,
@@ -104,11 +328,26 @@ func (sm *SubmittingMember) SubmitDKGResult(
 		)
 	}
 
-	// Someone who was ahead of us in the queue submitted the result. Giving up.
+	// The result has been already submitted, either by someone who was ahead
+	// of us in the queue or by us before a retry.
 	if alreadySubmitted {
-		return returnWithError(nil)
+		return returnWithError(sm.alreadySubmittedOutcome(
+			result,
+			chainRelay,
+			blockCounter,
+			startBlockHeight,
+		))
 	}
 
+	// The eligibility target depends on the chain config which may change
+	// while the member waits for its turn.
+	configChanges, stopWatchingConfig := sm.watchForConfigChanges(
+		config,
+		chainRelay,
+		blockCounter,
+	)
+	defer stopWatchingConfig()
+
 	// Wait until the current member is eligible to submit the result.
 	eligibleToSubmitWaiter, err := sm.waitForSubmissionEligibility(
 		blockCounter,
@@ -117,65 +356,800 @@ func (sm *SubmittingMember) SubmitDKGResult(
 	)
 	if err != nil {
 		return returnWithError(
+			SubmissionFailed,
+			0,
 			fmt.Errorf("wait for eligibility failure: [%v]", err),
 		)
 	}
 
+	// The block at which the member stops deferring the submission because of
+	// a network partition; set once the member becomes eligible.
+	var deferralDeadline *uint64
+
+	// Set once the gas of the member's submission has been estimated, which
+	// happens when the member becomes eligible.
+	gasEstimated := false
+
+	// The block from which the member no longer submits the result as the
+	// submission would not confirm before the publication deadline.
+	submissionDeadline := sm.submissionDeadline(startBlockHeight, config)
+
+	// The chain config the member's eligibility has been determined under.
+	chainConfig := config
+
 	for {
 		select {
+		case changedConfig := <-configChanges:
+			logger.Infof(
+				"[member:%v] chain config changed while waiting for "+
+					"eligibility; re-evaluating eligibility for block "+
+					"step [%v] and group size [%v]",
+				sm.index,
+				changedConfig.ResultPublicationBlockStep,
+				changedConfig.GroupSize,
+			)
+
+			eligibleToSubmitWaiter, err = sm.waitForSubmissionEligibility(
+				blockCounter,
+				startBlockHeight,
+				changedConfig.ResultPublicationBlockStep,
+			)
+			if err != nil {
+				return returnWithError(
+					SubmissionFailed,
+					0,
+					fmt.Errorf("wait for eligibility failure: [%v]", err),
+				)
+			}
+
+			submissionDeadline = sm.submissionDeadline(
+				startBlockHeight,
+				changedConfig,
+			)
+			chainConfig = changedConfig
 		case blockNumber := <-eligibleToSubmitWaiter:
-			// Member becomes eligible to submit the result.
-			errorChannel := make(chan error)
-			defer close(errorChannel)
+			// Member becomes eligible to submit the result. Config changes
+			// no longer affect the member's eligibility.
+			configChanges = nil
+
+			if sm.confirmationBufferBlocks > 0 {
+				// The waiter reports the block at which the member became
+				// eligible. If the eligibility has been determined late, for
+				// example after a config change, that block may be long past
+				// so the deadline is checked against the current block.
+				currentBlock := sm.currentBlockOrLatest(blockCounter, blockNumber)
+				if currentBlock >= submissionDeadline {
+					return returnWithError(
+						SubmissionFailed,
+						currentBlock,
+						fmt.Errorf(
+							"submission at block [%v] would not confirm "+
+								"before the publication deadline; "+
+								"submissions stop at block [%v]",
+							currentBlock,
+							submissionDeadline,
+						),
+					)
+				}
+			}
+
+			if sm.partitionGuard != nil {
+				if deferralDeadline == nil {
+					deadline := blockNumber + sm.partitionGuard.maxDeferralBlocks
+					deferralDeadline = &deadline
+				}
+
+				if partitioned, connected := sm.partitionGuard.isPartitioned(); partitioned {
+					if blockNumber < *deferralDeadline {
+						logger.Warningf(
+							"[member:%v,block:%v] deferring DKG result "+
+								"submission; connected to [%v] group members "+
+								"out of required [%v]",
+							sm.index,
+							blockNumber,
+							connected,
+							sm.partitionGuard.minConnectedMembers,
+						)
+
+						eligibleToSubmitWaiter, err = blockCounter.BlockHeightWaiter(
+							blockNumber + 1,
+						)
+						if err != nil {
+							return returnWithError(
+								SubmissionFailed,
+								blockNumber,
+								fmt.Errorf("wait for next block failure: [%v]", err),
+							)
+						}
+						continue
+					}
+
+					logger.Warningf(
+						"[member:%v,block:%v] submission deferral "+
+							"deadline reached; submitting DKG result while connected "+
+							"to [%v] group members out of required [%v]",
+						sm.index,
+						blockNumber,
+						connected,
+						sm.partitionGuard.minConnectedMembers,
+					)
+				}
+			}
+
+			if sm.gasBudget != nil && sm.queue == nil {
+				if !gasEstimated {
+					sm.expectedGas = sm.expectedSubmissionGas(
+						result,
+						signatures,
+						chainRelay,
+					)
+					gasEstimated = true
+				}
+
+				admitted, remaining, windowEnd := sm.gasBudget.admit(sm.expectedGas)
+				if !admitted {
+					if !sm.gasBudget.defers() ||
+						blockNumber+1 >= submissionDeadline {
+						logger.Errorf(
+							"[member:%v,block:%v] declining DKG result "+
+								"submission; expected gas [%v] exceeds [%v] gas "+
+								"left in the submission gas budget until [%v]",
+							sm.index,
+							blockNumber,
+							sm.expectedGas,
+							remaining,
+							windowEnd,
+						)
+						return returnWithError(
+							SubmissionFailed,
+							blockNumber,
+							fmt.Errorf(
+								"DKG result submission gas budget exhausted; "+
+									"expected gas [%v], gas left [%v]",
+								sm.expectedGas,
+								remaining,
+							),
+						)
+					}
 
-			subscription.Unsubscribe()
-			close(onSubmittedResultChan)
+					logger.Warningf(
+						"[member:%v,block:%v] deferring DKG result "+
+							"submission; expected gas [%v] exceeds [%v] gas "+
+							"left in the submission gas budget until [%v]",
+						sm.index,
+						blockNumber,
+						sm.expectedGas,
+						remaining,
+						windowEnd,
+					)
 
+					eligibleToSubmitWaiter, err = blockCounter.BlockHeightWaiter(
+						blockNumber + 1,
+					)
+					if err != nil {
+						return returnWithError(
+							SubmissionFailed,
+							blockNumber,
+							fmt.Errorf("wait for next block failure: [%v]", err),
+						)
+					}
+					continue
+				}
+			}
+
+			// Settles the queued submission in the deduplication ledger.
+			settleQueued := func(submitted bool) {}
+			send := func(
+				settle func(submitted bool),
+			) (SubmissionOutcome, uint64, error) {
+				settleQueued = settle
+				return sm.sendDKGResult(
+					result,
+					signatures,
+					chainRelay,
+					blockCounter,
+					startBlockHeight,
+					blockNumber,
+					onSubmittedResultChan,
+					settle,
+				)
+			}
+
+			var outcome SubmissionOutcome
+			var blockHeight uint64
+			if sm.deduplication != nil {
+				outcome, blockHeight, err = sm.deduplication.submit(
+					sm.index,
+					result,
+					chainRelay,
+					blockNumber,
+					send,
+				)
+			} else {
+				outcome, blockHeight, err = send(settleQueued)
+			}
+
+			if outcome == SubmissionQueued {
+				// Being accepted by the queue does not mean the result
+				// gets published so the member keeps watching the chain.
+				outcome, blockHeight, err = sm.awaitQueuedDKGResult(
+					result,
+					chainRelay,
+					blockCounter,
+					startBlockHeight,
+					PublicationDeadlineBlockHeight(
+						startBlockHeight,
+						chainConfig.GroupSize,
+						chainConfig.ResultPublicationBlockStep,
+					),
+					onSubmittedResultChan,
+				)
+				settleQueued(outcome == SubmissionSubmitted)
+			}
+
+			return returnWithError(outcome, blockHeight, err)
+		case blockNumber := <-onSubmittedResultChan:
 			logger.Infof(
-				"[member:%v] submitting DKG result with public key [0x%x] and "+
-					"[%v] supporting member signatures at block [%v]",
+				"[member:%v,block:%v] leaving; DKG result submitted by other member",
 				sm.index,
-				result.GroupPublicKey,
-				len(signatures),
 				blockNumber,
 			)
-			chainRelay.SubmitDKGResult(
+			// A result has been submitted by other member. Leave without
+			// publishing the result.
+			return returnWithError(SubmissionYielded, blockNumber, nil)
+		}
+	}
+}
+
+// submissionDeadline returns the block height from which the member no longer
+// submits the result under the given chain config, leaving the member's
+// confirmation buffer before the publication deadline.
+func (sm *SubmittingMember) submissionDeadline(
+	startBlockHeight uint64,
+	chainConfig *config.Chain,
+) uint64 {
+	return SubmissionDeadlineBlockHeight(
+		startBlockHeight,
+		chainConfig.GroupSize,
+		chainConfig.ResultPublicationBlockStep,
+		sm.confirmationBufferBlocks,
+	)
+}
+
+// alreadySubmittedOutcome determines the outcome of the submission of the
+// result already registered on the chain. If the chain provides past result
+// submissions and the result has been submitted by the member itself since
+// the given start block, for example before a retry, the submission is
+// considered done by the member. Otherwise, the member yields to the member
+// who submitted the result.
+func (sm *SubmittingMember) alreadySubmittedOutcome(
+	result *relayChain.DKGResult,
+	chainRelay relayChain.Interface,
+	blockCounter chain.BlockCounter,
+	startBlockHeight uint64,
+) (SubmissionOutcome, uint64, error) {
+	history, ok := chainRelay.(SubmissionHistory)
+	if !ok {
+		return SubmissionYielded, 0, nil
+	}
+
+	currentBlockHeight, err := blockCounter.CurrentBlock()
+	if err != nil {
+		logger.Warningf(
+			"[member:%v] could not determine who submitted the DKG result; "+
+				"could not get current block height: [%v]",
+			sm.index,
+			err,
+		)
+		return SubmissionYielded, 0, nil
+	}
+
+	submissions, err := history.PastDKGResultSubmissions(
+		startBlockHeight,
+		currentBlockHeight,
+	)
+	if err != nil {
+		logger.Warningf(
+			"[member:%v] could not determine who submitted the DKG result; "+
+				"could not get past submissions: [%v]",
+			sm.index,
+			err,
+		)
+		return SubmissionYielded, 0, nil
+	}
+
+	for i := len(submissions) - 1; i >= 0; i-- {
+		submission := submissions[i]
+		if !bytes.Equal(submission.GroupPublicKey, result.GroupPublicKey) {
+			continue
+		}
+
+		if submission.MemberIndex != uint32(sm.index) {
+			logger.Infof(
+				"[member:%v] leaving; DKG result already submitted by "+
+					"member [%v] at block [%v]",
 				sm.index,
-				result,
-				signatures,
-			).
-				OnComplete(func(
-					dkgResultPublishedEvent *event.DKGResultSubmission,
-					err error,
-				) {
-					errorChannel <- err
-				})
-			return <-errorChannel
-		case blockNumber := <-onSubmittedResultChan:
+				submission.MemberIndex,
+				submission.BlockNumber,
+			)
+			return SubmissionYielded, submission.BlockNumber, nil
+		}
+
+		sm.transactionHash = submission.TransactionHash
+		logger.Infof(
+			"[member:%v] DKG result already submitted by this member "+
+				"in transaction [%v] at block [%v]",
+			sm.index,
+			submission.TransactionHash,
+			submission.BlockNumber,
+		)
+		return SubmissionSubmitted, submission.BlockNumber, nil
+	}
+
+	return SubmissionYielded, 0, nil
+}
+
+// sendDKGResult hands the result off to the queue or, if there is no queue,
+// submits it to the chain at the given block and waits for the outcome. If
+// the member has a retry policy, the submission failed with an error is
+// retried unless a result submission is observed on the given channel while
+// waiting for the retry or the result has been meanwhile registered on the
+// chain. The queue is given the function settling the queued submission.
+func (sm *SubmittingMember) sendDKGResult(
+	result *relayChain.DKGResult,
+	signatures map[group.MemberIndex][]byte,
+	chainRelay relayChain.Interface,
+	blockCounter chain.BlockCounter,
+	startBlockHeight uint64,
+	blockNumber uint64,
+	onSubmittedResultChan <-chan uint64,
+	settle func(submitted bool),
+) (SubmissionOutcome, uint64, error) {
+	if sm.queue != nil {
+		return sm.enqueueDKGResult(result, signatures, blockNumber, settle)
+	}
+
+	if sm.cooldown != nil {
+		sm.cooldown.begin()
+		defer sm.cooldown.end()
+	}
+
+	if sm.gasBudget != nil {
+		defer sm.spendSubmissionGas(chainRelay)
+	}
+
+	logger.Infof(
+		"[member:%v,block:%v] submitting DKG result with public key "+
+			"[0x%x] and [%v] supporting member signatures",
+		sm.index,
+		blockNumber,
+		result.GroupPublicKey,
+		len(signatures),
+	)
+
+	submission, err := sm.submitToChain(result, signatures, chainRelay)
+	for retry := 1; err != nil; retry++ {
+		if sm.retry == nil || retry > sm.retry.attempts {
+			return SubmissionFailed, blockNumber, err
+		}
+
+		backoff := sm.retry.backoffFor(retry)
+		logger.Warningf(
+			"[member:%v,block:%v] DKG result submission failed: [%v]; "+
+				"retrying in [%v], retry [%v] of [%v]",
+			sm.index,
+			blockNumber,
+			err,
+			backoff,
+			retry,
+			sm.retry.attempts,
+		)
+
+		select {
+		case <-sm.retry.after(backoff):
+		case submittedBlockNumber := <-onSubmittedResultChan:
 			logger.Infof(
-				"[member:%v] leaving; DKG result submitted by other member at block [%v]",
+				"[member:%v,block:%v] aborting DKG result submission "+
+					"retries; DKG result submitted",
 				sm.index,
-				blockNumber,
+				submittedBlockNumber,
 			)
-			// A result has been submitted by other member. Leave without
-			// publishing the result.
-			return returnWithError(nil)
+			// A result landed while the member waited for the retry so
+			// there is nothing left to retry.
+			return sm.alreadySubmittedOutcome(
+				result,
+				chainRelay,
+				blockCounter,
+				startBlockHeight,
+			)
+		}
+
+		registered, checkErr := chainRelay.IsGroupRegistered(
+			result.GroupPublicKey,
+		)
+		if checkErr != nil {
+			logger.Warningf(
+				"[member:%v] could not check if the result is already "+
+					"submitted before the retry: [%v]",
+				sm.index,
+				checkErr,
+			)
+		} else if registered {
+			// The result has been submitted by other member while the member
+			// waited for the retry or the failed submission has landed anyway.
+			return sm.alreadySubmittedOutcome(
+				result,
+				chainRelay,
+				blockCounter,
+				startBlockHeight,
+			)
+		}
+
+		submission, err = sm.submitToChain(result, signatures, chainRelay)
+	}
+
+	if sm.confirmation != nil {
+		err := sm.confirmation.confirm(sm.index, result, submission)
+		if err != nil {
+			return SubmissionFailed, blockNumber, fmt.Errorf(
+				"DKG result submission not confirmed: [%v]",
+				err,
+			)
+		}
+	}
+
+	sm.transactionHash = submission.TransactionHash
+	logger.Infof(
+		"[member:%v] submitted DKG result with public key [0x%x] "+
+			"in transaction [%v]",
+		sm.index,
+		result.GroupPublicKey,
+		submission.TransactionHash,
+	)
+	return SubmissionSubmitted, blockNumber, nil
+}
+
+// submitToChain submits the result to the chain and waits for the outcome of
+// the submission. If the member confirms its submission, it waits for the
+// outcome for at most the confirmation timeout.
+func (sm *SubmittingMember) submitToChain(
+	result *relayChain.DKGResult,
+	signatures map[group.MemberIndex][]byte,
+	chainRelay relayChain.Interface,
+) (*event.DKGResultSubmission, error) {
+	// Buffered so that the outcome completed after the member stopped
+	// waiting for it does not block.
+	submissionChannel := make(chan *event.DKGResultSubmission, 1)
+	errorChannel := make(chan error, 1)
+
+	var timeout <-chan time.Time
+	if sm.confirmation != nil {
+		timeout = time.After(sm.confirmation.timeout)
+	}
+
+	chainRelay.SubmitDKGResult(
+		sm.index,
+		result,
+		signatures,
+	).
+		OnComplete(func(
+			dkgResultPublishedEvent *event.DKGResultSubmission,
+			err error,
+		) {
+			if err != nil {
+				errorChannel <- err
+				return
+			}
+			submissionChannel <- dkgResultPublishedEvent
+		})
+
+	select {
+	case err := <-errorChannel:
+		return nil, err
+	case submission := <-submissionChannel:
+		return submission, nil
+	case <-timeout:
+		return nil, fmt.Errorf(
+			"DKG result submission outcome not observed within [%v]",
+			sm.confirmation.timeout,
+		)
+	}
+}
+
+// watchForSubmissions returns a channel receiving the block number at which
+// a DKG result has been submitted to the chain and a function stopping the
+// watch. The function may be called more than once. Submissions are observed
+// with the chain's DKG result submission subscription. If the subscription
+// could not be established and polling on subscription failure is enabled,
+// the member checks on each new block if the group with the result's public
+// key has been registered instead.
+func (sm *SubmittingMember) watchForSubmissions(
+	result *relayChain.DKGResult,
+	chainRelay relayChain.Interface,
+	blockCounter chain.BlockCounter,
+) (<-chan uint64, func(), error) {
+	onSubmittedResultChan := make(chan uint64)
+
+	// Handlers may still be running when the watch is stopped so they give
+	// up on delivering the submission instead of sending on a closed channel.
+	done := make(chan struct{})
+
+	subscription, err := chainRelay.OnDKGResultSubmitted(
+		func(event *event.DKGResultSubmission) {
+			select {
+			case onSubmittedResultChan <- event.BlockNumber:
+			case <-done:
+			}
+		},
+	)
+	if err == nil {
+		var once sync.Once
+		return onSubmittedResultChan, func() {
+			once.Do(func() {
+				subscription.Unsubscribe()
+				close(done)
+			})
+		}, nil
+	}
+
+	if !sm.pollOnSubscriptionFailure {
+		return nil, nil, fmt.Errorf(
+			"could not watch for DKG result publications: [%v]",
+			err,
+		)
+	}
+
+	logger.Warningf(
+		"[member:%v] could not watch for DKG result publications: [%v]; "+
+			"polling the chain for the result submission",
+		sm.index,
+		err,
+	)
+
+	ctx, cancelCtx := context.WithCancel(context.Background())
+	blocks := blockCounter.WatchBlocks(ctx)
+
+	go func() {
+		for {
+			select {
+			case blockNumber := <-blocks:
+				submitted, err := chainRelay.IsGroupRegistered(
+					result.GroupPublicKey,
+				)
+				if err != nil {
+					logger.Warningf(
+						"[member:%v,block:%v] could not check if the "+
+							"result is submitted: [%v]",
+						sm.index,
+						blockNumber,
+						err,
+					)
+					continue
+				}
+
+				if submitted {
+					select {
+					case onSubmittedResultChan <- blockNumber:
+					case <-ctx.Done():
+					}
+					return
+				}
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+
+	return onSubmittedResultChan, cancelCtx, nil
+}
+
+// watchForConfigChanges returns a channel receiving the chain config each
+// time its parameters determining the member's eligibility, that is the
+// result publication block step and the group size, change compared to the
+// given config, along with a function stopping the watch. The config is
+// re-read from the chain on each new block.
+func (sm *SubmittingMember) watchForConfigChanges(
+	chainConfig *config.Chain,
+	chainRelay relayChain.Interface,
+	blockCounter chain.BlockCounter,
+) (<-chan *config.Chain, func()) {
+	configChanges := make(chan *config.Chain)
+
+	ctx, cancelCtx := context.WithCancel(context.Background())
+	blocks := blockCounter.WatchBlocks(ctx)
+
+	go func() {
+		current := chainConfig
+
+		for {
+			select {
+			case blockNumber := <-blocks:
+				fetched, err := chainRelay.GetConfig()
+				if err != nil {
+					logger.Warningf(
+						"[member:%v,block:%v] could not check chain "+
+							"config: [%v]",
+						sm.index,
+						blockNumber,
+						err,
+					)
+					continue
+				}
+
+				if fetched.ResultPublicationBlockStep ==
+					current.ResultPublicationBlockStep &&
+					fetched.GroupSize == current.GroupSize {
+					continue
+				}
+
+				select {
+				case configChanges <- fetched:
+					current = fetched
+				case <-ctx.Done():
+					return
+				}
+			case <-ctx.Done():
+				return
+			}
 		}
+	}()
+
+	return configChanges, cancelCtx
+}
+
+// enqueueDKGResult hands off the result to the submission queue. Once the
+// result is accepted by the queue, the submission is queued; whether it has
+// been done is determined by watching the chain for the result. The queue
+// consumer running in the same process settles the queued submission with
+// the given function once it submits the request or fails to do so.
+func (sm *SubmittingMember) enqueueDKGResult(
+	result *relayChain.DKGResult,
+	signatures map[group.MemberIndex][]byte,
+	blockNumber uint64,
+	settle func(submitted bool),
+) (SubmissionOutcome, uint64, error) {
+	logger.Infof(
+		"[member:%v,block:%v] enqueuing DKG result with public key "+
+			"[0x%x] and [%v] supporting member signatures",
+		sm.index,
+		blockNumber,
+		result.GroupPublicKey,
+		len(signatures),
+	)
+
+	err := sm.queue.Enqueue(&SubmissionRequest{
+		MemberIndex: sm.index,
+		Result:      result,
+		Signatures:  signatures,
+		onComplete:  settle,
+	})
+	if err != nil {
+		return SubmissionFailed, blockNumber, fmt.Errorf(
+			"could not enqueue DKG result submission: [%v]",
+			err,
+		)
+	}
+
+	return SubmissionQueued, blockNumber, nil
+}
+
+// awaitQueuedDKGResult waits until the result handed off to the submission
+// queue is published on the chain or the given publication deadline passes.
+// Once the result is published, the outcome depends on whether it has been
+// submitted in the name of the member. If the result is not published before
+// the deadline, the submission failed.
+func (sm *SubmittingMember) awaitQueuedDKGResult(
+	result *relayChain.DKGResult,
+	chainRelay relayChain.Interface,
+	blockCounter chain.BlockCounter,
+	startBlockHeight uint64,
+	publicationDeadline uint64,
+	onSubmittedResultChan <-chan uint64,
+) (SubmissionOutcome, uint64, error) {
+	deadlineWaiter, err := blockCounter.BlockHeightWaiter(publicationDeadline)
+	if err != nil {
+		return SubmissionFailed, 0, fmt.Errorf(
+			"wait for publication deadline failure: [%v]",
+			err,
+		)
+	}
+
+	select {
+	case <-onSubmittedResultChan:
+		return sm.alreadySubmittedOutcome(
+			result,
+			chainRelay,
+			blockCounter,
+			startBlockHeight,
+		)
+	case blockNumber := <-deadlineWaiter:
+		logger.Errorf(
+			"[member:%v,block:%v] queued DKG result with public key [0x%x] "+
+				"has not been published before the publication deadline",
+			sm.index,
+			blockNumber,
+			result.GroupPublicKey,
+		)
+		return SubmissionFailed, blockNumber, fmt.Errorf(
+			"queued DKG result not published before the publication "+
+				"deadline at block [%v]",
+			publicationDeadline,
+		)
 	}
 }
 
 // waitForSubmissionEligibility waits until the current member is eligible to
 // submit a result to the blockchain. First member is eligible to submit straight
-// away, each following member is eligible after pre-defined block step.
+// away, each following member is eligible after pre-defined block step. If the
+// given chain's block step is lower than the member's minimum block step, the
+// minimum is used instead. If the member's eligibility block has already been
+// reached, the returned channel is ready immediately.
 func (sm *SubmittingMember) waitForSubmissionEligibility(
 	blockCounter chain.BlockCounter,
 	startBlockHeight uint64,
-	blockStep uint64,
+	chainBlockStep uint64,
 ) (<-chan uint64, error) {
-	// T_init + (member_index - 1) * T_step
-	blockWaitTime := (uint64(sm.index) - 1) * blockStep
+	blockStep := EffectiveBlockStep(chainBlockStep, sm.minBlockStep)
+	if blockStep != chainBlockStep {
+		logger.Infof(
+			"[member:%v] chain result publication block step [%v] is lower "+
+				"than the configured minimum; using [%v] instead",
+			sm.index,
+			chainBlockStep,
+			blockStep,
+		)
+	}
+
+	eligibleBlockHeight := RotatedEligibleBlockHeight(
+		sm.rotation,
+		sm.index,
+		startBlockHeight,
+		blockStep,
+	)
+
+	// The first member in the submission order is eligible at the block the
+	// submission starts. As the submission never starts before that block,
+	// such a member, as well as any other member whose eligibility block has
+	// already been reached, is eligible immediately without waiting for the
+	// next block, regardless of the block counter implementation.
+	currentBlockHeight, err := currentBlockWithRetry(blockCounter)
+	if err != nil {
+		if sm.fallbackToLastKnownBlock {
+			// The submission never starts before its start block so it is
+			// the last block height known to be reached.
+			logger.Warningf(
+				"[member:%v] could not determine current block height: "+
+					"[%v]; falling back to the last known block height [%v]",
+				sm.index,
+				err,
+				startBlockHeight,
+			)
+			currentBlockHeight, err = startBlockHeight, nil
+		} else {
+			logger.Warningf(
+				"[member:%v] could not determine current block height: [%v]",
+				sm.index,
+				err,
+			)
+		}
+	}
+
+	if err == nil && eligibleBlockHeight <= currentBlockHeight {
+		logger.Infof(
+			"[member:%v] eligible to submit immediately; eligibility block "+
+				"[%v] already reached at block [%v]",
+			sm.index,
+			eligibleBlockHeight,
+			currentBlockHeight,
+		)
+
+		waiter := make(chan uint64, 1)
+		waiter <- eligibleBlockHeight
+		close(waiter)
+
+		return waiter, nil
+	}
 
-	eligibleBlockHeight := startBlockHeight + blockWaitTime
 	logger.Infof(
 		"[member:%v] waiting for block [%v] to submit",
 		sm.index,
@@ -189,3 +1163,88 @@ func (sm *SubmittingMember) waitForSubmissionEligibility(
 
 	return waiter, err
 }
+
+// EffectiveBlockStep returns the result publication block step used by
+// members, given the chain's block step and the minimum block step. The
+// minimum is a floor protecting against the chain's block step being
+// misconfigured too low; zero minimum means the chain's block step is used
+// as is.
+func EffectiveBlockStep(chainBlockStep uint64, minBlockStep uint64) uint64 {
+	if chainBlockStep < minBlockStep {
+		return minBlockStep
+	}
+
+	return chainBlockStep
+}
+
+// ExpectedSubmissionBlock returns the block at which the member with the
+// given index becomes eligible to submit the result, given the block at which
+// the result submission starts and the result publication block step. The
+// first member is eligible at the init block. The block step must not be
+// negative.
+func ExpectedSubmissionBlock(
+	index group.MemberIndex,
+	initBlock uint64,
+	blockStep int,
+) uint64 {
+	// T_init + (member_index - 1) * T_step
+	return initBlock + (uint64(index)-1)*uint64(blockStep)
+}
+
+// currentBlockAttempts is the number of attempts to determine the current
+// block height before giving up.
+const currentBlockAttempts = 3
+
+// currentBlockRetryDelay is the time between attempts to determine the
+// current block height.
+var currentBlockRetryDelay = 500 * time.Millisecond
+
+// currentBlockWithRetry returns the current block height, retrying a bounded
+// number of times if it could not be determined, e.g. because of a transient
+// RPC error.
+func currentBlockWithRetry(blockCounter chain.BlockCounter) (uint64, error) {
+	var err error
+	for attempt := 1; attempt <= currentBlockAttempts; attempt++ {
+		var blockHeight uint64
+		blockHeight, err = blockCounter.CurrentBlock()
+		if err == nil {
+			return blockHeight, nil
+		}
+
+		if attempt < currentBlockAttempts {
+			time.Sleep(currentBlockRetryDelay)
+		}
+	}
+
+	return 0, fmt.Errorf(
+		"could not get current block after [%v] attempts: [%v]",
+		currentBlockAttempts,
+		err,
+	)
+}
+
+// currentBlockOrLatest returns the current block height or the given block
+// height known to be already reached if it is higher or the current block
+// height could not be determined.
+func (sm *SubmittingMember) currentBlockOrLatest(
+	blockCounter chain.BlockCounter,
+	reachedBlockHeight uint64,
+) uint64 {
+	currentBlockHeight, err := blockCounter.CurrentBlock()
+	if err != nil {
+		logger.Warningf(
+			"[member:%v] could not determine current block height: [%v]; "+
+				"using block [%v]",
+			sm.index,
+			err,
+			reachedBlockHeight,
+		)
+		return reachedBlockHeight
+	}
+
+	if currentBlockHeight < reachedBlockHeight {
+		return reachedBlockHeight
+	}
+
+	return currentBlockHeight
+}
@@ -1,13 +1,21 @@
 package result
 
 import (
+	"fmt"
 	"math/big"
+	"reflect"
+	"sync"
 	"testing"
+	"time"
 
 	"github.com/keep-network/keep-core/pkg/chain"
 	"github.com/keep-network/keep-core/pkg/chain/local"
+	"github.com/keep-network/keep-core/pkg/gen/async"
+	"github.com/keep-network/keep-core/pkg/subscription"
 
 	relayChain "github.com/keep-network/keep-core/pkg/beacon/relay/chain"
+	"github.com/keep-network/keep-core/pkg/beacon/relay/config"
+	"github.com/keep-network/keep-core/pkg/beacon/relay/event"
 	"github.com/keep-network/keep-core/pkg/beacon/relay/group"
 )
 
@@ -244,3 +252,1117 @@ func initChainHandle(honestThreshold int, groupSize int) (chain.Handle, uint64,
 
 	return chainHandle, <-initialBlockChan, nil
 }
+
+func TestSubmitDKGResultByMultipleMembers(t *testing.T) {
+	honestThreshold := 3
+	groupSize := 5
+	tStep := uint64(3) // result publication block step of the local chain
+
+	var tests = map[string]struct {
+		// startDelays maps the index of every online member to the number of
+		// blocks the member joins the submission after its start; members
+		// missing in the map are offline
+		startDelays             map[group.MemberIndex]uint64
+		expectedSubmitter       group.MemberIndex
+		expectedSubmissionDelay uint64
+	}{
+		"all members online": {
+			startDelays: map[group.MemberIndex]uint64{
+				1: 0, 2: 0, 3: 0, 4: 0, 5: 0,
+			},
+			expectedSubmitter:       1,
+			expectedSubmissionDelay: 0,
+		},
+		"first member offline": {
+			startDelays: map[group.MemberIndex]uint64{
+				2: 0, 3: 0, 4: 0, 5: 0,
+			},
+			expectedSubmitter:       2,
+			expectedSubmissionDelay: tStep,
+		},
+		"first member slow but joining before second member is eligible": {
+			startDelays: map[group.MemberIndex]uint64{
+				1: 1, 2: 0, 3: 0, 4: 0, 5: 0,
+			},
+			expectedSubmitter:       1,
+			expectedSubmissionDelay: 1,
+		},
+		"first member slow and joining after second member submitted": {
+			startDelays: map[group.MemberIndex]uint64{
+				1: tStep + 1, 2: 0, 3: 0, 4: 0, 5: 0,
+			},
+			expectedSubmitter:       2,
+			expectedSubmissionDelay: tStep,
+		},
+	}
+
+	for testName, test := range tests {
+		t.Run(testName, func(t *testing.T) {
+			chainHandle, startBlockHeight, err := initChainHandle(
+				honestThreshold,
+				groupSize,
+			)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			reports, submitters, submissionBlock, err := runSubmittingMembers(
+				chainHandle,
+				startBlockHeight,
+				test.startDelays,
+			)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			expectedSubmitters := []group.MemberIndex{test.expectedSubmitter}
+			if !reflect.DeepEqual(expectedSubmitters, submitters) {
+				t.Fatalf(
+					"unexpected on-chain submitters\nexpected: %v\nactual:   %v\n",
+					expectedSubmitters,
+					submitters,
+				)
+			}
+
+			for memberIndex, report := range reports {
+				expectedOutcome := SubmissionYielded
+				if memberIndex == test.expectedSubmitter {
+					expectedOutcome = SubmissionSubmitted
+				}
+
+				if expectedOutcome != report.Outcome {
+					t.Errorf(
+						"unexpected outcome for member [%v]\n"+
+							"expected: %v\nactual:   %v\n",
+						memberIndex,
+						expectedOutcome,
+						report.Outcome,
+					)
+				}
+			}
+
+			expectedSubmissionBlock := startBlockHeight +
+				test.expectedSubmissionDelay
+			if expectedSubmissionBlock != submissionBlock {
+				t.Errorf(
+					"unexpected submission block\nexpected: %v\nactual:   %v\n",
+					expectedSubmissionBlock,
+					submissionBlock,
+				)
+			}
+		})
+	}
+}
+
+// submissionRecordingChain records the members submitting the DKG result to
+// the underlying chain along with the block of the last submission.
+type submissionRecordingChain struct {
+	relayChain.Interface
+	blockCounter chain.BlockCounter
+
+	mutex           sync.Mutex
+	submitters      []group.MemberIndex
+	submissionBlock uint64
+}
+
+func (src *submissionRecordingChain) SubmitDKGResult(
+	participantIndex relayChain.GroupMemberIndex,
+	result *relayChain.DKGResult,
+	signatures map[relayChain.GroupMemberIndex][]byte,
+) *async.EventDKGResultSubmissionPromise {
+	src.mutex.Lock()
+	src.submitters = append(src.submitters, participantIndex)
+	src.submissionBlock, _ = src.blockCounter.CurrentBlock()
+	src.mutex.Unlock()
+
+	return src.Interface.SubmitDKGResult(participantIndex, result, signatures)
+}
+
+// runSubmittingMembers executes the DKG result submission concurrently for
+// all online members against the shared chain. Each member joins the
+// submission after its start delay passes. It returns the submission reports
+// of all online members, the members who submitted the result on-chain and
+// the block of the last on-chain submission.
+func runSubmittingMembers(
+	chainHandle chain.Handle,
+	startBlockHeight uint64,
+	startDelays map[group.MemberIndex]uint64,
+) (
+	map[group.MemberIndex]*SubmissionReport,
+	[]group.MemberIndex,
+	uint64,
+	error,
+) {
+	blockCounter, err := chainHandle.BlockCounter()
+	if err != nil {
+		return nil, nil, 0, err
+	}
+
+	recordingChain := &submissionRecordingChain{
+		Interface:    chainHandle.ThresholdRelay(),
+		blockCounter: blockCounter,
+	}
+
+	result := &relayChain.DKGResult{GroupPublicKey: []byte{123, 45}}
+	signatures := map[group.MemberIndex][]byte{
+		1: []byte{101},
+		2: []byte{102},
+		3: []byte{103},
+		4: []byte{104},
+	}
+
+	var (
+		wg           sync.WaitGroup
+		reportsMutex sync.Mutex
+		reports      = make(map[group.MemberIndex]*SubmissionReport)
+		errs         = make(chan error, len(startDelays))
+	)
+
+	for memberIndex, startDelay := range startDelays {
+		wg.Add(1)
+		go func(memberIndex group.MemberIndex, startDelay uint64) {
+			defer wg.Done()
+
+			err := blockCounter.WaitForBlockHeight(startBlockHeight + startDelay)
+			if err != nil {
+				errs <- err
+				return
+			}
+
+			member := NewSubmittingMember(
+				memberIndex,
+				SubmissionConfig{
+					Observer: func(report *SubmissionReport) {
+						reportsMutex.Lock()
+						defer reportsMutex.Unlock()
+						reports[report.MemberIndex] = report
+					},
+				},
+			)
+
+			err = member.SubmitDKGResult(
+				result,
+				signatures,
+				recordingChain,
+				blockCounter,
+				startBlockHeight,
+			)
+			if err != nil {
+				errs <- fmt.Errorf(
+					"submission failed for member [%v]: [%v]",
+					memberIndex,
+					err,
+				)
+			}
+		}(memberIndex, startDelay)
+	}
+
+	wg.Wait()
+	close(errs)
+
+	if err := <-errs; err != nil {
+		return nil, nil, 0, err
+	}
+
+	recordingChain.mutex.Lock()
+	defer recordingChain.mutex.Unlock()
+
+	return reports,
+		recordingChain.submitters,
+		recordingChain.submissionBlock,
+		nil
+}
+
+func TestSubmitDKGResultWithoutSignatures(t *testing.T) {
+	var tests = map[string]struct {
+		signatures map[group.MemberIndex][]byte
+	}{
+		"nil signatures": {
+			signatures: nil,
+		},
+		"empty signatures": {
+			signatures: map[group.MemberIndex][]byte{},
+		},
+	}
+
+	for testName, test := range tests {
+		t.Run(testName, func(t *testing.T) {
+			chainHandle, initialBlockHeight, err := initChainHandle(3, 5)
+			if err != nil {
+				t.Fatal(err)
+			}
+			blockCounter, err := chainHandle.BlockCounter()
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			// Any interaction with the chain panics as the embedded
+			// interface is nil.
+			noInteractionChain := &submissionRecordingChain{}
+
+			err = NewSubmittingMember(1, SubmissionConfig{}).SubmitDKGResult(
+				&relayChain.DKGResult{GroupPublicKey: []byte{123, 45}},
+				test.signatures,
+				noInteractionChain,
+				blockCounter,
+				initialBlockHeight,
+			)
+
+			expectedError := fmt.Errorf(
+				"no signatures provided for result submission",
+			)
+			if !reflect.DeepEqual(expectedError, err) {
+				t.Errorf(
+					"unexpected error\nexpected: %v\nactual:   %v\n",
+					expectedError,
+					err,
+				)
+			}
+		})
+	}
+}
+
+func TestSubmitDKGResultPropagatesTransactionHash(t *testing.T) {
+	chainHandle, initialBlockHeight, err := initChainHandle(3, 5)
+	if err != nil {
+		t.Fatal(err)
+	}
+	blockCounter, err := chainHandle.BlockCounter()
+	if err != nil {
+		t.Fatal(err)
+	}
+	chainRelay := chainHandle.ThresholdRelay()
+
+	submittedEvents := make(chan *event.DKGResultSubmission, 1)
+	subscription, err := chainRelay.OnDKGResultSubmitted(
+		func(submission *event.DKGResultSubmission) {
+			submittedEvents <- submission
+		},
+	)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer subscription.Unsubscribe()
+
+	var report *SubmissionReport
+	member := NewSubmittingMember(
+		1,
+		SubmissionConfig{
+			Observer: func(r *SubmissionReport) { report = r },
+		},
+	)
+
+	err = member.SubmitDKGResult(
+		&relayChain.DKGResult{GroupPublicKey: []byte{123, 45}},
+		map[group.MemberIndex][]byte{
+			1: []byte{101},
+			2: []byte{102},
+			3: []byte{103},
+			4: []byte{104},
+		},
+		chainRelay,
+		blockCounter,
+		initialBlockHeight,
+	)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	expectedTransactionHash := (<-submittedEvents).TransactionHash
+	if expectedTransactionHash == "" {
+		t.Fatal("chain did not report the transaction hash")
+	}
+
+	if member.TransactionHash() != expectedTransactionHash {
+		t.Errorf(
+			"unexpected member transaction hash\nexpected: %v\nactual:   %v\n",
+			expectedTransactionHash,
+			member.TransactionHash(),
+		)
+	}
+	if report.TransactionHash != expectedTransactionHash {
+		t.Errorf(
+			"unexpected reported transaction hash\nexpected: %v\nactual:   %v\n",
+			expectedTransactionHash,
+			report.TransactionHash,
+		)
+	}
+}
+
+func TestSubmitDKGResultAlreadySubmitted(t *testing.T) {
+	var tests = map[string]struct {
+		submittingMember        group.MemberIndex
+		expectedOutcome         SubmissionOutcome
+		expectedTransactionHash bool
+	}{
+		"already submitted by self": {
+			submittingMember:        2,
+			expectedOutcome:         SubmissionSubmitted,
+			expectedTransactionHash: true,
+		},
+		"already submitted by other": {
+			submittingMember:        3,
+			expectedOutcome:         SubmissionYielded,
+			expectedTransactionHash: false,
+		},
+	}
+
+	for testName, test := range tests {
+		t.Run(testName, func(t *testing.T) {
+			chainHandle, initialBlockHeight, err := initChainHandle(3, 5)
+			if err != nil {
+				t.Fatal(err)
+			}
+			blockCounter, err := chainHandle.BlockCounter()
+			if err != nil {
+				t.Fatal(err)
+			}
+			chainRelay := chainHandle.ThresholdRelay()
+
+			result := &relayChain.DKGResult{GroupPublicKey: []byte{123, 45}}
+			signatures := map[group.MemberIndex][]byte{
+				1: []byte{101},
+				2: []byte{102},
+				3: []byte{103},
+				4: []byte{104},
+			}
+
+			submittedEvents := make(chan *event.DKGResultSubmission, 1)
+			subscription, err := chainRelay.OnDKGResultSubmitted(
+				func(submission *event.DKGResultSubmission) {
+					submittedEvents <- submission
+				},
+			)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			chainRelay.SubmitDKGResult(test.submittingMember, result, signatures)
+			submission := <-submittedEvents
+			subscription.Unsubscribe()
+
+			var report *SubmissionReport
+			member := NewSubmittingMember(
+				2,
+				SubmissionConfig{
+					Observer: func(r *SubmissionReport) { report = r },
+				},
+			)
+
+			err = member.SubmitDKGResult(
+				result,
+				signatures,
+				chainRelay,
+				blockCounter,
+				initialBlockHeight,
+			)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			if report.Outcome != test.expectedOutcome {
+				t.Errorf(
+					"unexpected outcome\nexpected: %v\nactual:   %v\n",
+					test.expectedOutcome,
+					report.Outcome,
+				)
+			}
+			if report.BlockHeight != submission.BlockNumber {
+				t.Errorf(
+					"unexpected block height\nexpected: %v\nactual:   %v\n",
+					submission.BlockNumber,
+					report.BlockHeight,
+				)
+			}
+
+			expectedTransactionHash := ""
+			if test.expectedTransactionHash {
+				expectedTransactionHash = submission.TransactionHash
+			}
+			if member.TransactionHash() != expectedTransactionHash {
+				t.Errorf(
+					"unexpected member transaction hash\nexpected: %v\nactual:   %v\n",
+					expectedTransactionHash,
+					member.TransactionHash(),
+				)
+			}
+		})
+	}
+}
+
+func TestSubmitDKGResultWithinConfirmationBuffer(t *testing.T) {
+	honestThreshold := 3
+	groupSize := 5
+	// With the result publication block step of the local chain, the
+	// publication deadline is 15 blocks after the submission start. The
+	// confirmation buffer stops submissions 8 blocks after the start.
+	confirmationBufferBlocks := uint64(7)
+
+	var tests = map[string]struct {
+		memberIndex group.MemberIndex
+		// number of blocks after the submission start the member joins
+		// the submission
+		startDelay        uint64
+		expectedOutcome   SubmissionOutcome
+		expectedSubmitted bool
+	}{
+		"eligible before the submission deadline": {
+			memberIndex:       3,
+			expectedOutcome:   SubmissionSubmitted,
+			expectedSubmitted: true,
+		},
+		"eligible after the submission deadline": {
+			memberIndex:       4,
+			expectedOutcome:   SubmissionFailed,
+			expectedSubmitted: false,
+		},
+		"eligible before the submission deadline already passed": {
+			memberIndex:       1,
+			startDelay:        9,
+			expectedOutcome:   SubmissionFailed,
+			expectedSubmitted: false,
+		},
+	}
+
+	for testName, test := range tests {
+		t.Run(testName, func(t *testing.T) {
+			chainHandle, initialBlockHeight, err := initChainHandle(
+				honestThreshold,
+				groupSize,
+			)
+			if err != nil {
+				t.Fatal(err)
+			}
+			blockCounter, err := chainHandle.BlockCounter()
+			if err != nil {
+				t.Fatal(err)
+			}
+			chainRelay := chainHandle.ThresholdRelay()
+
+			err = blockCounter.WaitForBlockHeight(
+				initialBlockHeight + test.startDelay,
+			)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			result := &relayChain.DKGResult{GroupPublicKey: []byte{123, 45}}
+
+			var report *SubmissionReport
+			member := NewSubmittingMember(
+				test.memberIndex,
+				SubmissionConfig{
+					Observer:                 func(r *SubmissionReport) { report = r },
+					ConfirmationBufferBlocks: confirmationBufferBlocks,
+				},
+			)
+
+			err = member.SubmitDKGResult(
+				result,
+				map[group.MemberIndex][]byte{
+					1: []byte{101},
+					2: []byte{102},
+					3: []byte{103},
+					4: []byte{104},
+				},
+				chainRelay,
+				blockCounter,
+				initialBlockHeight,
+			)
+			if test.expectedSubmitted && err != nil {
+				t.Fatal(err)
+			}
+			if !test.expectedSubmitted && err == nil {
+				t.Fatal("expected submission to be abandoned")
+			}
+
+			if report.Outcome != test.expectedOutcome {
+				t.Errorf(
+					"unexpected outcome\nexpected: %v\nactual:   %v\n",
+					test.expectedOutcome,
+					report.Outcome,
+				)
+			}
+
+			submitted, err := chainRelay.IsGroupRegistered(
+				result.GroupPublicKey,
+			)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if submitted != test.expectedSubmitted {
+				t.Errorf(
+					"unexpected submission\nexpected: %v\nactual:   %v\n",
+					test.expectedSubmitted,
+					submitted,
+				)
+			}
+		})
+	}
+}
+
+// failingSubscriptionChain fails to subscribe for DKG result submission
+// events and delegates all other interactions to the underlying chain.
+type failingSubscriptionChain struct {
+	relayChain.Interface
+}
+
+func (fsc *failingSubscriptionChain) OnDKGResultSubmitted(
+	handler func(submission *event.DKGResultSubmission),
+) (subscription.EventSubscription, error) {
+	return nil, fmt.Errorf("subscription failed")
+}
+
+func TestSubmitDKGResultOnSubscriptionFailure(t *testing.T) {
+	result := &relayChain.DKGResult{GroupPublicKey: []byte{123, 45}}
+	signatures := map[group.MemberIndex][]byte{
+		1: []byte{101},
+		2: []byte{102},
+		3: []byte{103},
+		4: []byte{104},
+	}
+
+	var tests = map[string]struct {
+		memberIndex               group.MemberIndex
+		pollOnSubscriptionFailure bool
+		submittedByOtherMember    bool
+		expectedOutcome           SubmissionOutcome
+		expectedError             error
+	}{
+		"polling disabled": {
+			memberIndex:     1,
+			expectedOutcome: SubmissionFailed,
+			expectedError: fmt.Errorf(
+				"could not watch for DKG result publications: " +
+					"[subscription failed]",
+			),
+		},
+		"polling enabled, member eligible to submit": {
+			memberIndex:               1,
+			pollOnSubscriptionFailure: true,
+			expectedOutcome:           SubmissionSubmitted,
+		},
+		"polling enabled, result submitted by other member": {
+			memberIndex:               3,
+			pollOnSubscriptionFailure: true,
+			submittedByOtherMember:    true,
+			expectedOutcome:           SubmissionYielded,
+		},
+	}
+
+	for testName, test := range tests {
+		t.Run(testName, func(t *testing.T) {
+			chainHandle, startBlockHeight, err := initChainHandle(3, 5)
+			if err != nil {
+				t.Fatal(err)
+			}
+			blockCounter, err := chainHandle.BlockCounter()
+			if err != nil {
+				t.Fatal(err)
+			}
+			chainRelay := chainHandle.ThresholdRelay()
+
+			var report *SubmissionReport
+			member := NewSubmittingMember(
+				test.memberIndex,
+				SubmissionConfig{
+					Observer:                  func(r *SubmissionReport) { report = r },
+					PollOnSubscriptionFailure: test.pollOnSubscriptionFailure,
+				},
+			)
+
+			errs := make(chan error, 1)
+			go func() {
+				errs <- member.SubmitDKGResult(
+					result,
+					signatures,
+					&failingSubscriptionChain{chainRelay},
+					blockCounter,
+					startBlockHeight,
+				)
+			}()
+
+			if test.submittedByOtherMember {
+				// The member is eligible to submit only after two block
+				// steps, so the result submitted by the first member is
+				// observed first.
+				err := blockCounter.WaitForBlockHeight(startBlockHeight + 1)
+				if err != nil {
+					t.Fatal(err)
+				}
+
+				chainRelay.SubmitDKGResult(1, result, signatures)
+			}
+
+			err = <-errs
+			if !reflect.DeepEqual(test.expectedError, err) {
+				t.Errorf(
+					"unexpected error\nexpected: %v\nactual:   %v\n",
+					test.expectedError,
+					err,
+				)
+			}
+			if report.Outcome != test.expectedOutcome {
+				t.Errorf(
+					"unexpected outcome\nexpected: %v\nactual:   %v\n",
+					test.expectedOutcome,
+					report.Outcome,
+				)
+			}
+		})
+	}
+}
+
+type heightRecordingBlockCounter struct {
+	chain.BlockCounter
+	currentBlockHeight uint64
+	waitedBlockHeight  uint64
+}
+
+func (hrbc *heightRecordingBlockCounter) CurrentBlock() (uint64, error) {
+	return hrbc.currentBlockHeight, nil
+}
+
+func (hrbc *heightRecordingBlockCounter) BlockHeightWaiter(
+	blockNumber uint64,
+) (<-chan uint64, error) {
+	hrbc.waitedBlockHeight = blockNumber
+
+	waiter := make(chan uint64, 1)
+	waiter <- blockNumber
+	close(waiter)
+
+	return waiter, nil
+}
+
+func TestWaitForSubmissionEligibilityWithMinBlockStep(t *testing.T) {
+	startBlockHeight := uint64(100)
+	memberIndex := group.MemberIndex(3)
+
+	var tests = map[string]struct {
+		chainBlockStep      uint64
+		minBlockStep        uint64
+		expectedBlockHeight uint64
+	}{
+		"no minimum": {
+			chainBlockStep:      2,
+			expectedBlockHeight: 104,
+		},
+		"chain step lower than minimum": {
+			chainBlockStep:      2,
+			minBlockStep:        5,
+			expectedBlockHeight: 110,
+		},
+		"chain step equal to minimum": {
+			chainBlockStep:      5,
+			minBlockStep:        5,
+			expectedBlockHeight: 110,
+		},
+		"chain step higher than minimum": {
+			chainBlockStep:      7,
+			minBlockStep:        5,
+			expectedBlockHeight: 114,
+		},
+	}
+
+	for testName, test := range tests {
+		t.Run(testName, func(t *testing.T) {
+			blockCounter := &heightRecordingBlockCounter{}
+			member := NewSubmittingMember(
+				memberIndex,
+				SubmissionConfig{
+					MinBlockStep: test.minBlockStep,
+				},
+			)
+
+			waiter, err := member.waitForSubmissionEligibility(
+				blockCounter,
+				startBlockHeight,
+				test.chainBlockStep,
+			)
+			if err != nil {
+				t.Fatal(err)
+			}
+			<-waiter
+
+			if blockCounter.waitedBlockHeight != test.expectedBlockHeight {
+				t.Errorf(
+					"unexpected eligible block height\n"+
+						"expected: %v\nactual:   %v\n",
+					test.expectedBlockHeight,
+					blockCounter.waitedBlockHeight,
+				)
+			}
+		})
+	}
+}
+
+// stalledBlockCounter is a block counter whose block height never advances.
+type stalledBlockCounter struct {
+	chain.BlockCounter
+	blockHeight uint64
+}
+
+func (sbc *stalledBlockCounter) CurrentBlock() (uint64, error) {
+	return sbc.blockHeight, nil
+}
+
+func (sbc *stalledBlockCounter) BlockHeightWaiter(
+	blockNumber uint64,
+) (<-chan uint64, error) {
+	waiter := make(chan uint64)
+	if blockNumber <= sbc.blockHeight {
+		go func() { waiter <- blockNumber }()
+	}
+
+	return waiter, nil
+}
+
+func TestWaitForSubmissionEligibilityWithoutBlockAdvance(t *testing.T) {
+	startBlockHeight := uint64(100)
+	blockStep := uint64(3)
+
+	var tests = map[string]struct {
+		memberIndex         group.MemberIndex
+		expectedReady       bool
+		expectedBlockHeight uint64
+	}{
+		"first member is eligible immediately": {
+			memberIndex:         1,
+			expectedReady:       true,
+			expectedBlockHeight: 100,
+		},
+		"second member waits for its block step": {
+			memberIndex:   2,
+			expectedReady: false,
+		},
+	}
+
+	for testName, test := range tests {
+		t.Run(testName, func(t *testing.T) {
+			blockCounter := &stalledBlockCounter{blockHeight: startBlockHeight}
+			member := NewSubmittingMember(
+				test.memberIndex,
+				SubmissionConfig{},
+			)
+
+			waiter, err := member.waitForSubmissionEligibility(
+				blockCounter,
+				startBlockHeight,
+				blockStep,
+			)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			// The waiter has to be ready without yielding to any other
+			// goroutine, so no block advance could make it ready.
+			select {
+			case blockHeight := <-waiter:
+				if !test.expectedReady {
+					t.Fatalf("member should not be eligible yet")
+				}
+				if blockHeight != test.expectedBlockHeight {
+					t.Errorf(
+						"unexpected eligible block height\n"+
+							"expected: %v\nactual:   %v\n",
+						test.expectedBlockHeight,
+						blockHeight,
+					)
+				}
+			default:
+				if test.expectedReady {
+					t.Fatalf("member should be eligible immediately")
+				}
+			}
+		})
+	}
+}
+
+// failingBlockCounter is a block counter failing to determine the current
+// block height the given number of times before it recovers. A negative
+// number of failures means it never recovers.
+type failingBlockCounter struct {
+	chain.BlockCounter
+	blockHeight uint64
+	failures    int
+	calls       int
+}
+
+func (fbc *failingBlockCounter) CurrentBlock() (uint64, error) {
+	fbc.calls++
+	if fbc.failures < 0 || fbc.calls <= fbc.failures {
+		return 0, fmt.Errorf("connection reset")
+	}
+	return fbc.blockHeight, nil
+}
+
+func (fbc *failingBlockCounter) BlockHeightWaiter(
+	blockNumber uint64,
+) (<-chan uint64, error) {
+	return make(chan uint64), nil
+}
+
+func TestWaitForSubmissionEligibilityCurrentBlockFailure(t *testing.T) {
+	defer func(delay time.Duration) { currentBlockRetryDelay = delay }(
+		currentBlockRetryDelay,
+	)
+	currentBlockRetryDelay = time.Millisecond
+
+	startBlockHeight := uint64(100)
+	blockStep := uint64(3)
+
+	var tests = map[string]struct {
+		memberIndex         group.MemberIndex
+		blockHeight         uint64
+		failures            int
+		fallback            bool
+		expectedReady       bool
+		expectedBlockHeight uint64
+		expectedCalls       int
+	}{
+		"current block recovers after retry": {
+			memberIndex:         2,
+			blockHeight:         105,
+			failures:            2,
+			expectedReady:       true,
+			expectedBlockHeight: 103,
+			expectedCalls:       3,
+		},
+		"current block stays down without fallback": {
+			memberIndex:   1,
+			failures:      -1,
+			fallback:      false,
+			expectedReady: false,
+			expectedCalls: currentBlockAttempts,
+		},
+		"current block stays down with fallback": {
+			memberIndex:         1,
+			failures:            -1,
+			fallback:            true,
+			expectedReady:       true,
+			expectedBlockHeight: 100,
+			expectedCalls:       currentBlockAttempts,
+		},
+		"current block stays down with fallback before eligibility": {
+			memberIndex:   2,
+			failures:      -1,
+			fallback:      true,
+			expectedReady: false,
+			expectedCalls: currentBlockAttempts,
+		},
+	}
+
+	for testName, test := range tests {
+		t.Run(testName, func(t *testing.T) {
+			blockCounter := &failingBlockCounter{
+				blockHeight: test.blockHeight,
+				failures:    test.failures,
+			}
+			member := NewSubmittingMember(
+				test.memberIndex,
+				SubmissionConfig{
+					FallbackToLastKnownBlock: test.fallback,
+				},
+			)
+
+			waiter, err := member.waitForSubmissionEligibility(
+				blockCounter,
+				startBlockHeight,
+				blockStep,
+			)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			if blockCounter.calls != test.expectedCalls {
+				t.Errorf(
+					"unexpected number of current block calls\n"+
+						"expected: %v\nactual:   %v\n",
+					test.expectedCalls,
+					blockCounter.calls,
+				)
+			}
+
+			select {
+			case blockHeight := <-waiter:
+				if !test.expectedReady {
+					t.Fatalf("member should not be eligible yet")
+				}
+				if blockHeight != test.expectedBlockHeight {
+					t.Errorf(
+						"unexpected eligible block height\n"+
+							"expected: %v\nactual:   %v\n",
+						test.expectedBlockHeight,
+						blockHeight,
+					)
+				}
+			default:
+				if test.expectedReady {
+					t.Fatalf("member should be eligible immediately")
+				}
+			}
+		})
+	}
+}
+
+func TestExpectedSubmissionBlock(t *testing.T) {
+	initBlock := uint64(100)
+
+	var tests = map[string]struct {
+		index         group.MemberIndex
+		blockStep     int
+		expectedBlock uint64
+	}{
+		"first member": {
+			index:         1,
+			blockStep:     3,
+			expectedBlock: 100,
+		},
+		"second member": {
+			index:         2,
+			blockStep:     3,
+			expectedBlock: 103,
+		},
+		"last member of a large group": {
+			index:         64,
+			blockStep:     3,
+			expectedBlock: 289,
+		},
+		"zero block step": {
+			index:         5,
+			blockStep:     0,
+			expectedBlock: 100,
+		},
+	}
+
+	for testName, test := range tests {
+		t.Run(testName, func(t *testing.T) {
+			block := ExpectedSubmissionBlock(
+				test.index,
+				initBlock,
+				test.blockStep,
+			)
+			if block != test.expectedBlock {
+				t.Errorf(
+					"unexpected submission block\nexpected: %v\nactual:   %v\n",
+					test.expectedBlock,
+					block,
+				)
+			}
+		})
+	}
+}
+
+// changingConfigChain returns the initial config on the first read and the
+// changed config on all subsequent reads. All other interactions are
+// delegated to the underlying chain.
+type changingConfigChain struct {
+	relayChain.Interface
+
+	mutex   sync.Mutex
+	reads   int
+	initial *config.Chain
+	changed *config.Chain
+}
+
+func (ccc *changingConfigChain) GetConfig() (*config.Chain, error) {
+	ccc.mutex.Lock()
+	defer ccc.mutex.Unlock()
+
+	ccc.reads++
+	if ccc.reads == 1 {
+		return ccc.initial, nil
+	}
+	return ccc.changed, nil
+}
+
+func TestSubmitDKGResultReevaluatesEligibilityOnConfigChange(t *testing.T) {
+	memberIndex := group.MemberIndex(3)
+
+	var tests = map[string]struct {
+		initialBlockStep uint64
+		changedBlockStep uint64
+		// offset of the block the member submits at from the start block
+		expectedBlockOffset uint64
+	}{
+		"block step decreased": {
+			initialBlockStep:    10,
+			changedBlockStep:    1,
+			expectedBlockOffset: 2,
+		},
+		"block step increased": {
+			initialBlockStep:    1,
+			changedBlockStep:    3,
+			expectedBlockOffset: 6,
+		},
+	}
+
+	for testName, test := range tests {
+		t.Run(testName, func(t *testing.T) {
+			chainHandle, startBlockHeight, err := initChainHandle(3, 5)
+			if err != nil {
+				t.Fatal(err)
+			}
+			blockCounter, err := chainHandle.BlockCounter()
+			if err != nil {
+				t.Fatal(err)
+			}
+			chainRelay := chainHandle.ThresholdRelay()
+
+			chainConfig, err := chainRelay.GetConfig()
+			if err != nil {
+				t.Fatal(err)
+			}
+			initialConfig := *chainConfig
+			initialConfig.ResultPublicationBlockStep = test.initialBlockStep
+			changedConfig := *chainConfig
+			changedConfig.ResultPublicationBlockStep = test.changedBlockStep
+
+			var report *SubmissionReport
+			member := NewSubmittingMember(
+				memberIndex,
+				SubmissionConfig{
+					Observer: func(r *SubmissionReport) { report = r },
+				},
+			)
+
+			err = member.SubmitDKGResult(
+				&relayChain.DKGResult{GroupPublicKey: []byte{123, 45}},
+				map[group.MemberIndex][]byte{
+					1: []byte{101},
+					2: []byte{102},
+					3: []byte{103},
+					4: []byte{104},
+				},
+				&changingConfigChain{
+					Interface: chainRelay,
+					initial:   &initialConfig,
+					changed:   &changedConfig,
+				},
+				blockCounter,
+				startBlockHeight,
+			)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			if report.Outcome != SubmissionSubmitted {
+				t.Fatalf(
+					"unexpected outcome\nexpected: %v\nactual:   %v\n",
+					SubmissionSubmitted,
+					report.Outcome,
+				)
+			}
+
+			expectedBlockHeight := startBlockHeight + test.expectedBlockOffset
+			if report.BlockHeight != expectedBlockHeight {
+				t.Errorf(
+					"unexpected submission block\nexpected: %v\nactual:   %v\n",
+					expectedBlockHeight,
+					report.BlockHeight,
+				)
+			}
+		})
+	}
+}
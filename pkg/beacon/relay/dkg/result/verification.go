@@ -0,0 +1,257 @@
+package result
+
+import (
+	"bytes"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"sort"
+	"strings"
+
+	relayChain "github.com/keep-network/keep-core/pkg/beacon/relay/chain"
+	"github.com/keep-network/keep-core/pkg/beacon/relay/config"
+	"github.com/keep-network/keep-core/pkg/beacon/relay/group"
+	"github.com/keep-network/keep-core/pkg/chain"
+)
+
+// ResultFile is the DKG result as stored in the result file. Byte fields are
+// hex-encoded.
+type ResultFile struct {
+	GroupPublicKey string `json:"groupPublicKey"`
+	Misbehaved     string `json:"misbehaved"`
+}
+
+// MemberSignature is a signature over the DKG result hash collected from the
+// group member with the given index, along with the member's public key. Byte
+// fields are hex-encoded in the signatures file.
+type MemberSignature struct {
+	MemberIndex group.MemberIndex `json:"memberIndex"`
+	PublicKey   hexBytes          `json:"publicKey"`
+	Signature   hexBytes          `json:"signature"`
+}
+
+// SignatureSetVerification is the outcome of verifying a set of signatures
+// collected for the DKG result.
+type SignatureSetVerification struct {
+	// Valid contains indexes of members whose signature is valid, in
+	// ascending order.
+	Valid []group.MemberIndex
+	// Invalid contains indexes of members whose signature is invalid, in
+	// ascending order.
+	Invalid []group.MemberIndex
+	// Missing contains indexes of group members who provided no signature,
+	// in ascending order.
+	Missing []group.MemberIndex
+	// Quorum is the quorum status of valid signatures.
+	Quorum *QuorumStatus
+}
+
+// ReadResultFile reads the DKG result from the JSON file at the given path.
+func ReadResultFile(path string) (*relayChain.DKGResult, error) {
+	content, err := ioutil.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("could not read result file: [%v]", err)
+	}
+
+	resultFile := &ResultFile{}
+	if err := json.Unmarshal(content, resultFile); err != nil {
+		return nil, fmt.Errorf("could not parse result file: [%v]", err)
+	}
+
+	groupPublicKey, err := decodeHex(resultFile.GroupPublicKey)
+	if err != nil {
+		return nil, fmt.Errorf("could not parse group public key: [%v]", err)
+	}
+
+	misbehaved, err := decodeHex(resultFile.Misbehaved)
+	if err != nil {
+		return nil, fmt.Errorf("could not parse misbehaved members: [%v]", err)
+	}
+
+	return &relayChain.DKGResult{
+		GroupPublicKey: groupPublicKey,
+		Misbehaved:     misbehaved,
+	}, nil
+}
+
+// ReadSignaturesFile reads signatures collected for the DKG result from the
+// JSON file at the given path.
+func ReadSignaturesFile(path string) ([]*MemberSignature, error) {
+	content, err := ioutil.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("could not read signatures file: [%v]", err)
+	}
+
+	var signatures []*MemberSignature
+	if err := json.Unmarshal(content, &signatures); err != nil {
+		return nil, fmt.Errorf("could not parse signatures file: [%v]", err)
+	}
+
+	return signatures, nil
+}
+
+// ReadWeightsFile reads weights of group members in the weighted signature
+// quorum from the JSON file at the given path. The file is a JSON object
+// mapping member indexes to their non-negative weights.
+func ReadWeightsFile(path string) (MemberWeights, error) {
+	content, err := ioutil.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("could not read weights file: [%v]", err)
+	}
+
+	weights := make(MemberWeights)
+	if err := json.Unmarshal(content, &weights); err != nil {
+		return nil, fmt.Errorf("could not parse weights file: [%v]", err)
+	}
+
+	for memberIndex, weight := range weights {
+		if weight < 0 {
+			return nil, fmt.Errorf(
+				"weight of member [%v] must not be negative",
+				memberIndex,
+			)
+		}
+	}
+
+	return weights, nil
+}
+
+// VerifySignatureSet verifies each of the given signatures against the DKG
+// result hash and determines if valid signatures meet the signature threshold
+// of the chain. A signature is valid if it has been produced with the given
+// public key, the key belongs to the group member with the signature's index
+// and the member provided no other signature. Group members are given as
+// staker addresses ordered by member index. If member weights are set, the
+// weighted quorum of valid signatures is determined.
+func VerifySignatureSet(
+	resultHash relayChain.DKGResultHash,
+	signatures []*MemberSignature,
+	members []relayChain.StakerAddress,
+	signing chain.Signing,
+	chainConfig *config.Chain,
+	weights MemberWeights,
+) *SignatureSetVerification {
+	signaturesCount := make(map[group.MemberIndex]int)
+	for _, signature := range signatures {
+		signaturesCount[signature.MemberIndex]++
+	}
+
+	validSignatures := make(map[group.MemberIndex][]byte)
+	valid := make(map[group.MemberIndex]bool)
+	invalid := make(map[group.MemberIndex]bool)
+
+	for _, signature := range signatures {
+		memberIndex := signature.MemberIndex
+
+		if reason := invalidSignatureReason(
+			resultHash,
+			signature,
+			signaturesCount[memberIndex],
+			members,
+			signing,
+		); reason != "" {
+			logger.Warningf(
+				"signature of member [%v] is invalid: %v",
+				memberIndex,
+				reason,
+			)
+			invalid[memberIndex] = true
+			continue
+		}
+
+		validSignatures[memberIndex] = signature.Signature
+		valid[memberIndex] = true
+	}
+
+	missing := make([]group.MemberIndex, 0)
+	for i := 1; i <= chainConfig.GroupSize; i++ {
+		memberIndex := group.MemberIndex(i)
+		if signaturesCount[memberIndex] == 0 {
+			missing = append(missing, memberIndex)
+		}
+	}
+
+	return &SignatureSetVerification{
+		Valid:   sortedMemberIndexes(valid),
+		Invalid: sortedMemberIndexes(invalid),
+		Missing: missing,
+		Quorum:  DetermineWeightedQuorumStatus(validSignatures, chainConfig, weights),
+	}
+}
+
+func invalidSignatureReason(
+	resultHash relayChain.DKGResultHash,
+	signature *MemberSignature,
+	memberSignaturesCount int,
+	members []relayChain.StakerAddress,
+	signing chain.Signing,
+) string {
+	memberIndex := signature.MemberIndex
+
+	if memberIndex < 1 || int(memberIndex) > len(members) {
+		return "not a group member"
+	}
+
+	if memberSignaturesCount > 1 {
+		return "multiple signatures provided"
+	}
+
+	address := signing.PublicKeyBytesToAddress(signature.PublicKey)
+	if !bytes.Equal(address, members[memberIndex-1]) {
+		return "public key does not belong to the member"
+	}
+
+	ok, err := signing.VerifyWithPublicKey(
+		resultHash[:],
+		signature.Signature,
+		signature.PublicKey,
+	)
+	if err != nil {
+		return fmt.Sprintf("verification failed [%v]", err)
+	}
+	if !ok {
+		return "signature does not match the result hash"
+	}
+
+	return ""
+}
+
+func sortedMemberIndexes(set map[group.MemberIndex]bool) []group.MemberIndex {
+	memberIndexes := make([]group.MemberIndex, 0, len(set))
+	for memberIndex := range set {
+		memberIndexes = append(memberIndexes, memberIndex)
+	}
+
+	sort.Slice(memberIndexes, func(i, j int) bool {
+		return memberIndexes[i] < memberIndexes[j]
+	})
+
+	return memberIndexes
+}
+
+// hexBytes are bytes hex-encoded in JSON.
+type hexBytes []byte
+
+func (hb hexBytes) MarshalJSON() ([]byte, error) {
+	return json.Marshal("0x" + hex.EncodeToString(hb))
+}
+
+func (hb *hexBytes) UnmarshalJSON(data []byte) error {
+	var encoded string
+	if err := json.Unmarshal(data, &encoded); err != nil {
+		return err
+	}
+
+	decoded, err := decodeHex(encoded)
+	if err != nil {
+		return err
+	}
+
+	*hb = decoded
+	return nil
+}
+
+func decodeHex(encoded string) ([]byte, error) {
+	return hex.DecodeString(strings.TrimPrefix(encoded, "0x"))
+}
@@ -0,0 +1,271 @@
+package result
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"math/big"
+	"path/filepath"
+	"reflect"
+	"testing"
+
+	relayChain "github.com/keep-network/keep-core/pkg/beacon/relay/chain"
+	"github.com/keep-network/keep-core/pkg/beacon/relay/group"
+	"github.com/keep-network/keep-core/pkg/chain"
+	"github.com/keep-network/keep-core/pkg/chain/local"
+)
+
+func TestVerifySignatureSet(t *testing.T) {
+	groupSize := 5
+	honestThreshold := 3
+
+	signings := make([]chain.Signing, groupSize)
+	members := make([]relayChain.StakerAddress, groupSize)
+	for i := range signings {
+		operatorKey, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+		if err != nil {
+			t.Fatal(err)
+		}
+		signings[i] = local.ConnectWithKey(
+			groupSize,
+			honestThreshold,
+			big.NewInt(200),
+			operatorKey,
+		).Signing()
+		members[i] = signings[i].PublicKeyBytesToAddress(signings[i].PublicKey())
+	}
+
+	chainHandle := local.Connect(groupSize, honestThreshold, big.NewInt(200))
+	chainConfig, err := chainHandle.ThresholdRelay().GetConfig()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	resultHash, err := chainHandle.ThresholdRelay().CalculateDKGResultHash(
+		&relayChain.DKGResult{GroupPublicKey: []byte{123, 45}},
+	)
+	if err != nil {
+		t.Fatal(err)
+	}
+	otherHash, err := chainHandle.ThresholdRelay().CalculateDKGResultHash(
+		&relayChain.DKGResult{GroupPublicKey: []byte{67, 89}},
+	)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	sign := func(memberIndex group.MemberIndex, hash relayChain.DKGResultHash) *MemberSignature {
+		signing := signings[memberIndex-1]
+		signature, err := signing.Sign(hash[:])
+		if err != nil {
+			t.Fatal(err)
+		}
+		return &MemberSignature{
+			MemberIndex: memberIndex,
+			PublicKey:   signing.PublicKey(),
+			Signature:   signature,
+		}
+	}
+
+	// signature of member 2 claimed by member 3
+	impersonated := sign(2, resultHash)
+	impersonated.MemberIndex = 3
+
+	var tests = map[string]struct {
+		signatures        []*MemberSignature
+		expectedValid     []group.MemberIndex
+		expectedInvalid   []group.MemberIndex
+		expectedMissing   []group.MemberIndex
+		expectedThreshold bool
+	}{
+		"threshold met with invalid signatures mixed in": {
+			signatures: []*MemberSignature{
+				sign(1, resultHash),
+				sign(2, resultHash),
+				sign(3, otherHash),
+				sign(4, resultHash),
+				sign(5, resultHash),
+			},
+			expectedValid:     []group.MemberIndex{1, 2, 4, 5},
+			expectedInvalid:   []group.MemberIndex{3},
+			expectedMissing:   []group.MemberIndex{},
+			expectedThreshold: true,
+		},
+		"threshold not met with invalid signatures mixed in": {
+			signatures: []*MemberSignature{
+				sign(1, resultHash),
+				sign(2, otherHash),
+				impersonated,
+				sign(5, resultHash),
+			},
+			expectedValid:     []group.MemberIndex{1, 5},
+			expectedInvalid:   []group.MemberIndex{2, 3},
+			expectedMissing:   []group.MemberIndex{4},
+			expectedThreshold: false,
+		},
+		"duplicated signatures are invalid": {
+			signatures: []*MemberSignature{
+				sign(1, resultHash),
+				sign(2, resultHash),
+				sign(3, resultHash),
+				sign(3, resultHash),
+				sign(4, resultHash),
+			},
+			expectedValid:     []group.MemberIndex{1, 2, 4},
+			expectedInvalid:   []group.MemberIndex{3},
+			expectedMissing:   []group.MemberIndex{5},
+			expectedThreshold: false,
+		},
+	}
+
+	for testName, test := range tests {
+		t.Run(testName, func(t *testing.T) {
+			verification := VerifySignatureSet(
+				resultHash,
+				test.signatures,
+				members,
+				signings[0],
+				chainConfig,
+				nil,
+			)
+
+			if !reflect.DeepEqual(test.expectedValid, verification.Valid) {
+				t.Errorf(
+					"unexpected valid signatures\nexpected: %v\nactual:   %v\n",
+					test.expectedValid,
+					verification.Valid,
+				)
+			}
+			if !reflect.DeepEqual(test.expectedInvalid, verification.Invalid) {
+				t.Errorf(
+					"unexpected invalid signatures\nexpected: %v\nactual:   %v\n",
+					test.expectedInvalid,
+					verification.Invalid,
+				)
+			}
+			if !reflect.DeepEqual(test.expectedMissing, verification.Missing) {
+				t.Errorf(
+					"unexpected missing signatures\nexpected: %v\nactual:   %v\n",
+					test.expectedMissing,
+					verification.Missing,
+				)
+			}
+			if test.expectedThreshold != verification.Quorum.Met {
+				t.Errorf(
+					"unexpected threshold status\nexpected: %v\nactual:   %v\n",
+					test.expectedThreshold,
+					verification.Quorum.Met,
+				)
+			}
+		})
+	}
+}
+
+func TestReadSignatureVerificationFiles(t *testing.T) {
+	dir, err := ioutil.TempDir("", "signatures")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	resultPath := filepath.Join(dir, "result.json")
+	err = ioutil.WriteFile(
+		resultPath,
+		[]byte(`{"groupPublicKey": "0x7b2d", "misbehaved": "0x03"}`),
+		0600,
+	)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	expectedSignatures := []*MemberSignature{
+		{MemberIndex: 1, PublicKey: []byte{1, 2}, Signature: []byte{3, 4}},
+		{MemberIndex: 4, PublicKey: []byte{5, 6}, Signature: []byte{7, 8}},
+	}
+	content, err := json.Marshal(expectedSignatures)
+	if err != nil {
+		t.Fatal(err)
+	}
+	signaturesPath := filepath.Join(dir, "signatures.json")
+	if err := ioutil.WriteFile(signaturesPath, content, 0600); err != nil {
+		t.Fatal(err)
+	}
+
+	result, err := ReadResultFile(resultPath)
+	if err != nil {
+		t.Fatal(err)
+	}
+	expectedResult := &relayChain.DKGResult{
+		GroupPublicKey: []byte{123, 45},
+		Misbehaved:     []byte{3},
+	}
+	if !reflect.DeepEqual(expectedResult, result) {
+		t.Errorf(
+			"unexpected result\nexpected: %+v\nactual:   %+v\n",
+			expectedResult,
+			result,
+		)
+	}
+
+	signatures, err := ReadSignaturesFile(signaturesPath)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !reflect.DeepEqual(expectedSignatures, signatures) {
+		t.Errorf(
+			"unexpected signatures\nexpected: %v\nactual:   %v\n",
+			expectedSignatures,
+			signatures,
+		)
+	}
+}
+
+func TestReadWeightsFile(t *testing.T) {
+	dir, err := ioutil.TempDir("", "weights")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var tests = map[string]struct {
+		content         string
+		expectedWeights MemberWeights
+		expectedError   error
+	}{
+		"valid weights": {
+			content:         `{"1": 10, "3": 40, "4": 0}`,
+			expectedWeights: MemberWeights{1: 10, 3: 40, 4: 0},
+		},
+		"negative weight": {
+			content:       `{"1": 10, "2": -5}`,
+			expectedError: fmt.Errorf("weight of member [2] must not be negative"),
+		},
+	}
+
+	for testName, test := range tests {
+		t.Run(testName, func(t *testing.T) {
+			path := filepath.Join(dir, "weights.json")
+			if err := ioutil.WriteFile(path, []byte(test.content), 0600); err != nil {
+				t.Fatal(err)
+			}
+
+			weights, err := ReadWeightsFile(path)
+			if !reflect.DeepEqual(test.expectedError, err) {
+				t.Errorf(
+					"unexpected error\nexpected: %v\nactual:   %v\n",
+					test.expectedError,
+					err,
+				)
+			}
+			if test.expectedError == nil &&
+				!reflect.DeepEqual(test.expectedWeights, weights) {
+				t.Errorf(
+					"unexpected weights\nexpected: %v\nactual:   %v\n",
+					test.expectedWeights,
+					weights,
+				)
+			}
+		})
+	}
+}
@@ -0,0 +1,45 @@
+package skew
+
+import (
+	"fmt"
+
+	"github.com/keep-network/keep-core/pkg/beacon/relay/dkg/skew/gen/pb"
+	"github.com/keep-network/keep-core/pkg/beacon/relay/group"
+)
+
+// MemberIndex is represented as uint8 in gjkr. Protobuf does not have uint8
+// type so we are using uint32. When unmarshalling message, we need to make
+// sure we do not overflow.
+const maxMemberIndex = 255
+
+// Type returns a string describing a BlockHeightMessage type for marshalling
+// purposes.
+func (bhm *BlockHeightMessage) Type() string {
+	return "skew/block_height_message"
+}
+
+// Marshal converts this BlockHeightMessage to a byte array suitable for
+// network communication.
+func (bhm *BlockHeightMessage) Marshal() ([]byte, error) {
+	return (&pb.BlockHeight{
+		SenderID:    uint32(bhm.senderID),
+		BlockHeight: bhm.blockHeight,
+	}).Marshal()
+}
+
+// Unmarshal converts a byte array produced by Marshal to a BlockHeightMessage.
+func (bhm *BlockHeightMessage) Unmarshal(bytes []byte) error {
+	pbMsg := pb.BlockHeight{}
+	if err := pbMsg.Unmarshal(bytes); err != nil {
+		return err
+	}
+
+	if pbMsg.SenderID > maxMemberIndex {
+		return fmt.Errorf("invalid member index value: [%v]", pbMsg.SenderID)
+	}
+
+	bhm.senderID = group.MemberIndex(pbMsg.SenderID)
+	bhm.blockHeight = pbMsg.BlockHeight
+
+	return nil
+}
@@ -0,0 +1,52 @@
+package skew
+
+import (
+	"reflect"
+	"testing"
+
+	fuzz "github.com/google/gofuzz"
+	"github.com/keep-network/keep-core/pkg/beacon/relay/group"
+	"github.com/keep-network/keep-core/pkg/internal/pbutils"
+)
+
+func TestBlockHeightMessageRoundtrip(t *testing.T) {
+	msg := &BlockHeightMessage{
+		senderID:    7,
+		blockHeight: 9123456,
+	}
+
+	unmarshaled := &BlockHeightMessage{}
+
+	err := pbutils.RoundTrip(msg, unmarshaled)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !reflect.DeepEqual(msg, unmarshaled) {
+		t.Fatalf("unexpected content of unmarshaled message")
+	}
+}
+
+func TestFuzzBlockHeightMessageRoundtrip(t *testing.T) {
+	for i := 0; i < 10; i++ {
+		var (
+			senderID    group.MemberIndex
+			blockHeight uint64
+		)
+
+		f := fuzz.New().NilChance(0.1)
+
+		f.Fuzz(&senderID)
+		f.Fuzz(&blockHeight)
+
+		message := &BlockHeightMessage{
+			senderID:    senderID,
+			blockHeight: blockHeight,
+		}
+
+		_ = pbutils.RoundTrip(message, &BlockHeightMessage{})
+	}
+}
+
+func TestFuzzBlockHeightMessageUnmarshaler(t *testing.T) {
+	pbutils.FuzzUnmarshaler(&BlockHeightMessage{})
+}
@@ -0,0 +1,20 @@
+package skew
+
+import "github.com/keep-network/keep-core/pkg/beacon/relay/group"
+
+// BlockHeightMessage announces the current block height observed by the
+// sending member.
+type BlockHeightMessage struct {
+	senderID    group.MemberIndex
+	blockHeight uint64
+}
+
+// SenderID returns the index of the member who sent the message.
+func (bhm *BlockHeightMessage) SenderID() group.MemberIndex {
+	return bhm.senderID
+}
+
+// BlockHeight returns the block height observed by the sender.
+func (bhm *BlockHeightMessage) BlockHeight() uint64 {
+	return bhm.blockHeight
+}
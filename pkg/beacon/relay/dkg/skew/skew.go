@@ -0,0 +1,148 @@
+// Package skew detects if the block height observed by the node skews from
+// the block heights observed by other members of the group. Members determine
+// their eligibility to act based on the current block height, so a node
+// connected to a lagging Ethereum endpoint acts at different blocks than the
+// rest of the group.
+package skew
+
+import (
+	"context"
+	"sort"
+	"sync"
+
+	"github.com/ipfs/go-log"
+	"github.com/keep-network/keep-core/pkg/beacon/relay/group"
+	"github.com/keep-network/keep-core/pkg/net"
+)
+
+var logger = log.Logger("keep-skew")
+
+// Skew describes how the block height observed by the node differs from the
+// median of block heights observed by the group.
+type Skew struct {
+	// BlockHeight is the block height observed by the node.
+	BlockHeight uint64
+	// MedianBlockHeight is the median of block heights observed by the
+	// group, the node included.
+	MedianBlockHeight uint64
+	// Observations is the number of block heights the median has been
+	// calculated from, the node's own included.
+	Observations int
+}
+
+// Blocks returns the number of blocks the node's block height is ahead of the
+// group median; negative if the node lags behind the group.
+func (s *Skew) Blocks() int64 {
+	return int64(s.BlockHeight) - int64(s.MedianBlockHeight)
+}
+
+// Exceeds returns true if the node's block height differs from the group
+// median by more than the given number of blocks.
+func (s *Skew) Exceeds(threshold uint64) bool {
+	blocks := s.Blocks()
+	if blocks < 0 {
+		blocks = -blocks
+	}
+
+	return uint64(blocks) > threshold
+}
+
+// Calculate returns the skew of the given block height observed by the node
+// from the median of block heights observed by the group, that is the given
+// block heights observed by other members and the node's own one. For an even
+// number of observations, the lower median is used.
+func Calculate(blockHeight uint64, observed []uint64) *Skew {
+	all := make([]uint64, 0, len(observed)+1)
+	all = append(all, blockHeight)
+	all = append(all, observed...)
+
+	sort.Slice(all, func(i, j int) bool { return all[i] < all[j] })
+
+	return &Skew{
+		BlockHeight:       blockHeight,
+		MedianBlockHeight: all[(len(all)-1)/2],
+		Observations:      len(all),
+	}
+}
+
+// RegisterUnmarshallers initializes the given broadcast channel to be able to
+// exchange block heights by registering all the required message
+// unmarshallers.
+func RegisterUnmarshallers(channel net.BroadcastChannel) {
+	channel.RegisterUnmarshaler(func() net.TaggedUnmarshaler {
+		return &BlockHeightMessage{}
+	})
+}
+
+// Check announces the given block height observed by the member with the
+// given index on the broadcast channel and collects block heights announced
+// by other members until the context is done. Then, it calculates the skew of
+// the node's block height from the group median and logs a warning if the
+// skew exceeds the given threshold. Only the latest announcement of each
+// member is taken into account.
+func Check(
+	ctx context.Context,
+	channel net.BroadcastChannel,
+	memberIndex group.MemberIndex,
+	blockHeight uint64,
+	threshold uint64,
+) (*Skew, error) {
+	mutex := &sync.Mutex{}
+	observed := make(map[group.MemberIndex]uint64)
+
+	channel.Recv(ctx, func(message net.Message) {
+		announcement, ok := message.Payload().(*BlockHeightMessage)
+		if !ok || announcement.senderID == memberIndex {
+			return
+		}
+
+		mutex.Lock()
+		observed[announcement.senderID] = announcement.blockHeight
+		mutex.Unlock()
+	})
+
+	err := channel.Send(ctx, &BlockHeightMessage{
+		senderID:    memberIndex,
+		blockHeight: blockHeight,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	<-ctx.Done()
+
+	mutex.Lock()
+	blockHeights := make([]uint64, 0, len(observed))
+	for _, observedBlockHeight := range observed {
+		blockHeights = append(blockHeights, observedBlockHeight)
+	}
+	mutex.Unlock()
+
+	skew := Calculate(blockHeight, blockHeights)
+	if skew.Exceeds(threshold) {
+		logger.Warningf(
+			"[member:%v] observed block height [%v] skews by [%v] blocks "+
+				"from the median block height [%v] observed by [%v] members "+
+				"of the group; please check if the Ethereum endpoint is "+
+				"in sync",
+			memberIndex,
+			skew.BlockHeight,
+			skew.Blocks(),
+			skew.MedianBlockHeight,
+			skew.Observations,
+		)
+	} else {
+		logger.Debugf(
+			"[member:%v] observed block height [%v] is within [%v] blocks "+
+				"from the median block height [%v] observed by [%v] members "+
+				"of the group",
+			memberIndex,
+			skew.BlockHeight,
+			threshold,
+			skew.MedianBlockHeight,
+			skew.Observations,
+		)
+	}
+
+	return skew, nil
+}
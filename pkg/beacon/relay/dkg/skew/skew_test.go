@@ -0,0 +1,197 @@
+package skew
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/keep-network/keep-core/pkg/beacon/relay/group"
+	"github.com/keep-network/keep-core/pkg/net"
+	"github.com/keep-network/keep-core/pkg/net/key"
+	netLocal "github.com/keep-network/keep-core/pkg/net/local"
+)
+
+func TestCalculate(t *testing.T) {
+	var tests = map[string]struct {
+		blockHeight          uint64
+		observed             []uint64
+		threshold            uint64
+		expectedMedian       uint64
+		expectedBlocks       int64
+		expectedExceeds      bool
+		expectedObservations int
+	}{
+		"no other observations": {
+			blockHeight:          100,
+			observed:             []uint64{},
+			threshold:            0,
+			expectedMedian:       100,
+			expectedBlocks:       0,
+			expectedExceeds:      false,
+			expectedObservations: 1,
+		},
+		"in sync with the group": {
+			blockHeight:          100,
+			observed:             []uint64{101, 99, 100, 100},
+			threshold:            2,
+			expectedMedian:       100,
+			expectedBlocks:       0,
+			expectedExceeds:      false,
+			expectedObservations: 5,
+		},
+		"lagging within threshold": {
+			blockHeight:          98,
+			observed:             []uint64{100, 100, 101, 100},
+			threshold:            2,
+			expectedMedian:       100,
+			expectedBlocks:       -2,
+			expectedExceeds:      false,
+			expectedObservations: 5,
+		},
+		"lagging beyond threshold": {
+			blockHeight:          90,
+			observed:             []uint64{100, 100, 101, 100},
+			threshold:            2,
+			expectedMedian:       100,
+			expectedBlocks:       -10,
+			expectedExceeds:      true,
+			expectedObservations: 5,
+		},
+		"ahead beyond threshold": {
+			blockHeight:          110,
+			observed:             []uint64{100, 100, 101, 100},
+			threshold:            2,
+			expectedMedian:       100,
+			expectedBlocks:       10,
+			expectedExceeds:      true,
+			expectedObservations: 5,
+		},
+		"even number of observations": {
+			blockHeight:          100,
+			observed:             []uint64{104, 102, 106},
+			threshold:            3,
+			expectedMedian:       102,
+			expectedBlocks:       -2,
+			expectedExceeds:      false,
+			expectedObservations: 4,
+		},
+	}
+
+	for testName, test := range tests {
+		t.Run(testName, func(t *testing.T) {
+			skew := Calculate(test.blockHeight, test.observed)
+
+			if skew.MedianBlockHeight != test.expectedMedian {
+				t.Errorf(
+					"unexpected median block height\nexpected: %v\nactual:   %v\n",
+					test.expectedMedian,
+					skew.MedianBlockHeight,
+				)
+			}
+			if skew.Blocks() != test.expectedBlocks {
+				t.Errorf(
+					"unexpected skew\nexpected: %v\nactual:   %v\n",
+					test.expectedBlocks,
+					skew.Blocks(),
+				)
+			}
+			if skew.Exceeds(test.threshold) != test.expectedExceeds {
+				t.Errorf(
+					"unexpected threshold check result\nexpected: %v\nactual:   %v\n",
+					test.expectedExceeds,
+					skew.Exceeds(test.threshold),
+				)
+			}
+			if skew.Observations != test.expectedObservations {
+				t.Errorf(
+					"unexpected number of observations\nexpected: %v\nactual:   %v\n",
+					test.expectedObservations,
+					skew.Observations,
+				)
+			}
+		})
+	}
+}
+
+func TestCheckDetectsLaggingMember(t *testing.T) {
+	threshold := uint64(5)
+	blockHeights := map[group.MemberIndex]uint64{
+		1: 1000,
+		2: 1001,
+		3: 1000,
+		4: 1002,
+		5: 980, // lagging behind the group
+	}
+
+	channels := make(map[group.MemberIndex]net.BroadcastChannel)
+	for memberIndex := range blockHeights {
+		_, staticKey, err := key.GenerateStaticNetworkKey()
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		channel, err := netLocal.ConnectWithKey(staticKey).BroadcastChannelFor(
+			"skew-test",
+		)
+		if err != nil {
+			t.Fatal(err)
+		}
+		RegisterUnmarshallers(channel)
+
+		channels[memberIndex] = channel
+	}
+
+	ctx, cancelCtx := context.WithTimeout(
+		context.Background(),
+		500*time.Millisecond,
+	)
+	defer cancelCtx()
+
+	type checkResult struct {
+		memberIndex group.MemberIndex
+		skew        *Skew
+		err         error
+	}
+
+	results := make(chan *checkResult, len(blockHeights))
+	for memberIndex, blockHeight := range blockHeights {
+		go func(memberIndex group.MemberIndex, blockHeight uint64) {
+			skew, err := Check(
+				ctx,
+				channels[memberIndex],
+				memberIndex,
+				blockHeight,
+				threshold,
+			)
+			results <- &checkResult{memberIndex, skew, err}
+		}(memberIndex, blockHeight)
+	}
+
+	for range blockHeights {
+		result := <-results
+		if result.err != nil {
+			t.Fatal(result.err)
+		}
+
+		if result.skew.Observations != len(blockHeights) {
+			t.Errorf(
+				"unexpected number of observations for member [%v]\n"+
+					"expected: %v\nactual:   %v\n",
+				result.memberIndex,
+				len(blockHeights),
+				result.skew.Observations,
+			)
+		}
+
+		expectedExceeds := result.memberIndex == 5
+		if result.skew.Exceeds(threshold) != expectedExceeds {
+			t.Errorf(
+				"unexpected threshold check result for member [%v]\n"+
+					"expected: %v\nactual:   %v\n",
+				result.memberIndex,
+				expectedExceeds,
+				result.skew.Exceeds(threshold),
+			)
+		}
+	}
+}
@@ -0,0 +1,243 @@
+package transcript
+
+import (
+	"io"
+	"math/big"
+	"sync"
+
+	relayChain "github.com/keep-network/keep-core/pkg/beacon/relay/chain"
+	"github.com/keep-network/keep-core/pkg/beacon/relay/group"
+	"github.com/keep-network/keep-core/pkg/beacon/relay/state"
+	"github.com/keep-network/keep-core/pkg/chain"
+	"github.com/keep-network/keep-core/pkg/net"
+)
+
+// Recorder records the transcript of a member's DKG execution. The recorder
+// is attached to the execution by decorating the member's source of
+// randomness, protocol states and chain interactions with the recorder's
+// counterparts.
+type Recorder struct {
+	mutex      sync.Mutex
+	transcript *Transcript
+}
+
+// NewRecorder creates a recorder of the DKG execution for the given seed
+// started at the given block and executed by the member with the given index.
+func NewRecorder(
+	seed *big.Int,
+	memberIndex group.MemberIndex,
+	groupSize int,
+	dishonestThreshold int,
+	startBlockHeight uint64,
+) *Recorder {
+	return &Recorder{
+		transcript: &Transcript{
+			Seed:               seed,
+			MemberIndex:        memberIndex,
+			GroupSize:          groupSize,
+			DishonestThreshold: dishonestThreshold,
+			StartBlockHeight:   startBlockHeight,
+			Randomness:         make([]byte, 0),
+			Messages:           make([]*Message, 0),
+			MembershipChecks:   make([]*MembershipCheck, 0),
+			SignatureChecks:    make([]*SignatureCheck, 0),
+		},
+	}
+}
+
+// Transcript returns the transcript recorded so far.
+func (r *Recorder) Transcript() *Transcript {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+
+	transcript := *r.transcript
+	return &transcript
+}
+
+// Randomness returns a source of randomness reading from the given source and
+// recording all the read bytes.
+func (r *Recorder) Randomness(source io.Reader) io.Reader {
+	return &recordingReader{source, r}
+}
+
+type recordingReader struct {
+	source   io.Reader
+	recorder *Recorder
+}
+
+func (rr *recordingReader) Read(p []byte) (int, error) {
+	n, err := rr.source.Read(p)
+
+	rr.recorder.mutex.Lock()
+	rr.recorder.transcript.Randomness = append(
+		rr.recorder.transcript.Randomness,
+		p[:n]...,
+	)
+	rr.recorder.mutex.Unlock()
+
+	return n, err
+}
+
+// MembershipValidator returns a membership validator delegating to the given
+// validator and recording results of all membership validations.
+func (r *Recorder) MembershipValidator(
+	validator group.MembershipValidator,
+) group.MembershipValidator {
+	return &recordingMembershipValidator{validator, r}
+}
+
+type recordingMembershipValidator struct {
+	group.MembershipValidator
+	recorder *Recorder
+}
+
+func (rmv *recordingMembershipValidator) IsValidMembership(
+	memberID group.MemberIndex,
+	publicKey []byte,
+) bool {
+	valid := rmv.MembershipValidator.IsValidMembership(memberID, publicKey)
+
+	rmv.recorder.mutex.Lock()
+	rmv.recorder.transcript.MembershipChecks = append(
+		rmv.recorder.transcript.MembershipChecks,
+		&MembershipCheck{
+			MemberIndex: memberID,
+			PublicKey:   publicKey,
+			Valid:       valid,
+		},
+	)
+	rmv.recorder.mutex.Unlock()
+
+	return valid
+}
+
+// Signing returns a signing delegating to the given signing and recording
+// the member's signature along with results of all signature verifications
+// with a provided public key.
+func (r *Recorder) Signing(signing chain.Signing) chain.Signing {
+	return &recordingSigning{signing, r}
+}
+
+type recordingSigning struct {
+	chain.Signing
+	recorder *Recorder
+}
+
+func (rs *recordingSigning) Sign(message []byte) ([]byte, error) {
+	signature, err := rs.Signing.Sign(message)
+	if err != nil {
+		return nil, err
+	}
+
+	rs.recorder.mutex.Lock()
+	rs.recorder.transcript.ResultSignature = signature
+	rs.recorder.transcript.PublicKey = rs.Signing.PublicKey()
+	rs.recorder.mutex.Unlock()
+
+	return signature, nil
+}
+
+func (rs *recordingSigning) VerifyWithPublicKey(
+	message []byte,
+	signature []byte,
+	publicKey []byte,
+) (bool, error) {
+	valid, err := rs.Signing.VerifyWithPublicKey(message, signature, publicKey)
+
+	rs.recorder.mutex.Lock()
+	rs.recorder.transcript.SignatureChecks = append(
+		rs.recorder.transcript.SignatureChecks,
+		&SignatureCheck{
+			Message:   message,
+			Signature: signature,
+			PublicKey: publicKey,
+			Valid:     valid && err == nil,
+		},
+	)
+	rs.recorder.mutex.Unlock()
+
+	return valid, err
+}
+
+// RelayChain returns a relay chain delegating to the given chain and recording
+// the calculated DKG result hash.
+func (r *Recorder) RelayChain(
+	relayChain relayChain.Interface,
+) relayChain.Interface {
+	return &recordingRelayChain{relayChain, r}
+}
+
+type recordingRelayChain struct {
+	relayChain.Interface
+	recorder *Recorder
+}
+
+func (rrc *recordingRelayChain) CalculateDKGResultHash(
+	dkgResult *relayChain.DKGResult,
+) (relayChain.DKGResultHash, error) {
+	resultHash, err := rrc.Interface.CalculateDKGResultHash(dkgResult)
+	if err != nil {
+		return resultHash, err
+	}
+
+	rrc.recorder.mutex.Lock()
+	rrc.recorder.transcript.ResultHash = resultHash[:]
+	rrc.recorder.mutex.Unlock()
+
+	return resultHash, nil
+}
+
+// State returns a state of the given protocol delegating to the given state
+// and recording all messages delivered to it. All states following the given
+// one are recorded as well.
+func (r *Recorder) State(protocol string, initialState state.State) state.State {
+	return &recordingState{initialState, r, protocol, 0}
+}
+
+type recordingState struct {
+	state.State
+	recorder *Recorder
+	protocol string
+	phase    int
+}
+
+func (rs *recordingState) Receive(msg net.Message) error {
+	if marshaler, ok := msg.Payload().(net.TaggedMarshaler); ok {
+		payload, err := marshaler.Marshal()
+		if err == nil {
+			rs.recorder.mutex.Lock()
+			rs.recorder.transcript.Messages = append(
+				rs.recorder.transcript.Messages,
+				&Message{
+					Protocol:        rs.protocol,
+					Phase:           rs.phase,
+					Type:            marshaler.Type(),
+					SenderPublicKey: msg.SenderPublicKey(),
+					Payload:         payload,
+				},
+			)
+			rs.recorder.mutex.Unlock()
+		}
+	}
+
+	return rs.State.Receive(msg)
+}
+
+func (rs *recordingState) Next() state.State {
+	next := rs.State.Next()
+	if next == nil {
+		return nil
+	}
+
+	return &recordingState{next, rs.recorder, rs.protocol, rs.phase + 1}
+}
+
+// Unwrap returns the protocol state decorated by the recorder or the given
+// state if it is not decorated.
+func Unwrap(s state.State) state.State {
+	if recording, ok := s.(*recordingState); ok {
+		return recording.State
+	}
+
+	return s
+}
@@ -0,0 +1,266 @@
+package transcript
+
+import (
+	"bytes"
+	"context"
+	"crypto/ecdsa"
+	"fmt"
+	"sync"
+
+	relayChain "github.com/keep-network/keep-core/pkg/beacon/relay/chain"
+	"github.com/keep-network/keep-core/pkg/beacon/relay/group"
+	"github.com/keep-network/keep-core/pkg/beacon/relay/state"
+	"github.com/keep-network/keep-core/pkg/chain"
+	"github.com/keep-network/keep-core/pkg/net"
+)
+
+// Channel is a broadcast channel the member replaying the transcript is
+// connected to. Messages sent by the member are discarded as all the messages
+// delivered to the member, including its own, are replayed from the
+// transcript.
+type Channel struct {
+	mutex        sync.Mutex
+	unmarshalers map[string]func() net.TaggedUnmarshaler
+}
+
+// NewChannel creates a broadcast channel for replaying a transcript.
+func NewChannel() *Channel {
+	return &Channel{
+		unmarshalers: make(map[string]func() net.TaggedUnmarshaler),
+	}
+}
+
+// Name returns the name of the replay channel.
+func (c *Channel) Name() string {
+	return "replay"
+}
+
+// Send discards the message.
+func (c *Channel) Send(ctx context.Context, m net.TaggedMarshaler) error {
+	return nil
+}
+
+// Recv does nothing as messages are delivered directly from the transcript.
+func (c *Channel) Recv(ctx context.Context, handler func(m net.Message)) {}
+
+// RegisterUnmarshaler registers an unmarshaler used to decode replayed
+// messages of the unmarshaler's type.
+func (c *Channel) RegisterUnmarshaler(
+	unmarshaler func() net.TaggedUnmarshaler,
+) error {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+
+	c.unmarshalers[unmarshaler().Type()] = unmarshaler
+	return nil
+}
+
+// SetFilter does nothing as replayed messages are not filtered.
+func (c *Channel) SetFilter(filter net.BroadcastChannelFilter) error {
+	return nil
+}
+
+func (c *Channel) decode(message *Message) (net.Message, error) {
+	c.mutex.Lock()
+	unmarshaler, ok := c.unmarshalers[message.Type]
+	c.mutex.Unlock()
+
+	if !ok {
+		return nil, fmt.Errorf(
+			"no unmarshaler registered for message type [%v]",
+			message.Type,
+		)
+	}
+
+	payload := unmarshaler()
+	if err := payload.Unmarshal(message.Payload); err != nil {
+		return nil, fmt.Errorf(
+			"could not unmarshal message of type [%v]: [%v]",
+			message.Type,
+			err,
+		)
+	}
+
+	return &replayedMessage{message, payload}, nil
+}
+
+type replayedMessage struct {
+	message *Message
+	payload interface{}
+}
+
+func (rm *replayedMessage) TransportSenderID() net.TransportIdentifier {
+	return nil
+}
+
+func (rm *replayedMessage) SenderPublicKey() []byte {
+	return rm.message.SenderPublicKey
+}
+
+func (rm *replayedMessage) Payload() interface{} {
+	return rm.payload
+}
+
+func (rm *replayedMessage) Type() string {
+	return rm.message.Type
+}
+
+func (rm *replayedMessage) Seqno() uint64 {
+	return 0
+}
+
+// Replay executes the given protocol starting from the given state. Each state
+// is initiated and receives messages delivered to the member in the same phase
+// of the original execution. Messages are decoded with unmarshalers registered
+// in the given channel. Replay stops once there are no more states or the
+// given function tells the state is the final one to replay, without
+// initiating it, and returns the last state.
+func (t *Transcript) Replay(
+	protocol string,
+	channel *Channel,
+	initialState state.State,
+	isFinal func(state.State) bool,
+) (state.State, error) {
+	currentState := initialState
+
+	for phase := 0; ; phase++ {
+		if isFinal != nil && isFinal(currentState) {
+			return currentState, nil
+		}
+
+		ctx, cancelCtx := context.WithCancel(context.Background())
+		err := currentState.Initiate(ctx)
+		if err != nil {
+			cancelCtx()
+			return nil, fmt.Errorf(
+				"failed to initiate state [%T]: [%v]",
+				currentState,
+				err,
+			)
+		}
+
+		for _, message := range t.messages(protocol, phase) {
+			msg, err := channel.decode(message)
+			if err != nil {
+				cancelCtx()
+				return nil, err
+			}
+
+			if err := currentState.Receive(msg); err != nil {
+				logger.Warningf(
+					"[member:%v,state:%T] failed to receive a message: [%v]",
+					currentState.MemberIndex(),
+					currentState,
+					err,
+				)
+			}
+		}
+		cancelCtx()
+
+		nextState := currentState.Next()
+		if nextState == nil {
+			return currentState, nil
+		}
+
+		currentState = nextState
+	}
+}
+
+// RandomnessSource returns a source of randomness reading the randomness
+// recorded in the transcript.
+func (t *Transcript) RandomnessSource() *bytes.Reader {
+	return bytes.NewReader(t.Randomness)
+}
+
+// MembershipValidator returns a membership validator answering with results
+// of membership validations recorded in the transcript. Validation of any
+// sender not recorded in the transcript fails.
+func (t *Transcript) MembershipValidator() group.MembershipValidator {
+	return &replayMembershipValidator{t}
+}
+
+type replayMembershipValidator struct {
+	transcript *Transcript
+}
+
+func (rmv *replayMembershipValidator) IsInGroup(
+	publicKey *ecdsa.PublicKey,
+) bool {
+	return true
+}
+
+func (rmv *replayMembershipValidator) IsValidMembership(
+	memberID group.MemberIndex,
+	publicKey []byte,
+) bool {
+	for _, check := range rmv.transcript.MembershipChecks {
+		if check.MemberIndex == memberID &&
+			bytes.Equal(check.PublicKey, publicKey) {
+			return check.Valid
+		}
+	}
+
+	return false
+}
+
+// Signing returns a signing producing the member's signature recorded in the
+// transcript and answering with results of signature verifications recorded
+// in the transcript. Verification of any signature not recorded in
+// the transcript fails.
+func (t *Transcript) Signing() chain.Signing {
+	return &replaySigning{transcript: t}
+}
+
+type replaySigning struct {
+	// Any other interaction with the signing panics as the embedded
+	// interface is nil.
+	chain.Signing
+	transcript *Transcript
+}
+
+func (rs *replaySigning) PublicKey() []byte {
+	return rs.transcript.PublicKey
+}
+
+func (rs *replaySigning) Sign(message []byte) ([]byte, error) {
+	if !bytes.Equal(message, rs.transcript.ResultHash) {
+		return nil, fmt.Errorf("message has not been signed by the member")
+	}
+
+	return rs.transcript.ResultSignature, nil
+}
+
+func (rs *replaySigning) VerifyWithPublicKey(
+	message []byte,
+	signature []byte,
+	publicKey []byte,
+) (bool, error) {
+	for _, check := range rs.transcript.SignatureChecks {
+		if bytes.Equal(check.Message, message) &&
+			bytes.Equal(check.Signature, signature) &&
+			bytes.Equal(check.PublicKey, publicKey) {
+			return check.Valid, nil
+		}
+	}
+
+	return false, nil
+}
+
+// RelayChain returns a relay chain calculating the DKG result hash recorded
+// in the transcript.
+func (t *Transcript) RelayChain() relayChain.Interface {
+	return &replayRelayChain{transcript: t}
+}
+
+type replayRelayChain struct {
+	// Any other interaction with the chain panics as the embedded
+	// interface is nil.
+	relayChain.Interface
+	transcript *Transcript
+}
+
+func (rrc *replayRelayChain) CalculateDKGResultHash(
+	dkgResult *relayChain.DKGResult,
+) (relayChain.DKGResultHash, error) {
+	return relayChain.DKGResultHashFromBytes(rrc.transcript.ResultHash)
+}
@@ -0,0 +1,77 @@
+package transcript
+
+import (
+	"fmt"
+	"sync"
+
+	"github.com/keep-network/keep-common/pkg/encryption"
+	"github.com/keep-network/keep-common/pkg/persistence"
+)
+
+// Storage persists DKG transcripts. Each transcript is encrypted and
+// authenticated before it is handed to the underlying persistence handle as
+// it contains the member's secrets.
+type Storage struct {
+	mutex sync.Mutex
+
+	handle persistence.Handle
+	box    encryption.Box
+}
+
+// NewStorage creates a transcript storage on top of the provided persistence
+// handle, encrypting transcripts with the provided key.
+func NewStorage(
+	handle persistence.Handle,
+	key [encryption.KeyLength]byte,
+) *Storage {
+	return &Storage{
+		handle: handle,
+		box:    encryption.NewBox(key),
+	}
+}
+
+// Save encrypts and persists the provided transcript, overwriting any previous
+// transcript of the same member for the same DKG execution.
+func (s *Storage) Save(transcript *Transcript) error {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	transcriptBytes, err := transcript.Marshal()
+	if err != nil {
+		return fmt.Errorf("marshalling of the transcript failed: [%v]", err)
+	}
+
+	encrypted, err := s.box.Encrypt(transcriptBytes)
+	if err != nil {
+		return fmt.Errorf("encryption of the transcript failed: [%v]", err)
+	}
+
+	return s.handle.Save(
+		encrypted,
+		fmt.Sprintf("%x", transcript.Seed),
+		fmt.Sprintf("/member_%v", transcript.MemberIndex),
+	)
+}
+
+// Decrypt decrypts and unmarshals the transcript persisted by the storage
+// with the provided key.
+func Decrypt(
+	encrypted []byte,
+	key [encryption.KeyLength]byte,
+) (*Transcript, error) {
+	decrypted, err := encryption.NewBox(key).Decrypt(encrypted)
+	if err != nil {
+		return nil, fmt.Errorf(
+			"could not decrypt transcript; the transcript has been tampered "+
+				"with or encrypted with a different key: [%v]",
+			err,
+		)
+	}
+
+	transcript := &Transcript{}
+	if err := transcript.Unmarshal(decrypted); err != nil {
+		return nil, err
+	}
+
+	return transcript, nil
+}
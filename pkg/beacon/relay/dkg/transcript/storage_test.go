@@ -0,0 +1,113 @@
+package transcript
+
+import (
+	"math/big"
+	"reflect"
+	"testing"
+
+	"github.com/keep-network/keep-common/pkg/encryption"
+	"github.com/keep-network/keep-common/pkg/persistence"
+)
+
+var (
+	testKey      = [encryption.KeyLength]byte{1, 2, 3}
+	testOtherKey = [encryption.KeyLength]byte{4, 5, 6}
+
+	testTranscript = &Transcript{
+		Seed:               big.NewInt(1337),
+		MemberIndex:        3,
+		GroupSize:          5,
+		DishonestThreshold: 2,
+		StartBlockHeight:   100,
+		Randomness:         []byte{1, 2, 3, 4},
+		Messages: []*Message{
+			{
+				Protocol:        KeyGeneration,
+				Phase:           1,
+				Type:            "gjkr/ephemeral_public_key",
+				SenderPublicKey: []byte{5, 6},
+				Payload:         []byte{7, 8, 9},
+			},
+		},
+		MembershipChecks: []*MembershipCheck{
+			{MemberIndex: 2, PublicKey: []byte{5, 6}, Valid: true},
+		},
+		SignatureChecks: []*SignatureCheck{
+			{
+				Message:   []byte{10},
+				Signature: []byte{11},
+				PublicKey: []byte{5, 6},
+				Valid:     false,
+			},
+		},
+		ResultHash:      []byte{12, 13},
+		ResultSignature: []byte{14},
+		PublicKey:       []byte{15},
+	}
+)
+
+func TestSaveAndDecrypt(t *testing.T) {
+	handle := &persistenceHandleMock{}
+	storage := NewStorage(handle, testKey)
+
+	if err := storage.Save(testTranscript); err != nil {
+		t.Fatal(err)
+	}
+
+	if handle.directory != "539" || handle.name != "/member_3" {
+		t.Errorf(
+			"unexpected transcript location\n"+
+				"expected: %v\nactual:   %v\n",
+			"539/member_3",
+			handle.directory+handle.name,
+		)
+	}
+
+	transcript, err := Decrypt(handle.data, testKey)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if !reflect.DeepEqual(testTranscript, transcript) {
+		t.Errorf(
+			"unexpected transcript\nexpected: %+v\nactual:   %+v\n",
+			testTranscript,
+			transcript,
+		)
+	}
+}
+
+func TestDecryptWithDifferentKey(t *testing.T) {
+	handle := &persistenceHandleMock{}
+	storage := NewStorage(handle, testKey)
+
+	if err := storage.Save(testTranscript); err != nil {
+		t.Fatal(err)
+	}
+
+	if _, err := Decrypt(handle.data, testOtherKey); err == nil {
+		t.Fatal("expected decryption with a different key to fail")
+	}
+}
+
+type persistenceHandleMock struct {
+	data      []byte
+	directory string
+	name      string
+}
+
+func (phm *persistenceHandleMock) Save(data []byte, directory string, name string) error {
+	phm.data = data
+	phm.directory = directory
+	phm.name = name
+
+	return nil
+}
+
+func (phm *persistenceHandleMock) ReadAll() (<-chan persistence.DataDescriptor, <-chan error) {
+	return nil, nil
+}
+
+func (phm *persistenceHandleMock) Archive(directory string) error {
+	return nil
+}
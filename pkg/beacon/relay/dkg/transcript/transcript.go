@@ -0,0 +1,111 @@
+// Package transcript records everything a member needs to deterministically
+// reproduce its DKG execution and replays such a record through the protocol
+// logic to reproduce the member's decisions.
+//
+// A transcript holds the randomness the member used to generate its secrets,
+// all messages delivered to the member in each protocol phase and results of
+// all checks the member delegated to the chain. The transcript contains
+// the member's secrets and has to be protected as well as the group private
+// key share.
+package transcript
+
+import (
+	"encoding/json"
+	"fmt"
+	"math/big"
+
+	"github.com/ipfs/go-log"
+
+	"github.com/keep-network/keep-core/pkg/beacon/relay/group"
+)
+
+var logger = log.Logger("keep-dkg-transcript")
+
+// Protocols recorded in the transcript.
+const (
+	// KeyGeneration is the GJKR key generation protocol.
+	KeyGeneration = "gjkr"
+	// ResultPublication is the DKG result signing and publication protocol.
+	ResultPublication = "publication"
+)
+
+// Transcript is a record of a single member's DKG execution.
+type Transcript struct {
+	Seed               *big.Int          `json:"seed"`
+	MemberIndex        group.MemberIndex `json:"memberIndex"`
+	GroupSize          int               `json:"groupSize"`
+	DishonestThreshold int               `json:"dishonestThreshold"`
+	StartBlockHeight   uint64            `json:"startBlockHeight"`
+
+	// Randomness consumed by the member to generate its secrets.
+	Randomness []byte `json:"randomness"`
+
+	// Messages delivered to the member, in the order of delivery.
+	Messages []*Message `json:"messages"`
+
+	// Results of the membership validation of message senders.
+	MembershipChecks []*MembershipCheck `json:"membershipChecks"`
+	// Results of the verification of DKG result signatures.
+	SignatureChecks []*SignatureCheck `json:"signatureChecks"`
+
+	// Hash of the DKG result the member signed along with the signature and
+	// the public key the result has been signed with.
+	ResultHash      []byte `json:"resultHash,omitempty"`
+	ResultSignature []byte `json:"resultSignature,omitempty"`
+	PublicKey       []byte `json:"publicKey,omitempty"`
+}
+
+// Message is a network message delivered to the member in the given phase
+// of the given protocol. Phases are numbered from zero in the order the member
+// entered them.
+type Message struct {
+	Protocol        string `json:"protocol"`
+	Phase           int    `json:"phase"`
+	Type            string `json:"type"`
+	SenderPublicKey []byte `json:"senderPublicKey"`
+	Payload         []byte `json:"payload"`
+}
+
+// MembershipCheck is a result of checking if the sender with the given public
+// key is a member of the group with the given index.
+type MembershipCheck struct {
+	MemberIndex group.MemberIndex `json:"memberIndex"`
+	PublicKey   []byte            `json:"publicKey"`
+	Valid       bool              `json:"valid"`
+}
+
+// SignatureCheck is a result of verifying the signature over the message with
+// the given public key.
+type SignatureCheck struct {
+	Message   []byte `json:"message"`
+	Signature []byte `json:"signature"`
+	PublicKey []byte `json:"publicKey"`
+	Valid     bool   `json:"valid"`
+}
+
+// Marshal converts the transcript to a byte array.
+func (t *Transcript) Marshal() ([]byte, error) {
+	return json.Marshal(t)
+}
+
+// Unmarshal converts a byte array produced by Marshal to a transcript.
+func (t *Transcript) Unmarshal(bytes []byte) error {
+	if err := json.Unmarshal(bytes, t); err != nil {
+		return fmt.Errorf("could not unmarshal transcript: [%v]", err)
+	}
+
+	return nil
+}
+
+// messages returns messages delivered to the member in the given phase of
+// the given protocol.
+func (t *Transcript) messages(protocol string, phase int) []*Message {
+	messages := make([]*Message, 0)
+	for _, message := range t.Messages {
+		if message.Protocol == protocol && message.Phase == phase {
+			messages = append(messages, message)
+		}
+	}
+
+	return messages
+}
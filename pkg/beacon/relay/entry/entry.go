@@ -29,16 +29,19 @@ func RegisterUnmarshallers(channel net.BroadcastChannel) {
 
 // SignAndSubmit triggers the threshold signature process for the
 // previous relay entry and publishes the signature to the chain as
-// a new relay entry.
+// a new relay entry. It returns the outcome telling if the relay entry has been
+// submitted by the member or by another one. Peers sending invalid signature
+// shares are reported to the connection manager, if provided.
 func SignAndSubmit(
 	blockCounter chain.BlockCounter,
 	channel net.BroadcastChannel,
+	connectionManager net.ConnectionManager,
 	relayChain relayChain.Interface,
 	previousEntryBytes []byte,
 	honestThreshold int,
 	signer *dkg.ThresholdSigner,
 	startBlockHeight uint64,
-) error {
+) (SubmissionOutcome, error) {
 	ctx, cancelCtx := context.WithCancel(context.Background())
 	defer cancelCtx()
 
@@ -49,26 +52,26 @@ func SignAndSubmit(
 		},
 	)
 	if err != nil {
-		return err
+		return SubmissionFailed, err
 	}
 	defer subscription.Unsubscribe()
 
 	chainConfig, err := relayChain.GetConfig()
 	if err != nil {
-		return err
+		return SubmissionFailed, err
 	}
 
 	relayEntryTimeoutChannel, err := blockCounter.BlockHeightWaiter(
 		startBlockHeight + chainConfig.RelayEntryTimeout,
 	)
 	if err != nil {
-		return err
+		return SubmissionFailed, err
 	}
 
 	previousEntry := new(bn256.G1)
 	_, err = previousEntry.Unmarshal(previousEntryBytes)
 	if err != nil {
-		return err
+		return SubmissionFailed, err
 	}
 
 	selfShare := signer.CalculateSignatureShare(previousEntry)
@@ -109,6 +112,12 @@ func SignAndSubmit(
 					message.senderID,
 					err,
 				)
+				if connectionManager != nil {
+					connectionManager.ReportMisbehavior(
+						netMessage.TransportSenderID().String(),
+						net.InvalidSignature,
+					)
+				}
 				continue
 			}
 
@@ -126,9 +135,9 @@ func SignAndSubmit(
 				signer.MemberID(),
 				blockNumber,
 			)
-			return nil
+			return SubmissionYielded, nil
 		case blockNumber := <-relayEntryTimeoutChannel:
-			return fmt.Errorf(
+			return SubmissionFailed, fmt.Errorf(
 				"relay entry timed out at block [%v]",
 				blockNumber,
 			)
@@ -137,7 +146,7 @@ func SignAndSubmit(
 
 	signature, err := completeSignature(signer, receivedValidShares, honestThreshold)
 	if err != nil {
-		return err
+		return SubmissionFailed, err
 	}
 
 	submitter := &relayEntrySubmitter{
@@ -9,6 +9,21 @@ import (
 	"github.com/keep-network/keep-core/pkg/chain"
 )
 
+// SubmissionOutcome is the terminal outcome of the relay entry signing and
+// submission by the given member.
+type SubmissionOutcome string
+
+const (
+	// SubmissionSubmitted means the member has submitted the relay entry.
+	SubmissionSubmitted SubmissionOutcome = "submitted"
+	// SubmissionYielded means the relay entry has been submitted by another
+	// member.
+	SubmissionYielded SubmissionOutcome = "yielded"
+	// SubmissionFailed means the member could not sign or submit the relay
+	// entry.
+	SubmissionFailed SubmissionOutcome = "failed"
+)
+
 type relayEntrySubmitter struct {
 	chain        relayChain.Interface
 	blockCounter chain.BlockCounter
@@ -21,17 +36,17 @@ type relayEntrySubmitter struct {
 // Group member with index 1 tries to submit as the first one, group member 2
 // tries to submit after a few blocks if member 1 did not submit and so on.
 // Relay entry submit process starts at block height defined by startBlockheight
-// parameter.
+// parameter. It returns the outcome of the submission.
 func (res *relayEntrySubmitter) submitRelayEntry(
 	newEntry []byte,
 	groupPublicKey []byte,
 	startBlockHeight uint64,
 	relayEntrySubmittedChannel <-chan uint64,
 	relayEntryTimeoutChannel <-chan uint64,
-) error {
+) (SubmissionOutcome, error) {
 	config, err := res.chain.GetConfig()
 	if err != nil {
-		return fmt.Errorf(
+		return SubmissionFailed, fmt.Errorf(
 			"could not fetch chain's config: [%v]",
 			err,
 		)
@@ -46,7 +61,10 @@ func (res *relayEntrySubmitter) submitRelayEntry(
 		config.ResultPublicationBlockStep,
 	)
 	if err != nil {
-		return fmt.Errorf("wait for eligibility failure: [%v]", err)
+		return SubmissionFailed, fmt.Errorf(
+			"wait for eligibility failure: [%v]",
+			err,
+		)
 	}
 
 	for {
@@ -77,7 +95,10 @@ func (res *relayEntrySubmitter) submitRelayEntry(
 					}
 					errorChannel <- err
 				})
-			return <-errorChannel
+			if err := <-errorChannel; err != nil {
+				return SubmissionFailed, err
+			}
+			return SubmissionSubmitted, nil
 		case blockNumber := <-relayEntrySubmittedChannel:
 			logger.Infof(
 				"[member:%v] leaving submitter; "+
@@ -85,9 +106,9 @@ func (res *relayEntrySubmitter) submitRelayEntry(
 				res.index,
 				blockNumber,
 			)
-			return nil
+			return SubmissionYielded, nil
 		case blockNumber := <-relayEntryTimeoutChannel:
-			return fmt.Errorf(
+			return SubmissionFailed, fmt.Errorf(
 				"relay entry timed out at block [%v]",
 				blockNumber,
 			)
@@ -57,6 +57,24 @@ type DKGResultSubmission struct {
 	MemberIndex    uint32
 	GroupPublicKey []byte
 	Misbehaved     []byte
+	// TransactionHash is the hash of the submission transaction; it is empty
+	// if the chain does not report it for the event.
+	TransactionHash string
+
+	BlockNumber uint64
+}
+
+// DKGCompleted indicates that the DKG result for the given request has been
+// published on-chain, either by this node or by another group member. It is
+// emitted within the node to notify interested components about the
+// completion of DKG.
+type DKGCompleted struct {
+	// RequestID is the seed of the group selection the DKG was executed for.
+	RequestID      *big.Int
+	GroupPublicKey []byte
+	// Outcome tells if the result has been submitted by this node's member
+	// or the member yielded to a result submitted by another member.
+	Outcome string
 
 	BlockNumber uint64
 }
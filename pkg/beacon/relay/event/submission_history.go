@@ -0,0 +1,80 @@
+package event
+
+import (
+	"sync"
+)
+
+// DKGResultSubmissionHistory remembers a fixed number of recently seen DKG
+// result submission events. It is used to detect the same event delivered
+// multiple times, e.g. after the event subscription has been renewed.
+// Once the history is full, the oldest event is forgotten to make room for
+// a new one, so the memory used by the history does not grow over time.
+//
+// Events are identified by the submitted group public key and the block at
+// which the result has been submitted.
+type DKGResultSubmissionHistory struct {
+	mutex sync.Mutex
+
+	// entries is a ring buffer of the remembered events; next points to the
+	// position where the next event is remembered, overwriting the oldest
+	// one once the buffer is full.
+	entries []dkgResultSubmissionKey
+	next    int
+	size    int
+
+	index map[dkgResultSubmissionKey]bool
+}
+
+type dkgResultSubmissionKey struct {
+	groupPublicKey string
+	blockNumber    uint64
+}
+
+// NewDKGResultSubmissionHistory creates a history remembering at most the
+// given number of the most recent events.
+func NewDKGResultSubmissionHistory(capacity int) *DKGResultSubmissionHistory {
+	if capacity < 1 {
+		capacity = 1
+	}
+
+	return &DKGResultSubmissionHistory{
+		entries: make([]dkgResultSubmissionKey, capacity),
+		index:   make(map[dkgResultSubmissionKey]bool, capacity),
+	}
+}
+
+// Add remembers the given event. It returns false if the event has already
+// been seen and is still remembered by the history.
+func (h *DKGResultSubmissionHistory) Add(event *DKGResultSubmission) bool {
+	h.mutex.Lock()
+	defer h.mutex.Unlock()
+
+	key := dkgResultSubmissionKey{
+		groupPublicKey: string(event.GroupPublicKey),
+		blockNumber:    event.BlockNumber,
+	}
+
+	if h.index[key] {
+		return false
+	}
+
+	if h.size == len(h.entries) {
+		delete(h.index, h.entries[h.next])
+	} else {
+		h.size++
+	}
+
+	h.entries[h.next] = key
+	h.index[key] = true
+	h.next = (h.next + 1) % len(h.entries)
+
+	return true
+}
+
+// Len returns the number of events currently remembered by the history.
+func (h *DKGResultSubmissionHistory) Len() int {
+	h.mutex.Lock()
+	defer h.mutex.Unlock()
+
+	return h.size
+}
@@ -0,0 +1,110 @@
+package event
+
+import (
+	"testing"
+)
+
+func TestDKGResultSubmissionHistoryAdd(t *testing.T) {
+	var tests = map[string]struct {
+		added         []*DKGResultSubmission
+		event         *DKGResultSubmission
+		expectedAdded bool
+	}{
+		"event not seen before": {
+			added: []*DKGResultSubmission{
+				{GroupPublicKey: []byte{1}, BlockNumber: 10},
+			},
+			event:         &DKGResultSubmission{GroupPublicKey: []byte{2}, BlockNumber: 10},
+			expectedAdded: true,
+		},
+		"recent event seen before": {
+			added: []*DKGResultSubmission{
+				{GroupPublicKey: []byte{1}, BlockNumber: 10},
+				{GroupPublicKey: []byte{2}, BlockNumber: 11},
+			},
+			event:         &DKGResultSubmission{GroupPublicKey: []byte{1}, BlockNumber: 10},
+			expectedAdded: false,
+		},
+		"same group public key submitted at another block": {
+			added: []*DKGResultSubmission{
+				{GroupPublicKey: []byte{1}, BlockNumber: 10},
+			},
+			event:         &DKGResultSubmission{GroupPublicKey: []byte{1}, BlockNumber: 12},
+			expectedAdded: true,
+		},
+		"evicted event seen before": {
+			added: []*DKGResultSubmission{
+				{GroupPublicKey: []byte{1}, BlockNumber: 10},
+				{GroupPublicKey: []byte{2}, BlockNumber: 11},
+				{GroupPublicKey: []byte{3}, BlockNumber: 12},
+				{GroupPublicKey: []byte{4}, BlockNumber: 13},
+			},
+			event:         &DKGResultSubmission{GroupPublicKey: []byte{1}, BlockNumber: 10},
+			expectedAdded: true,
+		},
+	}
+
+	for testName, test := range tests {
+		t.Run(testName, func(t *testing.T) {
+			history := NewDKGResultSubmissionHistory(3)
+
+			for _, event := range test.added {
+				history.Add(event)
+			}
+
+			added := history.Add(test.event)
+			if test.expectedAdded != added {
+				t.Errorf(
+					"unexpected result\nexpected: %v\nactual:   %v\n",
+					test.expectedAdded,
+					added,
+				)
+			}
+		})
+	}
+}
+
+func TestDKGResultSubmissionHistoryEvictsOldestAtCapacity(t *testing.T) {
+	capacity := 3
+	history := NewDKGResultSubmissionHistory(capacity)
+
+	for block := uint64(1); block <= 5; block++ {
+		history.Add(&DKGResultSubmission{
+			GroupPublicKey: []byte{byte(block)},
+			BlockNumber:    block,
+		})
+
+		expectedLen := int(block)
+		if expectedLen > capacity {
+			expectedLen = capacity
+		}
+		if expectedLen != history.Len() {
+			t.Fatalf(
+				"unexpected history length\nexpected: %v\nactual:   %v\n",
+				expectedLen,
+				history.Len(),
+			)
+		}
+	}
+
+	// Events from blocks 1 and 2 have been evicted, events from blocks 3, 4
+	// and 5 are still remembered. The remembered ones are checked first since
+	// re-adding an evicted event evicts the oldest remembered one.
+	for block := uint64(5); block >= 1; block-- {
+		added := history.Add(&DKGResultSubmission{
+			GroupPublicKey: []byte{byte(block)},
+			BlockNumber:    block,
+		})
+
+		expectedAdded := block < 3
+		if expectedAdded != added {
+			t.Errorf(
+				"unexpected result for event from block [%v]\n"+
+					"expected: %v\nactual:   %v\n",
+				block,
+				expectedAdded,
+				added,
+			)
+		}
+	}
+}
@@ -0,0 +1,114 @@
+package gjkr
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"encoding/hex"
+	"math/big"
+	"testing"
+
+	relaychain "github.com/keep-network/keep-core/pkg/beacon/relay/chain"
+	"github.com/keep-network/keep-core/pkg/beacon/relay/group"
+	"github.com/keep-network/keep-core/pkg/chain/local"
+	"github.com/keep-network/keep-core/pkg/net"
+)
+
+func TestDropMessagesFromNotAllowedOperators(t *testing.T) {
+	signing := local.Connect(3, 3, big.NewInt(100)).Signing()
+
+	operatorKeys := make([][]byte, 3)
+	stakers := make([]relaychain.StakerAddress, 3)
+	for i := range operatorKeys {
+		key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+		if err != nil {
+			t.Fatal(err)
+		}
+		operatorKeys[i] = elliptic.Marshal(key.Curve, key.X, key.Y)
+		stakers[i] = signing.PublicKeyBytesToAddress(operatorKeys[i])
+	}
+
+	// operator of member 3 has been selected but is not allowed
+	membershipValidator := group.NewAllowListMembershipValidator(
+		group.NewStakersMembershipValidator(stakers, signing),
+		[]string{
+			hex.EncodeToString(stakers[0]),
+			hex.EncodeToString(stakers[1]),
+		},
+		signing,
+	)
+
+	member := (&LocalMember{
+		memberCore: &memberCore{
+			ID:                  1,
+			group:               group.NewDkgGroup(1, 3),
+			membershipValidator: membershipValidator,
+			session:             newSession(big.NewInt(100), 10),
+		},
+	}).InitializeEphemeralKeysGeneration()
+
+	state := &ephemeralKeyPairGenerationState{member: member}
+
+	sessionMessage := func(senderID group.MemberIndex) *EphemeralPublicKeyMessage {
+		message := &EphemeralPublicKeyMessage{senderID: senderID}
+		newSession(big.NewInt(100), 10).bind(message)
+		return message
+	}
+
+	state.Receive(&mockEphemeralPublicKeyMessage{
+		payload:         sessionMessage(2),
+		senderPublicKey: operatorKeys[1],
+	})
+	state.Receive(&mockEphemeralPublicKeyMessage{
+		payload:         sessionMessage(3),
+		senderPublicKey: operatorKeys[2],
+	})
+	// not allowed operator impersonating member 2
+	state.Receive(&mockEphemeralPublicKeyMessage{
+		payload:         sessionMessage(2),
+		senderPublicKey: operatorKeys[2],
+	})
+
+	if len(state.phaseMessages) != 1 {
+		t.Fatalf(
+			"unexpected number of accepted messages\nexpected: %v\nactual:   %v\n",
+			1,
+			len(state.phaseMessages),
+		)
+	}
+	if state.phaseMessages[0].senderID != 2 {
+		t.Errorf(
+			"unexpected sender of accepted message\nexpected: %v\nactual:   %v\n",
+			2,
+			state.phaseMessages[0].senderID,
+		)
+	}
+	if len(member.group.DisqualifiedMemberIDs()) != 0 {
+		t.Errorf(
+			"unexpected disqualified members\nexpected: %v\nactual:   %v\n",
+			[]group.MemberIndex{},
+			member.group.DisqualifiedMemberIDs(),
+		)
+	}
+}
+
+type mockEphemeralPublicKeyMessage struct {
+	payload         *EphemeralPublicKeyMessage
+	senderPublicKey []byte
+}
+
+func (mepkm *mockEphemeralPublicKeyMessage) TransportSenderID() net.TransportIdentifier {
+	panic("not implemented")
+}
+func (mepkm *mockEphemeralPublicKeyMessage) Payload() interface{} {
+	return mepkm.payload
+}
+func (mepkm *mockEphemeralPublicKeyMessage) Type() string {
+	panic("not implemented")
+}
+func (mepkm *mockEphemeralPublicKeyMessage) SenderPublicKey() []byte {
+	return mepkm.senderPublicKey
+}
+func (mepkm *mockEphemeralPublicKeyMessage) Seqno() uint64 {
+	panic("not implemented")
+}
@@ -0,0 +1,23 @@
+package gjkr
+
+// Reasons for which a member is disqualified during the protocol execution.
+const (
+	ReasonInvalidEphemeralPublicKeyMessage  = "invalid ephemeral public key message"
+	ReasonInvalidCommitmentsMessage         = "invalid member commitments message"
+	ReasonInvalidPeerSharesMessage          = "invalid peer shares message"
+	ReasonUndecryptableShares               = "shares could not be decrypted"
+	ReasonSharesInconsistentWithCommitments = "shares inconsistent with commitments"
+	// The member accused this member; each member considers itself honest.
+	ReasonAccusedThisMember         = "accused this member"
+	ReasonRevealedKeyMismatch       = "revealed private key not matching public key"
+	ReasonUnrecoverableSymmetricKey = "could not recover symmetric key with " +
+		"inactive or disqualified member"
+	ReasonAccusedWithoutShares  = "accused member which did not provide shares"
+	ReasonFalseAccusation       = "false accusation"
+	ReasonConfirmedMisbehaviour = "confirmed misbehaviour"
+	// The member did not complain about an earlier protocol violation.
+	ReasonUnreportedViolation                   = "unreported protocol violation"
+	ReasonInvalidPublicKeySharePointsMessage    = "invalid public key share points message"
+	ReasonInvalidPublicKeySharePoints           = "invalid public key share points"
+	ReasonInvalidMisbehavedEphemeralKeysMessage = "invalid misbehaved ephemeral keys message"
+)
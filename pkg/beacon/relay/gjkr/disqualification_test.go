@@ -0,0 +1,97 @@
+package gjkr
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/keep-network/keep-core/pkg/beacon/relay/group"
+)
+
+type disqualification struct {
+	memberIndex group.MemberIndex
+	reason      string
+}
+
+func TestDisqualificationObserver(t *testing.T) {
+	dishonestThreshold := 1
+	groupSize := 4
+
+	members, err := initializeCommittingMembersGroup(dishonestThreshold, groupSize)
+	if err != nil {
+		t.Fatalf("group initialization failed [%s]", err)
+	}
+
+	member1 := members[0]
+	member2 := members[1]
+	member3 := members[2]
+	verifyingMember := members[3].InitializeCommitmentsVerification()
+
+	disqualifications := make([]*disqualification, 0)
+	verifyingMember.group.OnDisqualification(
+		func(memberIndex group.MemberIndex, reason string) {
+			disqualifications = append(
+				disqualifications,
+				&disqualification{memberIndex, reason},
+			)
+		},
+	)
+
+	var (
+		sharesMessages      []*PeerSharesMessage
+		commitmentsMessages []*MemberCommitmentsMessage
+	)
+	for _, member := range []*CommittingMember{member1, member2, member3} {
+		shares, commitments, err := member.CalculateMembersSharesAndCommitments()
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		sharesMessages = append(sharesMessages, shares)
+		commitmentsMessages = append(commitmentsMessages, commitments)
+	}
+
+	// member 1 sends a wrong number of commitments
+	commitmentsMessages[0].commitments = commitmentsMessages[0].commitments[1:]
+
+	// member 2 sends shares inconsistent with its commitments
+	err = alterPeerSharesMessage(
+		sharesMessages[1],
+		verifyingMember.ID,
+		verifyingMember.symmetricKeys[member2.ID],
+		true,
+		false,
+	)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	_, err = verifyingMember.VerifyReceivedSharesAndCommitmentsMessages(
+		sharesMessages,
+		commitmentsMessages,
+	)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	expectedDisqualifications := []*disqualification{
+		{member1.ID, ReasonInvalidCommitmentsMessage},
+		{member2.ID, ReasonSharesInconsistentWithCommitments},
+	}
+	if !reflect.DeepEqual(expectedDisqualifications, disqualifications) {
+		t.Errorf(
+			"unexpected disqualifications\nexpected: %v\nactual:   %v\n",
+			expectedDisqualifications,
+			disqualifications,
+		)
+	}
+
+	expectedDisqualifiedIDs := []group.MemberIndex{member1.ID, member2.ID}
+	disqualifiedIDs := verifyingMember.group.DisqualifiedMemberIDs()
+	if !reflect.DeepEqual(expectedDisqualifiedIDs, disqualifiedIDs) {
+		t.Errorf(
+			"unexpected disqualified members\nexpected: %v\nactual:   %v\n",
+			expectedDisqualifiedIDs,
+			disqualifiedIDs,
+		)
+	}
+}
@@ -1,6 +1,7 @@
 package gjkr
 
 import (
+	crand "crypto/rand"
 	"math/big"
 
 	bn256 "github.com/ethereum/go-ethereum/crypto/bn256/cloudflare"
@@ -134,7 +135,7 @@ func (mekm *MisbehavedEphemeralKeysMessage) RemovePrivateKey(
 }
 
 func GeneratePolynomial(degree int) ([]*big.Int, error) {
-	return generatePolynomial(degree)
+	return generatePolynomial(degree, crand.Reader)
 }
 
 func EvaluateMemberShare(
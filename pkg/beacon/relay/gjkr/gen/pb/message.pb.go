@@ -74,6 +74,8 @@ type EphemeralPublicKey struct {
 	SenderID            uint32            `protobuf:"varint,1,opt,name=senderID,proto3" json:"senderID,omitempty"`
 	ReceiverID          uint32            `protobuf:"varint,2,opt,name=receiverID,proto3" json:"receiverID,omitempty"`
 	EphemeralPublicKeys map[uint32][]byte `protobuf:"bytes,3,rep,name=ephemeralPublicKeys,proto3" json:"ephemeralPublicKeys,omitempty" protobuf_key:"varint,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	SessionID           []byte            `protobuf:"bytes,4,opt,name=sessionID,proto3" json:"sessionID,omitempty"`
+	SequenceNumber      uint64            `protobuf:"varint,5,opt,name=sequenceNumber,proto3" json:"sequenceNumber,omitempty"`
 }
 
 func (m *EphemeralPublicKey) Reset()      { *m = EphemeralPublicKey{} }
@@ -129,9 +131,25 @@ func (m *EphemeralPublicKey) GetEphemeralPublicKeys() map[uint32][]byte {
 	return nil
 }
 
+func (m *EphemeralPublicKey) GetSessionID() []byte {
+	if m != nil {
+		return m.SessionID
+	}
+	return nil
+}
+
+func (m *EphemeralPublicKey) GetSequenceNumber() uint64 {
+	if m != nil {
+		return m.SequenceNumber
+	}
+	return 0
+}
+
 type MemberCommitments struct {
-	SenderID    uint32   `protobuf:"varint,1,opt,name=senderID,proto3" json:"senderID,omitempty"`
-	Commitments [][]byte `protobuf:"bytes,2,rep,name=commitments,proto3" json:"commitments,omitempty"`
+	SenderID       uint32   `protobuf:"varint,1,opt,name=senderID,proto3" json:"senderID,omitempty"`
+	Commitments    [][]byte `protobuf:"bytes,2,rep,name=commitments,proto3" json:"commitments,omitempty"`
+	SessionID      []byte   `protobuf:"bytes,3,opt,name=sessionID,proto3" json:"sessionID,omitempty"`
+	SequenceNumber uint64   `protobuf:"varint,4,opt,name=sequenceNumber,proto3" json:"sequenceNumber,omitempty"`
 }
 
 func (m *MemberCommitments) Reset()      { *m = MemberCommitments{} }
@@ -180,9 +198,25 @@ func (m *MemberCommitments) GetCommitments() [][]byte {
 	return nil
 }
 
+func (m *MemberCommitments) GetSessionID() []byte {
+	if m != nil {
+		return m.SessionID
+	}
+	return nil
+}
+
+func (m *MemberCommitments) GetSequenceNumber() uint64 {
+	if m != nil {
+		return m.SequenceNumber
+	}
+	return 0
+}
+
 type PeerShares struct {
-	SenderID uint32                        `protobuf:"varint,1,opt,name=senderID,proto3" json:"senderID,omitempty"`
-	Shares   map[uint32]*PeerShares_Shares `protobuf:"bytes,2,rep,name=shares,proto3" json:"shares,omitempty" protobuf_key:"varint,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	SenderID       uint32                        `protobuf:"varint,1,opt,name=senderID,proto3" json:"senderID,omitempty"`
+	Shares         map[uint32]*PeerShares_Shares `protobuf:"bytes,2,rep,name=shares,proto3" json:"shares,omitempty" protobuf_key:"varint,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	SessionID      []byte                        `protobuf:"bytes,3,opt,name=sessionID,proto3" json:"sessionID,omitempty"`
+	SequenceNumber uint64                        `protobuf:"varint,4,opt,name=sequenceNumber,proto3" json:"sequenceNumber,omitempty"`
 }
 
 func (m *PeerShares) Reset()      { *m = PeerShares{} }
@@ -231,6 +265,20 @@ func (m *PeerShares) GetShares() map[uint32]*PeerShares_Shares {
 	return nil
 }
 
+func (m *PeerShares) GetSessionID() []byte {
+	if m != nil {
+		return m.SessionID
+	}
+	return nil
+}
+
+func (m *PeerShares) GetSequenceNumber() uint64 {
+	if m != nil {
+		return m.SequenceNumber
+	}
+	return 0
+}
+
 type PeerShares_Shares struct {
 	EncryptedShareS []byte `protobuf:"bytes,1,opt,name=encryptedShareS,proto3" json:"encryptedShareS,omitempty"`
 	EncryptedShareT []byte `protobuf:"bytes,2,opt,name=encryptedShareT,proto3" json:"encryptedShareT,omitempty"`
@@ -285,6 +333,8 @@ func (m *PeerShares_Shares) GetEncryptedShareT() []byte {
 type SecretSharesAccusations struct {
 	SenderID           uint32            `protobuf:"varint,1,opt,name=senderID,proto3" json:"senderID,omitempty"`
 	AccusedMembersKeys map[uint32][]byte `protobuf:"bytes,2,rep,name=accusedMembersKeys,proto3" json:"accusedMembersKeys,omitempty" protobuf_key:"varint,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	SessionID          []byte            `protobuf:"bytes,3,opt,name=sessionID,proto3" json:"sessionID,omitempty"`
+	SequenceNumber     uint64            `protobuf:"varint,4,opt,name=sequenceNumber,proto3" json:"sequenceNumber,omitempty"`
 }
 
 func (m *SecretSharesAccusations) Reset()      { *m = SecretSharesAccusations{} }
@@ -333,9 +383,25 @@ func (m *SecretSharesAccusations) GetAccusedMembersKeys() map[uint32][]byte {
 	return nil
 }
 
+func (m *SecretSharesAccusations) GetSessionID() []byte {
+	if m != nil {
+		return m.SessionID
+	}
+	return nil
+}
+
+func (m *SecretSharesAccusations) GetSequenceNumber() uint64 {
+	if m != nil {
+		return m.SequenceNumber
+	}
+	return 0
+}
+
 type MemberPublicKeySharePoints struct {
 	SenderID             uint32   `protobuf:"varint,1,opt,name=senderID,proto3" json:"senderID,omitempty"`
 	PublicKeySharePoints [][]byte `protobuf:"bytes,2,rep,name=publicKeySharePoints,proto3" json:"publicKeySharePoints,omitempty"`
+	SessionID            []byte   `protobuf:"bytes,3,opt,name=sessionID,proto3" json:"sessionID,omitempty"`
+	SequenceNumber       uint64   `protobuf:"varint,4,opt,name=sequenceNumber,proto3" json:"sequenceNumber,omitempty"`
 }
 
 func (m *MemberPublicKeySharePoints) Reset()      { *m = MemberPublicKeySharePoints{} }
@@ -384,9 +450,25 @@ func (m *MemberPublicKeySharePoints) GetPublicKeySharePoints() [][]byte {
 	return nil
 }
 
+func (m *MemberPublicKeySharePoints) GetSessionID() []byte {
+	if m != nil {
+		return m.SessionID
+	}
+	return nil
+}
+
+func (m *MemberPublicKeySharePoints) GetSequenceNumber() uint64 {
+	if m != nil {
+		return m.SequenceNumber
+	}
+	return 0
+}
+
 type PointsAccusations struct {
 	SenderID           uint32            `protobuf:"varint,1,opt,name=senderID,proto3" json:"senderID,omitempty"`
 	AccusedMembersKeys map[uint32][]byte `protobuf:"bytes,2,rep,name=accusedMembersKeys,proto3" json:"accusedMembersKeys,omitempty" protobuf_key:"varint,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	SessionID          []byte            `protobuf:"bytes,3,opt,name=sessionID,proto3" json:"sessionID,omitempty"`
+	SequenceNumber     uint64            `protobuf:"varint,4,opt,name=sequenceNumber,proto3" json:"sequenceNumber,omitempty"`
 }
 
 func (m *PointsAccusations) Reset()      { *m = PointsAccusations{} }
@@ -435,9 +517,25 @@ func (m *PointsAccusations) GetAccusedMembersKeys() map[uint32][]byte {
 	return nil
 }
 
+func (m *PointsAccusations) GetSessionID() []byte {
+	if m != nil {
+		return m.SessionID
+	}
+	return nil
+}
+
+func (m *PointsAccusations) GetSequenceNumber() uint64 {
+	if m != nil {
+		return m.SequenceNumber
+	}
+	return 0
+}
+
 type MisbehavedEphemeralKeys struct {
-	SenderID    uint32            `protobuf:"varint,1,opt,name=senderID,proto3" json:"senderID,omitempty"`
-	PrivateKeys map[uint32][]byte `protobuf:"bytes,2,rep,name=privateKeys,proto3" json:"privateKeys,omitempty" protobuf_key:"varint,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	SenderID       uint32            `protobuf:"varint,1,opt,name=senderID,proto3" json:"senderID,omitempty"`
+	PrivateKeys    map[uint32][]byte `protobuf:"bytes,2,rep,name=privateKeys,proto3" json:"privateKeys,omitempty" protobuf_key:"varint,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	SessionID      []byte            `protobuf:"bytes,3,opt,name=sessionID,proto3" json:"sessionID,omitempty"`
+	SequenceNumber uint64            `protobuf:"varint,4,opt,name=sequenceNumber,proto3" json:"sequenceNumber,omitempty"`
 }
 
 func (m *MisbehavedEphemeralKeys) Reset()      { *m = MisbehavedEphemeralKeys{} }
@@ -486,6 +584,20 @@ func (m *MisbehavedEphemeralKeys) GetPrivateKeys() map[uint32][]byte {
 	return nil
 }
 
+func (m *MisbehavedEphemeralKeys) GetSessionID() []byte {
+	if m != nil {
+		return m.SessionID
+	}
+	return nil
+}
+
+func (m *MisbehavedEphemeralKeys) GetSequenceNumber() uint64 {
+	if m != nil {
+		return m.SequenceNumber
+	}
+	return 0
+}
+
 func init() {
 	proto.RegisterType((*Join)(nil), "gjkr.Join")
 	proto.RegisterType((*EphemeralPublicKey)(nil), "gjkr.EphemeralPublicKey")
@@ -506,41 +618,45 @@ func init() {
 func init() { proto.RegisterFile("pb/message.proto", fileDescriptor_8447775385e7eb85) }
 
 var fileDescriptor_8447775385e7eb85 = []byte{
-	// 533 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xc4, 0x54, 0x4f, 0x8b, 0xd3, 0x40,
-	0x14, 0xcf, 0xb4, 0x6b, 0x91, 0x97, 0x8a, 0xdd, 0xb8, 0xd0, 0x12, 0x64, 0x28, 0x39, 0xf5, 0x62,
-	0x16, 0xab, 0xc2, 0xe2, 0x41, 0x58, 0xb5, 0x82, 0xca, 0x42, 0x4c, 0x3d, 0x89, 0x20, 0x49, 0xfa,
-	0xd8, 0xc6, 0x6d, 0xfe, 0x30, 0x93, 0x16, 0x7a, 0xf3, 0x23, 0xec, 0xc7, 0xf0, 0x9b, 0xe8, 0xb1,
-	0x37, 0xf7, 0x68, 0xd3, 0x8b, 0xc7, 0xfd, 0x02, 0x82, 0x74, 0x26, 0xb4, 0xa1, 0x4d, 0xa2, 0x7b,
-	0xf2, 0xd4, 0x99, 0xf7, 0x7e, 0xbf, 0xdf, 0x7b, 0xef, 0x37, 0xaf, 0x81, 0x56, 0xec, 0x1e, 0x07,
-	0xc8, 0xb9, 0x73, 0x8e, 0x66, 0xcc, 0xa2, 0x24, 0xd2, 0x0e, 0xce, 0x3f, 0x5f, 0x30, 0xc3, 0x80,
-	0x83, 0x37, 0x91, 0x1f, 0x6a, 0x3a, 0xdc, 0xe6, 0x18, 0x8e, 0x90, 0xbd, 0x7e, 0xd9, 0x21, 0x5d,
-	0xd2, 0xbb, 0x63, 0x6f, 0xee, 0xc6, 0x6f, 0x02, 0xda, 0x20, 0x1e, 0x63, 0x80, 0xcc, 0x99, 0x58,
-	0x53, 0x77, 0xe2, 0x7b, 0x6f, 0x71, 0x5e, 0x45, 0xd1, 0x28, 0x00, 0x43, 0x0f, 0xfd, 0x99, 0xc8,
-	0xd6, 0x44, 0x36, 0x17, 0xd1, 0x3c, 0xb8, 0x87, 0x7b, 0x8a, 0xbc, 0x53, 0xef, 0xd6, 0x7b, 0x6a,
-	0xff, 0xa1, 0xb9, 0x6e, 0xcd, 0xdc, 0x2f, 0x59, 0x10, 0xe2, 0x83, 0x30, 0x61, 0x73, 0xbb, 0x48,
-	0x4d, 0x7f, 0x05, 0x9d, 0x32, 0x82, 0xd6, 0x82, 0xfa, 0x05, 0xce, 0xb3, 0xbe, 0xd7, 0x47, 0xed,
-	0x08, 0x6e, 0xcd, 0x9c, 0xc9, 0x14, 0x45, 0xb7, 0x4d, 0x5b, 0x5e, 0x9e, 0xd6, 0x4e, 0x88, 0xf1,
-	0x0e, 0x0e, 0xcf, 0x30, 0x70, 0x91, 0xbd, 0x88, 0x82, 0xc0, 0x4f, 0x02, 0x0c, 0x13, 0x5e, 0x39,
-	0x7d, 0x17, 0x54, 0x6f, 0x0b, 0xed, 0xd4, 0xba, 0xf5, 0x5e, 0xd3, 0xce, 0x87, 0x8c, 0xcb, 0x1a,
-	0x80, 0x85, 0xc8, 0x86, 0x63, 0x87, 0x61, 0xb5, 0xd8, 0x63, 0x68, 0x70, 0x81, 0x12, 0x3a, 0x6a,
-	0xff, 0xbe, 0x74, 0x67, 0xcb, 0x36, 0xe5, 0x8f, 0x34, 0x22, 0xc3, 0xea, 0x1f, 0xa1, 0x91, 0x69,
-	0xf7, 0xe0, 0x2e, 0x86, 0x1e, 0x9b, 0xc7, 0x09, 0x8e, 0x44, 0x68, 0x28, 0x4a, 0x34, 0xed, 0xdd,
-	0xf0, 0x3e, 0xf2, 0x7d, 0xe6, 0xc5, 0x6e, 0x58, 0xb7, 0x41, 0xcd, 0x15, 0x2d, 0x30, 0xf3, 0x41,
-	0xde, 0x4c, 0xb5, 0xdf, 0x2e, 0xe9, 0x39, 0xef, 0xf2, 0x8a, 0x40, 0x7b, 0x88, 0x1e, 0xc3, 0x44,
-	0xe6, 0x4e, 0x3d, 0x6f, 0xca, 0x9d, 0xc4, 0x8f, 0xc2, 0x6a, 0x7f, 0x10, 0x34, 0x67, 0x0d, 0xc5,
-	0x91, 0x7c, 0x24, 0x2e, 0x36, 0x49, 0x7a, 0xf5, 0x44, 0xd6, 0x2d, 0x91, 0x35, 0x4f, 0xf7, 0x78,
-	0xd2, 0xc4, 0x02, 0x41, 0x7d, 0x00, 0xed, 0x12, 0xf8, 0x8d, 0x76, 0x69, 0x02, 0xba, 0xe4, 0x6f,
-	0x16, 0x52, 0xb4, 0x65, 0x45, 0xfe, 0xdf, 0x96, 0xaa, 0x0f, 0x47, 0x71, 0x01, 0x27, 0xdb, 0xae,
-	0xc2, 0x9c, 0xf1, 0x83, 0xc0, 0xa1, 0x3c, 0xfe, 0xab, 0x9b, 0x9f, 0x2a, 0xdc, 0x3c, 0xce, 0x5e,
-	0x71, 0x57, 0xf0, 0x7f, 0xf8, 0xf8, 0x8d, 0x40, 0xfb, 0xcc, 0xe7, 0x2e, 0x8e, 0x9d, 0x19, 0x8e,
-	0x36, 0x7f, 0xf3, 0xb5, 0x58, 0xe5, 0x7c, 0x16, 0xa8, 0x31, 0xf3, 0x67, 0x4e, 0x82, 0xb9, 0xc1,
-	0x4c, 0x39, 0x58, 0x89, 0x9e, 0x69, 0x6d, 0x09, 0x72, 0xae, 0xbc, 0x84, 0xfe, 0x0c, 0x5a, 0xbb,
-	0x80, 0x9b, 0x4c, 0xf2, 0xfc, 0x64, 0xb1, 0xa4, 0xca, 0xd5, 0x92, 0x2a, 0xd7, 0x4b, 0x4a, 0xbe,
-	0xa4, 0x94, 0x7c, 0x4d, 0x29, 0xf9, 0x9e, 0x52, 0xb2, 0x48, 0x29, 0xf9, 0x99, 0x52, 0xf2, 0x2b,
-	0xa5, 0xca, 0x75, 0x4a, 0xc9, 0xe5, 0x8a, 0x2a, 0x8b, 0x15, 0x55, 0xae, 0x56, 0x54, 0xf9, 0x50,
-	0x8b, 0x5d, 0xb7, 0x21, 0x3e, 0xe4, 0x8f, 0xfe, 0x04, 0x00, 0x00, 0xff, 0xff, 0x94, 0xa2, 0x04,
-	0xcc, 0xdc, 0x05, 0x00, 0x00,
+	// 601 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xd4, 0x55, 0x3f, 0x6f, 0xd3, 0x5e,
+	0x14, 0xf5, 0x73, 0xd2, 0xea, 0xf7, 0xbb, 0x0e, 0xd0, 0x9a, 0x4a, 0xb1, 0xa2, 0xea, 0xc9, 0xca,
+	0x80, 0xbc, 0xe0, 0x8a, 0x00, 0x52, 0xc5, 0x80, 0x54, 0x68, 0x91, 0x0a, 0x2a, 0x8a, 0x5c, 0x26,
+	0x84, 0x84, 0x6c, 0xe7, 0xaa, 0x35, 0x8d, 0xff, 0xf0, 0x9e, 0x13, 0x29, 0x1b, 0x5f, 0x00, 0x89,
+	0x8d, 0x89, 0x1d, 0x56, 0xbe, 0x02, 0x0b, 0x63, 0x26, 0xd4, 0x91, 0x38, 0x0b, 0x63, 0x3f, 0x02,
+	0x8a, 0x9f, 0x95, 0x98, 0xc4, 0x71, 0xa9, 0x94, 0x85, 0x29, 0xf1, 0xfd, 0x73, 0xee, 0xb9, 0xe7,
+	0xf8, 0x26, 0xb0, 0x11, 0x39, 0x3b, 0x3e, 0x72, 0x6e, 0x9f, 0xa0, 0x19, 0xb1, 0x30, 0x0e, 0xd5,
+	0xea, 0xc9, 0x9b, 0x33, 0xd6, 0x6c, 0x42, 0xf5, 0x69, 0xe8, 0x05, 0x6a, 0x03, 0xfe, 0xe3, 0x18,
+	0x74, 0x90, 0x1d, 0xee, 0x6b, 0x44, 0x27, 0xc6, 0x35, 0x6b, 0xfa, 0xdc, 0xfc, 0x26, 0x83, 0x7a,
+	0x10, 0x9d, 0xa2, 0x8f, 0xcc, 0xee, 0xb6, 0x7b, 0x4e, 0xd7, 0x73, 0x9f, 0xe1, 0xa0, 0xac, 0x45,
+	0xa5, 0x00, 0x0c, 0x5d, 0xf4, 0xfa, 0x69, 0x56, 0x4e, 0xb3, 0xb9, 0x88, 0xea, 0xc2, 0x4d, 0x5c,
+	0x40, 0xe4, 0x5a, 0x45, 0xaf, 0x18, 0x4a, 0xeb, 0x8e, 0x39, 0xa1, 0x66, 0x2e, 0x8e, 0x2c, 0x08,
+	0xf1, 0x83, 0x20, 0x66, 0x03, 0xab, 0x08, 0x4d, 0xdd, 0x86, 0xff, 0x39, 0x72, 0xee, 0x85, 0xc1,
+	0xe1, 0xbe, 0x56, 0xd5, 0x89, 0x51, 0xb3, 0x66, 0x01, 0xf5, 0x16, 0x5c, 0xe7, 0xf8, 0xb6, 0x87,
+	0x81, 0x8b, 0xcf, 0x7b, 0xbe, 0x83, 0x4c, 0x5b, 0xd3, 0x89, 0x51, 0xb5, 0xe6, 0xa2, 0x8d, 0x27,
+	0xa0, 0x2d, 0x1b, 0xab, 0x6e, 0x40, 0xe5, 0x0c, 0x07, 0xd9, 0xf6, 0x93, 0xaf, 0xea, 0x16, 0xac,
+	0xf5, 0xed, 0x6e, 0x0f, 0xd3, 0x9d, 0x6b, 0x96, 0x78, 0x78, 0x20, 0xef, 0x92, 0xe6, 0x47, 0x02,
+	0x9b, 0x47, 0x38, 0x81, 0x7c, 0x1c, 0xfa, 0xbe, 0x17, 0xfb, 0x18, 0xc4, 0xbc, 0x54, 0x44, 0x1d,
+	0x14, 0x77, 0x56, 0xaa, 0xc9, 0x7a, 0xc5, 0xa8, 0x59, 0xf9, 0xd0, 0x9f, 0x1b, 0x56, 0x2e, 0xdf,
+	0xb0, 0x5a, 0xb4, 0x61, 0xf3, 0x87, 0x0c, 0xd0, 0x46, 0x64, 0xc7, 0xa7, 0x36, 0xc3, 0x72, 0x4a,
+	0xf7, 0x60, 0x9d, 0xa7, 0x55, 0x29, 0x1b, 0xa5, 0xb5, 0x2d, 0xac, 0x9a, 0x75, 0x9b, 0xe2, 0x43,
+	0xb8, 0x92, 0xd5, 0xae, 0x86, 0x66, 0xe3, 0x15, 0xac, 0x67, 0x0c, 0x0d, 0xb8, 0x81, 0x81, 0xcb,
+	0x06, 0x51, 0x8c, 0x9d, 0x34, 0x74, 0x9c, 0x12, 0xad, 0x59, 0xf3, 0xe1, 0xc5, 0xca, 0x17, 0x99,
+	0x31, 0xf3, 0xe1, 0x86, 0x05, 0x4a, 0x8e, 0x7a, 0x81, 0xb3, 0xb7, 0xf3, 0xce, 0x2a, 0xad, 0xfa,
+	0x92, 0xcd, 0xf3, 0x96, 0x7f, 0x91, 0xa1, 0x7e, 0x8c, 0x2e, 0xc3, 0x58, 0xe4, 0xf6, 0x5c, 0xb7,
+	0xc7, 0xed, 0xd8, 0x0b, 0x83, 0x72, 0x95, 0x11, 0x54, 0x7b, 0x52, 0x8a, 0x1d, 0xf1, 0xc2, 0xf0,
+	0xf4, 0x38, 0x84, 0xe2, 0xf7, 0xc5, 0xdc, 0x25, 0xb0, 0xe6, 0xde, 0x42, 0x9f, 0xb0, 0xa2, 0x00,
+	0x70, 0x45, 0xb6, 0x1c, 0x40, 0x7d, 0xc9, 0xd0, 0x2b, 0x9d, 0xc7, 0x57, 0x02, 0x0d, 0x01, 0x30,
+	0x3d, 0xb2, 0x74, 0xbb, 0x76, 0xe8, 0x5d, 0x76, 0x27, 0x2d, 0xd8, 0x8a, 0x0a, 0x7a, 0xb2, 0x83,
+	0x29, 0xcc, 0xad, 0xe8, 0x72, 0x3e, 0xc9, 0xb0, 0x29, 0x00, 0xff, 0xd6, 0xda, 0xd7, 0x25, 0xd6,
+	0xee, 0x64, 0xaf, 0xd4, 0x3c, 0xe0, 0xbf, 0x6b, 0xea, 0x7b, 0x19, 0xea, 0x47, 0x1e, 0x77, 0xf0,
+	0xd4, 0xee, 0x63, 0x67, 0xfa, 0x33, 0x9a, 0x12, 0x2d, 0x53, 0xa9, 0x0d, 0x4a, 0xc4, 0xbc, 0xbe,
+	0x1d, 0x63, 0x4e, 0x1e, 0x53, 0xc8, 0xb3, 0x04, 0xcf, 0x6c, 0xcf, 0x1a, 0x84, 0x3a, 0x79, 0x88,
+	0x15, 0xc9, 0xf2, 0x10, 0x36, 0xe6, 0xc7, 0x5c, 0x45, 0x8f, 0x47, 0xbb, 0xc3, 0x11, 0x95, 0xce,
+	0x47, 0x54, 0xba, 0x18, 0x51, 0xf2, 0x2e, 0xa1, 0xe4, 0x73, 0x42, 0xc9, 0xf7, 0x84, 0x92, 0x61,
+	0x42, 0xc9, 0xcf, 0x84, 0x92, 0x5f, 0x09, 0x95, 0x2e, 0x12, 0x4a, 0x3e, 0x8c, 0xa9, 0x34, 0x1c,
+	0x53, 0xe9, 0x7c, 0x4c, 0xa5, 0x97, 0x72, 0xe4, 0x38, 0xeb, 0xe9, 0x9f, 0xf6, 0xdd, 0xdf, 0x03,
+	0x00, 0x8f, 0x0b, 0x6b, 0x17, 0xc8, 0x07, 0x00, 0x00,
 }
 
 func (this *Join) Equal(that interface{}) bool {
@@ -600,6 +716,12 @@ func (this *EphemeralPublicKey) Equal(that interface{}) bool {
 			return false
 		}
 	}
+	if !bytes.Equal(this.SessionID, that1.SessionID) {
+		return false
+	}
+	if this.SequenceNumber != that1.SequenceNumber {
+		return false
+	}
 	return true
 }
 func (this *MemberCommitments) Equal(that interface{}) bool {
@@ -632,6 +754,12 @@ func (this *MemberCommitments) Equal(that interface{}) bool {
 			return false
 		}
 	}
+	if !bytes.Equal(this.SessionID, that1.SessionID) {
+		return false
+	}
+	if this.SequenceNumber != that1.SequenceNumber {
+		return false
+	}
 	return true
 }
 func (this *PeerShares) Equal(that interface{}) bool {
@@ -664,6 +792,12 @@ func (this *PeerShares) Equal(that interface{}) bool {
 			return false
 		}
 	}
+	if !bytes.Equal(this.SessionID, that1.SessionID) {
+		return false
+	}
+	if this.SequenceNumber != that1.SequenceNumber {
+		return false
+	}
 	return true
 }
 func (this *PeerShares_Shares) Equal(that interface{}) bool {
@@ -723,6 +857,12 @@ func (this *SecretSharesAccusations) Equal(that interface{}) bool {
 			return false
 		}
 	}
+	if !bytes.Equal(this.SessionID, that1.SessionID) {
+		return false
+	}
+	if this.SequenceNumber != that1.SequenceNumber {
+		return false
+	}
 	return true
 }
 func (this *MemberPublicKeySharePoints) Equal(that interface{}) bool {
@@ -755,6 +895,12 @@ func (this *MemberPublicKeySharePoints) Equal(that interface{}) bool {
 			return false
 		}
 	}
+	if !bytes.Equal(this.SessionID, that1.SessionID) {
+		return false
+	}
+	if this.SequenceNumber != that1.SequenceNumber {
+		return false
+	}
 	return true
 }
 func (this *PointsAccusations) Equal(that interface{}) bool {
@@ -787,6 +933,12 @@ func (this *PointsAccusations) Equal(that interface{}) bool {
 			return false
 		}
 	}
+	if !bytes.Equal(this.SessionID, that1.SessionID) {
+		return false
+	}
+	if this.SequenceNumber != that1.SequenceNumber {
+		return false
+	}
 	return true
 }
 func (this *MisbehavedEphemeralKeys) Equal(that interface{}) bool {
@@ -819,6 +971,12 @@ func (this *MisbehavedEphemeralKeys) Equal(that interface{}) bool {
 			return false
 		}
 	}
+	if !bytes.Equal(this.SessionID, that1.SessionID) {
+		return false
+	}
+	if this.SequenceNumber != that1.SequenceNumber {
+		return false
+	}
 	return true
 }
 func (this *Join) GoString() string {
@@ -835,7 +993,7 @@ func (this *EphemeralPublicKey) GoString() string {
 	if this == nil {
 		return "nil"
 	}
-	s := make([]string, 0, 7)
+	s := make([]string, 0, 9)
 	s = append(s, "&pb.EphemeralPublicKey{")
 	s = append(s, "SenderID: "+fmt.Sprintf("%#v", this.SenderID)+",\n")
 	s = append(s, "ReceiverID: "+fmt.Sprintf("%#v", this.ReceiverID)+",\n")
@@ -852,6 +1010,8 @@ func (this *EphemeralPublicKey) GoString() string {
 	if this.EphemeralPublicKeys != nil {
 		s = append(s, "EphemeralPublicKeys: "+mapStringForEphemeralPublicKeys+",\n")
 	}
+	s = append(s, "SessionID: "+fmt.Sprintf("%#v", this.SessionID)+",\n")
+	s = append(s, "SequenceNumber: "+fmt.Sprintf("%#v", this.SequenceNumber)+",\n")
 	s = append(s, "}")
 	return strings.Join(s, "")
 }
@@ -859,10 +1019,12 @@ func (this *MemberCommitments) GoString() string {
 	if this == nil {
 		return "nil"
 	}
-	s := make([]string, 0, 6)
+	s := make([]string, 0, 8)
 	s = append(s, "&pb.MemberCommitments{")
 	s = append(s, "SenderID: "+fmt.Sprintf("%#v", this.SenderID)+",\n")
 	s = append(s, "Commitments: "+fmt.Sprintf("%#v", this.Commitments)+",\n")
+	s = append(s, "SessionID: "+fmt.Sprintf("%#v", this.SessionID)+",\n")
+	s = append(s, "SequenceNumber: "+fmt.Sprintf("%#v", this.SequenceNumber)+",\n")
 	s = append(s, "}")
 	return strings.Join(s, "")
 }
@@ -870,7 +1032,7 @@ func (this *PeerShares) GoString() string {
 	if this == nil {
 		return "nil"
 	}
-	s := make([]string, 0, 6)
+	s := make([]string, 0, 8)
 	s = append(s, "&pb.PeerShares{")
 	s = append(s, "SenderID: "+fmt.Sprintf("%#v", this.SenderID)+",\n")
 	keysForShares := make([]uint32, 0, len(this.Shares))
@@ -886,6 +1048,8 @@ func (this *PeerShares) GoString() string {
 	if this.Shares != nil {
 		s = append(s, "Shares: "+mapStringForShares+",\n")
 	}
+	s = append(s, "SessionID: "+fmt.Sprintf("%#v", this.SessionID)+",\n")
+	s = append(s, "SequenceNumber: "+fmt.Sprintf("%#v", this.SequenceNumber)+",\n")
 	s = append(s, "}")
 	return strings.Join(s, "")
 }
@@ -904,7 +1068,7 @@ func (this *SecretSharesAccusations) GoString() string {
 	if this == nil {
 		return "nil"
 	}
-	s := make([]string, 0, 6)
+	s := make([]string, 0, 8)
 	s = append(s, "&pb.SecretSharesAccusations{")
 	s = append(s, "SenderID: "+fmt.Sprintf("%#v", this.SenderID)+",\n")
 	keysForAccusedMembersKeys := make([]uint32, 0, len(this.AccusedMembersKeys))
@@ -920,6 +1084,8 @@ func (this *SecretSharesAccusations) GoString() string {
 	if this.AccusedMembersKeys != nil {
 		s = append(s, "AccusedMembersKeys: "+mapStringForAccusedMembersKeys+",\n")
 	}
+	s = append(s, "SessionID: "+fmt.Sprintf("%#v", this.SessionID)+",\n")
+	s = append(s, "SequenceNumber: "+fmt.Sprintf("%#v", this.SequenceNumber)+",\n")
 	s = append(s, "}")
 	return strings.Join(s, "")
 }
@@ -927,10 +1093,12 @@ func (this *MemberPublicKeySharePoints) GoString() string {
 	if this == nil {
 		return "nil"
 	}
-	s := make([]string, 0, 6)
+	s := make([]string, 0, 8)
 	s = append(s, "&pb.MemberPublicKeySharePoints{")
 	s = append(s, "SenderID: "+fmt.Sprintf("%#v", this.SenderID)+",\n")
 	s = append(s, "PublicKeySharePoints: "+fmt.Sprintf("%#v", this.PublicKeySharePoints)+",\n")
+	s = append(s, "SessionID: "+fmt.Sprintf("%#v", this.SessionID)+",\n")
+	s = append(s, "SequenceNumber: "+fmt.Sprintf("%#v", this.SequenceNumber)+",\n")
 	s = append(s, "}")
 	return strings.Join(s, "")
 }
@@ -938,7 +1106,7 @@ func (this *PointsAccusations) GoString() string {
 	if this == nil {
 		return "nil"
 	}
-	s := make([]string, 0, 6)
+	s := make([]string, 0, 8)
 	s = append(s, "&pb.PointsAccusations{")
 	s = append(s, "SenderID: "+fmt.Sprintf("%#v", this.SenderID)+",\n")
 	keysForAccusedMembersKeys := make([]uint32, 0, len(this.AccusedMembersKeys))
@@ -954,6 +1122,8 @@ func (this *PointsAccusations) GoString() string {
 	if this.AccusedMembersKeys != nil {
 		s = append(s, "AccusedMembersKeys: "+mapStringForAccusedMembersKeys+",\n")
 	}
+	s = append(s, "SessionID: "+fmt.Sprintf("%#v", this.SessionID)+",\n")
+	s = append(s, "SequenceNumber: "+fmt.Sprintf("%#v", this.SequenceNumber)+",\n")
 	s = append(s, "}")
 	return strings.Join(s, "")
 }
@@ -961,7 +1131,7 @@ func (this *MisbehavedEphemeralKeys) GoString() string {
 	if this == nil {
 		return "nil"
 	}
-	s := make([]string, 0, 6)
+	s := make([]string, 0, 8)
 	s = append(s, "&pb.MisbehavedEphemeralKeys{")
 	s = append(s, "SenderID: "+fmt.Sprintf("%#v", this.SenderID)+",\n")
 	keysForPrivateKeys := make([]uint32, 0, len(this.PrivateKeys))
@@ -977,6 +1147,8 @@ func (this *MisbehavedEphemeralKeys) GoString() string {
 	if this.PrivateKeys != nil {
 		s = append(s, "PrivateKeys: "+mapStringForPrivateKeys+",\n")
 	}
+	s = append(s, "SessionID: "+fmt.Sprintf("%#v", this.SessionID)+",\n")
+	s = append(s, "SequenceNumber: "+fmt.Sprintf("%#v", this.SequenceNumber)+",\n")
 	s = append(s, "}")
 	return strings.Join(s, "")
 }
@@ -1036,6 +1208,18 @@ func (m *EphemeralPublicKey) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.SequenceNumber != 0 {
+		i = encodeVarintMessage(dAtA, i, uint64(m.SequenceNumber))
+		i--
+		dAtA[i] = 0x28
+	}
+	if len(m.SessionID) > 0 {
+		i -= len(m.SessionID)
+		copy(dAtA[i:], m.SessionID)
+		i = encodeVarintMessage(dAtA, i, uint64(len(m.SessionID)))
+		i--
+		dAtA[i] = 0x22
+	}
 	if len(m.EphemeralPublicKeys) > 0 {
 		for k := range m.EphemeralPublicKeys {
 			v := m.EphemeralPublicKeys[k]
@@ -1088,6 +1272,18 @@ func (m *MemberCommitments) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.SequenceNumber != 0 {
+		i = encodeVarintMessage(dAtA, i, uint64(m.SequenceNumber))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.SessionID) > 0 {
+		i -= len(m.SessionID)
+		copy(dAtA[i:], m.SessionID)
+		i = encodeVarintMessage(dAtA, i, uint64(len(m.SessionID)))
+		i--
+		dAtA[i] = 0x1a
+	}
 	if len(m.Commitments) > 0 {
 		for iNdEx := len(m.Commitments) - 1; iNdEx >= 0; iNdEx-- {
 			i -= len(m.Commitments[iNdEx])
@@ -1125,6 +1321,18 @@ func (m *PeerShares) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.SequenceNumber != 0 {
+		i = encodeVarintMessage(dAtA, i, uint64(m.SequenceNumber))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.SessionID) > 0 {
+		i -= len(m.SessionID)
+		copy(dAtA[i:], m.SessionID)
+		i = encodeVarintMessage(dAtA, i, uint64(len(m.SessionID)))
+		i--
+		dAtA[i] = 0x1a
+	}
 	if len(m.Shares) > 0 {
 		for k := range m.Shares {
 			v := m.Shares[k]
@@ -1214,6 +1422,18 @@ func (m *SecretSharesAccusations) MarshalToSizedBuffer(dAtA []byte) (int, error)
 	_ = i
 	var l int
 	_ = l
+	if m.SequenceNumber != 0 {
+		i = encodeVarintMessage(dAtA, i, uint64(m.SequenceNumber))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.SessionID) > 0 {
+		i -= len(m.SessionID)
+		copy(dAtA[i:], m.SessionID)
+		i = encodeVarintMessage(dAtA, i, uint64(len(m.SessionID)))
+		i--
+		dAtA[i] = 0x1a
+	}
 	if len(m.AccusedMembersKeys) > 0 {
 		for k := range m.AccusedMembersKeys {
 			v := m.AccusedMembersKeys[k]
@@ -1261,6 +1481,18 @@ func (m *MemberPublicKeySharePoints) MarshalToSizedBuffer(dAtA []byte) (int, err
 	_ = i
 	var l int
 	_ = l
+	if m.SequenceNumber != 0 {
+		i = encodeVarintMessage(dAtA, i, uint64(m.SequenceNumber))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.SessionID) > 0 {
+		i -= len(m.SessionID)
+		copy(dAtA[i:], m.SessionID)
+		i = encodeVarintMessage(dAtA, i, uint64(len(m.SessionID)))
+		i--
+		dAtA[i] = 0x1a
+	}
 	if len(m.PublicKeySharePoints) > 0 {
 		for iNdEx := len(m.PublicKeySharePoints) - 1; iNdEx >= 0; iNdEx-- {
 			i -= len(m.PublicKeySharePoints[iNdEx])
@@ -1298,6 +1530,18 @@ func (m *PointsAccusations) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.SequenceNumber != 0 {
+		i = encodeVarintMessage(dAtA, i, uint64(m.SequenceNumber))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.SessionID) > 0 {
+		i -= len(m.SessionID)
+		copy(dAtA[i:], m.SessionID)
+		i = encodeVarintMessage(dAtA, i, uint64(len(m.SessionID)))
+		i--
+		dAtA[i] = 0x1a
+	}
 	if len(m.AccusedMembersKeys) > 0 {
 		for k := range m.AccusedMembersKeys {
 			v := m.AccusedMembersKeys[k]
@@ -1345,6 +1589,18 @@ func (m *MisbehavedEphemeralKeys) MarshalToSizedBuffer(dAtA []byte) (int, error)
 	_ = i
 	var l int
 	_ = l
+	if m.SequenceNumber != 0 {
+		i = encodeVarintMessage(dAtA, i, uint64(m.SequenceNumber))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.SessionID) > 0 {
+		i -= len(m.SessionID)
+		copy(dAtA[i:], m.SessionID)
+		i = encodeVarintMessage(dAtA, i, uint64(len(m.SessionID)))
+		i--
+		dAtA[i] = 0x1a
+	}
 	if len(m.PrivateKeys) > 0 {
 		for k := range m.PrivateKeys {
 			v := m.PrivateKeys[k]
@@ -1419,6 +1675,13 @@ func (m *EphemeralPublicKey) Size() (n int) {
 			n += mapEntrySize + 1 + sovMessage(uint64(mapEntrySize))
 		}
 	}
+	l = len(m.SessionID)
+	if l > 0 {
+		n += 1 + l + sovMessage(uint64(l))
+	}
+	if m.SequenceNumber != 0 {
+		n += 1 + sovMessage(uint64(m.SequenceNumber))
+	}
 	return n
 }
 
@@ -1437,6 +1700,13 @@ func (m *MemberCommitments) Size() (n int) {
 			n += 1 + l + sovMessage(uint64(l))
 		}
 	}
+	l = len(m.SessionID)
+	if l > 0 {
+		n += 1 + l + sovMessage(uint64(l))
+	}
+	if m.SequenceNumber != 0 {
+		n += 1 + sovMessage(uint64(m.SequenceNumber))
+	}
 	return n
 }
 
@@ -1462,6 +1732,13 @@ func (m *PeerShares) Size() (n int) {
 			n += mapEntrySize + 1 + sovMessage(uint64(mapEntrySize))
 		}
 	}
+	l = len(m.SessionID)
+	if l > 0 {
+		n += 1 + l + sovMessage(uint64(l))
+	}
+	if m.SequenceNumber != 0 {
+		n += 1 + sovMessage(uint64(m.SequenceNumber))
+	}
 	return n
 }
 
@@ -1503,6 +1780,13 @@ func (m *SecretSharesAccusations) Size() (n int) {
 			n += mapEntrySize + 1 + sovMessage(uint64(mapEntrySize))
 		}
 	}
+	l = len(m.SessionID)
+	if l > 0 {
+		n += 1 + l + sovMessage(uint64(l))
+	}
+	if m.SequenceNumber != 0 {
+		n += 1 + sovMessage(uint64(m.SequenceNumber))
+	}
 	return n
 }
 
@@ -1521,6 +1805,13 @@ func (m *MemberPublicKeySharePoints) Size() (n int) {
 			n += 1 + l + sovMessage(uint64(l))
 		}
 	}
+	l = len(m.SessionID)
+	if l > 0 {
+		n += 1 + l + sovMessage(uint64(l))
+	}
+	if m.SequenceNumber != 0 {
+		n += 1 + sovMessage(uint64(m.SequenceNumber))
+	}
 	return n
 }
 
@@ -1545,6 +1836,13 @@ func (m *PointsAccusations) Size() (n int) {
 			n += mapEntrySize + 1 + sovMessage(uint64(mapEntrySize))
 		}
 	}
+	l = len(m.SessionID)
+	if l > 0 {
+		n += 1 + l + sovMessage(uint64(l))
+	}
+	if m.SequenceNumber != 0 {
+		n += 1 + sovMessage(uint64(m.SequenceNumber))
+	}
 	return n
 }
 
@@ -1569,6 +1867,13 @@ func (m *MisbehavedEphemeralKeys) Size() (n int) {
 			n += mapEntrySize + 1 + sovMessage(uint64(mapEntrySize))
 		}
 	}
+	l = len(m.SessionID)
+	if l > 0 {
+		n += 1 + l + sovMessage(uint64(l))
+	}
+	if m.SequenceNumber != 0 {
+		n += 1 + sovMessage(uint64(m.SequenceNumber))
+	}
 	return n
 }
 
@@ -1606,6 +1911,8 @@ func (this *EphemeralPublicKey) String() string {
 		`SenderID:` + fmt.Sprintf("%v", this.SenderID) + `,`,
 		`ReceiverID:` + fmt.Sprintf("%v", this.ReceiverID) + `,`,
 		`EphemeralPublicKeys:` + mapStringForEphemeralPublicKeys + `,`,
+		`SessionID:` + fmt.Sprintf("%v", this.SessionID) + `,`,
+		`SequenceNumber:` + fmt.Sprintf("%v", this.SequenceNumber) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -1617,6 +1924,8 @@ func (this *MemberCommitments) String() string {
 	s := strings.Join([]string{`&MemberCommitments{`,
 		`SenderID:` + fmt.Sprintf("%v", this.SenderID) + `,`,
 		`Commitments:` + fmt.Sprintf("%v", this.Commitments) + `,`,
+		`SessionID:` + fmt.Sprintf("%v", this.SessionID) + `,`,
+		`SequenceNumber:` + fmt.Sprintf("%v", this.SequenceNumber) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -1638,6 +1947,8 @@ func (this *PeerShares) String() string {
 	s := strings.Join([]string{`&PeerShares{`,
 		`SenderID:` + fmt.Sprintf("%v", this.SenderID) + `,`,
 		`Shares:` + mapStringForShares + `,`,
+		`SessionID:` + fmt.Sprintf("%v", this.SessionID) + `,`,
+		`SequenceNumber:` + fmt.Sprintf("%v", this.SequenceNumber) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -1670,6 +1981,8 @@ func (this *SecretSharesAccusations) String() string {
 	s := strings.Join([]string{`&SecretSharesAccusations{`,
 		`SenderID:` + fmt.Sprintf("%v", this.SenderID) + `,`,
 		`AccusedMembersKeys:` + mapStringForAccusedMembersKeys + `,`,
+		`SessionID:` + fmt.Sprintf("%v", this.SessionID) + `,`,
+		`SequenceNumber:` + fmt.Sprintf("%v", this.SequenceNumber) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -1681,6 +1994,8 @@ func (this *MemberPublicKeySharePoints) String() string {
 	s := strings.Join([]string{`&MemberPublicKeySharePoints{`,
 		`SenderID:` + fmt.Sprintf("%v", this.SenderID) + `,`,
 		`PublicKeySharePoints:` + fmt.Sprintf("%v", this.PublicKeySharePoints) + `,`,
+		`SessionID:` + fmt.Sprintf("%v", this.SessionID) + `,`,
+		`SequenceNumber:` + fmt.Sprintf("%v", this.SequenceNumber) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -1702,6 +2017,8 @@ func (this *PointsAccusations) String() string {
 	s := strings.Join([]string{`&PointsAccusations{`,
 		`SenderID:` + fmt.Sprintf("%v", this.SenderID) + `,`,
 		`AccusedMembersKeys:` + mapStringForAccusedMembersKeys + `,`,
+		`SessionID:` + fmt.Sprintf("%v", this.SessionID) + `,`,
+		`SequenceNumber:` + fmt.Sprintf("%v", this.SequenceNumber) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -1723,6 +2040,8 @@ func (this *MisbehavedEphemeralKeys) String() string {
 	s := strings.Join([]string{`&MisbehavedEphemeralKeys{`,
 		`SenderID:` + fmt.Sprintf("%v", this.SenderID) + `,`,
 		`PrivateKeys:` + mapStringForPrivateKeys + `,`,
+		`SessionID:` + fmt.Sprintf("%v", this.SessionID) + `,`,
+		`SequenceNumber:` + fmt.Sprintf("%v", this.SequenceNumber) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -1988,6 +2307,59 @@ func (m *EphemeralPublicKey) Unmarshal(dAtA []byte) error {
 			}
 			m.EphemeralPublicKeys[mapkey] = mapvalue
 			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SessionID", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMessage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthMessage
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMessage
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SessionID = append(m.SessionID[:0], dAtA[iNdEx:postIndex]...)
+			if m.SessionID == nil {
+				m.SessionID = []byte{}
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SequenceNumber", wireType)
+			}
+			m.SequenceNumber = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMessage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SequenceNumber |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipMessage(dAtA[iNdEx:])
@@ -2092,6 +2464,59 @@ func (m *MemberCommitments) Unmarshal(dAtA []byte) error {
 			m.Commitments = append(m.Commitments, make([]byte, postIndex-iNdEx))
 			copy(m.Commitments[len(m.Commitments)-1], dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SessionID", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMessage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthMessage
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMessage
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SessionID = append(m.SessionID[:0], dAtA[iNdEx:postIndex]...)
+			if m.SessionID == nil {
+				m.SessionID = []byte{}
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SequenceNumber", wireType)
+			}
+			m.SequenceNumber = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMessage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SequenceNumber |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipMessage(dAtA[iNdEx:])
@@ -2279,6 +2704,59 @@ func (m *PeerShares) Unmarshal(dAtA []byte) error {
 			}
 			m.Shares[mapkey] = mapvalue
 			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SessionID", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMessage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthMessage
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMessage
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SessionID = append(m.SessionID[:0], dAtA[iNdEx:postIndex]...)
+			if m.SessionID == nil {
+				m.SessionID = []byte{}
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SequenceNumber", wireType)
+			}
+			m.SequenceNumber = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMessage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SequenceNumber |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipMessage(dAtA[iNdEx:])
@@ -2586,6 +3064,59 @@ func (m *SecretSharesAccusations) Unmarshal(dAtA []byte) error {
 			}
 			m.AccusedMembersKeys[mapkey] = mapvalue
 			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SessionID", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMessage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthMessage
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMessage
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SessionID = append(m.SessionID[:0], dAtA[iNdEx:postIndex]...)
+			if m.SessionID == nil {
+				m.SessionID = []byte{}
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SequenceNumber", wireType)
+			}
+			m.SequenceNumber = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMessage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SequenceNumber |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipMessage(dAtA[iNdEx:])
@@ -2690,6 +3221,59 @@ func (m *MemberPublicKeySharePoints) Unmarshal(dAtA []byte) error {
 			m.PublicKeySharePoints = append(m.PublicKeySharePoints, make([]byte, postIndex-iNdEx))
 			copy(m.PublicKeySharePoints[len(m.PublicKeySharePoints)-1], dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SessionID", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMessage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthMessage
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMessage
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SessionID = append(m.SessionID[:0], dAtA[iNdEx:postIndex]...)
+			if m.SessionID == nil {
+				m.SessionID = []byte{}
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SequenceNumber", wireType)
+			}
+			m.SequenceNumber = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMessage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SequenceNumber |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipMessage(dAtA[iNdEx:])
@@ -2876,6 +3460,59 @@ func (m *PointsAccusations) Unmarshal(dAtA []byte) error {
 			}
 			m.AccusedMembersKeys[mapkey] = mapvalue
 			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SessionID", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMessage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthMessage
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMessage
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SessionID = append(m.SessionID[:0], dAtA[iNdEx:postIndex]...)
+			if m.SessionID == nil {
+				m.SessionID = []byte{}
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SequenceNumber", wireType)
+			}
+			m.SequenceNumber = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMessage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SequenceNumber |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipMessage(dAtA[iNdEx:])
@@ -3062,6 +3699,59 @@ func (m *MisbehavedEphemeralKeys) Unmarshal(dAtA []byte) error {
 			}
 			m.PrivateKeys[mapkey] = mapvalue
 			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SessionID", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMessage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthMessage
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMessage
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SessionID = append(m.SessionID[:0], dAtA[iNdEx:postIndex]...)
+			if m.SessionID == nil {
+				m.SessionID = []byte{}
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SequenceNumber", wireType)
+			}
+			m.SequenceNumber = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMessage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SequenceNumber |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipMessage(dAtA[iNdEx:])
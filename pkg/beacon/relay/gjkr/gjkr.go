@@ -1,11 +1,14 @@
 package gjkr
 
 import (
+	crand "crypto/rand"
 	"fmt"
 	"math/big"
 
 	"github.com/ipfs/go-log"
 
+	"github.com/keep-network/keep-core/pkg/beacon/relay/config"
+	"github.com/keep-network/keep-core/pkg/beacon/relay/dkg/transcript"
 	"github.com/keep-network/keep-core/pkg/beacon/relay/group"
 	"github.com/keep-network/keep-core/pkg/beacon/relay/state"
 	"github.com/keep-network/keep-core/pkg/chain"
@@ -44,8 +47,12 @@ func RegisterUnmarshallers(channel net.BroadcastChannel) {
 
 // Execute runs the GJKR distributed key generation  protocol, given a
 // broadcast channel to mediate with, a block counter used for time tracking,
-// a player index to use in the group, dishonest threshold, and block height
-// when DKG protocol should start.
+// a player index to use in the group, dishonest threshold, block height
+// when DKG protocol should start and block budgets of protocol phases.
+// If the disqualification observer is set, it is notified each time a member
+// is disqualified by this member. If the liveness tracker is set, members
+// persistently disconnected are reported in the logs. If the
+// recorder is set, the member's execution is recorded in its transcript.
 // If the generation is successful, it returns a threshold group member which
 // can participate in the signing group; if the generation fails, it returns an
 // error.
@@ -58,31 +65,55 @@ func Execute(
 	seed *big.Int,
 	membershipValidator group.MembershipValidator,
 	startBlockHeight uint64,
+	phaseBudgets *config.DKGPhaseBudgets,
+	disqualificationObserver group.DisqualificationHandler,
+	livenessTracker *group.LivenessTracker,
+	recorder *transcript.Recorder,
 ) (*Result, uint64, error) {
 	logger.Debugf("[member:%v] initializing member", memberIndex)
 
+	if err := phaseBudgets.Validate(); err != nil {
+		return nil, 0, fmt.Errorf("invalid phase budgets: [%v]", err)
+	}
+
 	member, err := NewMember(
 		memberIndex,
 		groupSize,
 		dishonestThreshold,
 		membershipValidator,
 		seed,
+		startBlockHeight,
+		phaseBudgets,
 	)
 	if err != nil {
 		return nil, 0, fmt.Errorf("cannot create a new member: [%v]", err)
 	}
 
-	initialState := &ephemeralKeyPairGenerationState{
+	if disqualificationObserver != nil {
+		member.group.OnDisqualification(disqualificationObserver)
+	}
+
+	if livenessTracker != nil {
+		member.group.TrackLiveness(livenessTracker)
+	}
+
+	var initialState keyGenerationState = &ephemeralKeyPairGenerationState{
 		channel: channel,
 		member:  member.InitializeEphemeralKeysGeneration(),
 	}
 
+	if recorder != nil {
+		member.randomness = recorder.Randomness(crand.Reader)
+		initialState = recorder.State(transcript.KeyGeneration, initialState)
+	}
+
 	stateMachine := state.NewMachine(channel, blockCounter, initialState)
 
 	lastState, endBlockHeight, err := stateMachine.Execute(startBlockHeight)
 	if err != nil {
 		return nil, 0, err
 	}
+	lastState = transcript.Unwrap(lastState)
 
 	finalizationState, ok := lastState.(*finalizationState)
 	if !ok {
@@ -38,6 +38,8 @@ func (epkm *EphemeralPublicKeyMessage) Marshal() ([]byte, error) {
 	return (&pb.EphemeralPublicKey{
 		SenderID:            uint32(epkm.senderID),
 		EphemeralPublicKeys: ephemeralPublicKeys,
+		SessionID:           epkm.sessionID,
+		SequenceNumber:      epkm.sequenceNumber,
 	}).Marshal()
 }
 
@@ -53,6 +55,8 @@ func (epkm *EphemeralPublicKeyMessage) Unmarshal(bytes []byte) error {
 		return err
 	}
 	epkm.senderID = group.MemberIndex(pbMsg.SenderID)
+	epkm.sessionID = pbMsg.SessionID
+	epkm.sequenceNumber = pbMsg.SequenceNumber
 
 	ephemeralPublicKeys, err := unmarshalPublicKeyMap(pbMsg.EphemeralPublicKeys)
 	if err != nil {
@@ -79,8 +83,10 @@ func (mcm *MemberCommitmentsMessage) Marshal() ([]byte, error) {
 	}
 
 	return (&pb.MemberCommitments{
-		SenderID:    uint32(mcm.senderID),
-		Commitments: commitmentBytes,
+		SenderID:       uint32(mcm.senderID),
+		Commitments:    commitmentBytes,
+		SessionID:      mcm.sessionID,
+		SequenceNumber: mcm.sequenceNumber,
 	}).Marshal()
 }
 
@@ -96,6 +102,8 @@ func (mcm *MemberCommitmentsMessage) Unmarshal(bytes []byte) error {
 		return err
 	}
 	mcm.senderID = group.MemberIndex(pbMsg.SenderID)
+	mcm.sessionID = pbMsg.SessionID
+	mcm.sequenceNumber = pbMsg.SequenceNumber
 
 	var commitments []*bn256.G1
 	for _, commitmentBytes := range pbMsg.Commitments {
@@ -136,8 +144,10 @@ func (psm *PeerSharesMessage) Marshal() ([]byte, error) {
 	}
 
 	return (&pb.PeerShares{
-		SenderID: uint32(psm.senderID),
-		Shares:   pbShares,
+		SenderID:       uint32(psm.senderID),
+		Shares:         pbShares,
+		SessionID:      psm.sessionID,
+		SequenceNumber: psm.sequenceNumber,
 	}).Marshal()
 }
 
@@ -152,6 +162,8 @@ func (psm *PeerSharesMessage) Unmarshal(bytes []byte) error {
 		return err
 	}
 	psm.senderID = group.MemberIndex(pbMsg.SenderID)
+	psm.sessionID = pbMsg.SessionID
+	psm.sequenceNumber = pbMsg.SequenceNumber
 
 	shares := make(map[group.MemberIndex]*peerShares)
 	for memberID, pbShares := range pbMsg.Shares {
@@ -191,6 +203,8 @@ func (ssam *SecretSharesAccusationsMessage) Marshal() ([]byte, error) {
 	return (&pb.SecretSharesAccusations{
 		SenderID:           uint32(ssam.senderID),
 		AccusedMembersKeys: accusedMembersKeys,
+		SessionID:          ssam.sessionID,
+		SequenceNumber:     ssam.sequenceNumber,
 	}).Marshal()
 }
 
@@ -206,6 +220,8 @@ func (ssam *SecretSharesAccusationsMessage) Unmarshal(bytes []byte) error {
 		return err
 	}
 	ssam.senderID = group.MemberIndex(pbMsg.SenderID)
+	ssam.sessionID = pbMsg.SessionID
+	ssam.sequenceNumber = pbMsg.SequenceNumber
 
 	accusedMembersKeys, err := unmarshalPrivateKeyMap(pbMsg.AccusedMembersKeys)
 	if err != nil {
@@ -238,6 +254,8 @@ func (mpspm *MemberPublicKeySharePointsMessage) Marshal() ([]byte, error) {
 	return (&pb.MemberPublicKeySharePoints{
 		SenderID:             uint32(mpspm.senderID),
 		PublicKeySharePoints: keySharePoints,
+		SessionID:            mpspm.sessionID,
+		SequenceNumber:       mpspm.sequenceNumber,
 	}).Marshal()
 }
 
@@ -253,6 +271,8 @@ func (mpspm *MemberPublicKeySharePointsMessage) Unmarshal(bytes []byte) error {
 		return err
 	}
 	mpspm.senderID = group.MemberIndex(pbMsg.SenderID)
+	mpspm.sessionID = pbMsg.SessionID
+	mpspm.sequenceNumber = pbMsg.SequenceNumber
 
 	var keySharePoints []*bn256.G2
 	for _, keySharePointBytes := range pbMsg.PublicKeySharePoints {
@@ -288,6 +308,8 @@ func (pam *PointsAccusationsMessage) Marshal() ([]byte, error) {
 	return (&pb.PointsAccusations{
 		SenderID:           uint32(pam.senderID),
 		AccusedMembersKeys: accusedMembersKeys,
+		SessionID:          pam.sessionID,
+		SequenceNumber:     pam.sequenceNumber,
 	}).Marshal()
 }
 
@@ -303,6 +325,8 @@ func (pam *PointsAccusationsMessage) Unmarshal(bytes []byte) error {
 		return err
 	}
 	pam.senderID = group.MemberIndex(pbMsg.SenderID)
+	pam.sessionID = pbMsg.SessionID
+	pam.sequenceNumber = pbMsg.SequenceNumber
 
 	accusedMembersKeys, err := unmarshalPrivateKeyMap(pbMsg.AccusedMembersKeys)
 	if err != nil {
@@ -329,8 +353,10 @@ func (mekm *MisbehavedEphemeralKeysMessage) Marshal() ([]byte, error) {
 	}
 
 	return (&pb.MisbehavedEphemeralKeys{
-		SenderID:    uint32(mekm.senderID),
-		PrivateKeys: privateKeys,
+		SenderID:       uint32(mekm.senderID),
+		PrivateKeys:    privateKeys,
+		SessionID:      mekm.sessionID,
+		SequenceNumber: mekm.sequenceNumber,
 	}).Marshal()
 }
 
@@ -346,6 +372,8 @@ func (mekm *MisbehavedEphemeralKeysMessage) Unmarshal(bytes []byte) error {
 		return err
 	}
 	mekm.senderID = group.MemberIndex(pbMsg.SenderID)
+	mekm.sessionID = pbMsg.SessionID
+	mekm.sequenceNumber = pbMsg.SequenceNumber
 
 	privateKeys, err := unmarshalPrivateKeyMap(pbMsg.PrivateKeys)
 	if err != nil {
@@ -32,6 +32,7 @@ func TestEphemeralPublicKeyMessageRoundtrip(t *testing.T) {
 		senderID:            group.MemberIndex(38),
 		ephemeralPublicKeys: publicKeys,
 	}
+	msg.bindToSession([]byte{1, 2, 3}, 7)
 	unmarshaled := &EphemeralPublicKeyMessage{}
 
 	err = pbutils.RoundTrip(msg, unmarshaled)
@@ -1,9 +1,12 @@
 package gjkr
 
 import (
+	crand "crypto/rand"
+	"io"
 	"math/big"
 
 	bn256 "github.com/ethereum/go-ethereum/crypto/bn256/cloudflare"
+	"github.com/keep-network/keep-core/pkg/beacon/relay/config"
 	"github.com/keep-network/keep-core/pkg/beacon/relay/group"
 	"github.com/keep-network/keep-core/pkg/net/ephemeral"
 )
@@ -25,6 +28,27 @@ type memberCore struct {
 
 	// Cryptographic protocol parameters, the same for all members in the group.
 	protocolParameters *protocolParameters
+
+	// Block budgets of protocol phases, the same for all members in the group.
+	phaseBudgets *config.DKGPhaseBudgets
+
+	// Source of randomness for the member's ephemeral keys and polynomial
+	// coefficients; crypto/rand if nil.
+	randomness io.Reader
+
+	// DKG session messages sent by the member are bound to and received
+	// messages are checked against.
+	session *session
+}
+
+// randomSource returns the source of randomness the member uses to generate
+// its secrets.
+func (mc *memberCore) randomSource() io.Reader {
+	if mc.randomness == nil {
+		return crand.Reader
+	}
+
+	return mc.randomness
 }
 
 // LocalMember represents one member in a threshold group, prior to the
@@ -230,6 +254,8 @@ func NewMember(
 	dishonestThreshold int,
 	membershipValidator group.MembershipValidator,
 	seed *big.Int,
+	startBlockHeight uint64,
+	phaseBudgets *config.DKGPhaseBudgets,
 ) (*LocalMember, error) {
 	return &LocalMember{
 		memberCore: &memberCore{
@@ -238,6 +264,9 @@ func NewMember(
 			membershipValidator,
 			newDkgEvidenceLog(),
 			newProtocolParameters(seed),
+			phaseBudgets,
+			crand.Reader,
+			newSession(seed, startBlockHeight),
 		},
 	}, nil
 }
@@ -338,21 +367,6 @@ func (rm *ReconstructingMember) individualPublicKey() *bn256.G2 {
 	return rm.publicKeySharePoints[0]
 }
 
-// receivedValidPeerIndividualPublicKeys returns individual public keys received
-// from other members which passed the validation. Individual public key is zeroth
-// public key share point `A_j0`.
-func (sm *SharingMember) receivedValidPeerIndividualPublicKeys() []*bn256.G2 {
-	var receivedValidPeerIndividualPublicKeys []*bn256.G2
-
-	for _, peerPublicKeySharePoints := range sm.receivedValidPeerPublicKeySharePoints {
-		receivedValidPeerIndividualPublicKeys = append(
-			receivedValidPeerIndividualPublicKeys,
-			peerPublicKeySharePoints[0],
-		)
-	}
-	return receivedValidPeerIndividualPublicKeys
-}
-
 // Result can be either the successful computation of a round of distributed key
 // generation, or a notification of failure.
 // It returns the generated group public key and a private key share of a group
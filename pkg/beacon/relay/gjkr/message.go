@@ -23,6 +23,8 @@ import (
 // this message contains all the generated public keys and it is broadcast
 // within the group.
 type EphemeralPublicKeyMessage struct {
+	sessionBinding
+
 	senderID group.MemberIndex // i
 
 	ephemeralPublicKeys map[group.MemberIndex]*ephemeral.PublicKey // j -> Y_ij
@@ -34,6 +36,8 @@ type EphemeralPublicKeyMessage struct {
 //
 // It is expected to be broadcast.
 type MemberCommitmentsMessage struct {
+	sessionBinding
+
 	senderID group.MemberIndex
 
 	commitments []*bn256.G1 // slice of C_ik
@@ -44,6 +48,8 @@ type MemberCommitmentsMessage struct {
 //
 // It is expected to be broadcast within the group.
 type PeerSharesMessage struct {
+	sessionBinding
+
 	senderID group.MemberIndex // i
 
 	shares map[group.MemberIndex]*peerShares // j -> (s_ij, t_ij)
@@ -61,6 +67,8 @@ type peerShares struct {
 //
 // It is expected to be broadcast.
 type SecretSharesAccusationsMessage struct {
+	sessionBinding
+
 	senderID group.MemberIndex
 
 	accusedMembersKeys map[group.MemberIndex]*ephemeral.PrivateKey
@@ -71,6 +79,8 @@ type SecretSharesAccusationsMessage struct {
 //
 // It is expected to be broadcast.
 type MemberPublicKeySharePointsMessage struct {
+	sessionBinding
+
 	senderID group.MemberIndex
 
 	publicKeySharePoints []*bn256.G2 // A_ik = g^{a_ik} mod p
@@ -83,6 +93,8 @@ type MemberPublicKeySharePointsMessage struct {
 // message should be broadcast but with an empty map of `accusedMembersKeys`.
 // It is expected to be broadcast.
 type PointsAccusationsMessage struct {
+	sessionBinding
+
 	senderID group.MemberIndex
 
 	accusedMembersKeys map[group.MemberIndex]*ephemeral.PrivateKey
@@ -93,6 +105,8 @@ type PointsAccusationsMessage struct {
 // communication with members from QUAL set which were marked as disqualified
 // or inactive. It is expected to be broadcast.
 type MisbehavedEphemeralKeysMessage struct {
+	sessionBinding
+
 	senderID group.MemberIndex
 
 	privateKeys map[group.MemberIndex]*ephemeral.PrivateKey
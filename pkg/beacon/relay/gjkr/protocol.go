@@ -13,6 +13,7 @@ package gjkr
 import (
 	crand "crypto/rand"
 	"fmt"
+	"io"
 	"math/big"
 
 	bn256 "github.com/ethereum/go-ethereum/crypto/bn256/cloudflare"
@@ -38,7 +39,7 @@ func (em *EphemeralKeyPairGeneratingMember) GenerateEphemeralKeyPair() (
 			continue
 		}
 
-		ephemeralKeyPair, err := ephemeral.GenerateKeyPair()
+		ephemeralKeyPair, err := ephemeral.GenerateKeyPairFrom(em.randomSource())
 		if err != nil {
 			return nil, err
 		}
@@ -76,7 +77,10 @@ func (sm *SymmetricKeyGeneratingMember) GenerateSymmetricKeys(
 				sm.ID,
 				otherMember,
 			)
-			sm.group.MarkMemberAsDisqualified(otherMember)
+			sm.group.MarkMemberAsDisqualified(
+				otherMember,
+				ReasonInvalidEphemeralPublicKeyMessage,
+			)
 			continue
 		}
 
@@ -155,14 +159,14 @@ func (cm *CommittingMember) CalculateMembersSharesAndCommitments() (
 	error,
 ) {
 	polynomialDegree := cm.group.DishonestThreshold()
-	coefficientsA, err := generatePolynomial(polynomialDegree)
+	coefficientsA, err := generatePolynomial(polynomialDegree, cm.randomSource())
 	if err != nil {
 		return nil, nil, fmt.Errorf(
 			"could not generate shares polynomial [%v]",
 			err,
 		)
 	}
-	coefficientsB, err := generatePolynomial(polynomialDegree)
+	coefficientsB, err := generatePolynomial(polynomialDegree, cm.randomSource())
 	if err != nil {
 		return nil, nil, fmt.Errorf(
 			"could not generate hiding polynomial [%v]",
@@ -240,10 +244,11 @@ func (cm *CommittingMember) calculateCommitment(
 // This function will generate a slice of `degree + 1` coefficients (+1 for
 // a constant coefficient). Each value will be a random `big.Int` in range
 // `(0, q)` where `q` is the cardinality of alt_bn128 elliptic curve.
-func generatePolynomial(degree int) ([]*big.Int, error) {
+// Coefficients are drawn from the provided source of randomness.
+func generatePolynomial(degree int, randomness io.Reader) ([]*big.Int, error) {
 	generateCoefficient := func() (c *big.Int, err error) {
 		for {
-			c, err = crand.Int(crand.Reader, bn256.Order)
+			c, err = crand.Int(randomness, bn256.Order)
 			if c.Sign() > 0 || err != nil {
 				return
 			}
@@ -321,7 +326,10 @@ func (cvm *CommitmentsVerifyingMember) VerifyReceivedSharesAndCommitmentsMessage
 				cvm.ID,
 				commitmentsMessage.senderID,
 			)
-			cvm.group.MarkMemberAsDisqualified(commitmentsMessage.senderID)
+			cvm.group.MarkMemberAsDisqualified(
+				commitmentsMessage.senderID,
+				ReasonInvalidCommitmentsMessage,
+			)
 			continue
 		}
 
@@ -341,7 +349,10 @@ func (cvm *CommitmentsVerifyingMember) VerifyReceivedSharesAndCommitmentsMessage
 						cvm.ID,
 						sharesMessage.senderID,
 					)
-					cvm.group.MarkMemberAsDisqualified(sharesMessage.senderID)
+					cvm.group.MarkMemberAsDisqualified(
+						sharesMessage.senderID,
+						ReasonInvalidPeerSharesMessage,
+					)
 					break
 				}
 
@@ -379,7 +390,10 @@ func (cvm *CommitmentsVerifyingMember) VerifyReceivedSharesAndCommitmentsMessage
 						cvm.ID,
 						sharesMessage.senderID,
 					)
-					cvm.group.MarkMemberAsDisqualified(sharesMessage.senderID)
+					cvm.group.MarkMemberAsDisqualified(
+						sharesMessage.senderID,
+						ReasonUndecryptableShares,
+					)
 					accusedMembersKeys[sharesMessage.senderID] =
 						cvm.ephemeralKeyPairs[sharesMessage.senderID].PrivateKey
 					break
@@ -397,7 +411,10 @@ func (cvm *CommitmentsVerifyingMember) VerifyReceivedSharesAndCommitmentsMessage
 						cvm.ID,
 						commitmentsMessage.senderID,
 					)
-					cvm.group.MarkMemberAsDisqualified(commitmentsMessage.senderID)
+					cvm.group.MarkMemberAsDisqualified(
+						commitmentsMessage.senderID,
+						ReasonSharesInconsistentWithCommitments,
+					)
 					accusedMembersKeys[commitmentsMessage.senderID] =
 						cvm.ephemeralKeyPairs[commitmentsMessage.senderID].PrivateKey
 					break
@@ -552,7 +569,10 @@ func (sjm *SharesJustifyingMember) ResolveSecretSharesAccusationsMessages(
 				// The member does not resolve the dispute as an accused.
 				// Mark the accuser as disqualified immediately,
 				// as each member consider itself as a honest participant.
-				sjm.group.MarkMemberAsDisqualified(accuserID)
+				sjm.group.MarkMemberAsDisqualified(
+					accuserID,
+					ReasonAccusedThisMember,
+				)
 				sjm.discardReceivedShares(accuserID)
 				continue
 			}
@@ -587,7 +607,10 @@ func (sjm *SharesJustifyingMember) ResolveSecretSharesAccusationsMessages(
 					sjm.ID,
 					accuserID,
 				)
-				sjm.group.MarkMemberAsDisqualified(accuserID)
+				sjm.group.MarkMemberAsDisqualified(
+					accuserID,
+					ReasonRevealedKeyMismatch,
+				)
 				sjm.discardReceivedShares(accuserID)
 				continue
 			}
@@ -625,7 +648,10 @@ func (sjm *SharesJustifyingMember) ResolveSecretSharesAccusationsMessages(
 					accuserID,
 					accusedID,
 				)
-				sjm.group.MarkMemberAsDisqualified(accuserID)
+				sjm.group.MarkMemberAsDisqualified(
+					accuserID,
+					ReasonUnrecoverableSymmetricKey,
+				)
 				sjm.discardReceivedShares(accuserID)
 				continue
 			}
@@ -648,7 +674,10 @@ func (sjm *SharesJustifyingMember) ResolveSecretSharesAccusationsMessages(
 					accuserID,
 					accusedID,
 				)
-				sjm.group.MarkMemberAsDisqualified(accuserID)
+				sjm.group.MarkMemberAsDisqualified(
+					accuserID,
+					ReasonAccusedWithoutShares,
+				)
 				sjm.discardReceivedShares(accuserID)
 				continue
 			}
@@ -671,7 +700,10 @@ func (sjm *SharesJustifyingMember) ResolveSecretSharesAccusationsMessages(
 					accusedID,
 					accuserID,
 				)
-				sjm.group.MarkMemberAsDisqualified(accusedID)
+				sjm.group.MarkMemberAsDisqualified(
+					accusedID,
+					ReasonUndecryptableShares,
+				)
 				sjm.discardReceivedShares(accusedID)
 				continue
 			}
@@ -688,7 +720,10 @@ func (sjm *SharesJustifyingMember) ResolveSecretSharesAccusationsMessages(
 					accuserID,
 					accusedID,
 				)
-				sjm.group.MarkMemberAsDisqualified(accuserID)
+				sjm.group.MarkMemberAsDisqualified(
+					accuserID,
+					ReasonFalseAccusation,
+				)
 				sjm.discardReceivedShares(accuserID)
 			} else {
 				logger.Warningf(
@@ -698,7 +733,10 @@ func (sjm *SharesJustifyingMember) ResolveSecretSharesAccusationsMessages(
 					accusedID,
 					accuserID,
 				)
-				sjm.group.MarkMemberAsDisqualified(accusedID)
+				sjm.group.MarkMemberAsDisqualified(
+					accusedID,
+					ReasonConfirmedMisbehaviour,
+				)
 				sjm.discardReceivedShares(accusedID)
 			}
 		}
@@ -837,7 +875,10 @@ func (sm *SharingMember) VerifyPublicKeySharePoints(
 				sm.ID,
 				message.senderID,
 			)
-			sm.group.MarkMemberAsDisqualified(message.senderID)
+			sm.group.MarkMemberAsDisqualified(
+				message.senderID,
+				ReasonInvalidPublicKeySharePointsMessage,
+			)
 			continue
 		}
 
@@ -852,7 +893,10 @@ func (sm *SharingMember) VerifyPublicKeySharePoints(
 				sm.ID,
 				message.senderID,
 			)
-			sm.group.MarkMemberAsDisqualified(message.senderID)
+			sm.group.MarkMemberAsDisqualified(
+				message.senderID,
+				ReasonInvalidPublicKeySharePoints,
+			)
 			accusedMembersKeys[message.senderID] = sm.ephemeralKeyPairs[message.senderID].PrivateKey
 			continue
 		}
@@ -986,7 +1030,10 @@ func (pjm *PointsJustifyingMember) ResolvePublicKeySharePointsAccusationsMessage
 				// The member does not resolve the dispute as an accused.
 				// Mark the accuser as disqualified immediately,
 				// as each member consider itself as a honest participant.
-				pjm.group.MarkMemberAsDisqualified(accuserID)
+				pjm.group.MarkMemberAsDisqualified(
+					accuserID,
+					ReasonAccusedThisMember,
+				)
 				continue
 			}
 
@@ -1022,7 +1069,10 @@ func (pjm *PointsJustifyingMember) ResolvePublicKeySharePointsAccusationsMessage
 					pjm.ID,
 					accuserID,
 				)
-				pjm.group.MarkMemberAsDisqualified(accuserID)
+				pjm.group.MarkMemberAsDisqualified(
+					accuserID,
+					ReasonRevealedKeyMismatch,
+				)
 				continue
 			}
 
@@ -1059,7 +1109,10 @@ func (pjm *PointsJustifyingMember) ResolvePublicKeySharePointsAccusationsMessage
 					accuserID,
 					accusedID,
 				)
-				pjm.group.MarkMemberAsDisqualified(accuserID)
+				pjm.group.MarkMemberAsDisqualified(
+					accuserID,
+					ReasonUnrecoverableSymmetricKey,
+				)
 				continue
 			}
 			recoveredSymmetricKey := revealedAccuserPrivateKey.Ecdh(accusedPublicKey)
@@ -1081,7 +1134,10 @@ func (pjm *PointsJustifyingMember) ResolvePublicKeySharePointsAccusationsMessage
 					accuserID,
 					accusedID,
 				)
-				pjm.group.MarkMemberAsDisqualified(accuserID)
+				pjm.group.MarkMemberAsDisqualified(
+					accuserID,
+					ReasonAccusedWithoutShares,
+				)
 				continue
 			}
 
@@ -1107,8 +1163,14 @@ func (pjm *PointsJustifyingMember) ResolvePublicKeySharePointsAccusationsMessage
 					accusedID,
 					accuserID,
 				)
-				pjm.group.MarkMemberAsDisqualified(accuserID)
-				pjm.group.MarkMemberAsDisqualified(accusedID)
+				pjm.group.MarkMemberAsDisqualified(
+					accuserID,
+					ReasonUnreportedViolation,
+				)
+				pjm.group.MarkMemberAsDisqualified(
+					accusedID,
+					ReasonUndecryptableShares,
+				)
 				continue
 			}
 
@@ -1124,7 +1186,10 @@ func (pjm *PointsJustifyingMember) ResolvePublicKeySharePointsAccusationsMessage
 					accuserID,
 					accusedID,
 				)
-				pjm.group.MarkMemberAsDisqualified(accuserID)
+				pjm.group.MarkMemberAsDisqualified(
+					accuserID,
+					ReasonFalseAccusation,
+				)
 			} else {
 				logger.Warningf(
 					"[member:%v] member [%v] disqualified because of "+
@@ -1133,7 +1198,10 @@ func (pjm *PointsJustifyingMember) ResolvePublicKeySharePointsAccusationsMessage
 					accusedID,
 					accuserID,
 				)
-				pjm.group.MarkMemberAsDisqualified(accusedID)
+				pjm.group.MarkMemberAsDisqualified(
+					accusedID,
+					ReasonConfirmedMisbehaviour,
+				)
 			}
 		}
 	}
@@ -1224,7 +1292,10 @@ func (rm *ReconstructingMember) ReconstructMisbehavedIndividualKeys(
 				rm.ID,
 				message.senderID,
 			)
-			rm.group.MarkMemberAsDisqualified(message.senderID)
+			rm.group.MarkMemberAsDisqualified(
+				message.senderID,
+				ReasonInvalidMisbehavedEphemeralKeysMessage,
+			)
 		}
 	}
 
@@ -1317,7 +1388,10 @@ func (rm *ReconstructingMember) recoverMisbehavedShares(
 				// Mark the revealing member as disqualified immediately,
 				// as each member consider itself as a honest participant.
 				// Continue as there is no sense to recover own shares.
-				rm.group.MarkMemberAsDisqualified(revealingMemberID)
+				rm.group.MarkMemberAsDisqualified(
+					revealingMemberID,
+					ReasonAccusedThisMember,
+				)
 				continue
 			}
 
@@ -1361,7 +1435,10 @@ func (rm *ReconstructingMember) recoverMisbehavedShares(
 					rm.ID,
 					revealingMemberID,
 				)
-				rm.group.MarkMemberAsDisqualified(revealingMemberID)
+				rm.group.MarkMemberAsDisqualified(
+					revealingMemberID,
+					ReasonRevealedKeyMismatch,
+				)
 				continue
 			}
 
@@ -1402,7 +1479,10 @@ func (rm *ReconstructingMember) recoverMisbehavedShares(
 					revealingMemberID,
 					misbehavedMemberID,
 				)
-				rm.group.MarkMemberAsDisqualified(revealingMemberID)
+				rm.group.MarkMemberAsDisqualified(
+					revealingMemberID,
+					ReasonUnrecoverableSymmetricKey,
+				)
 				continue
 			}
 			recoveredSymmetricKey := revealedPrivateKey.Ecdh(misbehavedMemberPublicKey)
@@ -1423,7 +1503,10 @@ func (rm *ReconstructingMember) recoverMisbehavedShares(
 					rm.ID,
 					revealingMemberID,
 				)
-				rm.group.MarkMemberAsDisqualified(revealingMemberID)
+				rm.group.MarkMemberAsDisqualified(
+					revealingMemberID,
+					ReasonAccusedWithoutShares,
+				)
 				continue
 			}
 
@@ -1446,7 +1529,10 @@ func (rm *ReconstructingMember) recoverMisbehavedShares(
 					revealingMemberID,
 					misbehavedMemberID,
 				)
-				rm.group.MarkMemberAsDisqualified(revealingMemberID)
+				rm.group.MarkMemberAsDisqualified(
+					revealingMemberID,
+					ReasonUnreportedViolation,
+				)
 				continue
 			}
 
@@ -1472,7 +1558,10 @@ func (rm *ReconstructingMember) recoverMisbehavedShares(
 					revealingMemberID,
 					misbehavedMemberID,
 				)
-				rm.group.MarkMemberAsDisqualified(revealingMemberID)
+				rm.group.MarkMemberAsDisqualified(
+					revealingMemberID,
+					ReasonUnreportedViolation,
+				)
 			}
 		}
 	}
@@ -1662,8 +1751,17 @@ func (cm *CombiningMember) CombineGroupPublicKey() {
 	groupPublicKey := cm.individualPublicKey()
 
 	// Add received peer group members' individual public keys `A_j0`.
-	for _, peerPublicKey := range cm.receivedValidPeerIndividualPublicKeys() {
-		groupPublicKey = new(bn256.G2).Add(groupPublicKey, peerPublicKey)
+	// Members disqualified after their public key share points have been
+	// validated have their individual public keys reconstructed as well and
+	// those must not be counted twice.
+	for peerID, peerPublicKeySharePoints := range cm.receivedValidPeerPublicKeySharePoints {
+		if _, reconstructed := cm.reconstructedIndividualPublicKeys[peerID]; reconstructed {
+			continue
+		}
+		groupPublicKey = new(bn256.G2).Add(
+			groupPublicKey,
+			peerPublicKeySharePoints[0],
+		)
 	}
 
 	// Add reconstructed misbehaved members' individual public keys `G * z_m`.
@@ -1,6 +1,7 @@
 package gjkr
 
 import (
+	crand "crypto/rand"
 	"fmt"
 	"math/big"
 	"reflect"
@@ -360,7 +361,7 @@ func TestResolvePublicKeySharePointsAccusationsMessages(t *testing.T) {
 			shares := make(map[group.MemberIndex]*peerShares)
 			shares[test.accuserID] = &peerShares{encryptedShareS, encryptedShareT}
 			justifyingMember.evidenceLog.PutPeerSharesMessage(
-				&PeerSharesMessage{test.accusedID, shares},
+				&PeerSharesMessage{senderID: test.accusedID, shares: shares},
 			)
 
 			if test.modifyEvidenceLog != nil {
@@ -447,11 +448,11 @@ func initializeSharesJustifyingMemberGroup(dishonestThreshold, groupSize int) (
 	groupCommitments := make(map[group.MemberIndex][]*bn256.G1, groupSize)
 
 	for _, m := range sharesJustifyingMembers {
-		memberCoefficientsA, err := generatePolynomial(dishonestThreshold)
+		memberCoefficientsA, err := generatePolynomial(dishonestThreshold, crand.Reader)
 		if err != nil {
 			return nil, fmt.Errorf("polynomial generation failed [%s]", err)
 		}
-		memberCoefficientsB, err := generatePolynomial(dishonestThreshold)
+		memberCoefficientsB, err := generatePolynomial(dishonestThreshold, crand.Reader)
 		if err != nil {
 			return nil, fmt.Errorf("polynomial generation failed [%s]", err)
 		}
@@ -64,6 +64,50 @@ func TestCombineGroupPublicKey(t *testing.T) {
 	}
 }
 
+func TestCombineGroupPublicKeyWithReconstructedPeerKey(t *testing.T) {
+	dishonestThreshold := 1
+	groupSize := 3
+
+	expectedGroupPublicKey := new(bn256.G2).ScalarBaseMult(
+		big.NewInt(60), // 10 + 20 + 30
+	)
+	members, err := initializeCombiningMembersGroup(dishonestThreshold, groupSize)
+	if err != nil {
+		t.Fatal(err)
+	}
+	member := members[0]
+
+	member.publicKeySharePoints = []*bn256.G2{
+		new(bn256.G2).ScalarBaseMult(big.NewInt(10)),
+		new(bn256.G2).ScalarBaseMult(big.NewInt(11)),
+	}
+	member.receivedValidPeerPublicKeySharePoints[2] = []*bn256.G2{
+		new(bn256.G2).ScalarBaseMult(big.NewInt(20)),
+		new(bn256.G2).ScalarBaseMult(big.NewInt(21)),
+	}
+	member.receivedValidPeerPublicKeySharePoints[3] = []*bn256.G2{
+		new(bn256.G2).ScalarBaseMult(big.NewInt(30)),
+		new(bn256.G2).ScalarBaseMult(big.NewInt(31)),
+	}
+
+	// Member 3 has been disqualified after its public key share points were
+	// validated, so its individual public key has been reconstructed as well.
+	member.reconstructedIndividualPublicKeys[3] = new(bn256.G2).ScalarBaseMult(
+		big.NewInt(30),
+	)
+
+	member.CombineGroupPublicKey()
+
+	if member.groupPublicKey.String() != expectedGroupPublicKey.String() {
+		t.Fatalf(
+			"incorrect group public key for member %d\nexpected: %v\nactual:   %v\n",
+			member.ID,
+			expectedGroupPublicKey,
+			member.groupPublicKey,
+		)
+	}
+}
+
 func TestCombineGroupPublicKeyShares(t *testing.T) {
 	dishonestThreshold := 1
 	groupSize := 3
@@ -1,6 +1,7 @@
 package gjkr
 
 import (
+	crand "crypto/rand"
 	"fmt"
 	"math/big"
 	"reflect"
@@ -293,7 +294,7 @@ func assertValidSharesAndCommitments(
 func TestGeneratePolynomial(t *testing.T) {
 	degree := 3
 
-	coefficients, err := generatePolynomial(degree)
+	coefficients, err := generatePolynomial(degree, crand.Reader)
 	if err != nil {
 		t.Fatalf("unexpected error [%s]", err)
 	}
@@ -228,7 +228,10 @@ func generateGroupWithEphemeralKeys(
 		// simulating message broadcast in the group
 		for _, member := range symmetricKeyMembers {
 			member.evidenceLog.PutEphemeralMessage(
-				&EphemeralPublicKeyMessage{member1.ID, ephemeralKeys},
+				&EphemeralPublicKeyMessage{
+					senderID:            member1.ID,
+					ephemeralPublicKeys: ephemeralKeys,
+				},
 			)
 		}
 	}
@@ -24,9 +24,9 @@ func TestRevealMisbehavedMembersKeys(t *testing.T) {
 	disqualifiedSharingMember1 := group.MemberIndex(2)
 	disqualifiedSharingMember2 := group.MemberIndex(3)
 	disqualifiedNotSharingMember := group.MemberIndex(6)
-	firstMember.group.MarkMemberAsDisqualified(disqualifiedSharingMember1)
-	firstMember.group.MarkMemberAsDisqualified(disqualifiedSharingMember2)
-	firstMember.group.MarkMemberAsDisqualified(disqualifiedNotSharingMember)
+	firstMember.group.MarkMemberAsDisqualified(disqualifiedSharingMember1, "misbehaviour")
+	firstMember.group.MarkMemberAsDisqualified(disqualifiedSharingMember2, "misbehaviour")
+	firstMember.group.MarkMemberAsDisqualified(disqualifiedNotSharingMember, "misbehaviour")
 
 	// Simulate a case where member is disqualified in Phase 5.
 	delete(firstMember.receivedQualifiedSharesS, disqualifiedNotSharingMember)
@@ -159,7 +159,7 @@ func generateMisbehavedEphemeralKeysMessages(
 	var misbehavedEphemeralKeysMessages []*MisbehavedEphemeralKeysMessage
 	for _, otherMember := range otherMembers {
 		for _, disqualifiedMember := range disqualifiedMembers {
-			otherMember.group.MarkMemberAsDisqualified(disqualifiedMember.ID)
+			otherMember.group.MarkMemberAsDisqualified(disqualifiedMember.ID, "misbehaviour")
 		}
 		misbehavedEphemeralKeysMessage, err := otherMember.RevealMisbehavedMembersKeys()
 		if err != nil {
@@ -343,8 +343,8 @@ func TestReconstructMisbehavedIndividualKeys(t *testing.T) {
 
 	// Disqualified members must be also disqualified
 	// from the recovering member's perspective
-	member1.group.MarkMemberAsDisqualified(member5.ID)
-	member1.group.MarkMemberAsDisqualified(member6.ID)
+	member1.group.MarkMemberAsDisqualified(member5.ID, "misbehaviour")
+	member1.group.MarkMemberAsDisqualified(member6.ID, "misbehaviour")
 
 	var misbehavedEphemeralKeysMessages []*MisbehavedEphemeralKeysMessage
 	for _, otherMember := range otherMembers {
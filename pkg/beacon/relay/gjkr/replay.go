@@ -0,0 +1,52 @@
+package gjkr
+
+import (
+	"fmt"
+
+	"github.com/keep-network/keep-core/pkg/beacon/relay/dkg/transcript"
+)
+
+// Replay replays the GJKR protocol execution recorded in the given transcript.
+// The member generates the same secrets and receives the same messages in the
+// same phases as in the recorded execution so it reproduces the result of
+// the recorded execution, including disqualified and inactive members.
+func Replay(t *transcript.Transcript) (*Result, error) {
+	member, err := NewMember(
+		t.MemberIndex,
+		t.GroupSize,
+		t.DishonestThreshold,
+		t.MembershipValidator(),
+		t.Seed,
+		t.StartBlockHeight,
+		nil,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("cannot create a new member: [%v]", err)
+	}
+	member.randomness = t.RandomnessSource()
+
+	channel := transcript.NewChannel()
+	RegisterUnmarshallers(channel)
+
+	initialState := &ephemeralKeyPairGenerationState{
+		channel: channel,
+		member:  member.InitializeEphemeralKeysGeneration(),
+	}
+
+	lastState, err := t.Replay(
+		transcript.KeyGeneration,
+		channel,
+		initialState,
+		nil,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	finalizationState, ok := lastState.(*finalizationState)
+	if !ok {
+		return nil, fmt.Errorf("replay ended on state: %T", lastState)
+	}
+
+	return finalizationState.result(), nil
+}
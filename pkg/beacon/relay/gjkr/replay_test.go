@@ -0,0 +1,91 @@
+package gjkr_test
+
+import (
+	"testing"
+
+	"github.com/keep-network/keep-core/pkg/beacon/relay/gjkr"
+	"github.com/keep-network/keep-core/pkg/beacon/relay/group"
+	"github.com/keep-network/keep-core/pkg/internal/dkgtest"
+	"github.com/keep-network/keep-core/pkg/net"
+)
+
+func TestReplay_HappyPath(t *testing.T) {
+	t.Parallel()
+
+	groupSize := 5
+	honestThreshold := 3
+	seed := dkgtest.RandomSeed(t)
+
+	interceptor := func(msg net.TaggedMarshaler) net.TaggedMarshaler {
+		return msg
+	}
+
+	result, err := dkgtest.RunTest(groupSize, honestThreshold, seed, interceptor)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	dkgtest.AssertDkgResultPublished(t, result)
+	dkgtest.AssertNoMisbehavingMembers(t, result)
+	dkgtest.AssertReplayedResults(t, result)
+}
+
+func TestReplay_IA_members12_phase3(t *testing.T) {
+	t.Parallel()
+
+	groupSize := 7
+	honestThreshold := 4
+	seed := dkgtest.RandomSeed(t)
+
+	interceptor := func(msg net.TaggedMarshaler) net.TaggedMarshaler {
+		// drop commitment message from member 1
+		commitmentMessage, ok := msg.(*gjkr.MemberCommitmentsMessage)
+		if ok && commitmentMessage.SenderID() == group.MemberIndex(1) {
+			return nil
+		}
+
+		// drop shares message from member 2
+		sharesMessage, ok := msg.(*gjkr.PeerSharesMessage)
+		if ok && sharesMessage.SenderID() == group.MemberIndex(2) {
+			return nil
+		}
+
+		return msg
+	}
+
+	result, err := dkgtest.RunTest(groupSize, honestThreshold, seed, interceptor)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	dkgtest.AssertDkgResultPublished(t, result)
+	dkgtest.AssertMisbehavingMembers(t, result, group.MemberIndex(1), group.MemberIndex(2))
+	dkgtest.AssertReplayedResults(t, result)
+}
+
+func TestReplay_DQ_member5_invalidCommitmentsMessage_phase4(t *testing.T) {
+	t.Parallel()
+
+	groupSize := 5
+	honestThreshold := 3
+	seed := dkgtest.RandomSeed(t)
+
+	interceptor := func(msg net.TaggedMarshaler) net.TaggedMarshaler {
+		commitmentsMessage, ok := msg.(*gjkr.MemberCommitmentsMessage)
+		if ok && commitmentsMessage.SenderID() == group.MemberIndex(5) {
+			commitmentsMessage.RemoveCommitment(1)
+			return commitmentsMessage
+		}
+
+		return msg
+	}
+
+	result, err := dkgtest.RunTest(groupSize, honestThreshold, seed, interceptor)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	dkgtest.AssertDkgResultPublished(t, result)
+	dkgtest.AssertMisbehavingMembers(t, result, group.MemberIndex(5))
+	dkgtest.AssertReplayedResults(t, result)
+}
@@ -0,0 +1,126 @@
+package gjkr
+
+import (
+	"bytes"
+	"encoding/binary"
+	"math/big"
+	"sync"
+
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/keep-network/keep-core/pkg/beacon/relay/group"
+)
+
+// sessionMessage is a protocol message bound to the DKG session it has been
+// sent in.
+type sessionMessage interface {
+	group.ProtocolMessage
+
+	// SessionID returns the ID of the DKG session the message has been sent
+	// in.
+	SessionID() []byte
+	// SequenceNumber returns the number of the message in the sequence of all
+	// messages sent by the sender in the DKG session.
+	SequenceNumber() uint64
+
+	bindToSession(sessionID []byte, sequenceNumber uint64)
+}
+
+// sessionBinding is embedded in protocol messages to bind them to the DKG
+// session they are sent in.
+type sessionBinding struct {
+	sessionID      []byte
+	sequenceNumber uint64
+}
+
+// SessionID returns the ID of the DKG session the message has been sent in.
+func (sb *sessionBinding) SessionID() []byte {
+	return sb.sessionID
+}
+
+// SequenceNumber returns the number of the message in the sequence of all
+// messages sent by the sender in the DKG session.
+func (sb *sessionBinding) SequenceNumber() uint64 {
+	return sb.sequenceNumber
+}
+
+func (sb *sessionBinding) bindToSession(
+	sessionID []byte,
+	sequenceNumber uint64,
+) {
+	sb.sessionID = sessionID
+	sb.sequenceNumber = sequenceNumber
+}
+
+// session identifies a single DKG execution. It binds messages sent by the
+// member to the execution and rejects received messages sent in other
+// executions or already received in this one, so that messages captured from
+// one DKG execution can not be replayed into another or within the same one.
+type session struct {
+	id []byte
+
+	mutex             sync.Mutex
+	lastSentSequence  uint64
+	receivedSequences map[group.MemberIndex]map[uint64]bool
+}
+
+// newSession creates the session of the DKG execution started at the given
+// block for the given group selection seed. Both the seed and the start block
+// are the same for all members of the group. The start block distinguishes
+// executions which happen to be started for the same seed.
+func newSession(seed *big.Int, startBlockHeight uint64) *session {
+	startBlock := make([]byte, 8)
+	binary.BigEndian.PutUint64(startBlock, startBlockHeight)
+
+	return &session{
+		id:                crypto.Keccak256([]byte("gjkr"), seed.Bytes(), startBlock),
+		receivedSequences: make(map[group.MemberIndex]map[uint64]bool),
+	}
+}
+
+// bind binds the given message to be sent by the member to the session,
+// assigning it the next sequence number.
+func (s *session) bind(message sessionMessage) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	s.lastSentSequence++
+	message.bindToSession(s.id, s.lastSentSequence)
+}
+
+// accept returns true if the given received message has been sent in this
+// session and no message with the same sequence number has been received
+// from the sender so far. The message is expected to come from a validated
+// sender so that nobody else can use up the sender's sequence numbers.
+func (s *session) accept(message sessionMessage) bool {
+	if !bytes.Equal(message.SessionID(), s.id) {
+		logger.Warningf(
+			"rejecting message [%T] from member [%v] sent in other session",
+			message,
+			message.SenderID(),
+		)
+		return false
+	}
+
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	sequences, ok := s.receivedSequences[message.SenderID()]
+	if !ok {
+		sequences = make(map[uint64]bool)
+		s.receivedSequences[message.SenderID()] = sequences
+	}
+
+	if sequences[message.SequenceNumber()] {
+		logger.Warningf(
+			"rejecting message [%T] from member [%v] replaying "+
+				"sequence number [%v]",
+			message,
+			message.SenderID(),
+			message.SequenceNumber(),
+		)
+		return false
+	}
+	sequences[message.SequenceNumber()] = true
+
+	return true
+}
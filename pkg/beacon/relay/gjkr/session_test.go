@@ -0,0 +1,89 @@
+package gjkr
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/keep-network/keep-core/pkg/beacon/relay/group"
+)
+
+func TestSessionAccept(t *testing.T) {
+	senderSession := newSession(big.NewInt(100), 10)
+
+	var tests = map[string]struct {
+		messages       func() []*MemberCommitmentsMessage
+		expectedAccept []bool
+	}{
+		"messages of the session": {
+			messages: func() []*MemberCommitmentsMessage {
+				first := &MemberCommitmentsMessage{senderID: 2}
+				second := &MemberCommitmentsMessage{senderID: 2}
+				senderSession.bind(first)
+				senderSession.bind(second)
+				return []*MemberCommitmentsMessage{second, first}
+			},
+			expectedAccept: []bool{true, true},
+		},
+		"message replayed in the session": {
+			messages: func() []*MemberCommitmentsMessage {
+				message := &MemberCommitmentsMessage{senderID: 2}
+				senderSession.bind(message)
+				return []*MemberCommitmentsMessage{message, message}
+			},
+			expectedAccept: []bool{true, false},
+		},
+		"messages of different senders with the same sequence number": {
+			messages: func() []*MemberCommitmentsMessage {
+				message2 := &MemberCommitmentsMessage{senderID: 2}
+				message3 := &MemberCommitmentsMessage{senderID: 3}
+				message2.bindToSession(senderSession.id, 1)
+				message3.bindToSession(senderSession.id, 1)
+				return []*MemberCommitmentsMessage{message2, message3}
+			},
+			expectedAccept: []bool{true, true},
+		},
+		"message replayed from other session": {
+			messages: func() []*MemberCommitmentsMessage {
+				message := &MemberCommitmentsMessage{senderID: 2}
+				newSession(big.NewInt(99), 10).bind(message)
+				return []*MemberCommitmentsMessage{message}
+			},
+			expectedAccept: []bool{false},
+		},
+		"message replayed from other execution for the same seed": {
+			messages: func() []*MemberCommitmentsMessage {
+				message := &MemberCommitmentsMessage{senderID: 2}
+				newSession(big.NewInt(100), 20).bind(message)
+				return []*MemberCommitmentsMessage{message}
+			},
+			expectedAccept: []bool{false},
+		},
+		"message not bound to any session": {
+			messages: func() []*MemberCommitmentsMessage {
+				return []*MemberCommitmentsMessage{
+					{senderID: group.MemberIndex(2)},
+				}
+			},
+			expectedAccept: []bool{false},
+		},
+	}
+
+	for testName, test := range tests {
+		t.Run(testName, func(t *testing.T) {
+			receiverSession := newSession(big.NewInt(100), 10)
+
+			for i, message := range test.messages() {
+				accepted := receiverSession.accept(message)
+				if accepted != test.expectedAccept[i] {
+					t.Errorf(
+						"unexpected acceptance of message [%v]\n"+
+							"expected: %v\nactual:   %v\n",
+						i,
+						test.expectedAccept[i],
+						accepted,
+					)
+				}
+			}
+		})
+	}
+}
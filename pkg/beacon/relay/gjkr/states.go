@@ -3,6 +3,7 @@ package gjkr
 import (
 	"context"
 
+	"github.com/keep-network/keep-core/pkg/beacon/relay/config"
 	"github.com/keep-network/keep-core/pkg/beacon/relay/group"
 	"github.com/keep-network/keep-core/pkg/beacon/relay/state"
 	"github.com/keep-network/keep-core/pkg/net"
@@ -36,6 +37,42 @@ const (
 	combinationStateActiveBlocks = 20
 )
 
+// DefaultPhaseBudgets returns the default block budgets of protocol phases.
+// Their sum, together with the result signing phase, is equal to the DKG
+// duration expected by the on-chain result verification.
+func DefaultPhaseBudgets() *config.DKGPhaseBudgets {
+	return &config.DKGPhaseBudgets{
+		EphemeralKeyPair: config.PhaseBudget{
+			DelayBlocks:  ephemeralKeyPairStateDelayBlocks,
+			ActiveBlocks: ephemeralKeyPairStateActiveBlocks,
+		},
+		Commitment: config.PhaseBudget{
+			DelayBlocks:  commitmentStateDelayBlocks,
+			ActiveBlocks: commitmentStateActiveBlocks,
+		},
+		CommitmentVerification: config.PhaseBudget{
+			DelayBlocks:  commitmentVerificationStateDelayBlocks,
+			ActiveBlocks: commitmentVerificationStateActiveBlocks,
+		},
+		PointsShare: config.PhaseBudget{
+			DelayBlocks:  pointsShareStateDelayBlocks,
+			ActiveBlocks: pointsShareStateActiveBlocks,
+		},
+		PointsValidation: config.PhaseBudget{
+			DelayBlocks:  pointsValidationStateDelayBlocks,
+			ActiveBlocks: pointsValidationStateActiveBlocks,
+		},
+		KeyReveal: config.PhaseBudget{
+			DelayBlocks:  keyRevealStateDelayBlocks,
+			ActiveBlocks: keyRevealStateActiveBlocks,
+		},
+		Combination: config.PhaseBudget{
+			DelayBlocks:  combinationStateDelayBlocks,
+			ActiveBlocks: combinationStateActiveBlocks,
+		},
+	}
+}
+
 // ephemeralKeyPairGenerationState is the state during which members broadcast
 // public ephemeral keys generated for other members of the group.
 // `EphemeralPublicKeyMessage`s are valid in this state.
@@ -49,11 +86,11 @@ type ephemeralKeyPairGenerationState struct {
 }
 
 func (ekpgs *ephemeralKeyPairGenerationState) DelayBlocks() uint64 {
-	return ephemeralKeyPairStateDelayBlocks
+	return ekpgs.member.phaseBudgets.EphemeralKeyPair.DelayBlocks
 }
 
 func (ekpgs *ephemeralKeyPairGenerationState) ActiveBlocks() uint64 {
-	return ephemeralKeyPairStateActiveBlocks
+	return ekpgs.member.phaseBudgets.EphemeralKeyPair.ActiveBlocks
 }
 
 func (ekpgs *ephemeralKeyPairGenerationState) Initiate(ctx context.Context) error {
@@ -62,6 +99,7 @@ func (ekpgs *ephemeralKeyPairGenerationState) Initiate(ctx context.Context) erro
 		return err
 	}
 
+	ekpgs.member.session.bind(message)
 	if err := ekpgs.channel.Send(ctx, message); err != nil {
 		return err
 	}
@@ -73,7 +111,8 @@ func (ekpgs *ephemeralKeyPairGenerationState) Receive(msg net.Message) error {
 	case *EphemeralPublicKeyMessage:
 		if !group.IsMessageFromSelf(ekpgs.member.ID, phaseMessage) &&
 			group.IsSenderValid(ekpgs.member, phaseMessage, msg.SenderPublicKey()) &&
-			group.IsSenderAccepted(ekpgs.member, phaseMessage) {
+			group.IsSenderAccepted(ekpgs.member, phaseMessage) &&
+			ekpgs.member.session.accept(phaseMessage) {
 			ekpgs.phaseMessages = append(ekpgs.phaseMessages, phaseMessage)
 		}
 	}
@@ -148,11 +187,11 @@ type commitmentState struct {
 }
 
 func (cs *commitmentState) DelayBlocks() uint64 {
-	return commitmentStateDelayBlocks
+	return cs.member.phaseBudgets.Commitment.DelayBlocks
 }
 
 func (cs *commitmentState) ActiveBlocks() uint64 {
-	return commitmentStateActiveBlocks
+	return cs.member.phaseBudgets.Commitment.ActiveBlocks
 }
 
 func (cs *commitmentState) Initiate(ctx context.Context) error {
@@ -161,10 +200,12 @@ func (cs *commitmentState) Initiate(ctx context.Context) error {
 		return err
 	}
 
+	cs.member.session.bind(sharesMsg)
 	if err := cs.channel.Send(ctx, sharesMsg); err != nil {
 		return err
 	}
 
+	cs.member.session.bind(commitmentsMsg)
 	if err := cs.channel.Send(ctx, commitmentsMsg); err != nil {
 		return err
 	}
@@ -177,14 +218,16 @@ func (cs *commitmentState) Receive(msg net.Message) error {
 	case *PeerSharesMessage:
 		if !group.IsMessageFromSelf(cs.member.ID, phaseMessage) &&
 			group.IsSenderValid(cs.member, phaseMessage, msg.SenderPublicKey()) &&
-			group.IsSenderAccepted(cs.member, phaseMessage) {
+			group.IsSenderAccepted(cs.member, phaseMessage) &&
+			cs.member.session.accept(phaseMessage) {
 			cs.phaseSharesMessages = append(cs.phaseSharesMessages, phaseMessage)
 		}
 
 	case *MemberCommitmentsMessage:
 		if !group.IsMessageFromSelf(cs.member.ID, phaseMessage) &&
 			group.IsSenderValid(cs.member, phaseMessage, msg.SenderPublicKey()) &&
-			group.IsSenderAccepted(cs.member, phaseMessage) {
+			group.IsSenderAccepted(cs.member, phaseMessage) &&
+			cs.member.session.accept(phaseMessage) {
 			cs.phaseCommitmentsMessages = append(
 				cs.phaseCommitmentsMessages,
 				phaseMessage,
@@ -225,11 +268,11 @@ type commitmentsVerificationState struct {
 }
 
 func (cvs *commitmentsVerificationState) DelayBlocks() uint64 {
-	return commitmentVerificationStateDelayBlocks
+	return cvs.member.phaseBudgets.CommitmentVerification.DelayBlocks
 }
 
 func (cvs *commitmentsVerificationState) ActiveBlocks() uint64 {
-	return commitmentVerificationStateActiveBlocks
+	return cvs.member.phaseBudgets.CommitmentVerification.ActiveBlocks
 }
 
 func (cvs *commitmentsVerificationState) Initiate(ctx context.Context) error {
@@ -245,6 +288,7 @@ func (cvs *commitmentsVerificationState) Initiate(ctx context.Context) error {
 		return err
 	}
 
+	cvs.member.session.bind(accusationsMsg)
 	if err := cvs.channel.Send(ctx, accusationsMsg); err != nil {
 		return err
 	}
@@ -257,7 +301,8 @@ func (cvs *commitmentsVerificationState) Receive(msg net.Message) error {
 	case *SecretSharesAccusationsMessage:
 		if !group.IsMessageFromSelf(cvs.member.ID, phaseMessage) &&
 			group.IsSenderValid(cvs.member, phaseMessage, msg.SenderPublicKey()) &&
-			group.IsSenderAccepted(cvs.member, phaseMessage) {
+			group.IsSenderAccepted(cvs.member, phaseMessage) &&
+			cvs.member.session.accept(phaseMessage) {
 			cvs.phaseAccusationsMessages = append(
 				cvs.phaseAccusationsMessages,
 				phaseMessage,
@@ -380,15 +425,16 @@ type pointsShareState struct {
 }
 
 func (pss *pointsShareState) DelayBlocks() uint64 {
-	return pointsShareStateDelayBlocks
+	return pss.member.phaseBudgets.PointsShare.DelayBlocks
 }
 
 func (pss *pointsShareState) ActiveBlocks() uint64 {
-	return pointsShareStateActiveBlocks
+	return pss.member.phaseBudgets.PointsShare.ActiveBlocks
 }
 
 func (pss *pointsShareState) Initiate(ctx context.Context) error {
 	message := pss.member.CalculatePublicKeySharePoints()
+	pss.member.session.bind(message)
 	if err := pss.channel.Send(ctx, message); err != nil {
 		return err
 	}
@@ -401,7 +447,8 @@ func (pss *pointsShareState) Receive(msg net.Message) error {
 	case *MemberPublicKeySharePointsMessage:
 		if !group.IsMessageFromSelf(pss.member.ID, phaseMessage) &&
 			group.IsSenderValid(pss.member, phaseMessage, msg.SenderPublicKey()) &&
-			group.IsSenderAccepted(pss.member, phaseMessage) {
+			group.IsSenderAccepted(pss.member, phaseMessage) &&
+			pss.member.session.accept(phaseMessage) {
 			pss.phaseMessages = append(pss.phaseMessages, phaseMessage)
 		}
 	}
@@ -437,11 +484,11 @@ type pointsValidationState struct {
 }
 
 func (pvs *pointsValidationState) DelayBlocks() uint64 {
-	return pointsValidationStateDelayBlocks
+	return pvs.member.phaseBudgets.PointsValidation.DelayBlocks
 }
 
 func (pvs *pointsValidationState) ActiveBlocks() uint64 {
-	return pointsValidationStateActiveBlocks
+	return pvs.member.phaseBudgets.PointsValidation.ActiveBlocks
 }
 
 func (pvs *pointsValidationState) Initiate(ctx context.Context) error {
@@ -453,6 +500,7 @@ func (pvs *pointsValidationState) Initiate(ctx context.Context) error {
 		return err
 	}
 
+	pvs.member.session.bind(accusationMsg)
 	if err := pvs.channel.Send(ctx, accusationMsg); err != nil {
 		return err
 	}
@@ -465,7 +513,8 @@ func (pvs *pointsValidationState) Receive(msg net.Message) error {
 	case *PointsAccusationsMessage:
 		if !group.IsMessageFromSelf(pvs.member.ID, phaseMessage) &&
 			group.IsSenderValid(pvs.member, phaseMessage, msg.SenderPublicKey()) &&
-			group.IsSenderAccepted(pvs.member, phaseMessage) {
+			group.IsSenderAccepted(pvs.member, phaseMessage) &&
+			pvs.member.session.accept(phaseMessage) {
 			pvs.phaseMessages = append(pvs.phaseMessages, phaseMessage)
 		}
 	}
@@ -547,11 +596,11 @@ type keyRevealState struct {
 }
 
 func (rs *keyRevealState) DelayBlocks() uint64 {
-	return keyRevealStateDelayBlocks
+	return rs.member.phaseBudgets.KeyReveal.DelayBlocks
 }
 
 func (rs *keyRevealState) ActiveBlocks() uint64 {
-	return keyRevealStateActiveBlocks
+	return rs.member.phaseBudgets.KeyReveal.ActiveBlocks
 }
 
 func (rs *keyRevealState) Initiate(ctx context.Context) error {
@@ -560,6 +609,7 @@ func (rs *keyRevealState) Initiate(ctx context.Context) error {
 		return err
 	}
 
+	rs.member.session.bind(revealMsg)
 	if err := rs.channel.Send(ctx, revealMsg); err != nil {
 		return err
 	}
@@ -572,7 +622,8 @@ func (rs *keyRevealState) Receive(msg net.Message) error {
 	case *MisbehavedEphemeralKeysMessage:
 		if !group.IsMessageFromSelf(rs.member.ID, phaseMessage) &&
 			group.IsSenderValid(rs.member, phaseMessage, msg.SenderPublicKey()) &&
-			group.IsSenderAccepted(rs.member, phaseMessage) {
+			group.IsSenderAccepted(rs.member, phaseMessage) &&
+			rs.member.session.accept(phaseMessage) {
 			rs.phaseMessages = append(rs.phaseMessages, phaseMessage)
 		}
 	}
@@ -649,11 +700,11 @@ type combinationState struct {
 }
 
 func (cs *combinationState) DelayBlocks() uint64 {
-	return combinationStateDelayBlocks
+	return cs.member.phaseBudgets.Combination.DelayBlocks
 }
 
 func (cs *combinationState) ActiveBlocks() uint64 {
-	return combinationStateActiveBlocks
+	return cs.member.phaseBudgets.Combination.ActiveBlocks
 }
 
 func (cs *combinationState) Initiate(ctx context.Context) error {
@@ -0,0 +1,61 @@
+package gjkr
+
+import (
+	"context"
+	"reflect"
+	"testing"
+
+	"github.com/keep-network/keep-core/pkg/beacon/relay/group"
+)
+
+func TestMemberSilentPastPhaseEndMarkedInactive(t *testing.T) {
+	dishonestThreshold := 1
+	groupSize := 4
+
+	members := initializeEphemeralKeyPairMembersGroup(
+		dishonestThreshold,
+		groupSize,
+	)
+
+	// member 4 stays silent for the whole ephemeral key pair generation phase
+	messages := make([]*EphemeralPublicKeyMessage, 0)
+	for _, member := range members[:3] {
+		message, err := member.GenerateEphemeralKeyPair()
+		if err != nil {
+			t.Fatal(err)
+		}
+		messages = append(messages, message)
+	}
+
+	state := &ephemeralKeyPairGenerationState{
+		member:        members[0],
+		phaseMessages: messages[1:],
+	}
+
+	// the phase ends once its block budget is exhausted; the state machine
+	// then advances to the next state without waiting for the silent member
+	nextState := state.Next()
+	if err := nextState.Initiate(context.Background()); err != nil {
+		t.Fatal(err)
+	}
+
+	dkgGroup := nextState.(*symmetricKeyGenerationState).member.group
+
+	expectedInactive := []group.MemberIndex{4}
+	if !reflect.DeepEqual(expectedInactive, dkgGroup.InactiveMemberIDs()) {
+		t.Errorf(
+			"unexpected inactive members\nexpected: %v\nactual:   %v\n",
+			expectedInactive,
+			dkgGroup.InactiveMemberIDs(),
+		)
+	}
+
+	expectedOperating := []group.MemberIndex{1, 2, 3}
+	if !reflect.DeepEqual(expectedOperating, dkgGroup.OperatingMemberIDs()) {
+		t.Errorf(
+			"unexpected operating members\nexpected: %v\nactual:   %v\n",
+			expectedOperating,
+			dkgGroup.OperatingMemberIDs(),
+		)
+	}
+}
@@ -0,0 +1,80 @@
+package group
+
+import (
+	"crypto/ecdsa"
+	"encoding/hex"
+	"strings"
+
+	"github.com/keep-network/keep-core/pkg/chain"
+)
+
+// AllowListMembershipValidator restricts the membership validated by the
+// underlying validator to operators on the allow-list. In test and
+// permissioned networks it ensures that messages from peers which are not
+// known operators are dropped, so that they can not interfere with the
+// protocol and cause honest members to be disqualified.
+type AllowListMembershipValidator struct {
+	validator MembershipValidator
+	allowed   map[string]bool // lowercase hex operator address without prefix
+	signing   chain.Signing
+}
+
+// NewAllowListMembershipValidator creates a validator accepting only members
+// validated by the given validator whose operator address is one of the given
+// hex-encoded addresses.
+func NewAllowListMembershipValidator(
+	validator MembershipValidator,
+	allowedAddresses []string,
+	signing chain.Signing,
+) *AllowListMembershipValidator {
+	allowed := make(map[string]bool, len(allowedAddresses))
+	for _, address := range allowedAddresses {
+		allowed[normalizeAddress(address)] = true
+	}
+
+	return &AllowListMembershipValidator{
+		validator: validator,
+		allowed:   allowed,
+		signing:   signing,
+	}
+}
+
+// IsInGroup returns true if party with the given public key is on the
+// allow-list and has been selected to the group. Otherwise, function returns
+// false.
+func (alv *AllowListMembershipValidator) IsInGroup(
+	publicKey *ecdsa.PublicKey,
+) bool {
+	if !alv.isAllowed(alv.signing.PublicKeyToAddress(*publicKey)) {
+		return false
+	}
+
+	return alv.validator.IsInGroup(publicKey)
+}
+
+// IsValidMembership returns true if party with the given public key is on the
+// allow-list and has been selected to the group at the given position.
+// Otherwise, function returns false.
+func (alv *AllowListMembershipValidator) IsValidMembership(
+	memberID MemberIndex,
+	publicKey []byte,
+) bool {
+	if !alv.isAllowed(alv.signing.PublicKeyBytesToAddress(publicKey)) {
+		logger.Warningf(
+			"dropping message from member [%v]; operator is not on the "+
+				"allow-list",
+			memberID,
+		)
+		return false
+	}
+
+	return alv.validator.IsValidMembership(memberID, publicKey)
+}
+
+func (alv *AllowListMembershipValidator) isAllowed(address []byte) bool {
+	return alv.allowed[hex.EncodeToString(address)]
+}
+
+func normalizeAddress(address string) string {
+	return strings.TrimPrefix(strings.ToLower(address), "0x")
+}
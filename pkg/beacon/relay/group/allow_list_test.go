@@ -0,0 +1,79 @@
+package group
+
+import (
+	"encoding/hex"
+	"math/big"
+	"strings"
+	"testing"
+
+	relaychain "github.com/keep-network/keep-core/pkg/beacon/relay/chain"
+	"github.com/keep-network/keep-core/pkg/chain/local"
+)
+
+func TestAllowListIsInGroup(t *testing.T) {
+	chain := local.Connect(3, 3, big.NewInt(100))
+	signing := chain.Signing()
+
+	publicKey1 := generatePublicKey(t)
+	publicKey2 := generatePublicKey(t)
+	publicKey3 := generatePublicKey(t)
+
+	address1 := signing.PublicKeyToAddress(*publicKey1)
+	address2 := signing.PublicKeyToAddress(*publicKey2)
+	address3 := signing.PublicKeyToAddress(*publicKey3)
+
+	validator := NewAllowListMembershipValidator(
+		NewStakersMembershipValidator(
+			[]relaychain.StakerAddress{address1, address2},
+			signing,
+		),
+		[]string{
+			"0x" + strings.ToUpper(hex.EncodeToString(address1)),
+			hex.EncodeToString(address3),
+		},
+		signing,
+	)
+
+	if !validator.IsInGroup(publicKey1) {
+		t.Errorf("staker with public key 1 is allowed and has been selected")
+	}
+	if validator.IsInGroup(publicKey2) {
+		t.Errorf("staker with public key 2 is not allowed")
+	}
+	if validator.IsInGroup(publicKey3) {
+		t.Errorf("staker with public key 3 has not been selected")
+	}
+}
+
+func TestAllowListIsValidMembership(t *testing.T) {
+	chain := local.Connect(3, 3, big.NewInt(100))
+	signing := chain.Signing()
+
+	publicKey1 := generatePublicKeyBytes(t)
+	publicKey2 := generatePublicKeyBytes(t)
+
+	address1 := signing.PublicKeyBytesToAddress(publicKey1)
+	address2 := signing.PublicKeyBytesToAddress(publicKey2)
+
+	validator := NewAllowListMembershipValidator(
+		NewStakersMembershipValidator(
+			[]relaychain.StakerAddress{address1, address2, address1},
+			signing,
+		),
+		[]string{"0x" + hex.EncodeToString(address1)},
+		signing,
+	)
+
+	if !validator.IsValidMembership(1, publicKey1) {
+		t.Errorf("staker with public key 1 has been selected at index [0]")
+	}
+	if !validator.IsValidMembership(3, publicKey1) {
+		t.Errorf("staker with public key 1 has been selected at index [2]")
+	}
+	if validator.IsValidMembership(2, publicKey2) {
+		t.Errorf("staker with public key 2 is not allowed")
+	}
+	if validator.IsValidMembership(2, publicKey1) {
+		t.Errorf("staker with public key 1 has not been selected at index [1]")
+	}
+}
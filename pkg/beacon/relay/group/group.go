@@ -11,8 +11,19 @@ type Group struct {
 	inactiveMemberIDs []MemberIndex
 	// All member IDs in this group.
 	memberIDs []MemberIndex
+	// Handlers notified when a member is marked as disqualified or inactive.
+	changeHandlers []func()
+	// Handlers notified when a member is marked as disqualified.
+	disqualificationHandlers []DisqualificationHandler
+	// Tracks connectivity of members for diagnostics; nil if persistently
+	// disconnected members are not reported.
+	livenessTracker *LivenessTracker
 }
 
+// DisqualificationHandler is notified when a member of the group is marked as
+// disqualified, along with the reason of the disqualification.
+type DisqualificationHandler func(memberID MemberIndex, reason string)
+
 // NewDkgGroup creates a new Group with the provided dishonest threshold, member
 // identifiers, and empty IA and DQ members list.
 func NewDkgGroup(dishonestThreshold int, size int) *Group {
@@ -54,7 +65,8 @@ func (g *Group) DisqualifiedMemberIDs() []MemberIndex {
 }
 
 // InactiveMemberIDs returns indexes of all group members that have been marked
-// as inactive during DKG protocol execution.
+// as inactive during DKG protocol execution. Members marked as disqualified
+// are never reported as inactive.
 func (g *Group) InactiveMemberIDs() []MemberIndex {
 	return g.inactiveMemberIDs
 }
@@ -74,12 +86,28 @@ func (g *Group) OperatingMemberIDs() []MemberIndex {
 }
 
 // MarkMemberAsDisqualified adds the member with the given ID to the list of
-// disqualified members. If the member is not a part of the group, is already
-// disqualified or marked as inactive, method does nothing.
-func (g *Group) MarkMemberAsDisqualified(memberID MemberIndex) {
-	if g.IsOperating(memberID) {
-		g.disqualifiedMemberIDs = append(g.disqualifiedMemberIDs, memberID)
+// disqualified members for the given reason. Disqualification takes
+// precedence over inactivity, so a member already marked as inactive is moved
+// to the list of disqualified members. If the member is not a part of the
+// group or is already disqualified, method does nothing.
+func (g *Group) MarkMemberAsDisqualified(memberID MemberIndex, reason string) {
+	if !g.isInGroup(memberID) || g.isDisqualified(memberID) {
+		return
+	}
+
+	for i, inactiveMemberID := range g.inactiveMemberIDs {
+		if inactiveMemberID == memberID {
+			g.inactiveMemberIDs = append(
+				g.inactiveMemberIDs[:i:i],
+				g.inactiveMemberIDs[i+1:]...,
+			)
+			break
+		}
 	}
+
+	g.disqualifiedMemberIDs = append(g.disqualifiedMemberIDs, memberID)
+	g.notifyDisqualification(memberID, reason)
+	g.notifyChange()
 }
 
 // MarkMemberAsInactive adds the member with the given ID to the list of
@@ -88,9 +116,50 @@ func (g *Group) MarkMemberAsDisqualified(memberID MemberIndex) {
 func (g *Group) MarkMemberAsInactive(memberID MemberIndex) {
 	if g.IsOperating(memberID) {
 		g.inactiveMemberIDs = append(g.inactiveMemberIDs, memberID)
+		g.notifyChange()
 	}
 }
 
+// OnChange registers a handler called each time a member of the group is
+// marked as disqualified or inactive. Handlers are called synchronously by
+// the goroutine changing the group.
+func (g *Group) OnChange(handler func()) {
+	g.changeHandlers = append(g.changeHandlers, handler)
+}
+
+func (g *Group) notifyChange() {
+	for _, handler := range g.changeHandlers {
+		handler()
+	}
+}
+
+// OnDisqualification registers a handler called each time a member of the
+// group is marked as disqualified. Handlers are called synchronously by the
+// goroutine changing the group.
+func (g *Group) OnDisqualification(handler DisqualificationHandler) {
+	g.disqualificationHandlers = append(g.disqualificationHandlers, handler)
+}
+
+func (g *Group) notifyDisqualification(memberID MemberIndex, reason string) {
+	for _, handler := range g.disqualificationHandlers {
+		handler(memberID, reason)
+	}
+}
+
+// TrackLiveness makes the group report members persistently disconnected
+// according to the given tracker when inactive members are flushed to the
+// group. The tracker does not affect which members are marked as inactive.
+func (g *Group) TrackLiveness(tracker *LivenessTracker) {
+	g.livenessTracker = tracker
+}
+
+// isDisconnected returns true if the member with the given index has been
+// disconnected for longer than the grace period of the liveness tracker.
+func (g *Group) isDisconnected(memberID MemberIndex) bool {
+	return g.livenessTracker != nil &&
+		g.livenessTracker.IsDisconnected(memberID)
+}
+
 // IsOperating returns true if member with the given index has not been marked
 // as IA or DQ in the group.
 func (g *Group) IsOperating(memberID MemberIndex) bool {
@@ -129,13 +198,15 @@ func (g *Group) isDisqualified(memberID MemberIndex) bool {
 	return false
 }
 
+// eliminatedMembersCount returns the number of members marked as disqualified
+// or inactive. No member is marked as both.
 func (g *Group) eliminatedMembersCount() int {
 	return len(g.disqualifiedMemberIDs) + len(g.inactiveMemberIDs)
 }
 
-// isThresholdSatisfied checks number of disqualified and inactive members in
+// IsThresholdSatisfied checks number of disqualified and inactive members in
 // the group. If the number is less or equal half of dishonest threshold,
-// returns true.
-func (g *Group) isThresholdSatisfied() bool {
+// returns true. Otherwise, the group is too weak to be registered.
+func (g *Group) IsThresholdSatisfied() bool {
 	return g.eliminatedMembersCount() <= g.dishonestThreshold/2
 }
@@ -15,7 +15,7 @@ func TestMarkMemberAsDisqualified(t *testing.T) {
 		"mark member as disqualified": {
 			initialMembers: []MemberIndex{19, 11, 31, 33},
 			updateFunc: func(g *Group) {
-				g.MarkMemberAsDisqualified(19)
+				g.MarkMemberAsDisqualified(19, "misbehaviour")
 			},
 			expectedDisqualifiedMembers: []MemberIndex{19},
 			expectedInactiveMembers:     []MemberIndex{},
@@ -23,8 +23,8 @@ func TestMarkMemberAsDisqualified(t *testing.T) {
 		"mark member as disqualified twice": {
 			initialMembers: []MemberIndex{19, 11, 31, 33},
 			updateFunc: func(g *Group) {
-				g.MarkMemberAsDisqualified(11)
-				g.MarkMemberAsDisqualified(11)
+				g.MarkMemberAsDisqualified(11, "misbehaviour")
+				g.MarkMemberAsDisqualified(11, "misbehaviour")
 			},
 			expectedDisqualifiedMembers: []MemberIndex{11},
 			expectedInactiveMembers:     []MemberIndex{},
@@ -32,7 +32,7 @@ func TestMarkMemberAsDisqualified(t *testing.T) {
 		"mark member from out of the group as disqualified": {
 			initialMembers: []MemberIndex{19, 11, 31, 33},
 			updateFunc: func(g *Group) {
-				g.MarkMemberAsDisqualified(88)
+				g.MarkMemberAsDisqualified(88, "misbehaviour")
 			},
 			expectedDisqualifiedMembers: []MemberIndex{},
 			expectedInactiveMembers:     []MemberIndex{},
@@ -40,9 +40,9 @@ func TestMarkMemberAsDisqualified(t *testing.T) {
 		"mark all members as disqualified": {
 			initialMembers: []MemberIndex{11, 12, 13},
 			updateFunc: func(g *Group) {
-				g.MarkMemberAsDisqualified(11)
-				g.MarkMemberAsDisqualified(13)
-				g.MarkMemberAsDisqualified(12)
+				g.MarkMemberAsDisqualified(11, "misbehaviour")
+				g.MarkMemberAsDisqualified(13, "misbehaviour")
+				g.MarkMemberAsDisqualified(12, "misbehaviour")
 			},
 			expectedDisqualifiedMembers: []MemberIndex{11, 13, 12},
 			expectedInactiveMembers:     []MemberIndex{},
@@ -83,6 +83,26 @@ func TestMarkMemberAsDisqualified(t *testing.T) {
 			expectedDisqualifiedMembers: []MemberIndex{},
 			expectedInactiveMembers:     []MemberIndex{17, 19, 16, 18},
 		},
+		"mark inactive member as disqualified": {
+			initialMembers: []MemberIndex{19, 11, 31, 33},
+			updateFunc: func(g *Group) {
+				g.MarkMemberAsInactive(11)
+				g.MarkMemberAsInactive(31)
+				g.MarkMemberAsInactive(33)
+				g.MarkMemberAsDisqualified(31, "misbehaviour")
+			},
+			expectedDisqualifiedMembers: []MemberIndex{31},
+			expectedInactiveMembers:     []MemberIndex{11, 33},
+		},
+		"mark disqualified member as inactive": {
+			initialMembers: []MemberIndex{19, 11, 31, 33},
+			updateFunc: func(g *Group) {
+				g.MarkMemberAsDisqualified(31, "misbehaviour")
+				g.MarkMemberAsInactive(31)
+			},
+			expectedDisqualifiedMembers: []MemberIndex{31},
+			expectedInactiveMembers:     []MemberIndex{},
+		},
 	}
 
 	for testName, test := range tests {
@@ -131,7 +151,7 @@ func TestIsDisqualified(t *testing.T) {
 		t.Errorf("member should not be disqualified at this point")
 	}
 
-	group.MarkMemberAsDisqualified(19)
+	group.MarkMemberAsDisqualified(19, "misbehaviour")
 
 	if !group.isDisqualified(19) {
 		t.Errorf("member should be disqualified at this point")
@@ -167,7 +187,7 @@ func TestOperatingMembers(t *testing.T) {
 		"one member disqualified": {
 			initialMembers: []MemberIndex{99, 98, 12, 33, 44},
 			updateFunc: func(g *Group) {
-				g.MarkMemberAsDisqualified(98)
+				g.MarkMemberAsDisqualified(98, "misbehaviour")
 			},
 			expectedOperatingMembers: []MemberIndex{99, 12, 33, 44},
 		},
@@ -181,7 +201,7 @@ func TestOperatingMembers(t *testing.T) {
 		"one member disqualified and one member inactive": {
 			initialMembers: []MemberIndex{19, 11, 31, 33},
 			updateFunc: func(g *Group) {
-				g.MarkMemberAsDisqualified(19)
+				g.MarkMemberAsDisqualified(19, "misbehaviour")
 				g.MarkMemberAsInactive(33)
 			},
 			expectedOperatingMembers: []MemberIndex{11, 31},
@@ -189,16 +209,16 @@ func TestOperatingMembers(t *testing.T) {
 		"all but one inactive": {
 			initialMembers: []MemberIndex{28, 19, 29},
 			updateFunc: func(g *Group) {
-				g.MarkMemberAsDisqualified(19)
-				g.MarkMemberAsDisqualified(29)
+				g.MarkMemberAsDisqualified(19, "misbehaviour")
+				g.MarkMemberAsDisqualified(29, "misbehaviour")
 			},
 			expectedOperatingMembers: []MemberIndex{28},
 		},
 		"all but one disqualified": {
 			initialMembers: []MemberIndex{92, 11, 20},
 			updateFunc: func(g *Group) {
-				g.MarkMemberAsDisqualified(92)
-				g.MarkMemberAsDisqualified(11)
+				g.MarkMemberAsDisqualified(92, "misbehaviour")
+				g.MarkMemberAsDisqualified(11, "misbehaviour")
 			},
 			expectedOperatingMembers: []MemberIndex{20},
 		},
@@ -228,3 +248,164 @@ func TestOperatingMembers(t *testing.T) {
 		})
 	}
 }
+
+func TestOnChange(t *testing.T) {
+	var tests = map[string]struct {
+		updateFunc            func(g *Group)
+		expectedNotifications int
+	}{
+		"member marked as disqualified": {
+			updateFunc: func(g *Group) {
+				g.MarkMemberAsDisqualified(2, "misbehaviour")
+			},
+			expectedNotifications: 1,
+		},
+		"member marked as inactive": {
+			updateFunc: func(g *Group) {
+				g.MarkMemberAsInactive(3)
+			},
+			expectedNotifications: 1,
+		},
+		"member marked twice": {
+			updateFunc: func(g *Group) {
+				g.MarkMemberAsDisqualified(3, "misbehaviour")
+				g.MarkMemberAsInactive(3)
+			},
+			expectedNotifications: 1,
+		},
+		"inactive member marked as disqualified": {
+			updateFunc: func(g *Group) {
+				g.MarkMemberAsInactive(3)
+				g.MarkMemberAsDisqualified(3, "misbehaviour")
+			},
+			expectedNotifications: 2,
+		},
+		"member from out of the group marked": {
+			updateFunc: func(g *Group) {
+				g.MarkMemberAsDisqualified(8, "misbehaviour")
+			},
+			expectedNotifications: 0,
+		},
+	}
+
+	for testName, test := range tests {
+		t.Run(testName, func(t *testing.T) {
+			group := NewDkgGroup(2, 5)
+
+			notifications := 0
+			group.OnChange(func() {
+				notifications++
+			})
+
+			test.updateFunc(group)
+
+			if test.expectedNotifications != notifications {
+				t.Errorf(
+					"unexpected number of notifications\nexpected: %v\nactual:   %v\n",
+					test.expectedNotifications,
+					notifications,
+				)
+			}
+		})
+	}
+}
+
+func TestOnDisqualification(t *testing.T) {
+	var tests = map[string]struct {
+		updateFunc           func(g *Group)
+		expectedDisqualified []MemberIndex
+		expectedReasons      []string
+	}{
+		"members marked as disqualified": {
+			updateFunc: func(g *Group) {
+				g.MarkMemberAsDisqualified(2, "invalid message")
+				g.MarkMemberAsDisqualified(4, "false accusation")
+			},
+			expectedDisqualified: []MemberIndex{2, 4},
+			expectedReasons:      []string{"invalid message", "false accusation"},
+		},
+		"member marked as inactive": {
+			updateFunc: func(g *Group) {
+				g.MarkMemberAsInactive(3)
+			},
+			expectedDisqualified: []MemberIndex{},
+			expectedReasons:      []string{},
+		},
+		"inactive member disqualified": {
+			updateFunc: func(g *Group) {
+				g.MarkMemberAsInactive(3)
+				g.MarkMemberAsDisqualified(3, "invalid message")
+			},
+			expectedDisqualified: []MemberIndex{3},
+			expectedReasons:      []string{"invalid message"},
+		},
+		"member disqualified twice": {
+			updateFunc: func(g *Group) {
+				g.MarkMemberAsDisqualified(3, "invalid message")
+				g.MarkMemberAsDisqualified(3, "false accusation")
+			},
+			expectedDisqualified: []MemberIndex{3},
+			expectedReasons:      []string{"invalid message"},
+		},
+		"member from out of the group disqualified": {
+			updateFunc: func(g *Group) {
+				g.MarkMemberAsDisqualified(8, "invalid message")
+			},
+			expectedDisqualified: []MemberIndex{},
+			expectedReasons:      []string{},
+		},
+	}
+
+	for testName, test := range tests {
+		t.Run(testName, func(t *testing.T) {
+			group := NewDkgGroup(2, 5)
+
+			disqualified := make([]MemberIndex, 0)
+			reasons := make([]string, 0)
+			group.OnDisqualification(func(memberID MemberIndex, reason string) {
+				disqualified = append(disqualified, memberID)
+				reasons = append(reasons, reason)
+			})
+
+			test.updateFunc(group)
+
+			if !reflect.DeepEqual(test.expectedDisqualified, disqualified) {
+				t.Errorf(
+					"unexpected disqualified members\nexpected: %v\nactual:   %v\n",
+					test.expectedDisqualified,
+					disqualified,
+				)
+			}
+			if !reflect.DeepEqual(test.expectedReasons, reasons) {
+				t.Errorf(
+					"unexpected reasons\nexpected: %v\nactual:   %v\n",
+					test.expectedReasons,
+					reasons,
+				)
+			}
+		})
+	}
+}
+
+func TestThresholdWithInactiveMemberDisqualified(t *testing.T) {
+	group := NewDkgGroup(6, 7)
+
+	group.MarkMemberAsDisqualified(2, "misbehaviour")
+	group.MarkMemberAsInactive(3)
+	group.MarkMemberAsInactive(4)
+	group.MarkMemberAsDisqualified(3, "misbehaviour")
+
+	expectedEliminatedCount := 3
+	if group.eliminatedMembersCount() != expectedEliminatedCount {
+		t.Errorf(
+			"unexpected number of eliminated members\nexpected: %v\nactual:   %v\n",
+			expectedEliminatedCount,
+			group.eliminatedMembersCount(),
+		)
+	}
+
+	// member marked as both must not be counted twice against the threshold
+	if !group.IsThresholdSatisfied() {
+		t.Errorf("threshold should be satisfied")
+	}
+}
@@ -0,0 +1,90 @@
+package group
+
+import (
+	"sort"
+	"sync"
+)
+
+// LivenessTracker tracks connectivity of group members during DKG and reports
+// members disconnected from the node for longer than the grace period. It is
+// a diagnostic only: each member has its own view of connectivity, so it must
+// not decide which members are inactive. Members are marked as inactive by
+// the protocol only if their messages are not received in the phase.
+type LivenessTracker struct {
+	mutex sync.Mutex
+
+	gracePeriodBlocks uint64
+	isConnected       func(memberID MemberIndex) bool
+
+	lastBlockHeight   uint64
+	disconnectedSince map[MemberIndex]uint64
+}
+
+// NewLivenessTracker creates a new tracker reporting members disconnected for
+// more than the given number of blocks. The given function is used to check
+// whether the member with the given index is currently connected.
+func NewLivenessTracker(
+	gracePeriodBlocks uint64,
+	isConnected func(memberID MemberIndex) bool,
+) *LivenessTracker {
+	return &LivenessTracker{
+		gracePeriodBlocks: gracePeriodBlocks,
+		isConnected:       isConnected,
+		disconnectedSince: make(map[MemberIndex]uint64),
+	}
+}
+
+// Observe checks connectivity of the given members at the given block height.
+// A member is considered disconnected since the first block at which it has
+// been observed disconnected. Once the member reconnects, the grace period is
+// restarted.
+func (lt *LivenessTracker) Observe(memberIDs []MemberIndex, blockHeight uint64) {
+	lt.mutex.Lock()
+	defer lt.mutex.Unlock()
+
+	if blockHeight > lt.lastBlockHeight {
+		lt.lastBlockHeight = blockHeight
+	}
+
+	for _, memberID := range memberIDs {
+		if lt.isConnected(memberID) {
+			delete(lt.disconnectedSince, memberID)
+			continue
+		}
+
+		if _, ok := lt.disconnectedSince[memberID]; !ok {
+			lt.disconnectedSince[memberID] = blockHeight
+		}
+	}
+}
+
+// DisconnectedMemberIDs returns indexes of members disconnected for more than
+// the grace period as of the most recently observed block height, in ascending
+// order.
+func (lt *LivenessTracker) DisconnectedMemberIDs() []MemberIndex {
+	lt.mutex.Lock()
+	defer lt.mutex.Unlock()
+
+	disconnected := make([]MemberIndex, 0)
+	for memberID, since := range lt.disconnectedSince {
+		if lt.lastBlockHeight-since > lt.gracePeriodBlocks {
+			disconnected = append(disconnected, memberID)
+		}
+	}
+
+	sort.Slice(disconnected, func(i, j int) bool {
+		return disconnected[i] < disconnected[j]
+	})
+
+	return disconnected
+}
+
+// IsDisconnected returns true if the member with the given index has been
+// disconnected for more than the grace period.
+func (lt *LivenessTracker) IsDisconnected(memberID MemberIndex) bool {
+	lt.mutex.Lock()
+	defer lt.mutex.Unlock()
+
+	since, ok := lt.disconnectedSince[memberID]
+	return ok && lt.lastBlockHeight-since > lt.gracePeriodBlocks
+}
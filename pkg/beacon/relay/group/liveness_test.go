@@ -0,0 +1,100 @@
+package group
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestLivenessTrackerDisconnection(t *testing.T) {
+	var tests = map[string]struct {
+		// connectivity of member 3 observed at consecutive blocks starting
+		// from block 100
+		connectivity         []bool
+		expectedDisconnected []MemberIndex
+	}{
+		"always connected": {
+			connectivity:         []bool{true, true, true, true, true},
+			expectedDisconnected: []MemberIndex{},
+		},
+		"disconnected within grace period": {
+			connectivity:         []bool{true, true, false, false, false},
+			expectedDisconnected: []MemberIndex{},
+		},
+		"disconnected past grace period": {
+			connectivity:         []bool{true, false, false, false, false},
+			expectedDisconnected: []MemberIndex{3},
+		},
+		"reconnected before grace period passed": {
+			connectivity:         []bool{false, false, true, false, false},
+			expectedDisconnected: []MemberIndex{},
+		},
+	}
+
+	for testName, test := range tests {
+		t.Run(testName, func(t *testing.T) {
+			connected := true
+			tracker := NewLivenessTracker(
+				2,
+				func(memberID MemberIndex) bool {
+					return memberID != 3 || connected
+				},
+			)
+
+			for i, isConnected := range test.connectivity {
+				connected = isConnected
+				tracker.Observe([]MemberIndex{1, 2, 3}, uint64(100+i))
+			}
+
+			disconnected := tracker.DisconnectedMemberIDs()
+			if !reflect.DeepEqual(test.expectedDisconnected, disconnected) {
+				t.Errorf(
+					"unexpected disconnected members\nexpected: %v\nactual:   %v\n",
+					test.expectedDisconnected,
+					disconnected,
+				)
+			}
+
+			expectedIsDisconnected := len(test.expectedDisconnected) > 0
+			if tracker.IsDisconnected(3) != expectedIsDisconnected {
+				t.Errorf(
+					"unexpected disconnection of member [3]\nexpected: %v\nactual:   %v\n",
+					expectedIsDisconnected,
+					tracker.IsDisconnected(3),
+				)
+			}
+		})
+	}
+}
+
+func TestFlushDisconnectedMembers(t *testing.T) {
+	dkgGroup := NewDkgGroup(2, 5)
+
+	tracker := NewLivenessTracker(
+		2,
+		func(memberID MemberIndex) bool {
+			return memberID != 4
+		},
+	)
+	dkgGroup.TrackLiveness(tracker)
+
+	// member 4 is disconnected past the grace period but its messages are
+	// still relayed by other peers; member 5 is connected but its messages
+	// are not received
+	tracker.Observe(dkgGroup.MemberIDs(), 100)
+	tracker.Observe(dkgGroup.MemberIDs(), 103)
+
+	filter := NewInactiveMemberFilter(1, dkgGroup)
+	for _, memberID := range []MemberIndex{2, 3, 4} {
+		filter.MarkMemberAsActive(memberID)
+	}
+	filter.FlushInactiveMembers()
+
+	expectedInactive := []MemberIndex{5}
+	if !reflect.DeepEqual(expectedInactive, dkgGroup.InactiveMemberIDs()) {
+		t.Errorf(
+			"unexpected inactive members\nexpected: %v\nactual:   %v\n",
+			expectedInactive,
+			dkgGroup.InactiveMemberIDs(),
+		)
+	}
+}
@@ -60,7 +60,9 @@ func (mf *InactiveMemberFilter) MarkMemberAsActive(memberID MemberIndex) {
 }
 
 // FlushInactiveMembers takes all members who were not previously marked as
-// active and flushes them to DKG group as inactive members.
+// active and flushes them to DKG group as inactive members. Members reported
+// as persistently disconnected by the group's liveness tracker are only
+// logged; whether they are inactive depends solely on their messages.
 func (mf *InactiveMemberFilter) FlushInactiveMembers() {
 	isActive := func(id MemberIndex) bool {
 		if id == mf.selfMemberID {
@@ -77,7 +79,19 @@ func (mf *InactiveMemberFilter) FlushInactiveMembers() {
 	}
 
 	for _, operatingMemberID := range mf.group.OperatingMemberIDs() {
-		if !isActive(operatingMemberID) {
+		active := isActive(operatingMemberID)
+
+		if mf.group.isDisconnected(operatingMemberID) {
+			logger.Infof(
+				"[member:%v] member [%v] has been disconnected for longer "+
+					"than the grace period; active in the phase: [%v]",
+				mf.selfMemberID,
+				operatingMemberID,
+				active,
+			)
+		}
+
+		if !active {
 			logger.Warningf(
 				"[member:%v] marking member [%v] as inactive",
 				mf.selfMemberID,
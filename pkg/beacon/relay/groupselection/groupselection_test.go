@@ -366,6 +366,10 @@ func (stg *stubGroupInterface) GetSelectedParticipants() ([]chain.StakerAddress,
 	return selected, nil
 }
 
+func (stg *stubGroupInterface) IsGroupSelectionPossible() (bool, error) {
+	panic("not implemented")
+}
+
 func (stg *stubGroupInterface) OnGroupSelectionStarted(
 	func(groupSelectionStart *event.GroupSelectionStart),
 ) (subscription.EventSubscription, error) {
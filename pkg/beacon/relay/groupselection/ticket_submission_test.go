@@ -91,6 +91,10 @@ func (mgi *mockGroupInterface) GetSelectedParticipants() ([]chain.StakerAddress,
 	panic("unexpected")
 }
 
+func (mgi *mockGroupInterface) IsGroupSelectionPossible() (bool, error) {
+	panic("not implemented")
+}
+
 func (mgi *mockGroupInterface) OnGroupSelectionStarted(
 	func(groupSelectionStart *event.GroupSelectionStart),
 ) (subscription.EventSubscription, error) {
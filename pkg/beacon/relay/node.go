@@ -2,21 +2,50 @@ package relay
 
 import (
 	"bytes"
+	"context"
 	"encoding/hex"
+	"fmt"
 	"math/big"
+	"strconv"
 	"sync"
+	"time"
 
 	"github.com/keep-network/keep-core/pkg/beacon/relay/group"
 
 	relaychain "github.com/keep-network/keep-core/pkg/beacon/relay/chain"
 	"github.com/keep-network/keep-core/pkg/beacon/relay/config"
 	"github.com/keep-network/keep-core/pkg/beacon/relay/dkg"
+	"github.com/keep-network/keep-core/pkg/beacon/relay/dkg/checkpoint"
+	dkgResult "github.com/keep-network/keep-core/pkg/beacon/relay/dkg/result"
+	"github.com/keep-network/keep-core/pkg/beacon/relay/dkg/skew"
+	"github.com/keep-network/keep-core/pkg/beacon/relay/dkg/transcript"
+	"github.com/keep-network/keep-core/pkg/beacon/relay/event"
 	"github.com/keep-network/keep-core/pkg/beacon/relay/groupselection"
 	"github.com/keep-network/keep-core/pkg/beacon/relay/registry"
 	"github.com/keep-network/keep-core/pkg/chain"
+	"github.com/keep-network/keep-core/pkg/identifier"
+	"github.com/keep-network/keep-core/pkg/metrics"
 	"github.com/keep-network/keep-core/pkg/net"
+	"github.com/keep-network/keep-core/pkg/net/key"
+	"github.com/keep-network/keep-core/pkg/subscription"
+	"github.com/keep-network/keep-core/pkg/webhook"
 )
 
+const (
+	dkgParticipationsMetric         = "dkg_participations_total"
+	dkgParticipationsDeclinedMetric = "dkg_participations_declined_total"
+	dkgResultSubmissionsMetric      = "dkg_result_submissions_total"
+	dkgCompletionsMetric            = "dkg_completions_total"
+	dkgDisqualificationsMetric      = "dkg_disqualifications_total"
+	dkgExecutionsMetric             = "dkg_executions_total"
+	relayEntrySigningsMetric        = "relay_entry_signings_total"
+	relayEntryTimeoutsMetric        = "relay_entry_timeouts_total"
+)
+
+// blockHeightSkewCheckBlocks is the number of blocks during which block
+// heights announced by other group members are collected.
+const blockHeightSkewCheckBlocks = 3
+
 // Node represents the current state of a relay node.
 type Node struct {
 	mutex sync.Mutex
@@ -30,7 +59,529 @@ type Node struct {
 	blockCounter chain.BlockCounter
 	chainConfig  *config.Chain
 
+	dkgPhaseBudgets *config.DKGPhaseBudgets
+	dkgCheckpoints  *checkpoint.Storage
+	dkgProgress     *dkg.ProgressTracker
+
+	// Publishes progress events of DKG executions to live consumers.
+	dkgProgressFeed *dkg.ProgressFeed
+
+	// Records transcripts of DKG executions; nil if not configured.
+	dkgTranscripts *transcript.Storage
+
+	// Limits the rate of new DKG participations; nil if not configured.
+	dkgParticipationLimiter *dkg.ParticipationLimiter
+
+	// Whether the group public key is checked against public key shares
+	// before the DKG result is published.
+	verifyGroupPublicKey bool
+
+	// Minimum fraction of group members the node needs to be connected to
+	// for the DKG result submission and the maximum number of blocks the
+	// submission is deferred otherwise; zero fraction disables the check.
+	dkgPartitionMinConnectedFraction float64
+	dkgPartitionMaxDeferralBlocks    uint64
+
+	// Whether the DKG result submission is confirmed by the transaction
+	// receipt and the submission event, and the maximum time the node waits
+	// for the outcome of the confirmed submission.
+	confirmDKGSubmission             bool
+	dkgSubmissionConfirmationTimeout time.Duration
+
+	// Whether the order in which members become eligible to submit the DKG
+	// result is rotated per request.
+	rotateDKGSubmissionOrder bool
+
+	// Whether the DKG result submission falls back to polling the chain if
+	// subscribing for DKG result submission events fails.
+	dkgSubmissionPollingFallback bool
+
+	// Minimum DKG result publication block step; zero if the chain's block
+	// step is used as is.
+	minResultPublicationBlockStep uint64
+
+	// Spaces out on-chain DKG result submissions of the node.
+	dkgSubmissionCooldown *dkgResult.SubmissionCooldown
+
+	// Determines what happens with DKG results reflecting a failed DKG;
+	// failure results are submitted if empty.
+	dkgFailureResultPolicy string
+
+	// If set, DKG result submission eligibility is determined based on
+	// the last known block height when the current one is not available.
+	dkgSubmissionBlockFallback bool
+
+	// If set, DKG is not executed for groups with fewer members than
+	// the chain's signature threshold.
+	rejectUndersizedGroups bool
+
+	// Maximum number of signatures included in DKG result submissions;
+	// all signatures are included if zero.
+	dkgSubmissionMaxSignatures int
+
+	// Dedupes on-chain DKG result submissions of all members controlled
+	// by the node across retries.
+	dkgSubmissionLedger *dkgResult.SubmissionLedger
+
+	// Number of blocks before the DKG result publication deadline from which
+	// the node no longer submits the result; zero disables the check.
+	dkgSubmissionConfirmationBuffer uint64
+
+	// Caps the gas spent on on-chain DKG result submissions of all members
+	// controlled by the node per window; if nil, the gas is not capped.
+	dkgSubmissionGasBudget *dkgResult.SubmissionGasBudget
+
+	// Retries on-chain DKG result submissions failed with an error; if nil,
+	// failed submissions are not retried.
+	dkgSubmissionRetry *dkgResult.SubmissionRetry
+
+	// Addresses of operators allowed to participate in DKG; all selected
+	// operators participate if empty.
+	dkgAllowedOperators []string
+
+	// Maximum number of blocks the node's block height may differ from
+	// the group median when joining a group; zero disables the check.
+	dkgBlockHeightSkewThreshold uint64
+
+	// Number of blocks a group member may stay disconnected during DKG before
+	// it is reported in the logs; zero disables the tracking.
+	dkgPeerLivenessGraceBlocks uint64
+
+	// Notified about DKG result submission outcomes; nil if not configured.
+	dkgSubmissionWebhook *webhook.Notifier
+	// Accepts DKG result submissions instead of submitting them inline;
+	// nil if not configured.
+	dkgSubmissionQueue dkgResult.SubmissionQueue
+
+	// Records which member published the accepted DKG result.
+	dkgPublishers *dkg.PublisherTracker
+
+	// Records the history of DKG participations of this node's members.
+	dkgParticipations *dkg.ParticipationStore
+
+	// Delivers DKG completion events to interested components.
+	dkgCompletions *dkgResult.CompletionBus
+
 	groupRegistry *registry.Groups
+
+	// Last relay entry submitted to the chain observed by the node; nil
+	// until the node observes the first one. Guarded by the node's mutex.
+	lastRelayEntry *RelayEntryStatus
+
+	metrics metrics.Recorder
+}
+
+// DKGStatus reports the current phase of all DKG executions the node
+// currently participates in.
+func (n *Node) DKGStatus() ([]*dkg.PhaseStatus, error) {
+	currentBlockHeight, err := n.blockCounter.CurrentBlock()
+	if err != nil {
+		return nil, fmt.Errorf("could not get current block height: [%v]", err)
+	}
+
+	return n.dkgProgress.Status(currentBlockHeight), nil
+}
+
+// AmIEligibleToPublish reports whether any of the members controlled by this
+// node is eligible to publish the DKG result for the given request at the
+// current block height. If none is eligible yet, it also returns the number of
+// blocks until the first of them becomes eligible. An error is returned if
+// the node does not participate in DKG for the given request.
+func (n *Node) AmIEligibleToPublish(requestID *big.Int) (bool, uint64, error) {
+	eligibleBlockHeight, _, ok := n.dkgProgress.EligibleBlockHeight(
+		requestID,
+	)
+	if !ok {
+		return false, 0, fmt.Errorf(
+			"node is not a member of the group for request [%v]",
+			identifier.String(requestID),
+		)
+	}
+
+	currentBlockHeight, err := n.blockCounter.CurrentBlock()
+	if err != nil {
+		return false, 0, fmt.Errorf(
+			"could not get current block height: [%v]",
+			err,
+		)
+	}
+
+	if currentBlockHeight >= eligibleBlockHeight {
+		return true, 0, nil
+	}
+
+	return false, eligibleBlockHeight - currentBlockHeight, nil
+}
+
+// OnDKGProgress registers a handler invoked with each progress event of DKG
+// executions the node participates in. The handler must not block.
+func (n *Node) OnDKGProgress(
+	handler func(event *dkg.ProgressEvent),
+) subscription.EventSubscription {
+	return n.dkgProgressFeed.OnProgress(handler)
+}
+
+// DKGPublishers reports which members published accepted DKG results of DKG
+// executions the node participated in.
+func (n *Node) DKGPublishers() *dkg.PublisherStatus {
+	return n.dkgPublishers.Status()
+}
+
+// DKGParticipations returns completed DKG participations of members
+// controlled by this node selected by the given filter.
+func (n *Node) DKGParticipations(
+	filter *dkg.ParticipationFilter,
+) []*dkg.Participation {
+	return n.dkgParticipations.Query(filter)
+}
+
+// finishDKGParticipation completes the recorded participation of the member
+// with the given index in DKG for the given seed.
+func (n *Node) finishDKGParticipation(
+	relayChain relaychain.Interface,
+	seed *big.Int,
+	memberIndex group.MemberIndex,
+) {
+	gasUsage, _ := relayChain.(dkgResult.TransactionGasUsage)
+
+	err := n.dkgParticipations.Finish(seed, memberIndex, gasUsage)
+	if err != nil {
+		logger.Warningf(
+			"[member:%v] could not record DKG participation for seed "+
+				"[%v]: [%v]",
+			memberIndex,
+			identifier.String(seed),
+			err,
+		)
+	}
+}
+
+// watchDKGPublisher records the publisher of the accepted DKG result for the
+// given seed until the returned function is called. Members with the given
+// indexes are controlled by this node.
+func (n *Node) watchDKGPublisher(
+	relayChain relaychain.Interface,
+	seed *big.Int,
+	indexes []uint8,
+	startBlockHeight uint64,
+) func() {
+	memberIndexes := make([]group.MemberIndex, len(indexes))
+	for i, index := range indexes {
+		memberIndexes[i] = group.MemberIndex(index + 1)
+	}
+
+	stop, err := n.dkgPublishers.Watch(
+		relayChain,
+		seed,
+		memberIndexes,
+		startBlockHeight,
+	)
+	if err != nil {
+		logger.Warningf(
+			"could not watch DKG result publisher for seed [%v]: [%v]",
+			identifier.String(seed),
+			err,
+		)
+		return func() {}
+	}
+
+	return stop
+}
+
+// dkgDisqualificationObserver returns an observer reporting members
+// disqualified by the member with the given index during DKG for the given
+// seed. The given indexes are indexes of all members controlled by this node,
+// so that disqualifications of the node's own members are distinguished.
+// Disqualifications are not reported to the network layer: the peer of
+// a disqualified member may still take part in other groups and a ban would
+// cut it off all broadcast channels it shares with this node.
+func (n *Node) dkgDisqualificationObserver(
+	seed *big.Int,
+	memberIndex group.MemberIndex,
+	indexes []uint8,
+) group.DisqualificationHandler {
+	return func(disqualifiedIndex group.MemberIndex, reason string) {
+		n.dkgParticipations.RecordDisqualification(
+			seed,
+			memberIndex,
+			disqualifiedIndex,
+		)
+
+		ownMember := false
+		for _, index := range indexes {
+			if group.MemberIndex(index+1) == disqualifiedIndex {
+				ownMember = true
+				break
+			}
+		}
+
+		if ownMember {
+			logger.Errorf(
+				"[member:%v] member [%v] controlled by this node disqualified "+
+					"in DKG for seed [%v]: [%v]",
+				memberIndex,
+				disqualifiedIndex,
+				identifier.String(seed),
+				reason,
+			)
+		} else {
+			logger.Warningf(
+				"[member:%v] member [%v] disqualified in DKG for seed "+
+					"[%v]: [%v]",
+				memberIndex,
+				disqualifiedIndex,
+				identifier.String(seed),
+				reason,
+			)
+		}
+
+		n.metrics.IncrementCounter(
+			dkgDisqualificationsMetric,
+			metrics.Labels{
+				"reason": reason,
+				"self":   strconv.FormatBool(ownMember),
+			},
+		)
+	}
+}
+
+// OnDKGCompleted registers a handler invoked each time a member of this node
+// observes the DKG result has been published on-chain, either by the member
+// itself or by another member.
+func (n *Node) OnDKGCompleted(
+	handler func(completion *event.DKGCompleted),
+) subscription.EventSubscription {
+	return n.dkgCompletions.OnDKGCompleted(handler)
+}
+
+// dkgSubmissionObserver returns an observer of the DKG result submission for
+// the given seed. The observer records the submission outcome in metrics and
+// in the participation history. On success, it publishes the DKG completion
+// event and records its attestation signed with the operator key. It also
+// notifies the submission webhook if one is configured.
+func (n *Node) dkgSubmissionObserver(
+	seed *big.Int,
+	signing chain.Signing,
+) dkgResult.SubmissionObserver {
+	var webhookObserver dkgResult.SubmissionObserver
+	if n.dkgSubmissionWebhook != nil {
+		webhookObserver = dkgResult.NewWebhookObserver(
+			n.dkgSubmissionWebhook,
+			seed,
+		)
+	}
+
+	completionObserver := n.dkgCompletions.Observer(seed)
+	progressObserver := n.dkgProgressFeed.Observer(seed)
+	attestationObserver := dkgResult.AttestationObserver(
+		seed,
+		signing,
+		n.dkgParticipations.RecordAttestation,
+	)
+
+	return func(report *dkgResult.SubmissionReport) {
+		n.metrics.IncrementCounter(
+			dkgResultSubmissionsMetric,
+			metrics.Labels{"outcome": string(report.Outcome)},
+		)
+
+		n.dkgParticipations.RecordSubmission(seed, report)
+		attestationObserver(report)
+
+		completionObserver(report)
+		progressObserver(report)
+
+		if webhookObserver != nil {
+			webhookObserver(report)
+		}
+	}
+}
+
+// dkgPartitionGuard returns a guard deferring the DKG result submission while
+// the node is partitioned from most of the group with the given stakers.
+// It returns nil if the partition check is not configured.
+func (n *Node) dkgPartitionGuard(
+	groupSize int,
+	selectedStakers []relaychain.StakerAddress,
+	signing chain.Signing,
+) *dkgResult.PartitionGuard {
+	if n.dkgPartitionMinConnectedFraction == 0 {
+		return nil
+	}
+
+	return dkgResult.NewPartitionGuard(
+		groupSize,
+		n.dkgPartitionMinConnectedFraction,
+		n.dkgPartitionMaxDeferralBlocks,
+		func() int {
+			return n.connectedGroupMembers(selectedStakers, signing)
+		},
+	)
+}
+
+// dkgSubmissionConfirmation returns a confirmation of the DKG result
+// submission checking transaction receipts on the given chain. It returns nil
+// if the confirmation is not configured or the chain does not provide
+// transaction receipts.
+func (n *Node) dkgSubmissionConfirmation(
+	relayChain relaychain.Interface,
+) *dkgResult.SubmissionConfirmation {
+	if !n.confirmDKGSubmission {
+		return nil
+	}
+
+	receipts, ok := relayChain.(dkgResult.TransactionReceipts)
+	if !ok {
+		logger.Warningf(
+			"chain does not provide transaction receipts; " +
+				"DKG result submission will not be confirmed",
+		)
+		return nil
+	}
+
+	return dkgResult.NewSubmissionConfirmation(
+		receipts,
+		n.dkgSubmissionConfirmationTimeout,
+	)
+}
+
+// dkgSubmissionRotation returns a rotation of the DKG result submission order
+// for the given seed. It returns nil if the rotation is not configured.
+func (n *Node) dkgSubmissionRotation(
+	seed *big.Int,
+	groupSize int,
+) *dkgResult.SubmissionRotation {
+	if !n.rotateDKGSubmissionOrder {
+		return nil
+	}
+
+	return dkgResult.NewSubmissionRotation(seed, groupSize)
+}
+
+// connectedGroupMembers returns the number of group members controlled by
+// this node or by peers this node is currently connected to.
+func (n *Node) connectedGroupMembers(
+	selectedStakers []relaychain.StakerAddress,
+	signing chain.Signing,
+) int {
+	connectedStakers := n.connectedStakers(signing)
+
+	connectedMembers := 0
+	for _, staker := range selectedStakers {
+		if connectedStakers[hex.EncodeToString(staker)] {
+			connectedMembers++
+		}
+	}
+
+	return connectedMembers
+}
+
+// connectedStakers returns hex-encoded addresses of this node's staker and
+// stakers of peers this node is currently connected to.
+func (n *Node) connectedStakers(signing chain.Signing) map[string]bool {
+	connectedStakers := map[string]bool{
+		hex.EncodeToString(n.Staker.Address()): true,
+	}
+
+	connectionManager := n.netProvider.ConnectionManager()
+	for _, peer := range connectionManager.ConnectedPeers() {
+		publicKey, err := connectionManager.GetPeerPublicKey(peer)
+		if err != nil || publicKey == nil {
+			continue
+		}
+
+		address := signing.PublicKeyToAddress(
+			*key.NetworkKeyToECDSAKey(publicKey),
+		)
+		connectedStakers[hex.EncodeToString(address)] = true
+	}
+
+	return connectedStakers
+}
+
+// trackDKGLiveness starts tracking connectivity of the given stakers selected
+// to the group until the given context is done. It returns nil if the
+// tracking is not configured.
+func (n *Node) trackDKGLiveness(
+	ctx context.Context,
+	selectedStakers []relaychain.StakerAddress,
+	signing chain.Signing,
+) *group.LivenessTracker {
+	if n.dkgPeerLivenessGraceBlocks == 0 {
+		return nil
+	}
+
+	var connectedStakers map[string]bool
+	tracker := group.NewLivenessTracker(
+		n.dkgPeerLivenessGraceBlocks,
+		func(memberID group.MemberIndex) bool {
+			staker := selectedStakers[memberID-1]
+			return connectedStakers[hex.EncodeToString(staker)]
+		},
+	)
+
+	memberIDs := make([]group.MemberIndex, len(selectedStakers))
+	for i := range selectedStakers {
+		memberIDs[i] = group.MemberIndex(i + 1)
+	}
+
+	go func() {
+		for blockHeight := range n.blockCounter.WatchBlocks(ctx) {
+			connectedStakers = n.connectedStakers(signing)
+			tracker.Observe(memberIDs, blockHeight)
+		}
+	}()
+
+	return tracker
+}
+
+// checkBlockHeightSkew announces the current block height observed by this
+// node as the member with the given index and warns if it skews from block
+// heights observed by other group members beyond the configured threshold.
+// The check is executed once per node, even if the node controls many
+// members of the group.
+func (n *Node) checkBlockHeightSkew(
+	channel net.BroadcastChannel,
+	memberIndex group.MemberIndex,
+) {
+	blockHeight, err := n.blockCounter.CurrentBlock()
+	if err != nil {
+		logger.Warningf(
+			"could not check block height skew; "+
+				"could not get current block height: [%v]",
+			err,
+		)
+		return
+	}
+
+	blockWaiter, err := n.blockCounter.BlockHeightWaiter(
+		blockHeight + blockHeightSkewCheckBlocks,
+	)
+	if err != nil {
+		logger.Warningf(
+			"could not check block height skew; "+
+				"could not wait for block height: [%v]",
+			err,
+		)
+		return
+	}
+
+	ctx, cancelCtx := context.WithCancel(context.Background())
+	go func() {
+		<-blockWaiter
+		cancelCtx()
+	}()
+
+	_, err = skew.Check(
+		ctx,
+		channel,
+		memberIndex,
+		blockHeight,
+		n.dkgBlockHeightSkewThreshold,
+	)
+	if err != nil {
+		logger.Warningf("could not check block height skew: [%v]", err)
+	}
 }
 
 // IsInGroup checks if this node is a member of the group which was selected to
@@ -63,6 +614,27 @@ func (n *Node) JoinGroupIfEligible(
 		return
 	}
 
+	if n.rejectUndersizedGroups {
+		chainConfig, err := relayChain.GetConfig()
+		if err != nil {
+			logger.Errorf("could not get chain config: [%v]", err)
+			return
+		}
+
+		err = dkg.ValidateGroupSize(
+			len(groupSelectionResult.SelectedStakers),
+			chainConfig.HonestThreshold,
+		)
+		if err != nil {
+			logger.Errorf(
+				"not executing DKG for seed [%v]: [%v]",
+				identifier.String(newEntry),
+				err,
+			)
+			return
+		}
+	}
+
 	indexes := make([]uint8, 0)
 	for index, selectedStaker := range groupSelectionResult.SelectedStakers {
 		// See if we are amongst those chosen
@@ -72,6 +644,18 @@ func (n *Node) JoinGroupIfEligible(
 	}
 
 	if len(indexes) > 0 {
+		if n.dkgParticipationLimiter != nil &&
+			!n.dkgParticipationLimiter.Admit() {
+			logger.Warningf(
+				"declining participation in DKG for seed [%v]; "+
+					"DKG participation rate limit exceeded",
+				identifier.String(newEntry),
+			)
+			n.metrics.IncrementCounter(dkgParticipationsDeclinedMetric, nil)
+			return
+		}
+		n.metrics.IncrementCounter(dkgParticipationsMetric, nil)
+
 		// create temporary broadcast channel for DKG using the group selection
 		// seed
 		broadcastChannel, err := n.netProvider.BroadcastChannelFor(newEntry.Text(16))
@@ -80,10 +664,17 @@ func (n *Node) JoinGroupIfEligible(
 			return
 		}
 
-		membershipValidator := group.NewStakersMembershipValidator(
+		var membershipValidator group.MembershipValidator = group.NewStakersMembershipValidator(
 			groupSelectionResult.SelectedStakers,
 			signing,
 		)
+		if len(n.dkgAllowedOperators) > 0 {
+			membershipValidator = group.NewAllowListMembershipValidator(
+				membershipValidator,
+				n.dkgAllowedOperators,
+				signing,
+			)
+		}
 
 		err = broadcastChannel.SetFilter(membershipValidator.IsInGroup)
 		if err != nil {
@@ -94,28 +685,140 @@ func (n *Node) JoinGroupIfEligible(
 			)
 		}
 
+		skew.RegisterUnmarshallers(broadcastChannel)
+		if n.dkgBlockHeightSkewThreshold > 0 {
+			go n.checkBlockHeightSkew(
+				broadcastChannel,
+				group.MemberIndex(indexes[0]+1),
+			)
+		}
+
+		stopWatchingPublisher := n.watchDKGPublisher(
+			relayChain,
+			newEntry,
+			indexes,
+			dkgStartBlockHeight,
+		)
+
+		livenessCtx, cancelLivenessCtx := context.WithCancel(
+			context.Background(),
+		)
+		livenessTracker := n.trackDKGLiveness(
+			livenessCtx,
+			groupSelectionResult.SelectedStakers,
+			signing,
+		)
+
+		membersWaitGroup := &sync.WaitGroup{}
+		membersWaitGroup.Add(len(indexes))
+		go func() {
+			membersWaitGroup.Wait()
+			cancelLivenessCtx()
+
+			// Give the publisher watch a block to observe the submission
+			// members may have just seen before it is stopped.
+			if currentBlock, err := n.blockCounter.CurrentBlock(); err == nil {
+				n.blockCounter.WaitForBlockHeight(currentBlock + 1)
+			}
+			stopWatchingPublisher()
+		}()
+
 		for _, index := range indexes {
 			// capture player index for goroutine
 			playerIndex := index
 
 			go func() {
+				defer membersWaitGroup.Done()
+
+				memberIndex := group.MemberIndex(playerIndex + 1)
+				defer n.dkgProgress.Finish(newEntry, memberIndex)
+
+				n.dkgParticipations.Start(newEntry, memberIndex)
+				defer n.finishDKGParticipation(
+					relayChain,
+					newEntry,
+					memberIndex,
+				)
+
+				n.dkgProgress.Start(newEntry, memberIndex, dkgStartBlockHeight)
+
+				// fetch the chain config so that the current on-chain
+				// threshold is used
+				chainConfig, err := relayChain.GetConfig()
+				if err != nil {
+					logger.Errorf("could not get chain config: [%v]", err)
+					return
+				}
+
 				signer, err := dkg.ExecuteDKG(
 					newEntry,
 					playerIndex,
-					n.chainConfig.GroupSize,
-					n.chainConfig.DishonestThreshold(),
+					chainConfig.GroupSize,
+					chainConfig.DishonestThreshold(),
 					membershipValidator,
 					dkgStartBlockHeight,
 					n.blockCounter,
 					relayChain,
 					signing,
 					broadcastChannel,
+					n.dkgPhaseBudgets,
+					dkg.ExecutionConfig{
+						VerifyGroupPublicKey: n.verifyGroupPublicKey,
+						Submission: dkgResult.SubmissionConfig{
+							Observer: n.dkgSubmissionObserver(
+								newEntry,
+								signing,
+							),
+							Queue: n.dkgSubmissionQueue,
+							PartitionGuard: n.dkgPartitionGuard(
+								chainConfig.GroupSize,
+								groupSelectionResult.SelectedStakers,
+								signing,
+							),
+							Confirmation: n.dkgSubmissionConfirmation(
+								relayChain,
+							),
+							Rotation: n.dkgSubmissionRotation(
+								newEntry,
+								chainConfig.GroupSize,
+							),
+							PollOnSubscriptionFailure: n.dkgSubmissionPollingFallback,
+							MinBlockStep:              n.minResultPublicationBlockStep,
+							Cooldown:                  n.dkgSubmissionCooldown,
+							FallbackToLastKnownBlock:  n.dkgSubmissionBlockFallback,
+							MaxSignatures:             n.dkgSubmissionMaxSignatures,
+							Deduplication: n.dkgSubmissionLedger.ForRequest(
+								newEntry,
+							),
+							ConfirmationBufferBlocks: n.dkgSubmissionConfirmationBuffer,
+							GasBudget:                n.dkgSubmissionGasBudget,
+							Retry:                    n.dkgSubmissionRetry,
+						},
+						FailureResultPolicy: n.dkgFailureResultPolicy,
+						DisqualificationObserver: n.dkgDisqualificationObserver(
+							newEntry,
+							memberIndex,
+							indexes,
+						),
+						LivenessTracker: livenessTracker,
+						Checkpoints:     n.dkgCheckpoints,
+						Transcripts:     n.dkgTranscripts,
+					},
 				)
 				if err != nil {
+					n.metrics.IncrementCounter(
+						dkgExecutionsMetric,
+						metrics.Labels{"outcome": "failure"},
+					)
 					logger.Errorf("failed to execute dkg: [%v]", err)
 					return
 				}
 
+				n.metrics.IncrementCounter(
+					dkgExecutionsMetric,
+					metrics.Labels{"outcome": "success"},
+				)
+
 				// final broadcast channel name for group is the compressed
 				// public key of the group
 				channelName := hex.EncodeToString(
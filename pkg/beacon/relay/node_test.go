@@ -0,0 +1,110 @@
+package relay
+
+import (
+	"fmt"
+	"math/big"
+	"reflect"
+	"testing"
+
+	"github.com/keep-network/keep-core/pkg/beacon/relay/config"
+	"github.com/keep-network/keep-core/pkg/beacon/relay/dkg"
+	"github.com/keep-network/keep-core/pkg/beacon/relay/gjkr"
+	"github.com/keep-network/keep-core/pkg/chain"
+)
+
+func TestAmIEligibleToPublish(t *testing.T) {
+	chainConfig := &config.Chain{
+		GroupSize:                  5,
+		HonestThreshold:            3,
+		ResultPublicationBlockStep: 3,
+	}
+
+	requestID := big.NewInt(10)
+
+	// Default phase budgets, DKG starting at block 100; the result submission
+	// starts at block 172 and the third member is eligible from block 178.
+	var tests = map[string]struct {
+		requestID                   *big.Int
+		currentBlockHeight          uint64
+		expectedEligible            bool
+		expectedBlocksUntilEligible uint64
+		expectedErr                 error
+	}{
+		"eligible now": {
+			requestID:                   requestID,
+			currentBlockHeight:          180,
+			expectedEligible:            true,
+			expectedBlocksUntilEligible: 0,
+		},
+		"not yet eligible": {
+			requestID:                   requestID,
+			currentBlockHeight:          150,
+			expectedEligible:            false,
+			expectedBlocksUntilEligible: 28,
+		},
+		"not a member": {
+			requestID:          big.NewInt(20),
+			currentBlockHeight: 180,
+			expectedErr: fmt.Errorf(
+				"node is not a member of the group for request [20]",
+			),
+		},
+	}
+
+	for testName, test := range tests {
+		t.Run(testName, func(t *testing.T) {
+			progress := dkg.NewProgressTracker(
+				gjkr.DefaultPhaseBudgets(),
+				chainConfig,
+				false,
+				0,
+			)
+			progress.Start(requestID, 3, 100)
+			progress.Start(requestID, 5, 100)
+
+			node := &Node{
+				blockCounter: &fixedBlockCounter{
+					blockHeight: test.currentBlockHeight,
+				},
+				chainConfig: chainConfig,
+				dkgProgress: progress,
+			}
+
+			eligible, blocksUntilEligible, err := node.AmIEligibleToPublish(
+				test.requestID,
+			)
+
+			if !reflect.DeepEqual(test.expectedErr, err) {
+				t.Fatalf(
+					"unexpected error\nexpected: %v\nactual:   %v\n",
+					test.expectedErr,
+					err,
+				)
+			}
+			if eligible != test.expectedEligible {
+				t.Errorf(
+					"unexpected eligibility\nexpected: %v\nactual:   %v\n",
+					test.expectedEligible,
+					eligible,
+				)
+			}
+			if blocksUntilEligible != test.expectedBlocksUntilEligible {
+				t.Errorf(
+					"unexpected blocks until eligible\nexpected: %v\nactual:   %v\n",
+					test.expectedBlocksUntilEligible,
+					blocksUntilEligible,
+				)
+			}
+		})
+	}
+}
+
+// fixedBlockCounter is a block counter reporting a fixed block height.
+type fixedBlockCounter struct {
+	chain.BlockCounter
+	blockHeight uint64
+}
+
+func (fbc *fixedBlockCounter) CurrentBlock() (uint64, error) {
+	return fbc.blockHeight, nil
+}
@@ -3,10 +3,12 @@ package registry
 import (
 	"encoding/hex"
 	"fmt"
+	"sort"
 	"sync"
 
 	relaychain "github.com/keep-network/keep-core/pkg/beacon/relay/chain"
 	"github.com/keep-network/keep-core/pkg/beacon/relay/dkg"
+	"github.com/keep-network/keep-core/pkg/beacon/relay/group"
 
 	"github.com/keep-network/keep-common/pkg/persistence"
 )
@@ -29,6 +31,13 @@ type Membership struct {
 	ChannelName string
 }
 
+// GroupMemberships describes the memberships of the client in a single group.
+type GroupMemberships struct {
+	// GroupPublicKey is the hex-encoded public key of the group.
+	GroupPublicKey string
+	MemberIndexes  []group.MemberIndex
+}
+
 // NewGroupRegistry returns an empty GroupRegistry.
 func NewGroupRegistry(
 	relayChain relaychain.GroupRegistrationInterface,
@@ -76,6 +85,35 @@ func (g *Groups) GetGroup(groupPublicKey []byte) []*Membership {
 	return g.myGroups[groupKeyToString(groupPublicKey)]
 }
 
+// Memberships returns the memberships of the client in all registered
+// groups, ordered by the group public key.
+func (g *Groups) Memberships() []*GroupMemberships {
+	g.mutex.Lock()
+	defer g.mutex.Unlock()
+
+	memberships := make([]*GroupMemberships, 0, len(g.myGroups))
+	for groupPublicKey, groupMemberships := range g.myGroups {
+		memberIndexes := make([]group.MemberIndex, 0, len(groupMemberships))
+		for _, membership := range groupMemberships {
+			memberIndexes = append(memberIndexes, membership.Signer.MemberID())
+		}
+		sort.Slice(memberIndexes, func(i, j int) bool {
+			return memberIndexes[i] < memberIndexes[j]
+		})
+
+		memberships = append(memberships, &GroupMemberships{
+			GroupPublicKey: "0x" + groupPublicKey,
+			MemberIndexes:  memberIndexes,
+		})
+	}
+
+	sort.Slice(memberships, func(i, j int) bool {
+		return memberships[i].GroupPublicKey < memberships[j].GroupPublicKey
+	})
+
+	return memberships
+}
+
 // UnregisterStaleGroups lookup for groups that have been marked as stale
 // on-chain. A stale group is a group that has expired and a certain time passed
 // after the group expiration. This guarantees the group will not be selected to
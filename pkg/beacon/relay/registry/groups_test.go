@@ -152,6 +152,38 @@ func TestUnregisterStaleGroups(t *testing.T) {
 
 }
 
+func TestMemberships(t *testing.T) {
+	chain := chainLocal.Connect(5, 3, big.NewInt(200)).ThresholdRelay()
+	gr := NewGroupRegistry(chain, persistenceMock)
+
+	gr.RegisterGroup(signer4, channelName2)
+	gr.RegisterGroup(signer1, channelName1)
+	gr.RegisterGroup(signer2, channelName2)
+
+	expected := []*GroupMemberships{
+		{
+			GroupPublicKey: "0x" + hex.EncodeToString(signer1.GroupPublicKeyBytes()),
+			MemberIndexes:  []group.MemberIndex{1},
+		},
+		{
+			GroupPublicKey: "0x" + hex.EncodeToString(signer2.GroupPublicKeyBytes()),
+			MemberIndexes:  []group.MemberIndex{2, 3},
+		},
+	}
+	if expected[0].GroupPublicKey > expected[1].GroupPublicKey {
+		expected[0], expected[1] = expected[1], expected[0]
+	}
+
+	actual := gr.Memberships()
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf(
+			"unexpected memberships\nexpected: %+v\nactual:   %+v\n",
+			expected,
+			actual,
+		)
+	}
+}
+
 type mockGroupRegistrationInterface struct {
 	groupsToRemove [][]byte
 }
@@ -1,6 +1,10 @@
 package relay
 
 import (
+	"context"
+	"strconv"
+	"time"
+
 	"github.com/ipfs/go-log"
 	"github.com/keep-network/keep-core/pkg/beacon/relay/group"
 
@@ -9,40 +13,235 @@ import (
 	"github.com/keep-network/keep-core/pkg/beacon/relay/event"
 
 	"github.com/keep-network/keep-core/pkg/beacon/relay/config"
+	"github.com/keep-network/keep-core/pkg/beacon/relay/dkg"
+	"github.com/keep-network/keep-core/pkg/beacon/relay/dkg/checkpoint"
+	dkgResult "github.com/keep-network/keep-core/pkg/beacon/relay/dkg/result"
+	"github.com/keep-network/keep-core/pkg/beacon/relay/dkg/transcript"
+	"github.com/keep-network/keep-core/pkg/beacon/relay/gjkr"
 	"github.com/keep-network/keep-core/pkg/beacon/relay/registry"
 	"github.com/keep-network/keep-core/pkg/chain"
+	"github.com/keep-network/keep-core/pkg/metrics"
+	"github.com/keep-network/keep-core/pkg/nats"
 	"github.com/keep-network/keep-core/pkg/net"
+	"github.com/keep-network/keep-core/pkg/webhook"
 )
 
 var logger = log.Logger("keep-relay")
 
 const maxGroupSize = 255
 
+// DKGStorage holds the stores in which the node keeps the state of DKG
+// executions it participates in.
+type DKGStorage struct {
+	// Checkpoints stores the prepared DKG result so that it can be submitted
+	// after a restart; if nil, checkpoints are not stored.
+	Checkpoints *checkpoint.Storage
+	// Transcripts stores transcripts of DKG executions; if nil, transcripts
+	// are not recorded.
+	Transcripts *transcript.Storage
+	// Participations stores the history of DKG participations; if nil,
+	// the history is kept in memory.
+	Participations *dkg.ParticipationStore
+}
+
 // NewNode returns an empty Node with no group, zero group count, and a nil last
 // seen entry, tied to the given net.Provider.
+// The node is configured with the given node config, with defaults applied
+// to the values which are not set.
 func NewNode(
 	staker chain.Staker,
 	netProvider net.Provider,
 	blockCounter chain.BlockCounter,
 	chainConfig *config.Chain,
+	nodeConfig *config.Node,
+	dkgStorage DKGStorage,
 	groupRegistry *registry.Groups,
+	metricsRecorder metrics.Recorder,
 ) Node {
+	nodeConfig = withNodeDefaults(nodeConfig)
+
+	var dkgSubmissionWebhook *webhook.Notifier
+	if nodeConfig.DKGSubmissionWebhookURL != "" {
+		dkgSubmissionWebhook = webhook.NewNotifier(
+			nodeConfig.DKGSubmissionWebhookURL,
+		)
+	}
+
+	dkgParticipations := dkgStorage.Participations
+	if dkgParticipations == nil {
+		// an in-memory store never fails to be created
+		dkgParticipations, _ = dkg.NewParticipationStore(nil)
+	}
+
+	dkgSubmissionCooldown := time.Duration(
+		nodeConfig.DKGSubmissionCooldownSeconds,
+	) * time.Second
+	dkgSubmissionConfirmationTimeout := time.Duration(
+		nodeConfig.DKGSubmissionConfirmationTimeoutSeconds,
+	) * time.Second
+
+	dkgCompletions := dkgResult.NewCompletionBus()
+	dkgCompletions.OnDKGCompleted(func(completion *event.DKGCompleted) {
+		metricsRecorder.IncrementCounter(
+			dkgCompletionsMetric,
+			metrics.Labels{"outcome": completion.Outcome},
+		)
+	})
+
+	dkgProgress := dkg.NewProgressTracker(
+		nodeConfig.DKGPhaseBudgets,
+		chainConfig,
+		nodeConfig.RotateDKGSubmissionOrder,
+		nodeConfig.MinResultPublicationBlockStep,
+	)
+
 	return Node{
-		Staker:        staker,
-		netProvider:   netProvider,
-		blockCounter:  blockCounter,
-		chainConfig:   chainConfig,
-		groupRegistry: groupRegistry,
+		Staker:                           staker,
+		netProvider:                      netProvider,
+		blockCounter:                     blockCounter,
+		chainConfig:                      chainConfig,
+		dkgParticipationLimiter:          dkg.NewParticipationLimiter(nodeConfig),
+		dkgPhaseBudgets:                  nodeConfig.DKGPhaseBudgets,
+		dkgCheckpoints:                   dkgStorage.Checkpoints,
+		dkgTranscripts:                   dkgStorage.Transcripts,
+		dkgParticipations:                dkgParticipations,
+		dkgProgress:                      dkgProgress,
+		dkgProgressFeed:                  dkg.NewProgressFeed(dkgProgress),
+		dkgSubmissionWebhook:             dkgSubmissionWebhook,
+		dkgSubmissionQueue:               newDKGSubmissionQueue(nodeConfig),
+		verifyGroupPublicKey:             nodeConfig.VerifyGroupPublicKey,
+		dkgPartitionMinConnectedFraction: nodeConfig.DKGSubmissionMinConnectedFraction,
+		dkgPartitionMaxDeferralBlocks:    nodeConfig.DKGSubmissionMaxDeferralBlocks,
+		confirmDKGSubmission:             nodeConfig.ConfirmDKGSubmission,
+		dkgSubmissionConfirmationTimeout: dkgSubmissionConfirmationTimeout,
+		rotateDKGSubmissionOrder:         nodeConfig.RotateDKGSubmissionOrder,
+		dkgSubmissionPollingFallback:     nodeConfig.PollDKGSubmissionOnSubscriptionFailure,
+		minResultPublicationBlockStep:    nodeConfig.MinResultPublicationBlockStep,
+		dkgSubmissionCooldown:            dkgResult.NewSubmissionCooldown(dkgSubmissionCooldown),
+		dkgFailureResultPolicy:           nodeConfig.DKGFailureResultPolicy,
+		dkgSubmissionBlockFallback:       nodeConfig.DKGSubmissionFallbackToLastKnownBlock,
+		dkgSubmissionMaxSignatures:       nodeConfig.DKGSubmissionMaxSignatures,
+		rejectUndersizedGroups:           nodeConfig.DKGRejectUndersizedGroups,
+		dkgSubmissionLedger:              dkgResult.NewSubmissionLedger(),
+		dkgSubmissionConfirmationBuffer:  nodeConfig.DKGSubmissionConfirmationBufferBlocks,
+		dkgSubmissionGasBudget:           dkgResult.NewSubmissionGasBudget(nodeConfig),
+		dkgSubmissionRetry:               dkgResult.NewSubmissionRetry(nodeConfig),
+		dkgAllowedOperators:              nodeConfig.DKGAllowedOperators,
+		dkgBlockHeightSkewThreshold:      nodeConfig.DKGBlockHeightSkewThreshold,
+		dkgPeerLivenessGraceBlocks:       nodeConfig.DKGPeerLivenessGraceBlocks,
+		dkgPublishers:                    dkg.NewPublisherTracker(),
+		dkgCompletions:                   dkgCompletions,
+		groupRegistry:                    groupRegistry,
+		metrics:                          metricsRecorder,
+	}
+}
+
+// withNodeDefaults returns a copy of the given node config with defaults
+// applied to the values which are not set.
+func withNodeDefaults(nodeConfig *config.Node) *config.Node {
+	withDefaults := *nodeConfig
+
+	if withDefaults.DKGPhaseBudgets == nil {
+		withDefaults.DKGPhaseBudgets = gjkr.DefaultPhaseBudgets()
+	}
+	if withDefaults.DKGSubmissionQueueCapacity == 0 {
+		withDefaults.DKGSubmissionQueueCapacity =
+			config.DefaultDKGSubmissionQueueCapacity
+	}
+	if withDefaults.DKGSubmissionConfirmationTimeoutSeconds == 0 {
+		withDefaults.DKGSubmissionConfirmationTimeoutSeconds = uint64(
+			config.DefaultDKGSubmissionConfirmationTimeout / time.Second,
+		)
+	}
+	if withDefaults.DKGSubmissionCooldownSeconds == 0 {
+		withDefaults.DKGSubmissionCooldownSeconds = uint64(
+			config.DefaultDKGSubmissionCooldown / time.Second,
+		)
+	}
+
+	return &withDefaults
+}
+
+// newDKGSubmissionQueue creates the DKG result submission queue selected in
+// the node configuration. It returns nil if results should be submitted
+// inline by the submitting member.
+func newDKGSubmissionQueue(nodeConfig *config.Node) dkgResult.SubmissionQueue {
+	switch nodeConfig.DKGSubmissionQueue {
+	case config.MemoryDKGSubmissionQueue:
+		return dkgResult.NewMemoryQueue(nodeConfig.DKGSubmissionQueueCapacity)
+	case config.NatsDKGSubmissionQueue:
+		if nodeConfig.DryRun {
+			// Results handed off to the broker would be submitted by
+			// the consumer of the queue.
+			logger.Warningf(
+				"DKG results are not handed off to the submission broker " +
+					"in dry-run mode",
+			)
+			return nil
+		}
+		return dkgResult.NewBrokerQueue(
+			nats.NewPublisher(nodeConfig.DKGSubmissionBrokerAddress),
+			nodeConfig.DKGSubmissionBrokerSubject,
+		)
+	default:
+		return nil
+	}
+}
+
+// ConsumeDKGSubmissions submits DKG results from the in-memory submission
+// queue to the chain until the context is done. It returns immediately if
+// the node is not configured with the in-memory queue.
+func (n *Node) ConsumeDKGSubmissions(
+	ctx context.Context,
+	relayChain relayChain.Interface,
+) {
+	memoryQueue, ok := n.dkgSubmissionQueue.(*dkgResult.MemoryQueue)
+	if !ok {
+		return
 	}
+
+	memoryQueue.Consume(ctx, relayChain)
+}
+
+// FeedDKGProgress publishes progress events of DKG executions the node
+// participates in on each new block until the context is done.
+func (n *Node) FeedDKGProgress(ctx context.Context) {
+	for blockHeight := range n.blockCounter.WatchBlocks(ctx) {
+		n.dkgProgressFeed.Update(blockHeight)
+	}
+}
+
+// RelayEntryStatus describes a relay entry submitted to the chain observed by
+// the node.
+type RelayEntryStatus struct {
+	// RequestBlockNumber is the block at which the entry has been requested.
+	RequestBlockNumber uint64
+	// BlockNumber is the block at which the entry has been submitted.
+	BlockNumber uint64
+	// ObservedAt is the time at which the node observed the submission.
+	ObservedAt time.Time
+}
+
+// LastRelayEntry returns the last relay entry submitted to the chain observed
+// by the node while monitoring relay requests. It returns nil if the node has
+// not observed any relay entry since it started.
+func (n *Node) LastRelayEntry() *RelayEntryStatus {
+	n.mutex.Lock()
+	defer n.mutex.Unlock()
+
+	return n.lastRelayEntry
 }
 
 // MonitorRelayEntry is listetning to the chain for a new relay entry.
 // When a processing group which is supposed to deliver a relay entry does not
-// fulfill its work, then this Node notifies the chain about it. In the case of
+// fulfill its work, then this Node notifies the chain about it, so that
+// the request is assigned to another group, and counts the missed entry in
+// metrics, telling whether the node is a member of the group. In the case of
 // delivering a relay entry by a processing group, this Node does nothing.
 func (n *Node) MonitorRelayEntry(
 	relayChain relayChain.Interface,
 	relayRequestBlockNumber uint64,
+	groupPublicKey []byte,
 	chainConfig *config.Chain,
 ) {
 	logger.Infof("monitoring chain for a new relay entry")
@@ -70,20 +269,53 @@ func (n *Node) MonitorRelayEntry(
 		case blockNumber := <-timeoutWaiterChannel:
 			subscription.Unsubscribe()
 			close(onEntrySubmittedChannel)
-			logger.Warningf(
-				"relay entry was not submitted on time, reporting timeout at block [%v]",
-				blockNumber,
-			)
+
+			isGroupMember := n.IsInGroup(groupPublicKey)
+			if isGroupMember {
+				logger.Errorf(
+					"relay entry was not submitted on time by group [0x%x] "+
+						"this node is a member of, reporting timeout at block [%v]",
+					groupPublicKey,
+					blockNumber,
+				)
+			} else {
+				logger.Warningf(
+					"relay entry was not submitted on time by group [0x%x], "+
+						"reporting timeout at block [%v]",
+					groupPublicKey,
+					blockNumber,
+				)
+			}
+
+			report := "reported"
 			err = relayChain.ReportRelayEntryTimeout()
 			if err != nil {
+				// Other nodes may have reported the timeout already.
+				report = "failed"
 				logger.Errorf("could not report a relay entry timeout: [%v]", err)
 			}
+
+			n.metrics.IncrementCounter(
+				relayEntryTimeoutsMetric,
+				metrics.Labels{
+					"member": strconv.FormatBool(isGroupMember),
+					"report": report,
+				},
+			)
 			return
 		case entry := <-onEntrySubmittedChannel:
 			logger.Infof(
 				"relay entry was submitted by the selected group on time at block [%v]",
 				entry.BlockNumber,
 			)
+
+			n.mutex.Lock()
+			n.lastRelayEntry = &RelayEntryStatus{
+				RequestBlockNumber: relayRequestBlockNumber,
+				BlockNumber:        entry.BlockNumber,
+				ObservedAt:         time.Now(),
+			}
+			n.mutex.Unlock()
 			return
 		}
 	}
@@ -139,15 +371,20 @@ func (n *Node) GenerateRelayEntry(
 
 	for _, member := range memberships {
 		go func(member *registry.Membership) {
-			err = entry.SignAndSubmit(
+			outcome, err := entry.SignAndSubmit(
 				n.blockCounter,
 				channel,
+				n.netProvider.ConnectionManager(),
 				relayChain,
 				previousEntry,
 				n.chainConfig.HonestThreshold,
 				member.Signer,
 				startBlockHeight,
 			)
+			n.metrics.IncrementCounter(
+				relayEntrySigningsMetric,
+				metrics.Labels{"outcome": string(outcome)},
+			)
 			if err != nil {
 				logger.Errorf(
 					"error creating threshold signature: [%v]",
@@ -3,14 +3,20 @@ package relay
 import (
 	"fmt"
 	"math/big"
+	"reflect"
+	"sync"
 	"testing"
 
 	"github.com/keep-network/keep-core/pkg/beacon/relay/config"
+	"github.com/keep-network/keep-core/pkg/beacon/relay/gjkr"
+	"github.com/keep-network/keep-core/pkg/beacon/relay/registry"
 	chainLocal "github.com/keep-network/keep-core/pkg/chain/local"
+	"github.com/keep-network/keep-core/pkg/metrics"
 )
 
 var address = "0x65ea55c1f10491038425725dc00dffeab2a1e28a"
 var relayEntryTimeout = uint64(15)
+var groupPublicKey = []byte{100}
 
 func TestMonitorRelayEntryOnChain_EntrySubmitted(t *testing.T) {
 	chain := chainLocal.Connect(5, 3, big.NewInt(200))
@@ -19,11 +25,15 @@ func TestMonitorRelayEntryOnChain_EntrySubmitted(t *testing.T) {
 		fmt.Printf("failed to setup a block counter: [%v]", err)
 	}
 
+	relayChain := chain.ThresholdRelay()
+	metricsRecorder := newTestMetricsRecorder()
+
 	node := &Node{
-		blockCounter: blockCounter,
+		blockCounter:  blockCounter,
+		groupRegistry: registry.NewGroupRegistry(relayChain, nil),
+		metrics:       metricsRecorder,
 	}
 
-	relayChain := chain.ThresholdRelay()
 	chainConfig := &config.Chain{
 		RelayEntryTimeout: uint64(relayEntryTimeout),
 	}
@@ -35,6 +45,7 @@ func TestMonitorRelayEntryOnChain_EntrySubmitted(t *testing.T) {
 	go node.MonitorRelayEntry(
 		relayChain,
 		startBlockHeight,
+		groupPublicKey,
 		chainConfig,
 	)
 
@@ -68,6 +79,30 @@ func TestMonitorRelayEntryOnChain_EntrySubmitted(t *testing.T) {
 			numberOfReports,
 		)
 	}
+
+	if counters := metricsRecorder.snapshot(); len(counters) != 0 {
+		t.Errorf("expected no relay entry timeouts counted; has: [%v]", counters)
+	}
+
+	lastRelayEntry := node.LastRelayEntry()
+	if lastRelayEntry == nil {
+		t.Fatal("expected the submitted relay entry to be recorded")
+	}
+	if lastRelayEntry.RequestBlockNumber != startBlockHeight {
+		t.Errorf(
+			"unexpected relay entry request block\nexpected: %v\nactual:   %v\n",
+			startBlockHeight,
+			lastRelayEntry.RequestBlockNumber,
+		)
+	}
+	if lastRelayEntry.BlockNumber < relayEntrySubmissionWindow {
+		t.Errorf(
+			"relay entry recorded at block [%v] before its submission at "+
+				"block [%v]",
+			lastRelayEntry.BlockNumber,
+			relayEntrySubmissionWindow,
+		)
+	}
 }
 
 func TestMonitorRelayEntryOnChain_EntryNotSubmitted(t *testing.T) {
@@ -77,11 +112,15 @@ func TestMonitorRelayEntryOnChain_EntryNotSubmitted(t *testing.T) {
 		fmt.Printf("failed to setup a block counter: [%v]", err)
 	}
 
+	relayChain := chain.ThresholdRelay()
+	metricsRecorder := newTestMetricsRecorder()
+
 	node := &Node{
-		blockCounter: blockCounter,
+		blockCounter:  blockCounter,
+		groupRegistry: registry.NewGroupRegistry(relayChain, nil),
+		metrics:       metricsRecorder,
 	}
 
-	relayChain := chain.ThresholdRelay()
 	chainConfig := &config.Chain{
 		RelayEntryTimeout: uint64(relayEntryTimeout),
 	}
@@ -93,6 +132,7 @@ func TestMonitorRelayEntryOnChain_EntryNotSubmitted(t *testing.T) {
 	go node.MonitorRelayEntry(
 		relayChain,
 		startBlockHeight,
+		groupPublicKey,
 		chainConfig,
 	)
 
@@ -120,4 +160,115 @@ func TestMonitorRelayEntryOnChain_EntryNotSubmitted(t *testing.T) {
 			timeoutsReport[0],
 		)
 	}
+
+	if lastRelayEntry := node.LastRelayEntry(); lastRelayEntry != nil {
+		t.Errorf("unexpected relay entry recorded: [%+v]", lastRelayEntry)
+	}
+
+	expectedTimeouts := map[string]int{
+		"relay_entry_timeouts_total map[member:false report:reported]": 1,
+	}
+	counters := metricsRecorder.snapshot()
+	if !reflect.DeepEqual(expectedTimeouts, counters) {
+		t.Errorf(
+			"unexpected counted relay entry timeouts\n"+
+				"expected: %v\nactual:   %v\n",
+			expectedTimeouts,
+			counters,
+		)
+	}
+}
+
+// testMetricsRecorder counts increments of metric counters by their names
+// and labels.
+type testMetricsRecorder struct {
+	mutex    sync.Mutex
+	counters map[string]int
+}
+
+func newTestMetricsRecorder() *testMetricsRecorder {
+	return &testMetricsRecorder{counters: make(map[string]int)}
+}
+
+func (tmr *testMetricsRecorder) IncrementCounter(
+	name string,
+	labels metrics.Labels,
+) {
+	tmr.mutex.Lock()
+	defer tmr.mutex.Unlock()
+
+	tmr.counters[fmt.Sprintf("%v %v", name, map[string]string(labels))]++
+}
+
+func (tmr *testMetricsRecorder) SetGauge(
+	name string,
+	labels metrics.Labels,
+	value float64,
+) {
+}
+
+// snapshot returns the numbers of increments of all counters.
+func (tmr *testMetricsRecorder) snapshot() map[string]int {
+	tmr.mutex.Lock()
+	defer tmr.mutex.Unlock()
+
+	counters := make(map[string]int, len(tmr.counters))
+	for key, value := range tmr.counters {
+		counters[key] = value
+	}
+	return counters
+}
+
+func TestWithNodeDefaults(t *testing.T) {
+	phaseBudgets := &config.DKGPhaseBudgets{
+		Commitment: config.PhaseBudget{ActiveBlocks: 10},
+	}
+
+	var tests = map[string]struct {
+		nodeConfig     *config.Node
+		expectedConfig *config.Node
+	}{
+		"nothing set": {
+			nodeConfig: &config.Node{},
+			expectedConfig: &config.Node{
+				DKGPhaseBudgets:                         gjkr.DefaultPhaseBudgets(),
+				DKGSubmissionQueueCapacity:              100,
+				DKGSubmissionConfirmationTimeoutSeconds: 300,
+				DKGSubmissionCooldownSeconds:            5,
+			},
+		},
+		"everything set": {
+			nodeConfig: &config.Node{
+				DKGPhaseBudgets:                         phaseBudgets,
+				DKGSubmissionQueueCapacity:              10,
+				DKGSubmissionConfirmationTimeoutSeconds: 60,
+				DKGSubmissionCooldownSeconds:            1,
+				VerifyGroupPublicKey:                    true,
+			},
+			expectedConfig: &config.Node{
+				DKGPhaseBudgets:                         phaseBudgets,
+				DKGSubmissionQueueCapacity:              10,
+				DKGSubmissionConfirmationTimeoutSeconds: 60,
+				DKGSubmissionCooldownSeconds:            1,
+				VerifyGroupPublicKey:                    true,
+			},
+		},
+	}
+
+	for testName, test := range tests {
+		t.Run(testName, func(t *testing.T) {
+			nodeConfig := withNodeDefaults(test.nodeConfig)
+
+			if !reflect.DeepEqual(test.expectedConfig, nodeConfig) {
+				t.Errorf(
+					"unexpected node config\nexpected: %+v\nactual:   %+v\n",
+					test.expectedConfig,
+					nodeConfig,
+				)
+			}
+			if test.nodeConfig == nodeConfig {
+				t.Errorf("a copy of the node config should be returned")
+			}
+		})
+	}
 }
@@ -0,0 +1,177 @@
+package bls
+
+import (
+	"crypto/rand"
+	"fmt"
+	"math/big"
+
+	bn256 "github.com/ethereum/go-ethereum/crypto/bn256/cloudflare"
+)
+
+// Parameters of the simulated group used by the threshold signature check.
+const (
+	selfTestGroupSize = 5
+	selfTestThreshold = 3
+)
+
+var selfTestMessage = []byte("keep self-test message")
+
+// SelfTestCheck is the outcome of a single crypto self-test check.
+type SelfTestCheck struct {
+	Name string
+	// Err is the reason of the failure; nil if the check passed.
+	Err error
+}
+
+// Passed returns true if the check passed.
+func (stc *SelfTestCheck) Passed() bool {
+	return stc.Err == nil
+}
+
+// SelfTest verifies BLS operations work correctly on the current platform.
+// It generates a key pair, signs and verifies a message, reconstructs
+// a threshold signature in a small simulated group, and verifies the
+// reconstructed signature with the group public key. All checks are executed
+// and their outcomes are returned in the order of execution.
+func SelfTest() []*SelfTestCheck {
+	return selfTest(Verify)
+}
+
+func selfTest(
+	verify func(publicKey *bn256.G2, message []byte, signature *bn256.G1) bool,
+) []*SelfTestCheck {
+	checks := make([]*SelfTestCheck, 0)
+	check := func(name string, run func() error) {
+		checks = append(checks, &SelfTestCheck{Name: name, Err: run()})
+	}
+
+	var (
+		secretKey *big.Int
+		publicKey *bn256.G2
+	)
+
+	check("key pair generation", func() error {
+		var err error
+		secretKey, publicKey, err = generateSelfTestKeyPair()
+		return err
+	})
+
+	check("sign and verify", func() error {
+		if secretKey == nil {
+			return fmt.Errorf("no key pair")
+		}
+
+		signature := Sign(secretKey, selfTestMessage)
+		if !verify(publicKey, selfTestMessage, signature) {
+			return fmt.Errorf("valid signature rejected")
+		}
+
+		if verify(publicKey, []byte("other message"), signature) {
+			return fmt.Errorf("signature accepted for other message")
+		}
+
+		return nil
+	})
+
+	var (
+		groupPublicKey     *bn256.G2
+		recoveredSignature *bn256.G1
+	)
+
+	check("threshold signature reconstruction", func() error {
+		masterSecretKey := make([]*big.Int, selfTestThreshold)
+		for i := range masterSecretKey {
+			coefficient, err := rand.Int(rand.Reader, bn256.Order)
+			if err != nil {
+				return fmt.Errorf("could not generate secret key: [%v]", err)
+			}
+			masterSecretKey[i] = coefficient
+		}
+
+		publicKeyShares := make([]*PublicKeyShare, 0)
+		signatureShares := make([]*SignatureShare, 0)
+
+		// Only the last threshold members take part in signing, so that
+		// the reconstruction does not use the first shares only.
+		for i := 1; i <= selfTestGroupSize; i++ {
+			secretKeyShare := GetSecretKeyShare(masterSecretKey, i)
+			publicKeyShares = append(
+				publicKeyShares,
+				secretKeyShare.PublicKeyShare(),
+			)
+
+			if i > selfTestGroupSize-selfTestThreshold {
+				signatureShares = append(signatureShares, &SignatureShare{
+					I: i,
+					V: Sign(secretKeyShare.V, selfTestMessage),
+				})
+			}
+		}
+
+		var err error
+		recoveredSignature, err = RecoverSignature(
+			signatureShares,
+			selfTestThreshold,
+		)
+		if err != nil {
+			return fmt.Errorf("could not recover signature: [%v]", err)
+		}
+
+		expectedSignature := Sign(masterSecretKey[0], selfTestMessage)
+		if recoveredSignature.String() != expectedSignature.String() {
+			return fmt.Errorf("recovered signature does not match")
+		}
+
+		groupPublicKey = new(bn256.G2).ScalarBaseMult(masterSecretKey[0])
+
+		recoveredPublicKey, err := RecoverPublicKey(
+			publicKeyShares,
+			selfTestThreshold,
+		)
+		if err != nil {
+			return fmt.Errorf("could not recover public key: [%v]", err)
+		}
+		if recoveredPublicKey.String() != groupPublicKey.String() {
+			return fmt.Errorf("recovered public key does not match")
+		}
+
+		return nil
+	})
+
+	check("threshold signature verification", func() error {
+		if recoveredSignature == nil {
+			return fmt.Errorf("no threshold signature")
+		}
+
+		if !verify(groupPublicKey, selfTestMessage, recoveredSignature) {
+			return fmt.Errorf("valid threshold signature rejected")
+		}
+
+		if publicKey != nil &&
+			verify(publicKey, selfTestMessage, recoveredSignature) {
+			return fmt.Errorf("threshold signature accepted for other key")
+		}
+
+		return nil
+	})
+
+	return checks
+}
+
+func generateSelfTestKeyPair() (*big.Int, *bn256.G2, error) {
+	secretKey, publicKey, err := bn256.RandomG2(rand.Reader)
+	if err != nil {
+		return nil, nil, fmt.Errorf("could not generate key pair: [%v]", err)
+	}
+
+	// the public key has to survive the serialization roundtrip
+	unmarshalled := new(bn256.G2)
+	if _, err := unmarshalled.Unmarshal(publicKey.Marshal()); err != nil {
+		return nil, nil, fmt.Errorf("could not unmarshal public key: [%v]", err)
+	}
+	if unmarshalled.String() != publicKey.String() {
+		return nil, nil, fmt.Errorf("public key serialization mismatch")
+	}
+
+	return secretKey, publicKey, nil
+}
@@ -0,0 +1,67 @@
+package bls
+
+import (
+	"testing"
+
+	bn256 "github.com/ethereum/go-ethereum/crypto/bn256/cloudflare"
+)
+
+func TestSelfTestPasses(t *testing.T) {
+	checks := SelfTest()
+
+	if len(checks) != 4 {
+		t.Fatalf(
+			"unexpected number of checks\nexpected: %v\nactual:   %v\n",
+			4,
+			len(checks),
+		)
+	}
+
+	for _, check := range checks {
+		if !check.Passed() {
+			t.Errorf("check [%v] failed: [%v]", check.Name, check.Err)
+		}
+	}
+}
+
+func TestSelfTestDetectsBrokenVerification(t *testing.T) {
+	var tests = map[string]struct {
+		verify func(*bn256.G2, []byte, *bn256.G1) bool
+	}{
+		"verification accepting everything": {
+			verify: func(*bn256.G2, []byte, *bn256.G1) bool { return true },
+		},
+		"verification rejecting everything": {
+			verify: func(*bn256.G2, []byte, *bn256.G1) bool { return false },
+		},
+	}
+
+	for testName, test := range tests {
+		t.Run(testName, func(t *testing.T) {
+			failed := make(map[string]bool)
+			for _, check := range selfTest(test.verify) {
+				if !check.Passed() {
+					failed[check.Name] = true
+				}
+			}
+
+			for _, name := range []string{
+				"sign and verify",
+				"threshold signature verification",
+			} {
+				if !failed[name] {
+					t.Errorf("check [%v] should fail", name)
+				}
+			}
+
+			for _, name := range []string{
+				"key pair generation",
+				"threshold signature reconstruction",
+			} {
+				if failed[name] {
+					t.Errorf("check [%v] should pass", name)
+				}
+			}
+		})
+	}
+}
@@ -3,9 +3,12 @@ package chain
 import (
 	"context"
 	"crypto/ecdsa"
+	"math/big"
 
 	relaychain "github.com/keep-network/keep-core/pkg/beacon/relay/chain"
+	"github.com/keep-network/keep-core/pkg/beacon/relay/event"
 	"github.com/keep-network/keep-core/pkg/gen/async"
+	"github.com/keep-network/keep-core/pkg/subscription"
 )
 
 // BlockCounter is an interface that provides the ability to wait for a certain
@@ -104,5 +107,15 @@ type Utility interface {
 	Handle
 
 	Genesis() error
-	RequestRelayEntry() *async.EventEntryGeneratedPromise
+	// RequestRelayEntry requests a new relay entry paying the given amount of
+	// wei. If the payment is nil, the current entry fee estimate is paid.
+	RequestRelayEntry(payment *big.Int) *async.EventEntryGeneratedPromise
+	// LatestRelayEntry returns the most recent relay entry as a number, the
+	// same way it is reported when the entry is generated.
+	LatestRelayEntry() (*big.Int, error)
+	// OnRelayEntryGenerated registers a callback that is invoked each time
+	// a new relay entry is generated.
+	OnRelayEntryGenerated(
+		handler func(entry *event.EntryGenerated),
+	) (subscription.EventSubscription, error)
 }
@@ -0,0 +1,191 @@
+package ethereum
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+	"sync"
+	"time"
+
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/core/types"
+)
+
+// blockSubscriptionTimeout is the time after which the request subscribing
+// to new blocks is abandoned.
+const blockSubscriptionTimeout = 10 * time.Second
+
+// blockResubscriptionDelay is the time the block counter waits before
+// subscribing to new blocks again after the subscription failed.
+const blockResubscriptionDelay = 5 * time.Second
+
+// headerSource notifies about new block headers.
+type headerSource interface {
+	HeaderByNumber(ctx context.Context, number *big.Int) (*types.Header, error)
+	SubscribeNewHead(
+		ctx context.Context,
+		headers chan<- *types.Header,
+	) (ethereum.Subscription, error)
+}
+
+// blockCounter counts blocks the header source notifies about. Unlike the
+// keep-common block counter bound to a single Ethereum client, it subscribes
+// through the header source again each time the subscription fails and
+// catches up with blocks mined in the meantime, so it keeps counting when
+// the source switches to another Ethereum endpoint.
+type blockCounter struct {
+	mutex             sync.Mutex
+	latestBlockHeight uint64
+	waiters           map[uint64][]chan uint64
+	watchers          []chan uint64
+}
+
+func createBlockCounter(source headerSource) (*blockCounter, error) {
+	latestHeader, err := source.HeaderByNumber(context.Background(), nil)
+	if err != nil {
+		return nil, fmt.Errorf(
+			"failed to get initial block from the chain: [%v]",
+			err,
+		)
+	}
+
+	counter := &blockCounter{
+		latestBlockHeight: latestHeader.Number.Uint64(),
+		waiters:           make(map[uint64][]chan uint64),
+	}
+
+	go counter.subscribeBlocks(source)
+
+	return counter, nil
+}
+
+func (bc *blockCounter) WaitForBlockHeight(blockNumber uint64) error {
+	waiter, err := bc.BlockHeightWaiter(blockNumber)
+	if err != nil {
+		return err
+	}
+	<-waiter
+	return nil
+}
+
+func (bc *blockCounter) BlockHeightWaiter(
+	blockNumber uint64,
+) (<-chan uint64, error) {
+	waiter := make(chan uint64)
+
+	bc.mutex.Lock()
+	defer bc.mutex.Unlock()
+
+	if blockNumber <= bc.latestBlockHeight {
+		go func() { waiter <- blockNumber }()
+	} else {
+		bc.waiters[blockNumber] = append(bc.waiters[blockNumber], waiter)
+	}
+
+	return waiter, nil
+}
+
+func (bc *blockCounter) CurrentBlock() (uint64, error) {
+	bc.mutex.Lock()
+	defer bc.mutex.Unlock()
+
+	return bc.latestBlockHeight, nil
+}
+
+func (bc *blockCounter) WatchBlocks(ctx context.Context) <-chan uint64 {
+	watcher := make(chan uint64)
+
+	bc.mutex.Lock()
+	bc.watchers = append(bc.watchers, watcher)
+	bc.mutex.Unlock()
+
+	go func() {
+		<-ctx.Done()
+
+		bc.mutex.Lock()
+		defer bc.mutex.Unlock()
+
+		for i, w := range bc.watchers {
+			if w == watcher {
+				bc.watchers = append(bc.watchers[:i], bc.watchers[i+1:]...)
+				break
+			}
+		}
+		close(watcher)
+	}()
+
+	return watcher
+}
+
+// subscribeBlocks counts blocks notified over the subscription to new block
+// headers and subscribes again each time the subscription fails.
+func (bc *blockCounter) subscribeBlocks(source headerSource) {
+	for {
+		err := bc.receiveBlocks(source)
+		logger.Warningf(
+			"subscription to new blocks interrupted; "+
+				"subscribing again in [%v]: [%v]",
+			blockResubscriptionDelay,
+			err,
+		)
+		time.Sleep(blockResubscriptionDelay)
+	}
+}
+
+func (bc *blockCounter) receiveBlocks(source headerSource) error {
+	subscribeCtx, cancelSubscribeCtx := context.WithTimeout(
+		context.Background(),
+		blockSubscriptionTimeout,
+	)
+	defer cancelSubscribeCtx()
+
+	headers := make(chan *types.Header)
+	subscription, err := source.SubscribeNewHead(subscribeCtx, headers)
+	if err != nil {
+		return err
+	}
+	defer subscription.Unsubscribe()
+
+	// Blocks mined while the node was not subscribed are not notified about,
+	// so the counter catches up with the latest block first.
+	latestHeader, err := source.HeaderByNumber(subscribeCtx, nil)
+	if err != nil {
+		return err
+	}
+	bc.advance(latestHeader.Number.Uint64())
+
+	for {
+		select {
+		case header := <-headers:
+			bc.advance(header.Number.Uint64())
+		case err := <-subscription.Err():
+			return err
+		}
+	}
+}
+
+// advance moves the counter to the given block height notifying waiters and
+// watchers about each block on the way. Heights not above the current one
+// are ignored.
+func (bc *blockCounter) advance(blockHeight uint64) {
+	bc.mutex.Lock()
+	defer bc.mutex.Unlock()
+
+	for bc.latestBlockHeight < blockHeight {
+		bc.latestBlockHeight++
+		height := bc.latestBlockHeight
+
+		for _, waiter := range bc.waiters[height] {
+			go func(waiter chan uint64) { waiter <- height }(waiter)
+		}
+		delete(bc.waiters, height)
+
+		for _, watcher := range bc.watchers {
+			select {
+			case watcher <- height:
+			default:
+				// the watcher is not ready to receive; the block is dropped
+			}
+		}
+	}
+}
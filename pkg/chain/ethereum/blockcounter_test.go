@@ -0,0 +1,144 @@
+package ethereum
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/event"
+)
+
+// testHeaderSource notifies about blocks over subscriptions which can be
+// interrupted at any time, like subscriptions to an endpoint the node
+// switches away from.
+type testHeaderSource struct {
+	mutex         sync.Mutex
+	latestBlock   uint64
+	subscriptions []*testHeaderSubscription
+}
+
+type testHeaderSubscription struct {
+	headers chan<- *types.Header
+	errs    chan error
+}
+
+func (ths *testHeaderSource) HeaderByNumber(
+	ctx context.Context,
+	number *big.Int,
+) (*types.Header, error) {
+	ths.mutex.Lock()
+	defer ths.mutex.Unlock()
+
+	return &types.Header{Number: new(big.Int).SetUint64(ths.latestBlock)}, nil
+}
+
+func (ths *testHeaderSource) SubscribeNewHead(
+	ctx context.Context,
+	headers chan<- *types.Header,
+) (ethereum.Subscription, error) {
+	ths.mutex.Lock()
+	defer ths.mutex.Unlock()
+
+	subscription := &testHeaderSubscription{headers, make(chan error, 1)}
+	ths.subscriptions = append(ths.subscriptions, subscription)
+
+	return event.NewSubscription(func(unsubscribed <-chan struct{}) error {
+		select {
+		case err := <-subscription.errs:
+			return err
+		case <-unsubscribed:
+			return nil
+		}
+	}), nil
+}
+
+// mine mines a block and notifies about it if the given flag is set.
+func (ths *testHeaderSource) mine(notify bool) {
+	ths.mutex.Lock()
+	ths.latestBlock++
+	header := &types.Header{Number: new(big.Int).SetUint64(ths.latestBlock)}
+	subscriptions := ths.subscriptions
+	ths.mutex.Unlock()
+
+	if notify {
+		subscriptions[len(subscriptions)-1].headers <- header
+	}
+}
+
+// interrupt fails the active subscription.
+func (ths *testHeaderSource) interrupt() {
+	ths.mutex.Lock()
+	defer ths.mutex.Unlock()
+
+	ths.subscriptions[len(ths.subscriptions)-1].errs <- fmt.Errorf("closed")
+}
+
+func (ths *testHeaderSource) subscriptionCount() int {
+	ths.mutex.Lock()
+	defer ths.mutex.Unlock()
+
+	return len(ths.subscriptions)
+}
+
+func waitFor(t *testing.T, condition func() bool) {
+	deadline := time.Now().Add(2 * blockResubscriptionDelay)
+	for !condition() {
+		if time.Now().After(deadline) {
+			t.Fatal("condition not met in time")
+		}
+		time.Sleep(10 * time.Millisecond)
+	}
+}
+
+func TestBlockCounterCatchesUpAfterResubscription(t *testing.T) {
+	source := &testHeaderSource{latestBlock: 10}
+
+	counter, err := createBlockCounter(source)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	waitFor(t, func() bool { return source.subscriptionCount() == 1 })
+
+	waiter, err := counter.BlockHeightWaiter(13)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	source.mine(true)
+
+	waitFor(t, func() bool {
+		currentBlock, _ := counter.CurrentBlock()
+		return currentBlock == 11
+	})
+
+	source.interrupt()
+	source.mine(false)
+	source.mine(false)
+
+	select {
+	case blockNumber := <-waiter:
+		if blockNumber != 13 {
+			t.Errorf(
+				"unexpected block number\nexpected: %v\nactual:   %v\n",
+				13,
+				blockNumber,
+			)
+		}
+	case <-time.After(2 * blockResubscriptionDelay):
+		t.Fatal("blocks mined while not subscribed have not been counted")
+	}
+
+	if source.subscriptionCount() != 2 {
+		t.Errorf(
+			"unexpected number of subscriptions\nexpected: %v\nactual:   %v\n",
+			2,
+			source.subscriptionCount(),
+		)
+	}
+}
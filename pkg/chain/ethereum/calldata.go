@@ -0,0 +1,48 @@
+package ethereum
+
+import (
+	"fmt"
+	"math/big"
+	"strings"
+
+	ethabi "github.com/ethereum/go-ethereum/accounts/abi"
+	relaychain "github.com/keep-network/keep-core/pkg/beacon/relay/chain"
+	"github.com/keep-network/keep-core/pkg/chain/gen/abi"
+)
+
+// DKGResultSubmissionCalldata returns the ABI-encoded calldata of the
+// KeepRandomBeaconOperator contract call submitting the given DKG result with
+// supporting signatures in the name of the given member. The calldata consists
+// of the function selector followed by the encoded arguments and can be sent
+// to the operator contract with any tooling. Signatures are encoded in the
+// order of member indexes.
+func DKGResultSubmissionCalldata(
+	participantIndex relaychain.GroupMemberIndex,
+	result *relaychain.DKGResult,
+	signatures map[relaychain.GroupMemberIndex][]byte,
+) ([]byte, error) {
+	membersIndicesOnChainFormat, signaturesOnChainFormat, err :=
+		convertSignaturesToChainFormat(signatures)
+	if err != nil {
+		return nil, fmt.Errorf("converting signatures failed [%v]", err)
+	}
+
+	operatorABI, err := ethabi.JSON(
+		strings.NewReader(abi.KeepRandomBeaconOperatorABI),
+	)
+	if err != nil {
+		return nil, fmt.Errorf(
+			"could not parse KeepRandomBeaconOperator ABI: [%v]",
+			err,
+		)
+	}
+
+	return operatorABI.Pack(
+		"submitDkgResult",
+		big.NewInt(int64(participantIndex)),
+		result.GroupPublicKey,
+		result.Misbehaved,
+		signaturesOnChainFormat,
+		membersIndicesOnChainFormat,
+	)
+}
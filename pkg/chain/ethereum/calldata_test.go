@@ -0,0 +1,95 @@
+package ethereum
+
+import (
+	"bytes"
+	"fmt"
+	"math/big"
+	"reflect"
+	"strings"
+	"testing"
+
+	ethabi "github.com/ethereum/go-ethereum/accounts/abi"
+	relaychain "github.com/keep-network/keep-core/pkg/beacon/relay/chain"
+	"github.com/keep-network/keep-core/pkg/chain/gen/abi"
+)
+
+func TestDKGResultSubmissionCalldata(t *testing.T) {
+	result := &relaychain.DKGResult{
+		GroupPublicKey: []byte{10, 11, 12},
+		Misbehaved:     []byte{3},
+	}
+	signatures := map[relaychain.GroupMemberIndex][]byte{
+		5: bytes.Repeat([]byte{5}, SignatureSize),
+		1: bytes.Repeat([]byte{1}, SignatureSize),
+		2: bytes.Repeat([]byte{2}, SignatureSize),
+	}
+
+	calldata, err := DKGResultSubmissionCalldata(2, result, signatures)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	operatorABI, err := ethabi.JSON(
+		strings.NewReader(abi.KeepRandomBeaconOperatorABI),
+	)
+	if err != nil {
+		t.Fatal(err)
+	}
+	method := operatorABI.Methods["submitDkgResult"]
+
+	if !bytes.Equal(method.ID(), calldata[:4]) {
+		t.Errorf(
+			"unexpected function selector\nexpected: %x\nactual:   %x\n",
+			method.ID(),
+			calldata[:4],
+		)
+	}
+
+	arguments, err := method.Inputs.UnpackValues(calldata[4:])
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var expectedSignatures []byte
+	for _, memberIndex := range []relaychain.GroupMemberIndex{1, 2, 5} {
+		expectedSignatures = append(
+			expectedSignatures,
+			signatures[memberIndex]...,
+		)
+	}
+
+	expectedArguments := []interface{}{
+		big.NewInt(2),
+		result.GroupPublicKey,
+		result.Misbehaved,
+		expectedSignatures,
+		[]*big.Int{big.NewInt(1), big.NewInt(2), big.NewInt(5)},
+	}
+	if !reflect.DeepEqual(expectedArguments, arguments) {
+		t.Errorf(
+			"unexpected arguments\nexpected: %v\nactual:   %v\n",
+			expectedArguments,
+			arguments,
+		)
+	}
+}
+
+func TestDKGResultSubmissionCalldataInvalidSignature(t *testing.T) {
+	_, err := DKGResultSubmissionCalldata(
+		1,
+		&relaychain.DKGResult{GroupPublicKey: []byte{10}},
+		map[relaychain.GroupMemberIndex][]byte{1: []byte{1, 2, 3}},
+	)
+
+	expectedError := fmt.Errorf(
+		"converting signatures failed [invalid signature size for member " +
+			"[1] got [3]-bytes but required [65]-bytes]",
+	)
+	if !reflect.DeepEqual(expectedError, err) {
+		t.Errorf(
+			"unexpected error\nexpected: %v\nactual:   %v\n",
+			expectedError,
+			err,
+		)
+	}
+}
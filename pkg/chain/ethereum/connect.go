@@ -1,7 +1,9 @@
 package ethereum
 
 import (
+	"context"
 	"fmt"
+	"math/big"
 	"sync"
 
 	"github.com/ethereum/go-ethereum/accounts/abi/bind"
@@ -11,19 +13,37 @@ import (
 	"github.com/keep-network/keep-common/pkg/chain/ethereum"
 	"github.com/keep-network/keep-common/pkg/chain/ethereum/blockcounter"
 	"github.com/keep-network/keep-common/pkg/chain/ethereum/ethutil"
+	relaychain "github.com/keep-network/keep-core/pkg/beacon/relay/chain"
 	"github.com/keep-network/keep-core/pkg/chain"
 	"github.com/keep-network/keep-core/pkg/chain/gen/contract"
+	"github.com/keep-network/keep-core/pkg/metrics"
 )
 
 type ethereumChain struct {
 	config                           ethereum.Config
 	client                           bind.ContractBackend
+	receiptBackend                   bind.DeployBackend
 	clientRPC                        *rpc.Client
-	clientWS                         *rpc.Client
+	clientWS                         rpcCaller
 	keepRandomBeaconOperatorContract *contract.KeepRandomBeaconOperator
 	stakingContract                  *contract.TokenStaking
 	accountKey                       *keystore.Key
-	blockCounter                     *blockcounter.EthereumBlockCounter
+	blockCounter                     chain.BlockCounter
+	configCache                      *relaychain.ConfigCache
+	history                          HistoryConfig
+
+	// gasPrice prices submitted transactions; if nil, the gas price
+	// suggested by the Ethereum node is used.
+	gasPrice *gasPriceBackend
+
+	// chainID is the ID of the connected chain. It is a domain separator of
+	// DKG result hashes preventing cross-chain replay of result signatures.
+	chainID *big.Int
+
+	// dkgResultSubmitterContract is the operator contract handle DKG results
+	// are submitted with. It sends transactions to the private relay if one
+	// is configured and to the Ethereum node otherwise.
+	dkgResultSubmitterContract *contract.KeepRandomBeaconOperator
 
 	// transactionMutex allows interested parties to forcibly serialize
 	// transaction submission.
@@ -46,31 +66,29 @@ type ethereumUtilityChain struct {
 	keepRandomBeaconServiceContract *contract.KeepRandomBeaconService
 }
 
-func connect(config ethereum.Config) (*ethereumChain, error) {
-	client, clientWS, clientRPC, err := ethutil.ConnectClients(config.URL, config.URLRPC)
-	if err != nil {
-		return nil, fmt.Errorf(
-			"error connecting to Ethereum server: %s [%v]",
-			config.URL,
-			err,
-		)
+// connect makes the network connection to the Ethereum network. If the
+// endpoint selector is set, the node connects to the endpoint it selects and
+// follows its selection; otherwise, it connects to the configured URL. If the
+// metrics recorder is set, errors returned by the Ethereum node and the block
+// counter lag are recorded in it.
+func connect(
+	config ethereum.Config,
+	endpoints *EndpointSelector,
+	metricsRecorder metrics.Recorder,
+) (*ethereumChain, error) {
+	var pv *ethereumChain
+	var err error
+	if endpoints != nil {
+		pv, err = connectEndpoints(config, endpoints)
+	} else {
+		pv, err = connectClients(config)
 	}
-
-	blockCounter, err := blockcounter.CreateBlockCounter(client)
 	if err != nil {
-		return nil, fmt.Errorf(
-			"failed to create Ethereum blockcounter: [%v]",
-			err,
-		)
+		return nil, err
 	}
 
-	pv := &ethereumChain{
-		config:           config,
-		client:           ethutil.WrapCallLogging(logger, client),
-		clientRPC:        clientRPC,
-		clientWS:         clientWS,
-		transactionMutex: &sync.Mutex{},
-		blockCounter:     blockCounter,
+	if metricsRecorder != nil {
+		pv.observeMetrics(metricsRecorder)
 	}
 
 	if pv.accountKey == nil {
@@ -104,6 +122,7 @@ func connect(config ethereum.Config) (*ethereumChain, error) {
 		return nil, fmt.Errorf("error attaching to KeepRandomBeaconOperator contract: [%v]", err)
 	}
 	pv.keepRandomBeaconOperatorContract = keepRandomBeaconOperatorContract
+	pv.dkgResultSubmitterContract = keepRandomBeaconOperatorContract
 
 	address, err = addressForContract(config, "TokenStaking")
 	if err != nil {
@@ -122,16 +141,132 @@ func connect(config ethereum.Config) (*ethereumChain, error) {
 	}
 	pv.stakingContract = stakingContract
 
+	pv.configCache = relaychain.NewConfigCache(pv.fetchConfig, configCacheTTL)
+
 	return pv, nil
 }
 
+// connectClients connects to the Ethereum endpoint with the configured URL.
+func connectClients(config ethereum.Config) (*ethereumChain, error) {
+	client, clientWS, clientRPC, err := ethutil.ConnectClients(config.URL, config.URLRPC)
+	if err != nil {
+		return nil, fmt.Errorf(
+			"error connecting to Ethereum server: %s [%v]",
+			config.URL,
+			err,
+		)
+	}
+
+	blockCounter, err := blockcounter.CreateBlockCounter(client)
+	if err != nil {
+		return nil, fmt.Errorf(
+			"failed to create Ethereum blockcounter: [%v]",
+			err,
+		)
+	}
+
+	chainID, err := client.ChainID(context.Background())
+	if err != nil {
+		return nil, fmt.Errorf("failed to get Ethereum chain ID: [%v]", err)
+	}
+
+	return &ethereumChain{
+		config:           config,
+		chainID:          chainID,
+		client:           ethutil.WrapCallLogging(logger, client),
+		receiptBackend:   client,
+		clientRPC:        clientRPC,
+		clientWS:         clientWS,
+		transactionMutex: &sync.Mutex{},
+		blockCounter:     blockCounter,
+	}, nil
+}
+
+// connectEndpoints connects to the Ethereum endpoint already selected by the
+// given selector and switches to another endpoint each time the selection changes.
+// Failures of the active endpoint are reported to the selector so that the
+// selection is re-evaluated right away. Event subscriptions and the block
+// counter subscribe again on the new endpoint after the switch. The configured
+// URL is not used.
+func connectEndpoints(
+	config ethereum.Config,
+	endpoints *EndpointSelector,
+) (*ethereumChain, error) {
+	url := endpoints.Selected()
+	if url == "" {
+		return nil, fmt.Errorf("no Ethereum endpoint has been selected")
+	}
+
+	backend, err := newEndpointBackend(
+		context.Background(),
+		url,
+		dialEndpoint,
+		endpoints.ReportFailure,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	// The selection is passed to followers after each re-evaluation, so
+	// a change made before the backend follows it is caught up with on the
+	// next one.
+	endpoints.follow(func(url string) {
+		if err := backend.switchTo(context.Background(), url); err != nil {
+			logger.Errorf(
+				"could not switch to Ethereum endpoint [%v]: [%v]",
+				url,
+				err,
+			)
+		}
+	})
+
+	blockCounter, err := createBlockCounter(backend)
+	if err != nil {
+		return nil, fmt.Errorf(
+			"failed to create Ethereum blockcounter: [%v]",
+			err,
+		)
+	}
+
+	return &ethereumChain{
+		config:           config,
+		chainID:          backend.chainID,
+		client:           ethutil.WrapCallLogging(logger, backend),
+		receiptBackend:   backend,
+		clientWS:         backend,
+		transactionMutex: &sync.Mutex{},
+		blockCounter:     blockCounter,
+	}, nil
+}
+
+// validateChainID checks if the actual chain ID reported by the connected
+// Ethereum endpoint matches the expected one. Expected chain ID of zero means
+// any chain is accepted.
+func validateChainID(expectedChainID uint64, actualChainID *big.Int) error {
+	if expectedChainID == 0 {
+		return nil
+	}
+
+	if !actualChainID.IsUint64() || actualChainID.Uint64() != expectedChainID {
+		return fmt.Errorf(
+			"connected Ethereum chain ID [%v] does not match expected "+
+				"chain ID [%v]; please make sure the Ethereum URL points "+
+				"to the right network",
+			actualChainID,
+			expectedChainID,
+		)
+	}
+
+	return nil
+}
+
 // ConnectUtility makes the network connection to the Ethereum network and
 // returns a utility handle to the chain interface with additional methods for
 // non- standard client interactions. Note: for other things to work correctly
 // the configuration will need to reference a websocket, "ws://", or local IPC
 // connection.
 func ConnectUtility(config ethereum.Config) (chain.Utility, error) {
-	base, err := connect(config)
+	base, err := connect(config, nil, nil)
 	if err != nil {
 		return nil, err
 	}
@@ -163,7 +298,75 @@ func ConnectUtility(config ethereum.Config) (chain.Utility, error) {
 // correctly the configuration will need to reference a websocket, "ws://", or
 // local IPC connection.
 func Connect(config ethereum.Config) (chain.Handle, error) {
-	return connect(config)
+	return connect(config, nil, nil)
+}
+
+// ConnectOptions configures the connection made by ConnectWithOptions. Options
+// which are not set are not applied.
+type ConnectOptions struct {
+	// ConfigCacheFile is the file the relay config fetched from the chain is
+	// persisted to and used as a fallback from if the config could not be
+	// fetched from the chain at startup.
+	ConfigCacheFile string
+	// History configures how past events are queried.
+	History HistoryConfig
+	// ExpectedChainID is the ID of the chain the connected Ethereum endpoint
+	// has to report; connection fails otherwise.
+	ExpectedChainID uint64
+	// PrivateRelay configures the private relay DKG result submission
+	// transactions are sent to instead of the public mempool.
+	PrivateRelay PrivateRelayConfig
+	// GasPrice configures the gas price strategy relay entry, ticket and DKG
+	// result submission transactions are priced with.
+	GasPrice GasPriceConfig
+	// Endpoints selects the endpoint the node connects to instead of the
+	// configured URL. The node fails over to another endpoint each time the
+	// selection changes.
+	Endpoints *EndpointSelector
+	// MetricsRecorder records errors returned by the Ethereum node and the
+	// block counter lag.
+	MetricsRecorder metrics.Recorder
+}
+
+// ConnectWithOptions makes the network connection to the Ethereum network the
+// same way as Connect does and configures the connection with the given
+// options. Nonces of submitted transactions are tracked by the node so that
+// transactions sent shortly one after another do not reuse the same nonce.
+func ConnectWithOptions(
+	config ethereum.Config,
+	options ConnectOptions,
+) (chain.Handle, error) {
+	ec, err := connect(config, options.Endpoints, options.MetricsRecorder)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := validateChainID(options.ExpectedChainID, ec.chainID); err != nil {
+		return nil, err
+	}
+
+	ec.history = options.History
+
+	if options.ConfigCacheFile != "" {
+		ec.configCache.PersistTo(options.ConfigCacheFile)
+	}
+
+	if err := ec.useGasPrice(options.GasPrice); err != nil {
+		return nil, err
+	}
+
+	if err := ec.trackNonces(); err != nil {
+		return nil, err
+	}
+
+	if options.PrivateRelay.URL != "" {
+		err := ec.usePrivateRelay(options.PrivateRelay, ec.blockCounter.CurrentBlock)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return ec, nil
 }
 
 func addressForContract(config ethereum.Config, contractName string) (*common.Address, error) {
@@ -2,6 +2,7 @@ package ethereum
 
 import (
 	"fmt"
+	"math/big"
 	"reflect"
 	"testing"
 
@@ -78,3 +79,44 @@ func TestResolveContractByName(t *testing.T) {
 		})
 	}
 }
+
+func TestValidateChainID(t *testing.T) {
+	var tests = map[string]struct {
+		expectedChainID uint64
+		actualChainID   *big.Int
+		expectedError   error
+	}{
+		"matching chain ID": {
+			expectedChainID: 1,
+			actualChainID:   big.NewInt(1),
+			expectedError:   nil,
+		},
+		"no expected chain ID": {
+			expectedChainID: 0,
+			actualChainID:   big.NewInt(3),
+			expectedError:   nil,
+		},
+		"mismatched chain ID": {
+			expectedChainID: 1,
+			actualChainID:   big.NewInt(3),
+			expectedError: fmt.Errorf(
+				"connected Ethereum chain ID [3] does not match expected " +
+					"chain ID [1]; please make sure the Ethereum URL points " +
+					"to the right network",
+			),
+		},
+	}
+
+	for testName, test := range tests {
+		t.Run(testName, func(t *testing.T) {
+			err := validateChainID(test.expectedChainID, test.actualChainID)
+			if !reflect.DeepEqual(test.expectedError, err) {
+				t.Fatalf(
+					"Unexpected error\nExpected: [%v]\nActual:   [%v]\n",
+					test.expectedError,
+					err,
+				)
+			}
+		})
+	}
+}
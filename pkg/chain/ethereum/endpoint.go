@@ -0,0 +1,297 @@
+package ethereum
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/rpc"
+)
+
+// defaultProbeTimeout is the time after which an endpoint not responding to
+// the latency probe is considered unhealthy.
+const defaultProbeTimeout = 5 * time.Second
+
+// defaultMaxBlockLag is the number of blocks an endpoint may stay behind
+// the most up-to-date of the probed endpoints before it is considered
+// unhealthy.
+const defaultMaxBlockLag = 5
+
+// endpointSwitchLatencyRatio is how many times faster than the selected
+// endpoint another healthy endpoint has to respond to be selected instead.
+// Switching endpoints makes the node resubscribe to events, so endpoints with
+// similar latencies should not take turns on each re-evaluation.
+const endpointSwitchLatencyRatio = 2
+
+// EndpointProbe measures the latency of the Ethereum endpoint with the given
+// URL and returns the number of the latest block the endpoint knows about.
+// It returns an error if the endpoint is not healthy.
+type EndpointProbe func(
+	ctx context.Context,
+	url string,
+) (time.Duration, uint64, error)
+
+// EndpointSelector selects the Ethereum endpoint with the lowest latency out
+// of the configured ones. Endpoints are probed at startup and can be
+// re-evaluated periodically or after the selected endpoint failed, so that
+// operators running in multiple regions use the closest healthy endpoint.
+// Endpoints lagging behind the most up-to-date one are considered unhealthy.
+type EndpointSelector struct {
+	urls        []string
+	probe       EndpointProbe
+	maxBlockLag uint64
+
+	mutex    sync.Mutex
+	selected string
+	// followers are called with the selected endpoint after each
+	// re-evaluation by the monitor.
+	followers []func(url string)
+
+	failures chan string
+}
+
+// NewEndpointSelector creates a selector of the given endpoints probing them
+// with JSON-RPC requests timing out after the given time. Endpoints more than
+// the given number of blocks behind the most up-to-date one are not selected.
+// If the timeout or the maximum block lag is zero, the default is used.
+func NewEndpointSelector(
+	urls []string,
+	timeout time.Duration,
+	maxBlockLag uint64,
+) *EndpointSelector {
+	if timeout == 0 {
+		timeout = defaultProbeTimeout
+	}
+
+	return newEndpointSelector(urls, rpcEndpointProbe(timeout), maxBlockLag)
+}
+
+func newEndpointSelector(
+	urls []string,
+	probe EndpointProbe,
+	maxBlockLag uint64,
+) *EndpointSelector {
+	if maxBlockLag == 0 {
+		maxBlockLag = defaultMaxBlockLag
+	}
+
+	return &EndpointSelector{
+		urls:        urls,
+		probe:       probe,
+		maxBlockLag: maxBlockLag,
+		failures:    make(chan string, 1),
+	}
+}
+
+// Select probes all endpoints and selects the healthy one with the lowest
+// latency. The selected endpoint is kept as long as it is healthy unless
+// another one responds considerably faster. It returns an error if none of
+// the endpoints is healthy.
+func (es *EndpointSelector) Select(ctx context.Context) (string, error) {
+	type probeResult struct {
+		url         string
+		latency     time.Duration
+		blockNumber uint64
+		err         error
+	}
+
+	results := make(chan *probeResult, len(es.urls))
+	for _, url := range es.urls {
+		go func(url string) {
+			latency, blockNumber, err := es.probe(ctx, url)
+			results <- &probeResult{url, latency, blockNumber, err}
+		}(url)
+	}
+
+	var healthy []*probeResult
+	var latestBlock uint64
+	for range es.urls {
+		result := <-results
+		if result.err != nil {
+			logger.Warningf(
+				"Ethereum endpoint [%v] is not healthy: [%v]",
+				result.url,
+				result.err,
+			)
+			continue
+		}
+
+		logger.Debugf(
+			"Ethereum endpoint [%v] responded in [%v] at block [%v]",
+			result.url,
+			result.latency,
+			result.blockNumber,
+		)
+
+		healthy = append(healthy, result)
+		if result.blockNumber > latestBlock {
+			latestBlock = result.blockNumber
+		}
+	}
+
+	es.mutex.Lock()
+	defer es.mutex.Unlock()
+
+	var fastest, selected *probeResult
+	for _, result := range healthy {
+		if lag := latestBlock - result.blockNumber; lag > es.maxBlockLag {
+			logger.Warningf(
+				"Ethereum endpoint [%v] is not healthy: "+
+					"it is [%v] blocks behind the latest block [%v]",
+				result.url,
+				lag,
+				latestBlock,
+			)
+			continue
+		}
+
+		if fastest == nil || result.latency < fastest.latency {
+			fastest = result
+		}
+		if result.url == es.selected {
+			selected = result
+		}
+	}
+
+	if fastest == nil {
+		return "", fmt.Errorf(
+			"none of [%v] configured Ethereum endpoints is healthy",
+			len(es.urls),
+		)
+	}
+
+	if selected != nil &&
+		fastest.latency*endpointSwitchLatencyRatio > selected.latency {
+		return selected.url, nil
+	}
+
+	if es.selected != fastest.url {
+		logger.Infof(
+			"selected Ethereum endpoint [%v] with latency [%v]",
+			fastest.url,
+			fastest.latency,
+		)
+	}
+	es.selected = fastest.url
+
+	return fastest.url, nil
+}
+
+// Selected returns the currently selected endpoint. It is empty if no
+// endpoint has been selected yet.
+func (es *EndpointSelector) Selected() string {
+	es.mutex.Lock()
+	defer es.mutex.Unlock()
+
+	return es.selected
+}
+
+// ReportFailure reports the failure of the given endpoint so that the
+// selection is re-evaluated by the monitor.
+func (es *EndpointSelector) ReportFailure(url string) {
+	select {
+	case es.failures <- url:
+	default:
+		// re-evaluation is already pending
+	}
+}
+
+// follow registers the given function to be called with the selected
+// endpoint after each re-evaluation by the monitor, whether the selection
+// changed or not.
+func (es *EndpointSelector) follow(handler func(url string)) {
+	es.mutex.Lock()
+	defer es.mutex.Unlock()
+
+	es.followers = append(es.followers, handler)
+}
+
+// Monitor re-evaluates the endpoint selection on each tick of the given
+// interval and each time an endpoint failure is reported, until the context
+// is done. The given function, if set, is called with the newly selected
+// endpoint each time the selection changes.
+func (es *EndpointSelector) Monitor(
+	ctx context.Context,
+	interval time.Duration,
+	onChange func(url string),
+) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ticker.C:
+		case failed := <-es.failures:
+			logger.Warningf(
+				"Ethereum endpoint [%v] failed; re-evaluating endpoints",
+				failed,
+			)
+		case <-ctx.Done():
+			return
+		}
+
+		previous := es.Selected()
+
+		selected, err := es.Select(ctx)
+		if err != nil {
+			logger.Errorf("could not re-evaluate Ethereum endpoints: [%v]", err)
+			continue
+		}
+
+		if selected != previous && onChange != nil {
+			onChange(selected)
+		}
+
+		es.mutex.Lock()
+		followers := make([]func(string), len(es.followers))
+		copy(followers, es.followers)
+		es.mutex.Unlock()
+
+		for _, follower := range followers {
+			follower(selected)
+		}
+	}
+}
+
+// ProbeEndpoint measures the time it takes the Ethereum endpoint with the
+// given URL to respond to the block number JSON-RPC request. It returns an
+// error if the endpoint is not healthy or does not respond within the given
+// timeout. If the timeout is zero, the default timeout is used.
+func ProbeEndpoint(
+	ctx context.Context,
+	url string,
+	timeout time.Duration,
+) (time.Duration, error) {
+	if timeout == 0 {
+		timeout = defaultProbeTimeout
+	}
+
+	latency, _, err := rpcEndpointProbe(timeout)(ctx, url)
+	return latency, err
+}
+
+// rpcEndpointProbe returns a probe measuring the time it takes the endpoint to
+// respond to the block number JSON-RPC request.
+func rpcEndpointProbe(timeout time.Duration) EndpointProbe {
+	return func(ctx context.Context, url string) (time.Duration, uint64, error) {
+		ctx, cancel := context.WithTimeout(ctx, timeout)
+		defer cancel()
+
+		start := time.Now()
+
+		client, err := rpc.DialContext(ctx, url)
+		if err != nil {
+			return 0, 0, err
+		}
+		defer client.Close()
+
+		var blockNumber hexutil.Uint64
+		if err := client.CallContext(ctx, &blockNumber, "eth_blockNumber"); err != nil {
+			return 0, 0, err
+		}
+
+		return time.Since(start), uint64(blockNumber), nil
+	}
+}
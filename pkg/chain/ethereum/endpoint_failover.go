@@ -0,0 +1,304 @@
+package ethereum
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+	"sync"
+
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/ethclient"
+	"github.com/ethereum/go-ethereum/rpc"
+)
+
+// endpointClient is a connection to a single Ethereum endpoint.
+type endpointClient interface {
+	bind.ContractBackend
+	rpcCaller
+	headerSource
+
+	TransactionReceipt(
+		ctx context.Context,
+		transactionHash common.Hash,
+	) (*types.Receipt, error)
+	ChainID(ctx context.Context) (*big.Int, error)
+	Close()
+}
+
+// rpcEndpointClient is a JSON-RPC connection to an Ethereum endpoint.
+type rpcEndpointClient struct {
+	*ethclient.Client
+	rpcClient *rpc.Client
+}
+
+func (rec *rpcEndpointClient) CallContext(
+	ctx context.Context,
+	result interface{},
+	method string,
+	args ...interface{},
+) error {
+	return rec.rpcClient.CallContext(ctx, result, method, args...)
+}
+
+func dialEndpoint(ctx context.Context, url string) (endpointClient, error) {
+	rpcClient, err := rpc.DialContext(ctx, url)
+	if err != nil {
+		return nil, err
+	}
+
+	return &rpcEndpointClient{ethclient.NewClient(rpcClient), rpcClient}, nil
+}
+
+// endpointBackend is a contract backend connected to one of the configured
+// Ethereum endpoints at a time. All calls are handled by the active endpoint
+// and its failures are reported so that another endpoint can be selected.
+// Switching to another endpoint closes the connection to the previous one,
+// so subscriptions made through the backend fail and are made again on the
+// new endpoint by their owners.
+type endpointBackend struct {
+	dial func(ctx context.Context, url string) (endpointClient, error)
+	// onFailure is called with the URL of the active endpoint each time it
+	// fails to handle a call.
+	onFailure func(url string)
+
+	chainID *big.Int
+
+	mutex  sync.RWMutex
+	url    string
+	client endpointClient
+}
+
+func newEndpointBackend(
+	ctx context.Context,
+	url string,
+	dial func(ctx context.Context, url string) (endpointClient, error),
+	onFailure func(url string),
+) (*endpointBackend, error) {
+	client, err := dial(ctx, url)
+	if err != nil {
+		return nil, fmt.Errorf(
+			"error connecting to Ethereum endpoint: %s [%v]",
+			url,
+			err,
+		)
+	}
+
+	chainID, err := client.ChainID(ctx)
+	if err != nil {
+		client.Close()
+		return nil, fmt.Errorf("failed to get Ethereum chain ID: [%v]", err)
+	}
+
+	return &endpointBackend{
+		dial:      dial,
+		onFailure: onFailure,
+		chainID:   chainID,
+		url:       url,
+		client:    client,
+	}, nil
+}
+
+// switchTo connects the backend to the endpoint with the given URL and closes
+// the connection to the previously active endpoint. Endpoints connected to
+// another chain than the one the backend has been connected to initially are
+// refused.
+func (eb *endpointBackend) switchTo(ctx context.Context, url string) error {
+	if activeURL, _ := eb.active(); activeURL == url {
+		return nil
+	}
+
+	client, err := eb.dial(ctx, url)
+	if err != nil {
+		return fmt.Errorf(
+			"error connecting to Ethereum endpoint: %s [%v]",
+			url,
+			err,
+		)
+	}
+
+	chainID, err := client.ChainID(ctx)
+	if err != nil {
+		client.Close()
+		return fmt.Errorf("failed to get Ethereum chain ID: [%v]", err)
+	}
+	if chainID.Cmp(eb.chainID) != 0 {
+		client.Close()
+		return fmt.Errorf(
+			"Ethereum endpoint [%v] is connected to chain [%v] "+
+				"instead of [%v]",
+			url,
+			chainID,
+			eb.chainID,
+		)
+	}
+
+	eb.mutex.Lock()
+	previousURL, previousClient := eb.url, eb.client
+	eb.url, eb.client = url, client
+	eb.mutex.Unlock()
+
+	previousClient.Close()
+
+	logger.Warningf(
+		"switched Ethereum endpoint from [%v] to [%v]",
+		previousURL,
+		url,
+	)
+
+	return nil
+}
+
+func (eb *endpointBackend) active() (string, endpointClient) {
+	eb.mutex.RLock()
+	defer eb.mutex.RUnlock()
+
+	return eb.url, eb.client
+}
+
+// check reports the failure of the endpoint with the given URL if the given
+// error has been caused by the endpoint and the endpoint is still the active
+// one. The error is returned as is.
+func (eb *endpointBackend) check(url string, err error) error {
+	if !isEndpointFailure(err) || eb.onFailure == nil {
+		return err
+	}
+
+	if activeURL, _ := eb.active(); activeURL == url {
+		eb.onFailure(url)
+	}
+
+	return err
+}
+
+// isEndpointFailure tells if the given error has been caused by the endpoint
+// failing to handle the call rather than by the call itself. Errors returned
+// by the endpoint in a JSON-RPC response, e.g. for a reverted call or
+// a rejected transaction, would be returned by any other endpoint as well.
+func isEndpointFailure(err error) bool {
+	if err == nil || err == ethereum.NotFound || err == context.Canceled {
+		return false
+	}
+
+	_, isResponseError := err.(rpc.Error)
+	return !isResponseError
+}
+
+func (eb *endpointBackend) CodeAt(
+	ctx context.Context,
+	contract common.Address,
+	blockNumber *big.Int,
+) ([]byte, error) {
+	url, client := eb.active()
+	code, err := client.CodeAt(ctx, contract, blockNumber)
+	return code, eb.check(url, err)
+}
+
+func (eb *endpointBackend) CallContract(
+	ctx context.Context,
+	call ethereum.CallMsg,
+	blockNumber *big.Int,
+) ([]byte, error) {
+	url, client := eb.active()
+	result, err := client.CallContract(ctx, call, blockNumber)
+	return result, eb.check(url, err)
+}
+
+func (eb *endpointBackend) PendingCodeAt(
+	ctx context.Context,
+	account common.Address,
+) ([]byte, error) {
+	url, client := eb.active()
+	code, err := client.PendingCodeAt(ctx, account)
+	return code, eb.check(url, err)
+}
+
+func (eb *endpointBackend) PendingNonceAt(
+	ctx context.Context,
+	account common.Address,
+) (uint64, error) {
+	url, client := eb.active()
+	nonce, err := client.PendingNonceAt(ctx, account)
+	return nonce, eb.check(url, err)
+}
+
+func (eb *endpointBackend) SuggestGasPrice(ctx context.Context) (*big.Int, error) {
+	url, client := eb.active()
+	gasPrice, err := client.SuggestGasPrice(ctx)
+	return gasPrice, eb.check(url, err)
+}
+
+func (eb *endpointBackend) EstimateGas(
+	ctx context.Context,
+	call ethereum.CallMsg,
+) (uint64, error) {
+	url, client := eb.active()
+	gas, err := client.EstimateGas(ctx, call)
+	return gas, eb.check(url, err)
+}
+
+func (eb *endpointBackend) SendTransaction(
+	ctx context.Context,
+	transaction *types.Transaction,
+) error {
+	url, client := eb.active()
+	return eb.check(url, client.SendTransaction(ctx, transaction))
+}
+
+func (eb *endpointBackend) FilterLogs(
+	ctx context.Context,
+	query ethereum.FilterQuery,
+) ([]types.Log, error) {
+	url, client := eb.active()
+	logs, err := client.FilterLogs(ctx, query)
+	return logs, eb.check(url, err)
+}
+
+func (eb *endpointBackend) SubscribeFilterLogs(
+	ctx context.Context,
+	query ethereum.FilterQuery,
+	logs chan<- types.Log,
+) (ethereum.Subscription, error) {
+	url, client := eb.active()
+	subscription, err := client.SubscribeFilterLogs(ctx, query, logs)
+	return subscription, eb.check(url, err)
+}
+
+func (eb *endpointBackend) TransactionReceipt(
+	ctx context.Context,
+	transactionHash common.Hash,
+) (*types.Receipt, error) {
+	url, client := eb.active()
+	receipt, err := client.TransactionReceipt(ctx, transactionHash)
+	return receipt, eb.check(url, err)
+}
+
+func (eb *endpointBackend) HeaderByNumber(
+	ctx context.Context,
+	number *big.Int,
+) (*types.Header, error) {
+	url, client := eb.active()
+	header, err := client.HeaderByNumber(ctx, number)
+	return header, eb.check(url, err)
+}
+
+func (eb *endpointBackend) SubscribeNewHead(
+	ctx context.Context,
+	headers chan<- *types.Header,
+) (ethereum.Subscription, error) {
+	url, client := eb.active()
+	subscription, err := client.SubscribeNewHead(ctx, headers)
+	return subscription, eb.check(url, err)
+}
+
+func (eb *endpointBackend) CallContext(
+	ctx context.Context,
+	result interface{},
+	method string,
+	args ...interface{},
+) error {
+	url, client := eb.active()
+	return eb.check(url, client.CallContext(ctx, result, method, args...))
+}
@@ -0,0 +1,186 @@
+package ethereum
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+	"reflect"
+	"sync"
+	"testing"
+
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/rpc"
+)
+
+// testEndpointClient is an endpoint client connected to the chain with
+// the given ID, returning the given error from the pending nonce calls.
+type testEndpointClient struct {
+	endpointClient
+
+	chainID  *big.Int
+	nonceErr error
+
+	mutex  sync.Mutex
+	closed bool
+}
+
+func (tec *testEndpointClient) ChainID(ctx context.Context) (*big.Int, error) {
+	return tec.chainID, nil
+}
+
+func (tec *testEndpointClient) PendingNonceAt(
+	ctx context.Context,
+	account common.Address,
+) (uint64, error) {
+	return 0, tec.nonceErr
+}
+
+func (tec *testEndpointClient) Close() {
+	tec.mutex.Lock()
+	defer tec.mutex.Unlock()
+
+	tec.closed = true
+}
+
+func (tec *testEndpointClient) isClosed() bool {
+	tec.mutex.Lock()
+	defer tec.mutex.Unlock()
+
+	return tec.closed
+}
+
+type testResponseError struct{}
+
+func (tre *testResponseError) Error() string  { return "nonce too low" }
+func (tre *testResponseError) ErrorCode() int { return -32000 }
+
+func TestEndpointBackendSwitchTo(t *testing.T) {
+	clients := map[string]*testEndpointClient{
+		"ws://first":  {chainID: big.NewInt(1)},
+		"ws://second": {chainID: big.NewInt(1)},
+		"ws://other":  {chainID: big.NewInt(3)},
+	}
+	dial := func(ctx context.Context, url string) (endpointClient, error) {
+		return clients[url], nil
+	}
+
+	backend, err := newEndpointBackend(
+		context.Background(),
+		"ws://first",
+		dial,
+		nil,
+	)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	err = backend.switchTo(context.Background(), "ws://other")
+	expectedErr := fmt.Errorf(
+		"Ethereum endpoint [ws://other] is connected to chain [3] " +
+			"instead of [1]",
+	)
+	if !reflect.DeepEqual(expectedErr, err) {
+		t.Errorf(
+			"unexpected error\nexpected: %v\nactual:   %v\n",
+			expectedErr,
+			err,
+		)
+	}
+	if !clients["ws://other"].isClosed() {
+		t.Errorf("endpoint connected to another chain should be closed")
+	}
+
+	if err := backend.switchTo(context.Background(), "ws://second"); err != nil {
+		t.Fatal(err)
+	}
+
+	url, client := backend.active()
+	if url != "ws://second" || client != clients["ws://second"] {
+		t.Errorf(
+			"unexpected active endpoint\nexpected: %v\nactual:   %v\n",
+			"ws://second",
+			url,
+		)
+	}
+	if !clients["ws://first"].isClosed() {
+		t.Errorf("previously active endpoint should be closed")
+	}
+	if clients["ws://second"].isClosed() {
+		t.Errorf("active endpoint should not be closed")
+	}
+}
+
+func TestEndpointBackendReportsFailures(t *testing.T) {
+	var tests = map[string]struct {
+		nonceErr        error
+		expectedFailure bool
+	}{
+		"no error": {
+			expectedFailure: false,
+		},
+		"connection error": {
+			nonceErr:        fmt.Errorf("connection refused"),
+			expectedFailure: true,
+		},
+		"client closed": {
+			nonceErr:        rpc.ErrClientQuit,
+			expectedFailure: true,
+		},
+		"error response": {
+			nonceErr:        &testResponseError{},
+			expectedFailure: false,
+		},
+		"not found": {
+			nonceErr:        ethereum.NotFound,
+			expectedFailure: false,
+		},
+		"call canceled": {
+			nonceErr:        context.Canceled,
+			expectedFailure: false,
+		},
+	}
+
+	for testName, test := range tests {
+		t.Run(testName, func(t *testing.T) {
+			client := &testEndpointClient{
+				chainID:  big.NewInt(1),
+				nonceErr: test.nonceErr,
+			}
+
+			var failures []string
+			backend, err := newEndpointBackend(
+				context.Background(),
+				"ws://first",
+				func(ctx context.Context, url string) (endpointClient, error) {
+					return client, nil
+				},
+				func(url string) { failures = append(failures, url) },
+			)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			_, err = backend.PendingNonceAt(context.Background(), common.Address{})
+			if err != test.nonceErr {
+				t.Errorf(
+					"unexpected error\nexpected: %v\nactual:   %v\n",
+					test.nonceErr,
+					err,
+				)
+			}
+
+			var expectedFailures []string
+			if test.expectedFailure {
+				expectedFailures = []string{"ws://first"}
+			}
+			if !reflect.DeepEqual(expectedFailures, failures) {
+				t.Errorf(
+					"unexpected failures\nexpected: %v\nactual:   %v\n",
+					expectedFailures,
+					failures,
+				)
+			}
+		})
+	}
+}
@@ -0,0 +1,332 @@
+package ethereum
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"sync"
+	"testing"
+	"time"
+)
+
+func newMockEndpoint(latency time.Duration, healthy *bool) *httptest.Server {
+	return httptest.NewServer(http.HandlerFunc(
+		func(w http.ResponseWriter, r *http.Request) {
+			if healthy != nil && !*healthy {
+				w.WriteHeader(http.StatusServiceUnavailable)
+				return
+			}
+
+			time.Sleep(latency)
+			w.Header().Set("Content-Type", "application/json")
+			fmt.Fprint(w, `{"jsonrpc":"2.0","id":1,"result":"0x10"}`)
+		},
+	))
+}
+
+func TestEndpointSelectorSelectsFastest(t *testing.T) {
+	slow := newMockEndpoint(300*time.Millisecond, nil)
+	defer slow.Close()
+	fast := newMockEndpoint(10*time.Millisecond, nil)
+	defer fast.Close()
+	medium := newMockEndpoint(150*time.Millisecond, nil)
+	defer medium.Close()
+
+	unhealthy := false
+	broken := newMockEndpoint(0, &unhealthy)
+	defer broken.Close()
+
+	selector := NewEndpointSelector(
+		[]string{slow.URL, broken.URL, fast.URL, medium.URL},
+		time.Second,
+		0,
+	)
+
+	selected, err := selector.Select(context.Background())
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if selected != fast.URL {
+		t.Errorf(
+			"unexpected selected endpoint\nexpected: %v\nactual:   %v\n",
+			fast.URL,
+			selected,
+		)
+	}
+	if selector.Selected() != fast.URL {
+		t.Errorf(
+			"unexpected current endpoint\nexpected: %v\nactual:   %v\n",
+			fast.URL,
+			selector.Selected(),
+		)
+	}
+}
+
+func TestEndpointSelectorNoHealthyEndpoint(t *testing.T) {
+	unhealthy := false
+	broken := newMockEndpoint(0, &unhealthy)
+	defer broken.Close()
+
+	selector := NewEndpointSelector([]string{broken.URL}, time.Second, 0)
+
+	_, err := selector.Select(context.Background())
+
+	expectedErr := fmt.Errorf("none of [1] configured Ethereum endpoints is healthy")
+	if !reflect.DeepEqual(expectedErr, err) {
+		t.Errorf(
+			"unexpected error\nexpected: %v\nactual:   %v\n",
+			expectedErr,
+			err,
+		)
+	}
+}
+
+func TestProbeEndpoint(t *testing.T) {
+	endpoint := newMockEndpoint(50*time.Millisecond, nil)
+	defer endpoint.Close()
+
+	unhealthy := false
+	broken := newMockEndpoint(0, &unhealthy)
+	defer broken.Close()
+
+	latency, err := ProbeEndpoint(context.Background(), endpoint.URL, time.Second)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if latency < 50*time.Millisecond {
+		t.Errorf("unexpected latency: [%v]", latency)
+	}
+
+	if _, err := ProbeEndpoint(
+		context.Background(),
+		broken.URL,
+		time.Second,
+	); err == nil {
+		t.Errorf("expected probe of unhealthy endpoint to fail")
+	}
+}
+
+func TestEndpointSelectorReselectsOnFailure(t *testing.T) {
+	mutex := &sync.Mutex{}
+	latencies := map[string]time.Duration{
+		"ws://fast": 10 * time.Millisecond,
+		"ws://slow": 100 * time.Millisecond,
+	}
+	failed := map[string]bool{}
+
+	probe := func(
+		ctx context.Context,
+		url string,
+	) (time.Duration, uint64, error) {
+		mutex.Lock()
+		defer mutex.Unlock()
+
+		if failed[url] {
+			return 0, 0, fmt.Errorf("connection refused")
+		}
+		return latencies[url], 100, nil
+	}
+
+	selector := newEndpointSelector([]string{"ws://slow", "ws://fast"}, probe, 0)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	selected, err := selector.Select(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if selected != "ws://fast" {
+		t.Fatalf(
+			"unexpected selected endpoint\nexpected: %v\nactual:   %v\n",
+			"ws://fast",
+			selected,
+		)
+	}
+
+	changes := make(chan string, 1)
+	go selector.Monitor(ctx, time.Hour, func(url string) { changes <- url })
+
+	mutex.Lock()
+	failed["ws://fast"] = true
+	mutex.Unlock()
+
+	selector.ReportFailure("ws://fast")
+
+	select {
+	case changed := <-changes:
+		if changed != "ws://slow" {
+			t.Errorf(
+				"unexpected reselected endpoint\nexpected: %v\nactual:   %v\n",
+				"ws://slow",
+				changed,
+			)
+		}
+	case <-time.After(5 * time.Second):
+		t.Fatal("endpoint has not been reselected after failure")
+	}
+
+	if selector.Selected() != "ws://slow" {
+		t.Errorf(
+			"unexpected current endpoint\nexpected: %v\nactual:   %v\n",
+			"ws://slow",
+			selector.Selected(),
+		)
+	}
+}
+
+func TestEndpointSelectorSkipsLaggingEndpoint(t *testing.T) {
+	probe := func(
+		ctx context.Context,
+		url string,
+	) (time.Duration, uint64, error) {
+		switch url {
+		case "ws://lagging":
+			return 10 * time.Millisecond, 94, nil
+		case "ws://behind":
+			return 50 * time.Millisecond, 95, nil
+		default:
+			return 100 * time.Millisecond, 100, nil
+		}
+	}
+
+	var tests = map[string]struct {
+		maxBlockLag      uint64
+		expectedSelected string
+	}{
+		"default maximum lag": {
+			expectedSelected: "ws://behind",
+		},
+		"custom maximum lag": {
+			maxBlockLag:      3,
+			expectedSelected: "ws://latest",
+		},
+	}
+
+	for testName, test := range tests {
+		t.Run(testName, func(t *testing.T) {
+			selector := newEndpointSelector(
+				[]string{"ws://lagging", "ws://behind", "ws://latest"},
+				probe,
+				test.maxBlockLag,
+			)
+
+			selected, err := selector.Select(context.Background())
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			if selected != test.expectedSelected {
+				t.Errorf(
+					"unexpected selected endpoint\nexpected: %v\nactual:   %v\n",
+					test.expectedSelected,
+					selected,
+				)
+			}
+		})
+	}
+}
+
+func TestEndpointSelectorKeepsSelectedEndpoint(t *testing.T) {
+	mutex := &sync.Mutex{}
+	latencies := map[string]time.Duration{
+		"ws://first":  20 * time.Millisecond,
+		"ws://second": 30 * time.Millisecond,
+	}
+
+	probe := func(
+		ctx context.Context,
+		url string,
+	) (time.Duration, uint64, error) {
+		mutex.Lock()
+		defer mutex.Unlock()
+
+		return latencies[url], 100, nil
+	}
+
+	selector := newEndpointSelector(
+		[]string{"ws://first", "ws://second"},
+		probe,
+		0,
+	)
+
+	if _, err := selector.Select(context.Background()); err != nil {
+		t.Fatal(err)
+	}
+
+	mutex.Lock()
+	latencies["ws://second"] = 15 * time.Millisecond
+	mutex.Unlock()
+
+	selected, err := selector.Select(context.Background())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if selected != "ws://first" {
+		t.Errorf(
+			"unexpected selected endpoint\nexpected: %v\nactual:   %v\n",
+			"ws://first",
+			selected,
+		)
+	}
+
+	mutex.Lock()
+	latencies["ws://second"] = 5 * time.Millisecond
+	mutex.Unlock()
+
+	selected, err = selector.Select(context.Background())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if selected != "ws://second" {
+		t.Errorf(
+			"unexpected selected endpoint\nexpected: %v\nactual:   %v\n",
+			"ws://second",
+			selected,
+		)
+	}
+}
+
+func TestEndpointSelectorNotifiesFollowers(t *testing.T) {
+	probe := func(
+		ctx context.Context,
+		url string,
+	) (time.Duration, uint64, error) {
+		return 10 * time.Millisecond, 100, nil
+	}
+
+	selector := newEndpointSelector([]string{"ws://only"}, probe, 0)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	if _, err := selector.Select(ctx); err != nil {
+		t.Fatal(err)
+	}
+
+	followed := make(chan string, 1)
+	selector.follow(func(url string) {
+		select {
+		case followed <- url:
+		default:
+		}
+	})
+
+	go selector.Monitor(ctx, 10*time.Millisecond, nil)
+
+	select {
+	case url := <-followed:
+		if url != "ws://only" {
+			t.Errorf(
+				"unexpected followed endpoint\nexpected: %v\nactual:   %v\n",
+				"ws://only",
+				url,
+			)
+		}
+	case <-time.After(5 * time.Second):
+		t.Fatal("follower has not been notified about unchanged selection")
+	}
+}
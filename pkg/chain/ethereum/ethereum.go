@@ -1,13 +1,17 @@
 package ethereum
 
 import (
+	"context"
 	"fmt"
 	"math/big"
+	"sort"
 	"time"
 
 	"github.com/ipfs/go-log"
 
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
 	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
 	"github.com/ethereum/go-ethereum/crypto"
 	"github.com/keep-network/keep-common/pkg/chain/ethereum/ethutil"
 	"github.com/keep-network/keep-core/pkg/beacon/relay/chain"
@@ -30,7 +34,17 @@ func (ec *ethereumChain) GetKeys() (*operator.PrivateKey, *operator.PublicKey) {
 	return operator.EthereumKeyToOperatorKey(ec.accountKey)
 }
 
+// configCacheTTL is the time after which the relay config cached from the
+// chain is considered stale and fetched again.
+const configCacheTTL = 30 * time.Second
+
+// GetConfig returns the relay config cached from the chain. The config is
+// fetched from the chain again once the cached one becomes stale.
 func (ec *ethereumChain) GetConfig() (*relayconfig.Chain, error) {
+	return ec.configCache.Get()
+}
+
+func (ec *ethereumChain) fetchConfig() (*relayconfig.Chain, error) {
 	groupSize, err := ec.keepRandomBeaconOperatorContract.GroupSize()
 	if err != nil {
 		return nil, fmt.Errorf("error calling GroupSize: [%v]", err)
@@ -261,85 +275,98 @@ func (ec *ethereumChain) SubmitRelayEntry(
 func (ec *ethereumChain) OnRelayEntrySubmitted(
 	handle func(entry *event.EntrySubmitted),
 ) (subscription.EventSubscription, error) {
-	return ec.keepRandomBeaconOperatorContract.WatchRelayEntrySubmitted(
-		func(blockNumber uint64) {
+	return ec.watchLogs(
+		"RelayEntrySubmitted",
+		func(log types.Log) {
 			handle(&event.EntrySubmitted{
-				BlockNumber: blockNumber,
+				BlockNumber: log.BlockNumber,
 			})
 		},
-		func(err error) error {
-			return fmt.Errorf(
-				"watch relay entry generated failed with [%v]",
-				err,
-			)
-		},
 	)
 }
 
 func (ec *ethereumChain) OnRelayEntryRequested(
 	handle func(request *event.Request),
 ) (subscription.EventSubscription, error) {
-	return ec.keepRandomBeaconOperatorContract.WatchRelayEntryRequested(
-		func(
-			previousEntry []byte,
-			groupPublicKey []byte,
-			blockNumber uint64,
-		) {
+	filterer, err := ec.operatorFilterer()
+	if err != nil {
+		return nil, err
+	}
+
+	return ec.watchLogs(
+		"RelayEntryRequested",
+		func(log types.Log) {
+			requested, err := filterer.ParseRelayEntryRequested(log)
+			if err != nil {
+				logger.Errorf(
+					"could not parse relay entry requested event: [%v]",
+					err,
+				)
+				return
+			}
+
 			handle(&event.Request{
-				PreviousEntry:  previousEntry,
-				GroupPublicKey: groupPublicKey,
-				BlockNumber:    blockNumber,
+				PreviousEntry:  requested.PreviousEntry,
+				GroupPublicKey: requested.GroupPublicKey,
+				BlockNumber:    log.BlockNumber,
 			})
 		},
-		func(err error) error {
-			return fmt.Errorf(
-				"watch relay entry requested failed with [%v]",
-				err,
-			)
-		},
 	)
 }
 
 func (ec *ethereumChain) OnGroupSelectionStarted(
 	handle func(groupSelectionStart *event.GroupSelectionStart),
 ) (subscription.EventSubscription, error) {
-	return ec.keepRandomBeaconOperatorContract.WatchGroupSelectionStarted(
-		func(
-			newEntry *big.Int,
-			blockNumber uint64,
-		) {
+	filterer, err := ec.operatorFilterer()
+	if err != nil {
+		return nil, err
+	}
+
+	return ec.watchLogs(
+		"GroupSelectionStarted",
+		func(log types.Log) {
+			started, err := filterer.ParseGroupSelectionStarted(log)
+			if err != nil {
+				logger.Errorf(
+					"could not parse group selection started event: [%v]",
+					err,
+				)
+				return
+			}
+
 			handle(&event.GroupSelectionStart{
-				NewEntry:    newEntry,
-				BlockNumber: blockNumber,
+				NewEntry:    started.NewEntry,
+				BlockNumber: log.BlockNumber,
 			})
 		},
-		func(err error) error {
-			return fmt.Errorf(
-				"watch group selection started failed with [%v]",
-				err,
-			)
-		},
 	)
 }
 
 func (ec *ethereumChain) OnGroupRegistered(
 	handle func(groupRegistration *event.GroupRegistration),
 ) (subscription.EventSubscription, error) {
-	return ec.keepRandomBeaconOperatorContract.WatchDkgResultSubmittedEvent(
-		func(
-			memberIndex *big.Int,
-			groupPublicKey []byte,
-			misbehaved []byte,
-			blockNumber uint64,
-		) {
+	filterer, err := ec.operatorFilterer()
+	if err != nil {
+		return nil, err
+	}
+
+	return ec.watchLogs(
+		"DkgResultSubmittedEvent",
+		func(log types.Log) {
+			submitted, err := filterer.ParseDkgResultSubmittedEvent(log)
+			if err != nil {
+				logger.Errorf(
+					"could not parse DKG result submitted event: [%v]",
+					err,
+				)
+				return
+			}
+
 			handle(&event.GroupRegistration{
-				GroupPublicKey: groupPublicKey,
-				BlockNumber:    blockNumber,
+				GroupPublicKey: submitted.GroupPubKey,
+				BlockNumber:    log.BlockNumber,
 			})
 		},
-		func(err error) error {
-			return fmt.Errorf("entry of group key failed with: [%v]", err)
-		},
 	)
 }
 
@@ -347,6 +374,10 @@ func (ec *ethereumChain) IsGroupRegistered(groupPublicKey []byte) (bool, error)
 	return ec.keepRandomBeaconOperatorContract.IsGroupRegistered(groupPublicKey)
 }
 
+func (ec *ethereumChain) IsGroupSelectionPossible() (bool, error) {
+	return ec.keepRandomBeaconOperatorContract.IsGroupSelectionPossible()
+}
+
 func (ec *ethereumChain) IsStaleGroup(groupPublicKey []byte) (bool, error) {
 	return ec.keepRandomBeaconOperatorContract.IsStaleGroup(groupPublicKey)
 }
@@ -370,28 +401,56 @@ func (ec *ethereumChain) GetGroupMembers(groupPublicKey []byte) (
 	return stakerAddresses, nil
 }
 
+// dkgResultSubmissionHistoryCapacity is the number of recently seen DKG result
+// submission events remembered by each subscription to detect duplicates.
+const dkgResultSubmissionHistoryCapacity = 100
+
+// OnDKGResultSubmitted registers a callback invoked when a DKG result
+// submission event is seen. Events delivered more than once to the
+// subscription, e.g. after it has been renewed, are passed to the handler
+// only the first time.
 func (ec *ethereumChain) OnDKGResultSubmitted(
 	handler func(dkgResultPublication *event.DKGResultSubmission),
 ) (subscription.EventSubscription, error) {
-	return ec.keepRandomBeaconOperatorContract.WatchDkgResultSubmittedEvent(
-		func(
-			memberIndex *big.Int,
-			groupPublicKey []byte,
-			misbehaved []byte,
-			blockNumber uint64,
-		) {
-			handler(&event.DKGResultSubmission{
-				MemberIndex:    uint32(memberIndex.Uint64()),
-				GroupPublicKey: groupPublicKey,
-				Misbehaved:     misbehaved,
-				BlockNumber:    blockNumber,
-			})
-		},
-		func(err error) error {
-			return fmt.Errorf(
-				"watch DKG result published failed with: [%v]",
-				err,
-			)
+	history := event.NewDKGResultSubmissionHistory(
+		dkgResultSubmissionHistoryCapacity,
+	)
+
+	filterer, err := ec.operatorFilterer()
+	if err != nil {
+		return nil, err
+	}
+
+	return ec.watchLogs(
+		"DkgResultSubmittedEvent",
+		func(log types.Log) {
+			submitted, err := filterer.ParseDkgResultSubmittedEvent(log)
+			if err != nil {
+				logger.Errorf(
+					"could not parse DKG result submitted event: [%v]",
+					err,
+				)
+				return
+			}
+
+			submission := &event.DKGResultSubmission{
+				MemberIndex:    uint32(submitted.MemberIndex.Uint64()),
+				GroupPublicKey: submitted.GroupPubKey,
+				Misbehaved:     submitted.Misbehaved,
+				BlockNumber:    log.BlockNumber,
+			}
+
+			if !history.Add(submission) {
+				logger.Debugf(
+					"ignoring duplicated DKG result submission event for "+
+						"group public key [0x%x] at block [%v]",
+					submission.GroupPublicKey,
+					submission.BlockNumber,
+				)
+				return
+			}
+
+			handler(submission)
 		},
 	)
 }
@@ -424,6 +483,15 @@ func (ec *ethereumChain) SubmitDKGResult(
 	}
 
 	publishedResult := make(chan *event.DKGResultSubmission)
+	// Receives the hash of the submission transaction once it is sent; closed
+	// without a value if the transaction could not be sent.
+	transactionHash := make(chan string, 1)
+	// Receives the error if the submission transaction has been mined but
+	// reverted, in which case no submission event is emitted for it.
+	transactionFailure := make(chan error, 1)
+	// Cancelled once the submission event has been observed so that the
+	// receipt of the submission transaction is no longer awaited.
+	receiptCtx, cancelReceipt := context.WithCancel(context.Background())
 
 	subscription, err := ec.OnDKGResultSubmitted(
 		func(onChainEvent *event.DKGResultSubmission) {
@@ -431,14 +499,21 @@ func (ec *ethereumChain) SubmitDKGResult(
 		},
 	)
 	if err != nil {
+		cancelReceipt()
 		close(publishedResult)
 		failPromise(err)
 		return resultPublicationPromise
 	}
 
 	go func() {
+		defer cancelReceipt()
+
 		for {
 			select {
+			case err := <-transactionFailure:
+				subscription.Unsubscribe()
+				failPromise(err)
+				return
 			case event, success := <-publishedResult:
 				// Channel is closed when SubmitDKGResult failed.
 				// When this happens, event is nil.
@@ -449,6 +524,14 @@ func (ec *ethereumChain) SubmitDKGResult(
 				subscription.Unsubscribe()
 				close(publishedResult)
 
+				// The event is emitted for the submission by any member;
+				// only our own submission is attributed our transaction.
+				if event.MemberIndex == uint32(participantIndex) {
+					submission := *event
+					submission.TransactionHash = <-transactionHash
+					event = &submission
+				}
+
 				err := resultPublicationPromise.Fulfill(event)
 				if err != nil {
 					logger.Errorf(
@@ -466,25 +549,133 @@ func (ec *ethereumChain) SubmitDKGResult(
 		convertSignaturesToChainFormat(signatures)
 	if err != nil {
 		close(publishedResult)
+		close(transactionHash)
 		failPromise(fmt.Errorf("converting signatures failed [%v]", err))
 		return resultPublicationPromise
 	}
 
-	if _, err = ec.keepRandomBeaconOperatorContract.SubmitDkgResult(
+	transaction, err := ec.dkgResultSubmitterContract.SubmitDkgResult(
 		big.NewInt(int64(participantIndex)),
 		result.GroupPublicKey,
 		result.Misbehaved,
 		signaturesOnChainFormat,
 		membersIndicesOnChainFormat,
-	); err != nil {
+	)
+	if err != nil {
 		subscription.Unsubscribe()
 		close(publishedResult)
+		close(transactionHash)
 		failPromise(err)
+		return resultPublicationPromise
 	}
 
+	logger.Infof(
+		"submitted DKG result with group public key [0x%x] in transaction [%v]",
+		result.GroupPublicKey,
+		transaction.Hash().Hex(),
+	)
+	transactionHash <- transaction.Hash().Hex()
+
+	go ec.watchDKGResultSubmissionReceipt(
+		receiptCtx,
+		transaction,
+		transactionFailure,
+	)
+
 	return resultPublicationPromise
 }
 
+// watchDKGResultSubmissionReceipt waits until the given DKG result submission
+// transaction is mined and reports an error on the given channel if the
+// transaction reverted. It stops waiting once the context is done.
+func (ec *ethereumChain) watchDKGResultSubmissionReceipt(
+	ctx context.Context,
+	transaction *types.Transaction,
+	transactionFailure chan<- error,
+) {
+	receipt, err := bind.WaitMined(ctx, ec.receiptBackend, transaction)
+	if err != nil {
+		return
+	}
+
+	if receipt.Status != types.ReceiptStatusSuccessful {
+		logger.Errorf(
+			"DKG result submission transaction [%v] reverted",
+			transaction.Hash().Hex(),
+		)
+		transactionFailure <- fmt.Errorf(
+			"DKG result submission transaction [%v] reverted",
+			transaction.Hash().Hex(),
+		)
+	}
+}
+
+// EstimateDKGResultSubmissionGas estimates the gas cost of submitting the
+// given DKG result with supporting signatures in the name of the given
+// member. The estimation executes the submission against the current chain
+// state so it fails if the contract rejects the result.
+func (ec *ethereumChain) EstimateDKGResultSubmissionGas(
+	participantIndex chain.GroupMemberIndex,
+	result *relaychain.DKGResult,
+	signatures map[chain.GroupMemberIndex][]byte,
+) (uint64, error) {
+	membersIndicesOnChainFormat, signaturesOnChainFormat, err :=
+		convertSignaturesToChainFormat(signatures)
+	if err != nil {
+		return 0, fmt.Errorf("converting signatures failed [%v]", err)
+	}
+
+	return ec.dkgResultSubmitterContract.SubmitDkgResultGasEstimate(
+		big.NewInt(int64(participantIndex)),
+		result.GroupPublicKey,
+		result.Misbehaved,
+		signaturesOnChainFormat,
+		membersIndicesOnChainFormat,
+	)
+}
+
+// IsTransactionSuccessful returns true if the transaction with the given hash
+// has been mined and its receipt reports successful execution. It returns an
+// error if the receipt could not be retrieved, for example, because the
+// transaction has not been mined yet.
+func (ec *ethereumChain) IsTransactionSuccessful(
+	transactionHash string,
+) (bool, error) {
+	receipt, err := ec.receiptBackend.TransactionReceipt(
+		context.Background(),
+		common.HexToHash(transactionHash),
+	)
+	if err != nil {
+		return false, fmt.Errorf(
+			"could not get receipt of transaction [%v]: [%v]",
+			transactionHash,
+			err,
+		)
+	}
+
+	return receipt.Status == types.ReceiptStatusSuccessful, nil
+}
+
+// TransactionGasUsed returns the amount of gas used by the mined transaction
+// with the given hash.
+func (ec *ethereumChain) TransactionGasUsed(
+	transactionHash string,
+) (uint64, error) {
+	receipt, err := ec.receiptBackend.TransactionReceipt(
+		context.Background(),
+		common.HexToHash(transactionHash),
+	)
+	if err != nil {
+		return 0, fmt.Errorf(
+			"could not get receipt of transaction [%v]: [%v]",
+			transactionHash,
+			err,
+		)
+	}
+
+	return receipt.GasUsed, nil
+}
+
 // convertSignaturesToChainFormat converts signatures map to two slices. First
 // slice contains indices of members from the map, second slice is a slice of
 // concatenated signatures. Signatures and member indices are returned in the
@@ -495,7 +686,18 @@ func convertSignaturesToChainFormat(
 	var membersIndices []*big.Int
 	var signaturesSlice []byte
 
-	for memberIndex, signature := range signatures {
+	// Signatures are ordered by member index so that the encoding of the
+	// same signatures is always the same.
+	memberIndexes := make([]chain.GroupMemberIndex, 0, len(signatures))
+	for memberIndex := range signatures {
+		memberIndexes = append(memberIndexes, memberIndex)
+	}
+	sort.Slice(memberIndexes, func(i, j int) bool {
+		return memberIndexes[i] < memberIndexes[j]
+	})
+
+	for _, memberIndex := range memberIndexes {
+		signature := signatures[memberIndex]
 		if len(signatures[memberIndex]) != SignatureSize {
 			return nil, nil, fmt.Errorf(
 				"invalid signature size for member [%v] got [%d]-bytes but required [%d]-bytes",
@@ -514,15 +716,21 @@ func convertSignaturesToChainFormat(
 // CalculateDKGResultHash calculates Keccak-256 hash of the DKG result. Operation
 // is performed off-chain.
 //
-// It first encodes the result using solidity ABI and then calculates Keccak-256
-// hash over it. This corresponds to the DKG result hash calculation on-chain.
+// It first encodes the chain ID and the result using solidity ABI and then
+// calculates Keccak-256 hash over it. The chain ID separates hashes of results
+// submitted to different chains so that result signatures can not be replayed
+// across chains. This corresponds to the DKG result hash calculation on-chain.
 // Hashes calculated off-chain and on-chain must always match.
 func (ec *ethereumChain) CalculateDKGResultHash(
 	dkgResult *relaychain.DKGResult,
 ) (relaychain.DKGResultHash, error) {
 
 	// Encode DKG result to the format matched with Solidity keccak256(abi.encodePacked(...))
-	hash := crypto.Keccak256(dkgResult.GroupPublicKey, dkgResult.Misbehaved)
+	hash := crypto.Keccak256(
+		common.LeftPadBytes(ec.chainID.Bytes(), 32),
+		dkgResult.GroupPublicKey,
+		dkgResult.Misbehaved,
+	)
 
 	return relaychain.DKGResultHashFromBytes(hash)
 }
@@ -2,13 +2,20 @@ package ethereum
 
 import (
 	"bytes"
+	"context"
 	"encoding/hex"
 	"fmt"
 	"math/big"
 	"reflect"
 	"testing"
+	"time"
 
+	"github.com/ethereum/go-ethereum/accounts/abi/bind/backends"
+	"github.com/ethereum/go-ethereum/accounts/keystore"
 	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
 	"github.com/keep-network/keep-core/pkg/beacon/relay/chain"
 	relaychain "github.com/keep-network/keep-core/pkg/beacon/relay/chain"
 )
@@ -16,34 +23,44 @@ import (
 // TestCalculateDKGResultHash validates if calculated DKG result hash matches
 // expected one.
 //
-// Expected hashes have been calculated on-chain with:
-// `keccak256(abi.encode(groupPubKey, misbehaved))`
+// Expected hashes correspond to the on-chain calculation:
+// `keccak256(abi.encodePacked(chainId, groupPubKey, misbehaved))`
 func TestCalculateDKGResultHash(t *testing.T) {
-	chain := &ethereumChain{}
-
 	var tests = map[string]struct {
+		chainID      int64
 		dkgResult    *relaychain.DKGResult
 		expectedHash string
 	}{
 
 		"dkg result with no misbehaving members": {
+			chainID: 1,
 			dkgResult: &relaychain.DKGResult{
 				GroupPublicKey: []byte{0x64},
 				Misbehaved:     []byte{},
 			},
-			expectedHash: "f1918e8562236eb17adc8502332f4c9c82bc14e19bfc0aa10ab674ff75b3d2f3",
+			expectedHash: "5a949d324b97e4dd66e2f0ff2dc71ed97af43207dfaa841cf61c182357d9e35f",
 		},
 		"dkg result with misbehaving members": {
+			chainID: 1,
 			dkgResult: &relaychain.DKGResult{
 				GroupPublicKey: []byte{0x64},
 				Misbehaved:     []byte{0x03, 0x05},
 			},
-			expectedHash: "9b84bec611298ebcd371abd418e5716f511d7ff3f086cc574a84afe01afb02ec",
+			expectedHash: "f6f2898bd8ae34d611e1eb86afc8bb0ba9a5acfaf94a3f3da9ffa1db66698d3c",
+		},
+		"dkg result with misbehaving members on another chain": {
+			chainID: 3,
+			dkgResult: &relaychain.DKGResult{
+				GroupPublicKey: []byte{0x64},
+				Misbehaved:     []byte{0x03, 0x05},
+			},
+			expectedHash: "8a70e41659f39958a9ff9cc85b102fe7ebe6f2b6b78913ff5b20311d817d7044",
 		},
 	}
 
 	for testName, test := range tests {
 		t.Run(testName, func(t *testing.T) {
+			chain := &ethereumChain{chainID: big.NewInt(test.chainID)}
 			expectedHash := common.Hex2Bytes(test.expectedHash)
 
 			actualHash, err := chain.CalculateDKGResultHash(test.dkgResult)
@@ -211,3 +228,97 @@ func TestPackTicket(t *testing.T) {
 		})
 	}
 }
+
+func TestWatchDKGResultSubmissionReceipt(t *testing.T) {
+	var tests = map[string]struct {
+		contractCode    []byte
+		expectedFailure bool
+	}{
+		"transaction succeeded": {
+			contractCode:    []byte{0x00}, // STOP
+			expectedFailure: false,
+		},
+		"transaction reverted": {
+			// PUSH1 0, PUSH1 0, REVERT
+			contractCode:    []byte{0x60, 0x00, 0x60, 0x00, 0xfd},
+			expectedFailure: true,
+		},
+	}
+
+	for testName, test := range tests {
+		t.Run(testName, func(t *testing.T) {
+			key, err := newTestKey()
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			contractAddress := common.HexToAddress(
+				"0xcf64c2a367341170cb4e09cf8c0ed137d8473ceb",
+			)
+
+			backend := backends.NewSimulatedBackend(
+				core.GenesisAlloc{
+					key.Address: {Balance: big.NewInt(1000000000000000000)},
+					contractAddress: {
+						Code:    test.contractCode,
+						Balance: big.NewInt(0),
+					},
+				},
+				10000000,
+			)
+
+			transaction, err := types.SignTx(
+				types.NewTransaction(
+					0,
+					contractAddress,
+					big.NewInt(0),
+					100000,
+					big.NewInt(1),
+					nil,
+				),
+				types.HomesteadSigner{},
+				key.PrivateKey,
+			)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			err = backend.SendTransaction(context.Background(), transaction)
+			if err != nil {
+				t.Fatal(err)
+			}
+			backend.Commit()
+
+			ec := &ethereumChain{receiptBackend: backend}
+
+			ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+			defer cancel()
+
+			transactionFailure := make(chan error, 1)
+			ec.watchDKGResultSubmissionReceipt(ctx, transaction, transactionFailure)
+
+			select {
+			case err := <-transactionFailure:
+				if !test.expectedFailure {
+					t.Errorf("unexpected failure: [%v]", err)
+				}
+			default:
+				if test.expectedFailure {
+					t.Errorf("expected transaction failure")
+				}
+			}
+		})
+	}
+}
+
+func newTestKey() (*keystore.Key, error) {
+	privateKey, err := crypto.GenerateKey()
+	if err != nil {
+		return nil, err
+	}
+
+	return &keystore.Key{
+		Address:    crypto.PubkeyToAddress(privateKey.PublicKey),
+		PrivateKey: privateKey,
+	}, nil
+}
@@ -0,0 +1,459 @@
+package ethereum
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"math/big"
+	"net/http"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/params"
+	"github.com/keep-network/keep-core/pkg/chain/gen/contract"
+)
+
+const (
+	// StaticGasPrice is the name of the gas price strategy using the
+	// configured gas price for all transactions.
+	StaticGasPrice = "static"
+	// OracleGasPrice is the name of the gas price strategy reading the gas
+	// price from an external gas price oracle.
+	OracleGasPrice = "oracle"
+	// DynamicFeeGasPrice is the name of the gas price strategy deriving the
+	// gas price from the base fee of the latest block, as EIP-1559 wallets
+	// derive the maximum fee per gas.
+	DynamicFeeGasPrice = "dynamic"
+)
+
+const (
+	// DefaultGasPriceOracleField is the default field of the gas price
+	// oracle response holding the gas price.
+	DefaultGasPriceOracleField = "fast"
+	// DefaultGasPriceOracleTimeout is the maximum time a single request to
+	// the gas price oracle may take.
+	DefaultGasPriceOracleTimeout = 5 * time.Second
+	// DefaultPriorityFeeGwei is the default priority fee in Gwei added to the
+	// base fee by the dynamic fee strategy.
+	DefaultPriorityFeeGwei = 2
+)
+
+// GasPriceConfig configures how the gas price of relay entry, ticket and DKG
+// result submission transactions is determined. If the strategy is not set,
+// the gas price suggested by the Ethereum node is used.
+type GasPriceConfig struct {
+	// Strategy is the name of the gas price strategy, one of static, oracle
+	// or dynamic.
+	Strategy string
+	// GasPriceGwei is the gas price in Gwei used by the static strategy.
+	GasPriceGwei uint64
+	// OracleURL is the URL of the gas price oracle used by the oracle
+	// strategy. The oracle is expected to respond to GET requests with a JSON
+	// object holding the gas price in Gwei.
+	OracleURL string
+	// OracleField is the dot-separated path of the oracle response field
+	// holding the gas price in Gwei, e.g. result.FastGasPrice. The value may
+	// be a number or a string. If not set, DefaultGasPriceOracleField is used.
+	OracleField string
+	// PriorityFeeGwei is the priority fee in Gwei the dynamic strategy adds to
+	// twice the base fee of the latest block. If not set,
+	// DefaultPriorityFeeGwei is used.
+	PriorityFeeGwei uint64
+	// MaxGasPriceGwei is the optional gas price in Gwei no transaction
+	// exceeds, whatever the strategy.
+	MaxGasPriceGwei uint64
+}
+
+func (gpc GasPriceConfig) oracleField() string {
+	if gpc.OracleField == "" {
+		return DefaultGasPriceOracleField
+	}
+	return gpc.OracleField
+}
+
+func (gpc GasPriceConfig) priorityFeeGwei() uint64 {
+	if gpc.PriorityFeeGwei == 0 {
+		return DefaultPriorityFeeGwei
+	}
+	return gpc.PriorityFeeGwei
+}
+
+// gasPriceStrategy determines the gas price of the transaction about to be
+// submitted.
+type gasPriceStrategy interface {
+	gasPrice(ctx context.Context) (*big.Int, error)
+}
+
+// rpcCaller performs raw JSON-RPC calls to the Ethereum node.
+type rpcCaller interface {
+	CallContext(
+		ctx context.Context,
+		result interface{},
+		method string,
+		args ...interface{},
+	) error
+}
+
+// gasPriceStrategyFor returns the gas price strategy configured with the given
+// config. It returns nil if no strategy is configured. The dynamic fee
+// strategy reads the base fee of the latest block with the given caller.
+func gasPriceStrategyFor(
+	config GasPriceConfig,
+	caller rpcCaller,
+) (gasPriceStrategy, error) {
+	switch config.Strategy {
+	case "":
+		return nil, nil
+	case StaticGasPrice:
+		if config.GasPriceGwei == 0 {
+			return nil, fmt.Errorf("static gas price strategy requires gas price")
+		}
+		if config.MaxGasPriceGwei != 0 &&
+			config.GasPriceGwei > config.MaxGasPriceGwei {
+			return nil, fmt.Errorf(
+				"static gas price [%v] Gwei exceeds max gas price [%v] Gwei",
+				config.GasPriceGwei,
+				config.MaxGasPriceGwei,
+			)
+		}
+		return &staticGasPriceStrategy{
+			price: gweiToWei(config.GasPriceGwei),
+		}, nil
+	case OracleGasPrice:
+		if config.OracleURL == "" {
+			return nil, fmt.Errorf("oracle gas price strategy requires oracle URL")
+		}
+		return &oracleGasPriceStrategy{
+			url:    config.OracleURL,
+			field:  config.oracleField(),
+			client: &http.Client{Timeout: DefaultGasPriceOracleTimeout},
+		}, nil
+	case DynamicFeeGasPrice:
+		if caller == nil {
+			return nil, fmt.Errorf(
+				"dynamic fee gas price strategy requires RPC connection",
+			)
+		}
+		return &dynamicFeeGasPriceStrategy{
+			caller:      caller,
+			priorityFee: gweiToWei(config.priorityFeeGwei()),
+		}, nil
+	default:
+		return nil, fmt.Errorf(
+			"unknown gas price strategy [%v]; expected [%v], [%v] or [%v]",
+			config.Strategy,
+			StaticGasPrice,
+			OracleGasPrice,
+			DynamicFeeGasPrice,
+		)
+	}
+}
+
+// staticGasPriceStrategy uses the same gas price for all transactions.
+type staticGasPriceStrategy struct {
+	price *big.Int
+}
+
+func (sgps *staticGasPriceStrategy) gasPrice(
+	ctx context.Context,
+) (*big.Int, error) {
+	return new(big.Int).Set(sgps.price), nil
+}
+
+// oracleGasPriceStrategy reads the gas price in Gwei from the given field of
+// the gas price oracle response.
+type oracleGasPriceStrategy struct {
+	url    string
+	field  string
+	client *http.Client
+}
+
+func (ogps *oracleGasPriceStrategy) gasPrice(
+	ctx context.Context,
+) (*big.Int, error) {
+	request, err := http.NewRequest(http.MethodGet, ogps.url, nil)
+	if err != nil {
+		return nil, err
+	}
+	request = request.WithContext(ctx)
+
+	response, err := ogps.client.Do(request)
+	if err != nil {
+		return nil, err
+	}
+	defer response.Body.Close()
+
+	if response.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf(
+			"unexpected oracle response status [%v]",
+			response.StatusCode,
+		)
+	}
+
+	var body interface{}
+	if err := json.NewDecoder(response.Body).Decode(&body); err != nil {
+		return nil, fmt.Errorf("could not decode oracle response: [%v]", err)
+	}
+
+	value := body
+	for _, key := range strings.Split(ogps.field, ".") {
+		object, ok := value.(map[string]interface{})
+		if !ok {
+			return nil, fmt.Errorf(
+				"oracle response has no field [%v]",
+				ogps.field,
+			)
+		}
+		if value, ok = object[key]; !ok {
+			return nil, fmt.Errorf(
+				"oracle response has no field [%v]",
+				ogps.field,
+			)
+		}
+	}
+
+	var gwei float64
+	switch v := value.(type) {
+	case float64:
+		gwei = v
+	case string:
+		if gwei, err = strconv.ParseFloat(v, 64); err != nil {
+			return nil, fmt.Errorf(
+				"oracle response field [%v] is not a number: [%v]",
+				ogps.field,
+				v,
+			)
+		}
+	default:
+		return nil, fmt.Errorf(
+			"oracle response field [%v] is not a number: [%v]",
+			ogps.field,
+			v,
+		)
+	}
+
+	if gwei <= 0 {
+		return nil, fmt.Errorf("oracle returned non-positive gas price [%v]", gwei)
+	}
+
+	wei, _ := new(big.Float).Mul(
+		big.NewFloat(gwei),
+		big.NewFloat(params.GWei),
+	).Int(nil)
+
+	return wei, nil
+}
+
+// dynamicFeeGasPriceStrategy derives the gas price from the base fee of the
+// latest block the way EIP-1559 wallets derive the maximum fee per gas: twice
+// the base fee plus the priority fee, so that the transaction stays
+// includable even if the base fee keeps rising for several blocks.
+// Transactions are still sent as legacy transactions paying the whole gas
+// price, so the max gas price should be configured to bound the cost.
+type dynamicFeeGasPriceStrategy struct {
+	caller      rpcCaller
+	priorityFee *big.Int
+}
+
+func (dfgps *dynamicFeeGasPriceStrategy) gasPrice(
+	ctx context.Context,
+) (*big.Int, error) {
+	var block struct {
+		BaseFee *hexutil.Big `json:"baseFeePerGas"`
+	}
+
+	err := dfgps.caller.CallContext(
+		ctx,
+		&block,
+		"eth_getBlockByNumber",
+		"latest",
+		false,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("could not get latest block: [%v]", err)
+	}
+
+	if block.BaseFee == nil {
+		return nil, fmt.Errorf(
+			"latest block has no base fee; the chain does not support EIP-1559",
+		)
+	}
+
+	gasPrice := new(big.Int).Mul(block.BaseFee.ToInt(), big.NewInt(2))
+	return gasPrice.Add(gasPrice, dfgps.priorityFee), nil
+}
+
+// gasPriceBackend is a contract backend suggesting gas prices of submitted
+// transactions with the gas price strategy. If the strategy is not set or
+// fails, the gas price suggested by the wrapped backend is used. Suggested gas
+// prices never exceed the max gas price, if set. All other calls are handled
+// by the wrapped backend.
+type gasPriceBackend struct {
+	bind.ContractBackend
+
+	mutex       sync.RWMutex
+	strategy    gasPriceStrategy
+	maxGasPrice *big.Int
+}
+
+// SuggestGasPrice returns the gas price of the transaction about to be
+// submitted.
+func (gpb *gasPriceBackend) SuggestGasPrice(
+	ctx context.Context,
+) (*big.Int, error) {
+	gpb.mutex.RLock()
+	strategy, maxGasPrice := gpb.strategy, gpb.maxGasPrice
+	gpb.mutex.RUnlock()
+
+	var gasPrice *big.Int
+	var err error
+
+	if strategy != nil {
+		gasPrice, err = strategy.gasPrice(ctx)
+		if err != nil {
+			logger.Warningf(
+				"could not determine gas price with the configured "+
+					"strategy; using gas price suggested by the node: [%v]",
+				err,
+			)
+		}
+	}
+
+	if gasPrice == nil {
+		gasPrice, err = gpb.ContractBackend.SuggestGasPrice(ctx)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	if maxGasPrice != nil && gasPrice.Cmp(maxGasPrice) > 0 {
+		logger.Warningf(
+			"gas price [%v] wei exceeds max gas price; using [%v] wei",
+			gasPrice,
+			maxGasPrice,
+		)
+		gasPrice = maxGasPrice
+	}
+
+	return gasPrice, nil
+}
+
+// configure replaces the gas price strategy and the max gas price of the
+// backend with the ones from the given config.
+func (gpb *gasPriceBackend) configure(
+	config GasPriceConfig,
+	caller rpcCaller,
+) error {
+	strategy, err := gasPriceStrategyFor(config, caller)
+	if err != nil {
+		return err
+	}
+
+	var maxGasPrice *big.Int
+	if config.MaxGasPriceGwei != 0 {
+		maxGasPrice = gweiToWei(config.MaxGasPriceGwei)
+	}
+
+	gpb.mutex.Lock()
+	defer gpb.mutex.Unlock()
+
+	gpb.strategy = strategy
+	gpb.maxGasPrice = maxGasPrice
+
+	return nil
+}
+
+// useGasPrice makes relay entry, ticket and DKG result submission transactions
+// priced with the gas price strategy from the given config. It has to be
+// called before the private relay is configured so that it uses the gas price
+// strategy as well.
+func (ec *ethereumChain) useGasPrice(config GasPriceConfig) error {
+	if config.Strategy == "" && config.MaxGasPriceGwei == 0 {
+		return nil
+	}
+
+	backend := &gasPriceBackend{ContractBackend: ec.client}
+	if err := backend.configure(config, ec.rpcCaller()); err != nil {
+		return err
+	}
+
+	address, err := addressForContract(ec.config, "KeepRandomBeaconOperator")
+	if err != nil {
+		return fmt.Errorf(
+			"error resolving KeepRandomBeaconOperator contract: [%v]",
+			err,
+		)
+	}
+
+	operatorContract, err := contract.NewKeepRandomBeaconOperator(
+		*address,
+		ec.accountKey,
+		backend,
+		ec.transactionMutex,
+	)
+	if err != nil {
+		return fmt.Errorf(
+			"error attaching to KeepRandomBeaconOperator contract "+
+				"with gas price strategy: [%v]",
+			err,
+		)
+	}
+
+	ec.client = backend
+	ec.gasPrice = backend
+	ec.keepRandomBeaconOperatorContract = operatorContract
+	ec.dkgResultSubmitterContract = operatorContract
+
+	logGasPrice(config)
+
+	return nil
+}
+
+// UpdateGasPrice makes transactions submitted from now on priced with the gas
+// price strategy and the max gas price from the given config. Transactions
+// can be repriced only if a gas price strategy or a max gas price was
+// configured when the chain was connected.
+func (ec *ethereumChain) UpdateGasPrice(config GasPriceConfig) error {
+	if ec.gasPrice == nil {
+		return fmt.Errorf(
+			"gas price has not been configured at startup; " +
+				"restart the node to configure it",
+		)
+	}
+
+	if err := ec.gasPrice.configure(config, ec.rpcCaller()); err != nil {
+		return err
+	}
+
+	logGasPrice(config)
+
+	return nil
+}
+
+func (ec *ethereumChain) rpcCaller() rpcCaller {
+	return ec.clientWS
+}
+
+func logGasPrice(config GasPriceConfig) {
+	strategyName := config.Strategy
+	if strategyName == "" {
+		strategyName = "node suggestion"
+	}
+	logger.Infof(
+		"transactions will be priced with [%v] gas price strategy; "+
+			"max gas price: [%v] Gwei",
+		strategyName,
+		config.MaxGasPriceGwei,
+	)
+}
+
+func gweiToWei(gwei uint64) *big.Int {
+	return new(big.Int).Mul(
+		new(big.Int).SetUint64(gwei),
+		big.NewInt(params.GWei),
+	)
+}
@@ -0,0 +1,325 @@
+package ethereum
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/accounts/abi/bind/backends"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/core"
+	"github.com/keep-network/keep-common/pkg/chain/ethereum"
+)
+
+// mockRPCCaller responds to eth_getBlockByNumber with a block of the given
+// base fee.
+type mockRPCCaller struct {
+	baseFee *big.Int
+}
+
+func (mrc *mockRPCCaller) CallContext(
+	ctx context.Context,
+	result interface{},
+	method string,
+	args ...interface{},
+) error {
+	block := result.(*struct {
+		BaseFee *hexutil.Big `json:"baseFeePerGas"`
+	})
+	if mrc.baseFee != nil {
+		block.BaseFee = (*hexutil.Big)(mrc.baseFee)
+	}
+	return nil
+}
+
+func TestGasPriceStrategies(t *testing.T) {
+	oracle := httptest.NewServer(http.HandlerFunc(
+		func(w http.ResponseWriter, r *http.Request) {
+			fmt.Fprint(
+				w,
+				`{"fast":52.5,"result":{"FastGasPrice":"61"},"slow":"none"}`,
+			)
+		},
+	))
+	defer oracle.Close()
+
+	var tests = map[string]struct {
+		config           GasPriceConfig
+		caller           rpcCaller
+		expectedGasPrice *big.Int
+		expectedError    bool
+	}{
+		"static": {
+			config:           GasPriceConfig{Strategy: StaticGasPrice, GasPriceGwei: 20},
+			expectedGasPrice: big.NewInt(20000000000),
+		},
+		"oracle with default field": {
+			config:           GasPriceConfig{Strategy: OracleGasPrice, OracleURL: oracle.URL},
+			expectedGasPrice: big.NewInt(52500000000),
+		},
+		"oracle with nested string field": {
+			config: GasPriceConfig{
+				Strategy:    OracleGasPrice,
+				OracleURL:   oracle.URL,
+				OracleField: "result.FastGasPrice",
+			},
+			expectedGasPrice: big.NewInt(61000000000),
+		},
+		"oracle with missing field": {
+			config: GasPriceConfig{
+				Strategy:    OracleGasPrice,
+				OracleURL:   oracle.URL,
+				OracleField: "result.SafeGasPrice",
+			},
+			expectedError: true,
+		},
+		"oracle with non-numeric field": {
+			config: GasPriceConfig{
+				Strategy:    OracleGasPrice,
+				OracleURL:   oracle.URL,
+				OracleField: "slow",
+			},
+			expectedError: true,
+		},
+		"dynamic fee": {
+			config:           GasPriceConfig{Strategy: DynamicFeeGasPrice},
+			caller:           &mockRPCCaller{baseFee: big.NewInt(30000000000)},
+			expectedGasPrice: big.NewInt(62000000000),
+		},
+		"dynamic fee with priority fee": {
+			config: GasPriceConfig{
+				Strategy:        DynamicFeeGasPrice,
+				PriorityFeeGwei: 5,
+			},
+			caller:           &mockRPCCaller{baseFee: big.NewInt(30000000000)},
+			expectedGasPrice: big.NewInt(65000000000),
+		},
+		"dynamic fee without base fee": {
+			config:        GasPriceConfig{Strategy: DynamicFeeGasPrice},
+			caller:        &mockRPCCaller{},
+			expectedError: true,
+		},
+	}
+
+	for testName, test := range tests {
+		t.Run(testName, func(t *testing.T) {
+			strategy, err := gasPriceStrategyFor(test.config, test.caller)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			gasPrice, err := strategy.gasPrice(context.Background())
+			if test.expectedError != (err != nil) {
+				t.Fatalf(
+					"unexpected error\nexpected error: %v\nactual error:   %v\n",
+					test.expectedError,
+					err,
+				)
+			}
+
+			if !test.expectedError && gasPrice.Cmp(test.expectedGasPrice) != 0 {
+				t.Errorf(
+					"unexpected gas price\nexpected: %v\nactual:   %v\n",
+					test.expectedGasPrice,
+					gasPrice,
+				)
+			}
+		})
+	}
+}
+
+func TestGasPriceStrategyForInvalidConfig(t *testing.T) {
+	var tests = map[string]struct {
+		config GasPriceConfig
+		caller rpcCaller
+	}{
+		"static without gas price": {
+			config: GasPriceConfig{Strategy: StaticGasPrice},
+		},
+		"static over max gas price": {
+			config: GasPriceConfig{
+				Strategy:        StaticGasPrice,
+				GasPriceGwei:    200,
+				MaxGasPriceGwei: 100,
+			},
+		},
+		"oracle without URL": {
+			config: GasPriceConfig{Strategy: OracleGasPrice},
+		},
+		"dynamic fee without RPC connection": {
+			config: GasPriceConfig{Strategy: DynamicFeeGasPrice},
+		},
+		"unknown strategy": {
+			config: GasPriceConfig{Strategy: "fastest"},
+			caller: &mockRPCCaller{},
+		},
+	}
+
+	for testName, test := range tests {
+		t.Run(testName, func(t *testing.T) {
+			_, err := gasPriceStrategyFor(test.config, test.caller)
+			if err == nil {
+				t.Fatal("expected error")
+			}
+		})
+	}
+}
+
+func TestSubmitDKGResultWithGasPriceStrategy(t *testing.T) {
+	operatorKey, err := newTestKey()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	operatorContractAddress := "0xcf64c2a367341170cb4e09cf8c0ed137d8473ceb"
+
+	var tests = map[string]struct {
+		config           GasPriceConfig
+		expectedGasPrice *big.Int
+	}{
+		"max gas price without strategy": {
+			config: GasPriceConfig{MaxGasPriceGwei: 20},
+			// simulated backend suggests 1 wei
+			expectedGasPrice: big.NewInt(1),
+		},
+		"static": {
+			config:           GasPriceConfig{Strategy: StaticGasPrice, GasPriceGwei: 20},
+			expectedGasPrice: big.NewInt(20000000000),
+		},
+		"failed strategy falls back to node suggestion": {
+			config: GasPriceConfig{
+				Strategy:  OracleGasPrice,
+				OracleURL: "http://127.0.0.1:0",
+			},
+			expectedGasPrice: big.NewInt(1),
+		},
+	}
+
+	for testName, test := range tests {
+		t.Run(testName, func(t *testing.T) {
+			// The operator contract is stubbed with code accepting any call.
+			backend := backends.NewSimulatedBackend(
+				core.GenesisAlloc{
+					operatorKey.Address: {
+						Balance: big.NewInt(1000000000000000000),
+					},
+					common.HexToAddress(operatorContractAddress): {
+						Code:    []byte{0x00}, // STOP
+						Balance: big.NewInt(0),
+					},
+				},
+				10000000,
+			)
+			ec := &ethereumChain{
+				config: ethereum.Config{
+					ContractAddresses: map[string]string{
+						"KeepRandomBeaconOperator": operatorContractAddress,
+					},
+				},
+				client:           backend,
+				receiptBackend:   backend,
+				accountKey:       operatorKey,
+				transactionMutex: &sync.Mutex{},
+			}
+
+			if err := ec.useGasPrice(test.config); err != nil {
+				t.Fatal(err)
+			}
+
+			transaction, err := ec.dkgResultSubmitterContract.SubmitDkgResult(
+				big.NewInt(1),
+				[]byte{123, 45},
+				[]byte{},
+				[]byte{},
+				[]*big.Int{},
+			)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			if transaction.GasPrice().Cmp(test.expectedGasPrice) != 0 {
+				t.Errorf(
+					"unexpected gas price\nexpected: %v\nactual:   %v\n",
+					test.expectedGasPrice,
+					transaction.GasPrice(),
+				)
+			}
+		})
+	}
+}
+
+func TestGasPriceBackendCapsGasPrice(t *testing.T) {
+	backend := &gasPriceBackend{
+		ContractBackend: backends.NewSimulatedBackend(core.GenesisAlloc{}, 10000000),
+		strategy:        &staticGasPriceStrategy{price: gweiToWei(300)},
+		maxGasPrice:     gweiToWei(100),
+	}
+
+	gasPrice, err := backend.SuggestGasPrice(context.Background())
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if gasPrice.Cmp(gweiToWei(100)) != 0 {
+		t.Errorf(
+			"unexpected gas price\nexpected: %v\nactual:   %v\n",
+			gweiToWei(100),
+			gasPrice,
+		)
+	}
+}
+
+func TestUpdateGasPrice(t *testing.T) {
+	operatorKey, err := newTestKey()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	ec := &ethereumChain{
+		config: ethereum.Config{
+			ContractAddresses: map[string]string{
+				"KeepRandomBeaconOperator": "0xcf64c2a367341170cb4e09cf8c0ed137d8473ceb",
+			},
+		},
+		client:           backends.NewSimulatedBackend(core.GenesisAlloc{}, 10000000),
+		accountKey:       operatorKey,
+		transactionMutex: &sync.Mutex{},
+	}
+
+	err = ec.UpdateGasPrice(GasPriceConfig{MaxGasPriceGwei: 100})
+	if err == nil {
+		t.Fatal("gas price not configured at startup should not be updated")
+	}
+
+	err = ec.useGasPrice(
+		GasPriceConfig{Strategy: StaticGasPrice, GasPriceGwei: 300},
+	)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	err = ec.UpdateGasPrice(
+		GasPriceConfig{Strategy: StaticGasPrice, GasPriceGwei: 100},
+	)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	gasPrice, err := ec.client.SuggestGasPrice(context.Background())
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if gasPrice.Cmp(gweiToWei(100)) != 0 {
+		t.Errorf(
+			"unexpected gas price\nexpected: %v\nactual:   %v\n",
+			gweiToWei(100),
+			gasPrice,
+		)
+	}
+}
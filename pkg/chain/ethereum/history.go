@@ -0,0 +1,168 @@
+package ethereum
+
+import (
+	"context"
+	"fmt"
+	"sync"
+
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/keep-network/keep-core/pkg/beacon/relay/event"
+	"github.com/keep-network/keep-core/pkg/chain/gen/abi"
+)
+
+const (
+	// DefaultHistoryChunkBlocks is the default maximum number of blocks
+	// queried for past events at once.
+	DefaultHistoryChunkBlocks = 5000
+	// DefaultHistoryParallelism is the default maximum number of block range
+	// chunks queried for past events concurrently.
+	DefaultHistoryParallelism = 4
+)
+
+// HistoryConfig configures queries of past events. Long block ranges are
+// split into chunks queried concurrently so that a single query does not
+// time out.
+type HistoryConfig struct {
+	// ChunkBlocks is the maximum number of blocks queried at once. If not
+	// set, DefaultHistoryChunkBlocks is used.
+	ChunkBlocks uint64
+	// Parallelism is the maximum number of chunks queried concurrently. If
+	// not set, DefaultHistoryParallelism is used.
+	Parallelism int
+}
+
+func (hc HistoryConfig) chunkBlocks() uint64 {
+	if hc.ChunkBlocks == 0 {
+		return DefaultHistoryChunkBlocks
+	}
+	return hc.ChunkBlocks
+}
+
+func (hc HistoryConfig) parallelism() int {
+	if hc.Parallelism <= 0 {
+		return DefaultHistoryParallelism
+	}
+	return hc.Parallelism
+}
+
+// blockRange is a range of blocks from start to end, both inclusive.
+type blockRange struct {
+	start uint64
+	end   uint64
+}
+
+// splitBlockRange splits the range of blocks from start to end, both
+// inclusive, into consecutive ranges of at most the given number of blocks.
+func splitBlockRange(start, end, chunkBlocks uint64) []blockRange {
+	ranges := make([]blockRange, 0)
+	if start > end || chunkBlocks == 0 {
+		return ranges
+	}
+
+	for chunkStart := start; ; chunkStart += chunkBlocks {
+		chunkEnd := chunkStart + chunkBlocks - 1
+		if chunkEnd >= end || chunkEnd < chunkStart {
+			ranges = append(ranges, blockRange{chunkStart, end})
+			return ranges
+		}
+		ranges = append(ranges, blockRange{chunkStart, chunkEnd})
+	}
+}
+
+// queryInChunks executes the query for each of the block ranges running at
+// most the given number of queries concurrently. Results are merged in the
+// order of block ranges. If any of the queries fails, an error is returned.
+func queryInChunks(
+	ranges []blockRange,
+	parallelism int,
+	query func(blockRange blockRange) ([]*event.DKGResultSubmission, error),
+) ([]*event.DKGResultSubmission, error) {
+	results := make([][]*event.DKGResultSubmission, len(ranges))
+	errors := make([]error, len(ranges))
+
+	semaphore := make(chan struct{}, parallelism)
+	wg := &sync.WaitGroup{}
+	wg.Add(len(ranges))
+
+	for i, chunk := range ranges {
+		semaphore <- struct{}{}
+		go func(i int, chunk blockRange) {
+			defer wg.Done()
+			defer func() { <-semaphore }()
+
+			results[i], errors[i] = query(chunk)
+		}(i, chunk)
+	}
+
+	wg.Wait()
+
+	merged := make([]*event.DKGResultSubmission, 0)
+	for i, chunk := range ranges {
+		if errors[i] != nil {
+			return nil, fmt.Errorf(
+				"could not query blocks [%v-%v]: [%v]",
+				chunk.start,
+				chunk.end,
+				errors[i],
+			)
+		}
+		merged = append(merged, results[i]...)
+	}
+
+	return merged, nil
+}
+
+// PastDKGResultSubmissions returns DKG result submission events emitted
+// between the given blocks, both inclusive, ordered by block number. The
+// block range is queried in chunks according to the history config.
+func (ec *ethereumChain) PastDKGResultSubmissions(
+	startBlock uint64,
+	endBlock uint64,
+) ([]*event.DKGResultSubmission, error) {
+	address, err := addressForContract(ec.config, "KeepRandomBeaconOperator")
+	if err != nil {
+		return nil, fmt.Errorf(
+			"error resolving KeepRandomBeaconOperator contract: [%v]",
+			err,
+		)
+	}
+
+	filterer, err := abi.NewKeepRandomBeaconOperatorFilterer(*address, ec.client)
+	if err != nil {
+		return nil, fmt.Errorf(
+			"error attaching to KeepRandomBeaconOperator contract: [%v]",
+			err,
+		)
+	}
+
+	return queryInChunks(
+		splitBlockRange(startBlock, endBlock, ec.history.chunkBlocks()),
+		ec.history.parallelism(),
+		func(chunk blockRange) ([]*event.DKGResultSubmission, error) {
+			iterator, err := filterer.FilterDkgResultSubmittedEvent(
+				&bind.FilterOpts{
+					Start:   chunk.start,
+					End:     &chunk.end,
+					Context: context.Background(),
+				},
+			)
+			if err != nil {
+				return nil, err
+			}
+			defer iterator.Close()
+
+			submissions := make([]*event.DKGResultSubmission, 0)
+			for iterator.Next() {
+				submissions = append(submissions, &event.DKGResultSubmission{
+					MemberIndex:     uint32(iterator.Event.MemberIndex.Uint64()),
+					GroupPublicKey:  iterator.Event.GroupPubKey,
+					Misbehaved:      iterator.Event.Misbehaved,
+					TransactionHash: iterator.Event.Raw.TxHash.Hex(),
+					BlockNumber:     iterator.Event.Raw.BlockNumber,
+				})
+			}
+
+			return submissions, iterator.Error()
+		},
+	)
+}
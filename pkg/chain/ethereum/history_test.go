@@ -0,0 +1,189 @@
+package ethereum
+
+import (
+	"fmt"
+	"reflect"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/keep-network/keep-core/pkg/beacon/relay/event"
+)
+
+func TestSplitBlockRange(t *testing.T) {
+	var tests = map[string]struct {
+		start          uint64
+		end            uint64
+		chunkBlocks    uint64
+		expectedRanges []blockRange
+	}{
+		"range shorter than chunk": {
+			start:          100,
+			end:            150,
+			chunkBlocks:    100,
+			expectedRanges: []blockRange{{100, 150}},
+		},
+		"range equal to chunk": {
+			start:          100,
+			end:            199,
+			chunkBlocks:    100,
+			expectedRanges: []blockRange{{100, 199}},
+		},
+		"range split into full chunks": {
+			start:          100,
+			end:            399,
+			chunkBlocks:    100,
+			expectedRanges: []blockRange{{100, 199}, {200, 299}, {300, 399}},
+		},
+		"range split with partial last chunk": {
+			start:          100,
+			end:            350,
+			chunkBlocks:    100,
+			expectedRanges: []blockRange{{100, 199}, {200, 299}, {300, 350}},
+		},
+		"single block": {
+			start:          100,
+			end:            100,
+			chunkBlocks:    100,
+			expectedRanges: []blockRange{{100, 100}},
+		},
+		"empty range": {
+			start:          200,
+			end:            100,
+			chunkBlocks:    100,
+			expectedRanges: []blockRange{},
+		},
+	}
+
+	for testName, test := range tests {
+		t.Run(testName, func(t *testing.T) {
+			ranges := splitBlockRange(test.start, test.end, test.chunkBlocks)
+
+			if !reflect.DeepEqual(test.expectedRanges, ranges) {
+				t.Errorf(
+					"unexpected ranges\nexpected: %v\nactual:   %v\n",
+					test.expectedRanges,
+					ranges,
+				)
+			}
+		})
+	}
+}
+
+func TestSplitLargeBlockRangeHasNoGapsOrOverlaps(t *testing.T) {
+	start, end := uint64(1000), uint64(1234567)
+
+	ranges := splitBlockRange(start, end, DefaultHistoryChunkBlocks)
+
+	next := start
+	for _, chunk := range ranges {
+		if chunk.start != next {
+			t.Fatalf(
+				"unexpected chunk start\nexpected: %v\nactual:   %v\n",
+				next,
+				chunk.start,
+			)
+		}
+		if chunk.end-chunk.start+1 > DefaultHistoryChunkBlocks {
+			t.Fatalf("chunk [%v-%v] exceeds chunk size", chunk.start, chunk.end)
+		}
+		next = chunk.end + 1
+	}
+
+	if next != end+1 {
+		t.Errorf(
+			"unexpected end of the last chunk\nexpected: %v\nactual:   %v\n",
+			end,
+			next-1,
+		)
+	}
+}
+
+func TestQueryInChunksMergesInBlockOrder(t *testing.T) {
+	// one submission every 7 blocks
+	var submissions []*event.DKGResultSubmission
+	for block := uint64(0); block <= 1000; block += 7 {
+		submissions = append(
+			submissions,
+			&event.DKGResultSubmission{BlockNumber: block},
+		)
+	}
+
+	parallelism := 3
+
+	mutex := &sync.Mutex{}
+	running := 0
+	maxRunning := 0
+
+	query := func(chunk blockRange) ([]*event.DKGResultSubmission, error) {
+		mutex.Lock()
+		running++
+		if running > maxRunning {
+			maxRunning = running
+		}
+		mutex.Unlock()
+
+		// later chunks complete first
+		time.Sleep(time.Duration(1000-chunk.start) * time.Microsecond)
+
+		mutex.Lock()
+		running--
+		mutex.Unlock()
+
+		result := make([]*event.DKGResultSubmission, 0)
+		for _, submission := range submissions {
+			if submission.BlockNumber >= chunk.start &&
+				submission.BlockNumber <= chunk.end {
+				result = append(result, submission)
+			}
+		}
+		return result, nil
+	}
+
+	merged, err := queryInChunks(
+		splitBlockRange(0, 1000, 50),
+		parallelism,
+		query,
+	)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if !reflect.DeepEqual(submissions, merged) {
+		t.Errorf(
+			"unexpected merged submissions\nexpected: %v\nactual:   %v\n",
+			len(submissions),
+			len(merged),
+		)
+	}
+
+	if maxRunning > parallelism {
+		t.Errorf(
+			"too many concurrent queries\nexpected at most: %v\nactual:           %v\n",
+			parallelism,
+			maxRunning,
+		)
+	}
+}
+
+func TestQueryInChunksFailure(t *testing.T) {
+	_, err := queryInChunks(
+		splitBlockRange(0, 999, 100),
+		2,
+		func(chunk blockRange) ([]*event.DKGResultSubmission, error) {
+			if chunk.start == 500 {
+				return nil, fmt.Errorf("query timed out")
+			}
+			return []*event.DKGResultSubmission{}, nil
+		},
+	)
+
+	expectedErr := fmt.Errorf("could not query blocks [500-599]: [query timed out]")
+	if !reflect.DeepEqual(expectedErr, err) {
+		t.Errorf(
+			"unexpected error\nexpected: %v\nactual:   %v\n",
+			expectedErr,
+			err,
+		)
+	}
+}
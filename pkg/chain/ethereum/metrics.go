@@ -0,0 +1,160 @@
+package ethereum
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/keep-network/keep-core/pkg/metrics"
+)
+
+const (
+	rpcErrorsMetric       = "ethereum_rpc_errors_total"
+	blockCounterLagMetric = "ethereum_block_counter_lag"
+)
+
+// metricsBackend is a contract backend counting errors returned by the
+// wrapped backend per JSON-RPC method.
+type metricsBackend struct {
+	bind.ContractBackend
+
+	recorder metrics.Recorder
+}
+
+func (mb *metricsBackend) record(method string, err error) {
+	if err != nil {
+		mb.recorder.IncrementCounter(
+			rpcErrorsMetric,
+			metrics.Labels{"method": method},
+		)
+	}
+}
+
+func (mb *metricsBackend) CodeAt(
+	ctx context.Context,
+	contract common.Address,
+	blockNumber *big.Int,
+) ([]byte, error) {
+	code, err := mb.ContractBackend.CodeAt(ctx, contract, blockNumber)
+	mb.record("eth_getCode", err)
+	return code, err
+}
+
+func (mb *metricsBackend) CallContract(
+	ctx context.Context,
+	call ethereum.CallMsg,
+	blockNumber *big.Int,
+) ([]byte, error) {
+	result, err := mb.ContractBackend.CallContract(ctx, call, blockNumber)
+	mb.record("eth_call", err)
+	return result, err
+}
+
+func (mb *metricsBackend) PendingCodeAt(
+	ctx context.Context,
+	account common.Address,
+) ([]byte, error) {
+	code, err := mb.ContractBackend.PendingCodeAt(ctx, account)
+	mb.record("eth_getCode", err)
+	return code, err
+}
+
+func (mb *metricsBackend) PendingNonceAt(
+	ctx context.Context,
+	account common.Address,
+) (uint64, error) {
+	nonce, err := mb.ContractBackend.PendingNonceAt(ctx, account)
+	mb.record("eth_getTransactionCount", err)
+	return nonce, err
+}
+
+func (mb *metricsBackend) SuggestGasPrice(ctx context.Context) (*big.Int, error) {
+	gasPrice, err := mb.ContractBackend.SuggestGasPrice(ctx)
+	mb.record("eth_gasPrice", err)
+	return gasPrice, err
+}
+
+func (mb *metricsBackend) EstimateGas(
+	ctx context.Context,
+	call ethereum.CallMsg,
+) (uint64, error) {
+	gas, err := mb.ContractBackend.EstimateGas(ctx, call)
+	mb.record("eth_estimateGas", err)
+	return gas, err
+}
+
+func (mb *metricsBackend) SendTransaction(
+	ctx context.Context,
+	transaction *types.Transaction,
+) error {
+	err := mb.ContractBackend.SendTransaction(ctx, transaction)
+	mb.record("eth_sendRawTransaction", err)
+	return err
+}
+
+func (mb *metricsBackend) FilterLogs(
+	ctx context.Context,
+	query ethereum.FilterQuery,
+) ([]types.Log, error) {
+	logs, err := mb.ContractBackend.FilterLogs(ctx, query)
+	mb.record("eth_getLogs", err)
+	return logs, err
+}
+
+func (mb *metricsBackend) SubscribeFilterLogs(
+	ctx context.Context,
+	query ethereum.FilterQuery,
+	logs chan<- types.Log,
+) (ethereum.Subscription, error) {
+	subscription, err := mb.ContractBackend.SubscribeFilterLogs(ctx, query, logs)
+	mb.record("eth_subscribe", err)
+	return subscription, err
+}
+
+// blockCounterLag returns the number of blocks the block counter stays behind
+// the latest block reported by the Ethereum node.
+func (ec *ethereumChain) blockCounterLag() (float64, error) {
+	var latestBlock hexutil.Uint64
+	err := ec.clientWS.CallContext(
+		context.Background(),
+		&latestBlock,
+		"eth_blockNumber",
+	)
+	if err != nil {
+		return 0, fmt.Errorf("could not get latest block: [%v]", err)
+	}
+
+	currentBlock, err := ec.blockCounter.CurrentBlock()
+	if err != nil {
+		return 0, fmt.Errorf("could not get current block: [%v]", err)
+	}
+
+	if currentBlock >= uint64(latestBlock) {
+		return 0, nil
+	}
+
+	return float64(uint64(latestBlock) - currentBlock), nil
+}
+
+// observeMetrics records errors returned by the Ethereum node in the given
+// metrics recorder and starts observing the block counter lag. It has to be
+// called before contracts are attached so that their calls are recorded.
+func (ec *ethereumChain) observeMetrics(metricsRecorder metrics.Recorder) {
+	ec.client = &metricsBackend{
+		ContractBackend: ec.client,
+		recorder:        metricsRecorder,
+	}
+
+	go metrics.ObserveGauge(
+		context.Background(),
+		metricsRecorder,
+		blockCounterLagMetric,
+		metrics.DefaultObservationInterval,
+		ec.blockCounterLag,
+	)
+}
@@ -0,0 +1,55 @@
+package ethereum
+
+import (
+	"context"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/accounts/abi/bind/backends"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core"
+	"github.com/keep-network/keep-core/pkg/metrics"
+)
+
+func TestMetricsBackendCountsErrors(t *testing.T) {
+	recorder := metrics.NewPrometheusRecorder()
+	backend := &metricsBackend{
+		ContractBackend: backends.NewSimulatedBackend(
+			core.GenesisAlloc{},
+			10000000,
+		),
+		recorder: recorder,
+	}
+
+	address := common.HexToAddress("0xcf64c2a367341170cb4e09cf8c0ed137d8473ceb")
+
+	// the simulated backend supports only the latest block
+	if _, err := backend.CodeAt(context.Background(), address, nil); err != nil {
+		t.Fatal(err)
+	}
+	for i := 0; i < 2; i++ {
+		_, err := backend.CodeAt(context.Background(), address, big.NewInt(100))
+		if err == nil {
+			t.Fatal("expected error")
+		}
+	}
+
+	response := httptest.NewRecorder()
+	recorder.ServeHTTP(
+		response,
+		httptest.NewRequest(http.MethodGet, "/metrics", nil),
+	)
+
+	exposition := response.Body.String()
+	expectedValue := "ethereum_rpc_errors_total{method=\"eth_getCode\"} 2\n"
+	if !strings.Contains(exposition, expectedValue) {
+		t.Errorf(
+			"unexpected metrics\nexpected: %v\nactual:   %v\n",
+			expectedValue,
+			exposition,
+		)
+	}
+}
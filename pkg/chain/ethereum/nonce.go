@@ -0,0 +1,325 @@
+package ethereum
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/keep-network/keep-core/pkg/chain/gen/contract"
+)
+
+// nonceGapTimeout is the time after which a transaction sent from the node's
+// account is considered dropped if the Ethereum node still does not count
+// it in the account's pending nonce. The nonce of such transaction is reused
+// so that transactions with higher nonces do not wait for it forever.
+const nonceGapTimeout = 2 * time.Minute
+
+// replacementGasPriceBump is the percentage by which the gas price of
+// a transaction replacing a pending one is raised above the gas price of
+// the replaced transaction, so that Ethereum nodes accept the replacement.
+const replacementGasPriceBump = 10
+
+// pendingTransaction is a transaction sent from the node's account which may
+// be not yet counted in the account's pending nonce reported by the Ethereum
+// node.
+type pendingTransaction struct {
+	gasPrice *big.Int
+	sentAt   time.Time
+}
+
+// accountNonces tracks nonces of transactions sent from a single account.
+type accountNonces struct {
+	next    uint64
+	pending map[uint64]*pendingTransaction
+}
+
+// nonceManager tracks nonces of transactions sent from the node's accounts.
+// The pending nonce reported by the Ethereum node lags behind transactions
+// sent just before, e.g. when requests are balanced between several nodes,
+// so transactions sent shortly one after another get the same nonce and all
+// but the first one fail with "nonce too low". The manager hands out nonces
+// following the ones it has already handed out instead, and reuses the nonce
+// of a transaction the node has not counted for too long.
+type nonceManager struct {
+	mutex    sync.Mutex
+	accounts map[common.Address]*accountNonces
+
+	now func() time.Time
+}
+
+func newNonceManager() *nonceManager {
+	return &nonceManager{
+		accounts: make(map[common.Address]*accountNonces),
+		now:      time.Now,
+	}
+}
+
+// nonceAt returns the nonce of the next transaction sent from the given
+// account given the pending nonce reported by the Ethereum node. If the nonce
+// is taken by a tracked transaction considered dropped, it also returns the
+// minimum gas price of the transaction replacing it.
+func (nm *nonceManager) nonceAt(
+	account common.Address,
+	chainNonce uint64,
+) (uint64, *big.Int) {
+	nm.mutex.Lock()
+	defer nm.mutex.Unlock()
+
+	nonces, ok := nm.accounts[account]
+	if !ok {
+		nonces = &accountNonces{pending: make(map[uint64]*pendingTransaction)}
+		nm.accounts[account] = nonces
+	}
+
+	// Transactions counted by the node no longer need to be tracked.
+	for nonce := range nonces.pending {
+		if nonce < chainNonce {
+			delete(nonces.pending, nonce)
+		}
+	}
+
+	if nonces.next <= chainNonce {
+		nonces.next = chainNonce
+		return chainNonce, nil
+	}
+
+	gapTransaction, ok := nonces.pending[chainNonce]
+	if !ok {
+		logger.Warningf(
+			"nonce gap detected for account [%v]; "+
+				"transaction with nonce [%v] is not tracked; reusing the nonce",
+			account.Hex(),
+			chainNonce,
+		)
+		return chainNonce, nil
+	}
+
+	if nm.now().Sub(gapTransaction.sentAt) >= nonceGapTimeout {
+		logger.Warningf(
+			"nonce gap detected for account [%v]; "+
+				"transaction with nonce [%v] has not been counted by "+
+				"the Ethereum node for [%v]; replacing the transaction",
+			account.Hex(),
+			chainNonce,
+			nm.now().Sub(gapTransaction.sentAt),
+		)
+		return chainNonce, replacementGasPrice(gapTransaction.gasPrice)
+	}
+
+	return nonces.next, nil
+}
+
+// sent records the transaction with the given nonce and gas price has been
+// sent from the given account.
+func (nm *nonceManager) sent(
+	account common.Address,
+	nonce uint64,
+	gasPrice *big.Int,
+) {
+	nm.mutex.Lock()
+	defer nm.mutex.Unlock()
+
+	nonces, ok := nm.accounts[account]
+	if !ok {
+		nonces = &accountNonces{pending: make(map[uint64]*pendingTransaction)}
+		nm.accounts[account] = nonces
+	}
+
+	nonces.pending[nonce] = &pendingTransaction{
+		gasPrice: gasPrice,
+		sentAt:   nm.now(),
+	}
+	if nonce >= nonces.next {
+		nonces.next = nonce + 1
+	}
+}
+
+// reset forgets the nonces tracked for the given account so that the next
+// transaction uses the pending nonce reported by the Ethereum node.
+func (nm *nonceManager) reset(account common.Address) {
+	nm.mutex.Lock()
+	defer nm.mutex.Unlock()
+
+	delete(nm.accounts, account)
+}
+
+func replacementGasPrice(gasPrice *big.Int) *big.Int {
+	replacement := new(big.Int).Mul(
+		gasPrice,
+		big.NewInt(100+replacementGasPriceBump),
+	)
+	replacement.Div(replacement, big.NewInt(100))
+	return replacement.Add(replacement, big.NewInt(1))
+}
+
+// nonceBackend is a contract backend handing out nonces of submitted
+// transactions with the nonce manager. Transactions replacing a transaction
+// considered dropped are priced high enough to replace it. If the Ethereum
+// node rejects a transaction because of its nonce, nonces tracked for the
+// sender are reset. All other calls are handled by the wrapped backend.
+type nonceBackend struct {
+	bind.ContractBackend
+
+	nonces *nonceManager
+	signer types.Signer
+
+	mutex sync.Mutex
+	// replacements holds minimum gas prices of transactions about to replace
+	// dropped transactions, per account. The gas price is suggested without
+	// knowing the sender of the transaction, so the highest of them applies
+	// to any transaction priced in the meantime.
+	replacements map[common.Address]*big.Int
+}
+
+func newNonceBackend(
+	backend bind.ContractBackend,
+	chainID *big.Int,
+) *nonceBackend {
+	return &nonceBackend{
+		ContractBackend: backend,
+		nonces:          newNonceManager(),
+		signer:          types.NewEIP155Signer(chainID),
+		replacements:    make(map[common.Address]*big.Int),
+	}
+}
+
+// PendingNonceAt returns the nonce of the next transaction sent from the given
+// account.
+func (nb *nonceBackend) PendingNonceAt(
+	ctx context.Context,
+	account common.Address,
+) (uint64, error) {
+	chainNonce, err := nb.ContractBackend.PendingNonceAt(ctx, account)
+	if err != nil {
+		return 0, err
+	}
+
+	nonce, replacementGasPrice := nb.nonces.nonceAt(account, chainNonce)
+
+	nb.mutex.Lock()
+	if replacementGasPrice != nil {
+		nb.replacements[account] = replacementGasPrice
+	} else {
+		delete(nb.replacements, account)
+	}
+	nb.mutex.Unlock()
+
+	return nonce, nil
+}
+
+// SuggestGasPrice returns the gas price suggested by the wrapped backend
+// raised to the gas price replacing a dropped transaction, if any is about
+// to be submitted.
+func (nb *nonceBackend) SuggestGasPrice(ctx context.Context) (*big.Int, error) {
+	gasPrice, err := nb.ContractBackend.SuggestGasPrice(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	nb.mutex.Lock()
+	defer nb.mutex.Unlock()
+
+	for _, replacementGasPrice := range nb.replacements {
+		if gasPrice.Cmp(replacementGasPrice) < 0 {
+			gasPrice = new(big.Int).Set(replacementGasPrice)
+		}
+	}
+
+	return gasPrice, nil
+}
+
+// SendTransaction sends the transaction with the wrapped backend and tracks
+// its nonce.
+func (nb *nonceBackend) SendTransaction(
+	ctx context.Context,
+	transaction *types.Transaction,
+) error {
+	err := nb.ContractBackend.SendTransaction(ctx, transaction)
+
+	sender, senderErr := types.Sender(nb.signer, transaction)
+	if senderErr != nil {
+		logger.Warningf(
+			"could not track nonce of transaction [%v]: [%v]",
+			transaction.Hash().Hex(),
+			senderErr,
+		)
+		return err
+	}
+
+	nb.mutex.Lock()
+	delete(nb.replacements, sender)
+	nb.mutex.Unlock()
+
+	if err != nil {
+		if isNonceRejection(err) {
+			logger.Warningf(
+				"transaction with nonce [%v] from account [%v] rejected; "+
+					"resetting tracked nonces: [%v]",
+				transaction.Nonce(),
+				sender.Hex(),
+				err,
+			)
+			nb.nonces.reset(sender)
+		}
+		return err
+	}
+
+	nb.nonces.sent(sender, transaction.Nonce(), transaction.GasPrice())
+
+	return nil
+}
+
+// trackNonces makes nonces of relay entry, ticket and DKG result submission
+// transactions handed out by the nonce manager. It has to be called after
+// the gas price is configured, so that replacement transactions are priced
+// above the gas price strategy, and before the private relay is configured.
+// Transactions sent to the private relay are not tracked as they are not
+// visible to the Ethereum node until included.
+func (ec *ethereumChain) trackNonces() error {
+	backend := newNonceBackend(ec.client, ec.chainID)
+
+	address, err := addressForContract(ec.config, "KeepRandomBeaconOperator")
+	if err != nil {
+		return fmt.Errorf(
+			"error resolving KeepRandomBeaconOperator contract: [%v]",
+			err,
+		)
+	}
+
+	operatorContract, err := contract.NewKeepRandomBeaconOperator(
+		*address,
+		ec.accountKey,
+		backend,
+		ec.transactionMutex,
+	)
+	if err != nil {
+		return fmt.Errorf(
+			"error attaching to KeepRandomBeaconOperator contract "+
+				"with nonce tracking: [%v]",
+			err,
+		)
+	}
+
+	ec.client = backend
+	ec.keepRandomBeaconOperatorContract = operatorContract
+	ec.dkgResultSubmitterContract = operatorContract
+
+	return nil
+}
+
+// isNonceRejection checks if the given transaction submission error is caused
+// by the nonce of the transaction being already used. Ethereum nodes report it
+// only as an error message so the message is matched. A transaction the node
+// already knows does not count since its nonce is still valid.
+func isNonceRejection(err error) bool {
+	message := strings.ToLower(err.Error())
+
+	return strings.Contains(message, "nonce too low") ||
+		strings.Contains(message, "replacement transaction underpriced")
+}
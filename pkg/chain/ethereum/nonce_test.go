@@ -0,0 +1,158 @@
+package ethereum
+
+import (
+	"context"
+	"math/big"
+	"reflect"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind/backends"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core"
+	"github.com/keep-network/keep-common/pkg/chain/ethereum"
+)
+
+func TestNonceManagerNonceAt(t *testing.T) {
+	account := common.HexToAddress("0x6Ffba2D0F4C8FD7263F546afAaf25Fe2d56f6044")
+
+	var tests = map[string]struct {
+		sentNonces                  []uint64
+		sentAgo                     time.Duration
+		chainNonce                  uint64
+		expectedNonce               uint64
+		expectedReplacementGasPrice *big.Int
+	}{
+		"no transactions sent": {
+			chainNonce:    5,
+			expectedNonce: 5,
+		},
+		"sent transactions counted by the node": {
+			sentNonces:    []uint64{5, 6},
+			chainNonce:    7,
+			expectedNonce: 7,
+		},
+		"sent transactions not yet counted by the node": {
+			sentNonces:    []uint64{5, 6},
+			chainNonce:    5,
+			expectedNonce: 7,
+		},
+		"sent transaction dropped": {
+			sentNonces:                  []uint64{5, 6},
+			sentAgo:                     nonceGapTimeout,
+			chainNonce:                  6,
+			expectedNonce:               6,
+			expectedReplacementGasPrice: big.NewInt(111),
+		},
+	}
+
+	for testName, test := range tests {
+		t.Run(testName, func(t *testing.T) {
+			now := time.Now()
+
+			manager := newNonceManager()
+			manager.now = func() time.Time { return now }
+
+			for _, nonce := range test.sentNonces {
+				manager.sent(account, nonce, big.NewInt(100))
+			}
+			now = now.Add(test.sentAgo)
+
+			nonce, replacementGasPrice := manager.nonceAt(
+				account,
+				test.chainNonce,
+			)
+
+			if nonce != test.expectedNonce {
+				t.Errorf(
+					"unexpected nonce\nexpected: %v\nactual:   %v\n",
+					test.expectedNonce,
+					nonce,
+				)
+			}
+			if !reflect.DeepEqual(
+				test.expectedReplacementGasPrice,
+				replacementGasPrice,
+			) {
+				t.Errorf(
+					"unexpected replacement gas price\n"+
+						"expected: %v\nactual:   %v\n",
+					test.expectedReplacementGasPrice,
+					replacementGasPrice,
+				)
+			}
+		})
+	}
+}
+
+// laggingBackend reports the pending nonce the Ethereum node reported before
+// any transaction has been sent.
+type laggingBackend struct {
+	bind.ContractBackend
+}
+
+func (lb *laggingBackend) PendingNonceAt(
+	ctx context.Context,
+	account common.Address,
+) (uint64, error) {
+	return 0, nil
+}
+
+func TestTrackNoncesWithLaggingNode(t *testing.T) {
+	operatorKey, err := newTestKey()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	operatorContractAddress := "0xcf64c2a367341170cb4e09cf8c0ed137d8473ceb"
+
+	// The operator contract is stubbed with code accepting any call.
+	backend := backends.NewSimulatedBackend(
+		core.GenesisAlloc{
+			operatorKey.Address: {Balance: big.NewInt(1000000000000000000)},
+			common.HexToAddress(operatorContractAddress): {
+				Code:    []byte{0x00}, // STOP
+				Balance: big.NewInt(0),
+			},
+		},
+		10000000,
+	)
+	ec := &ethereumChain{
+		config: ethereum.Config{
+			ContractAddresses: map[string]string{
+				"KeepRandomBeaconOperator": operatorContractAddress,
+			},
+		},
+		client:           &laggingBackend{backend},
+		receiptBackend:   backend,
+		accountKey:       operatorKey,
+		transactionMutex: &sync.Mutex{},
+	}
+
+	if err := ec.trackNonces(); err != nil {
+		t.Fatal(err)
+	}
+
+	for expectedNonce := uint64(0); expectedNonce < 3; expectedNonce++ {
+		transaction, err := ec.dkgResultSubmitterContract.SubmitDkgResult(
+			big.NewInt(1),
+			[]byte{123, 45},
+			[]byte{},
+			[]byte{},
+			[]*big.Int{},
+		)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if transaction.Nonce() != expectedNonce {
+			t.Errorf(
+				"unexpected nonce\nexpected: %v\nactual:   %v\n",
+				expectedNonce,
+				transaction.Nonce(),
+			)
+		}
+	}
+}
@@ -0,0 +1,328 @@
+package ethereum
+
+import (
+	"bytes"
+	"context"
+	"crypto/ecdsa"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"time"
+
+	"github.com/ethereum/go-ethereum/accounts"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/rlp"
+	"github.com/keep-network/keep-core/pkg/chain/gen/contract"
+)
+
+const (
+	// DefaultPrivateRelayMaxBlocks is the default number of blocks within
+	// which the private relay should include the submission transaction.
+	DefaultPrivateRelayMaxBlocks = 25
+	// DefaultPrivateRelayTimeout is the maximum time a single request to the
+	// private relay may take.
+	DefaultPrivateRelayTimeout = 10 * time.Second
+)
+
+// privateRelaySignatureHeader is the header authenticating requests to the
+// private relay with the signature of the request body.
+const privateRelaySignatureHeader = "X-Flashbots-Signature"
+
+// privateRelayInclusionPollInterval is the interval at which the chain is
+// checked for the inclusion of transactions sent to the private relay.
+var privateRelayInclusionPollInterval = 15 * time.Second
+
+// PrivateRelayConfig configures submission of DKG results through a private
+// transaction relay instead of the public mempool, so that submissions are
+// not front-run and do not fail when the mempool is congested.
+type PrivateRelayConfig struct {
+	// URL of the private relay JSON-RPC endpoint accepting
+	// eth_sendPrivateTransaction requests. If not set, DKG results are
+	// submitted to the public mempool.
+	URL string
+	// MaxBlocks is the number of blocks after the current one within which
+	// the relay should include the transaction. The relay drops the
+	// transaction afterwards. If not set, DefaultPrivateRelayMaxBlocks is
+	// used.
+	MaxBlocks uint64
+}
+
+func (prc PrivateRelayConfig) maxBlocks() uint64 {
+	if prc.MaxBlocks == 0 {
+		return DefaultPrivateRelayMaxBlocks
+	}
+	return prc.MaxBlocks
+}
+
+// privateRelay sends signed transactions to a private relay endpoint.
+// Requests are authenticated with the signature of the request body made
+// with the key the transactions are signed with.
+type privateRelay struct {
+	url        string
+	signingKey *ecdsa.PrivateKey
+	client     *http.Client
+}
+
+func newPrivateRelay(url string, signingKey *ecdsa.PrivateKey) *privateRelay {
+	return &privateRelay{
+		url:        url,
+		signingKey: signingKey,
+		client:     &http.Client{Timeout: DefaultPrivateRelayTimeout},
+	}
+}
+
+type privateRelayRequest struct {
+	JSONRPC string                          `json:"jsonrpc"`
+	ID      int                             `json:"id"`
+	Method  string                          `json:"method"`
+	Params  []privateRelayTransactionParams `json:"params"`
+}
+
+type privateRelayTransactionParams struct {
+	Tx             string `json:"tx"`
+	MaxBlockNumber string `json:"maxBlockNumber"`
+}
+
+type privateRelayResponse struct {
+	Result string `json:"result"`
+	Error  *struct {
+		Code    int    `json:"code"`
+		Message string `json:"message"`
+	} `json:"error"`
+}
+
+// send sends the signed transaction to the private relay to be included
+// not later than in the block with the given number.
+func (pr *privateRelay) send(
+	ctx context.Context,
+	transaction *types.Transaction,
+	maxBlockNumber uint64,
+) error {
+	rawTransaction, err := rlp.EncodeToBytes(transaction)
+	if err != nil {
+		return fmt.Errorf("could not encode transaction: [%v]", err)
+	}
+
+	body, err := json.Marshal(&privateRelayRequest{
+		JSONRPC: "2.0",
+		ID:      1,
+		Method:  "eth_sendPrivateTransaction",
+		Params: []privateRelayTransactionParams{{
+			Tx:             hexutil.Encode(rawTransaction),
+			MaxBlockNumber: hexutil.EncodeUint64(maxBlockNumber),
+		}},
+	})
+	if err != nil {
+		return fmt.Errorf("could not encode request: [%v]", err)
+	}
+
+	signature, err := pr.sign(body)
+	if err != nil {
+		return fmt.Errorf("could not sign request: [%v]", err)
+	}
+
+	request, err := http.NewRequest(http.MethodPost, pr.url, bytes.NewReader(body))
+	if err != nil {
+		return err
+	}
+	request = request.WithContext(ctx)
+	request.Header.Set("Content-Type", "application/json")
+	request.Header.Set(privateRelaySignatureHeader, signature)
+
+	response, err := pr.client.Do(request)
+	if err != nil {
+		return err
+	}
+	defer response.Body.Close()
+
+	responseBody, err := ioutil.ReadAll(response.Body)
+	if err != nil {
+		return fmt.Errorf("could not read response: [%v]", err)
+	}
+
+	if response.StatusCode != http.StatusOK {
+		return fmt.Errorf(
+			"unexpected response status [%v]: [%s]",
+			response.StatusCode,
+			responseBody,
+		)
+	}
+
+	relayResponse := &privateRelayResponse{}
+	if err := json.Unmarshal(responseBody, relayResponse); err != nil {
+		return fmt.Errorf("could not decode response: [%v]", err)
+	}
+
+	if relayResponse.Error != nil {
+		return fmt.Errorf(
+			"relay rejected transaction: [%v]",
+			relayResponse.Error.Message,
+		)
+	}
+
+	if relayResponse.Result != transaction.Hash().Hex() {
+		return fmt.Errorf(
+			"relay accepted transaction [%v] instead of [%v]",
+			relayResponse.Result,
+			transaction.Hash().Hex(),
+		)
+	}
+
+	return nil
+}
+
+// sign returns the signature header value for the given request body: the
+// signer address and the signature of the hex-encoded Keccak-256 hash of the
+// body, separated with a colon.
+func (pr *privateRelay) sign(body []byte) (string, error) {
+	bodyHash := crypto.Keccak256Hash(body).Hex()
+
+	signature, err := crypto.Sign(
+		accounts.TextHash([]byte(bodyHash)),
+		pr.signingKey,
+	)
+	if err != nil {
+		return "", err
+	}
+
+	return fmt.Sprintf(
+		"%v:%v",
+		crypto.PubkeyToAddress(pr.signingKey.PublicKey).Hex(),
+		hexutil.Encode(signature),
+	), nil
+}
+
+// privateRelayBackend is a contract backend sending transactions to the
+// private relay instead of the public mempool. All other calls are handled by
+// the wrapped backend.
+type privateRelayBackend struct {
+	bind.ContractBackend
+
+	relay        *privateRelay
+	receipts     bind.DeployBackend
+	currentBlock func() (uint64, error)
+	maxBlocks    uint64
+}
+
+// SendTransaction sends the signed transaction to the private relay and
+// watches the chain for its inclusion in the background. The relay drops the
+// transaction if it is not included within the configured number of blocks.
+func (prb *privateRelayBackend) SendTransaction(
+	ctx context.Context,
+	transaction *types.Transaction,
+) error {
+	currentBlock, err := prb.currentBlock()
+	if err != nil {
+		return fmt.Errorf("could not get current block: [%v]", err)
+	}
+
+	maxBlockNumber := currentBlock + prb.maxBlocks
+
+	if err := prb.relay.send(ctx, transaction, maxBlockNumber); err != nil {
+		return fmt.Errorf(
+			"could not send transaction [%v] to private relay: [%v]",
+			transaction.Hash().Hex(),
+			err,
+		)
+	}
+
+	logger.Infof(
+		"sent transaction [%v] to private relay for inclusion "+
+			"not later than block [%v]",
+		transaction.Hash().Hex(),
+		maxBlockNumber,
+	)
+
+	go prb.watchInclusion(transaction, maxBlockNumber)
+
+	return nil
+}
+
+// watchInclusion waits until the transaction is mined or the given block
+// number is passed. Transactions sent to the private relay are not visible in
+// the public mempool, so the only confirmation of the submission is the
+// receipt of the mined transaction.
+func (prb *privateRelayBackend) watchInclusion(
+	transaction *types.Transaction,
+	maxBlockNumber uint64,
+) bool {
+	ticker := time.NewTicker(privateRelayInclusionPollInterval)
+	defer ticker.Stop()
+
+	for {
+		receipt, err := prb.receipts.TransactionReceipt(
+			context.Background(),
+			transaction.Hash(),
+		)
+		if err == nil && receipt != nil {
+			logger.Infof(
+				"transaction [%v] sent to private relay included in block [%v]",
+				transaction.Hash().Hex(),
+				receipt.BlockNumber,
+			)
+			return true
+		}
+
+		currentBlock, err := prb.currentBlock()
+		if err == nil && currentBlock > maxBlockNumber {
+			logger.Warningf(
+				"transaction [%v] sent to private relay has not been "+
+					"included until block [%v]; the relay dropped it",
+				transaction.Hash().Hex(),
+				maxBlockNumber,
+			)
+			return false
+		}
+
+		<-ticker.C
+	}
+}
+
+// usePrivateRelay makes DKG result submission transactions sent to the private
+// relay instead of the public mempool. The given function returns the current
+// block the relay inclusion deadline is counted from.
+func (ec *ethereumChain) usePrivateRelay(
+	config PrivateRelayConfig,
+	currentBlock func() (uint64, error),
+) error {
+	address, err := addressForContract(ec.config, "KeepRandomBeaconOperator")
+	if err != nil {
+		return fmt.Errorf(
+			"error resolving KeepRandomBeaconOperator contract: [%v]",
+			err,
+		)
+	}
+
+	submitterContract, err := contract.NewKeepRandomBeaconOperator(
+		*address,
+		ec.accountKey,
+		&privateRelayBackend{
+			ContractBackend: ec.client,
+			relay:           newPrivateRelay(config.URL, ec.accountKey.PrivateKey),
+			receipts:        ec.receiptBackend,
+			currentBlock:    currentBlock,
+			maxBlocks:       config.maxBlocks(),
+		},
+		ec.transactionMutex,
+	)
+	if err != nil {
+		return fmt.Errorf(
+			"error attaching to KeepRandomBeaconOperator contract "+
+				"with private relay: [%v]",
+			err,
+		)
+	}
+
+	ec.dkgResultSubmitterContract = submitterContract
+
+	logger.Infof(
+		"DKG results will be submitted through private relay [%v]",
+		config.URL,
+	)
+
+	return nil
+}
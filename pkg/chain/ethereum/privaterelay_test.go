@@ -0,0 +1,303 @@
+package ethereum
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/accounts"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind/backends"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/core"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/rlp"
+	"github.com/keep-network/keep-common/pkg/chain/ethereum"
+)
+
+type mockPrivateRelay struct {
+	server *httptest.Server
+
+	body      []byte
+	signature string
+	// response returns the relay response for the received request.
+	response func(request *privateRelayRequest) string
+}
+
+func newMockPrivateRelay(
+	response func(request *privateRelayRequest) string,
+) *mockPrivateRelay {
+	relay := &mockPrivateRelay{response: response}
+	relay.server = httptest.NewServer(http.HandlerFunc(
+		func(w http.ResponseWriter, r *http.Request) {
+			relay.body, _ = ioutil.ReadAll(r.Body)
+			relay.signature = r.Header.Get(privateRelaySignatureHeader)
+
+			request := &privateRelayRequest{}
+			if err := json.Unmarshal(relay.body, request); err != nil {
+				w.WriteHeader(http.StatusBadRequest)
+				return
+			}
+
+			w.Header().Set("Content-Type", "application/json")
+			fmt.Fprint(w, relay.response(request))
+		},
+	))
+
+	return relay
+}
+
+func acceptingPrivateRelay(request *privateRelayRequest) string {
+	rawTransaction, _ := hexutil.Decode(request.Params[0].Tx)
+	transaction := &types.Transaction{}
+	_ = rlp.DecodeBytes(rawTransaction, transaction)
+
+	return fmt.Sprintf(
+		`{"jsonrpc":"2.0","id":1,"result":"%v"}`,
+		transaction.Hash().Hex(),
+	)
+}
+
+func newPrivateRelayTestChain(
+	t *testing.T,
+	relayURL string,
+) (*ethereumChain, *backends.SimulatedBackend) {
+	operatorKey, err := newTestKey()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	operatorContractAddress := "0xcf64c2a367341170cb4e09cf8c0ed137d8473ceb"
+
+	// The operator contract is stubbed with code accepting any call.
+	backend := backends.NewSimulatedBackend(
+		core.GenesisAlloc{
+			operatorKey.Address: {Balance: big.NewInt(1000000000000000000)},
+			common.HexToAddress(operatorContractAddress): {
+				Code:    []byte{0x00}, // STOP
+				Balance: big.NewInt(0),
+			},
+		},
+		10000000,
+	)
+	ec := &ethereumChain{
+		config: ethereum.Config{
+			ContractAddresses: map[string]string{
+				"KeepRandomBeaconOperator": operatorContractAddress,
+			},
+		},
+		client:           backend,
+		receiptBackend:   backend,
+		accountKey:       operatorKey,
+		transactionMutex: &sync.Mutex{},
+	}
+
+	err = ec.usePrivateRelay(
+		PrivateRelayConfig{URL: relayURL, MaxBlocks: 10},
+		func() (uint64, error) { return 100, nil },
+	)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	return ec, backend
+}
+
+func TestSubmitDKGResultThroughPrivateRelay(t *testing.T) {
+	relay := newMockPrivateRelay(acceptingPrivateRelay)
+	defer relay.server.Close()
+
+	ec, backend := newPrivateRelayTestChain(t, relay.server.URL)
+
+	transaction, err := ec.dkgResultSubmitterContract.SubmitDkgResult(
+		big.NewInt(1),
+		[]byte{123, 45},
+		[]byte{},
+		[]byte{},
+		[]*big.Int{},
+	)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	request := &privateRelayRequest{}
+	if err := json.Unmarshal(relay.body, request); err != nil {
+		t.Fatal(err)
+	}
+
+	if request.Method != "eth_sendPrivateTransaction" {
+		t.Errorf(
+			"unexpected method\nexpected: %v\nactual:   %v\n",
+			"eth_sendPrivateTransaction",
+			request.Method,
+		)
+	}
+
+	expectedRawTransaction, err := rlp.EncodeToBytes(transaction)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if request.Params[0].Tx != hexutil.Encode(expectedRawTransaction) {
+		t.Errorf(
+			"unexpected transaction\nexpected: %v\nactual:   %v\n",
+			hexutil.Encode(expectedRawTransaction),
+			request.Params[0].Tx,
+		)
+	}
+
+	// current block 100 plus 10 blocks
+	if request.Params[0].MaxBlockNumber != "0x6e" {
+		t.Errorf(
+			"unexpected max block number\nexpected: %v\nactual:   %v\n",
+			"0x6e",
+			request.Params[0].MaxBlockNumber,
+		)
+	}
+
+	signatureParts := strings.Split(relay.signature, ":")
+	if len(signatureParts) != 2 {
+		t.Fatalf("unexpected signature header [%v]", relay.signature)
+	}
+	signature, err := hexutil.Decode(signatureParts[1])
+	if err != nil {
+		t.Fatal(err)
+	}
+	signerPublicKey, err := crypto.SigToPub(
+		accounts.TextHash([]byte(crypto.Keccak256Hash(relay.body).Hex())),
+		signature,
+	)
+	if err != nil {
+		t.Fatal(err)
+	}
+	signer := crypto.PubkeyToAddress(*signerPublicKey).Hex()
+	if signer != ec.accountKey.Address.Hex() ||
+		signatureParts[0] != ec.accountKey.Address.Hex() {
+		t.Errorf(
+			"unexpected request signer\nexpected: %v\nactual:   %v\n",
+			ec.accountKey.Address.Hex(),
+			signer,
+		)
+	}
+
+	// the transaction must not reach the public mempool
+	backend.Commit()
+	nonce, err := backend.NonceAt(context.Background(), ec.accountKey.Address, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if nonce != 0 {
+		t.Errorf(
+			"unexpected public transactions count\nexpected: %v\nactual:   %v\n",
+			0,
+			nonce,
+		)
+	}
+}
+
+func TestSubmitDKGResultRejectedByPrivateRelay(t *testing.T) {
+	relay := newMockPrivateRelay(func(request *privateRelayRequest) string {
+		return `{"jsonrpc":"2.0","id":1,"error":{"code":-32000,"message":"nonce too low"}}`
+	})
+	defer relay.server.Close()
+
+	ec, _ := newPrivateRelayTestChain(t, relay.server.URL)
+
+	_, err := ec.dkgResultSubmitterContract.SubmitDkgResult(
+		big.NewInt(1),
+		[]byte{123, 45},
+		[]byte{},
+		[]byte{},
+		[]*big.Int{},
+	)
+	if err == nil || !strings.Contains(err.Error(), "nonce too low") {
+		t.Errorf(
+			"unexpected error\nexpected: %v\nactual:   %v\n",
+			"relay rejected transaction: [nonce too low]",
+			err,
+		)
+	}
+}
+
+func TestPrivateRelayTransactionInclusion(t *testing.T) {
+	var tests = map[string]struct {
+		mined            bool
+		currentBlock     uint64
+		expectedIncluded bool
+	}{
+		"transaction mined": {
+			mined:            true,
+			currentBlock:     105,
+			expectedIncluded: true,
+		},
+		"transaction not mined after max block": {
+			mined:            false,
+			currentBlock:     111,
+			expectedIncluded: false,
+		},
+	}
+
+	for testName, test := range tests {
+		t.Run(testName, func(t *testing.T) {
+			key, err := newTestKey()
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			backend := backends.NewSimulatedBackend(
+				core.GenesisAlloc{
+					key.Address: {Balance: big.NewInt(1000000000000000000)},
+				},
+				10000000,
+			)
+
+			transaction, err := types.SignTx(
+				types.NewTransaction(
+					0,
+					key.Address,
+					big.NewInt(1),
+					21000,
+					big.NewInt(1),
+					nil,
+				),
+				types.HomesteadSigner{},
+				key.PrivateKey,
+			)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			if test.mined {
+				err := backend.SendTransaction(context.Background(), transaction)
+				if err != nil {
+					t.Fatal(err)
+				}
+				backend.Commit()
+			}
+
+			relayBackend := &privateRelayBackend{
+				ContractBackend: backend,
+				receipts:        backend,
+				currentBlock: func() (uint64, error) {
+					return test.currentBlock, nil
+				},
+			}
+
+			included := relayBackend.watchInclusion(transaction, 110)
+			if included != test.expectedIncluded {
+				t.Errorf(
+					"unexpected inclusion\nexpected: %v\nactual:   %v\n",
+					test.expectedIncluded,
+					included,
+				)
+			}
+		})
+	}
+}
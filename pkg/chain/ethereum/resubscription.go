@@ -0,0 +1,361 @@
+package ethereum
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+	"strings"
+	"time"
+
+	"github.com/ethereum/go-ethereum"
+	ethabi "github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/keep-network/keep-core/pkg/chain/gen/abi"
+	"github.com/keep-network/keep-core/pkg/subscription"
+)
+
+const (
+	// resubscriptionMinBackoff is the delay before the first attempt to
+	// re-establish a failed event subscription. The delay doubles with each
+	// failed attempt.
+	resubscriptionMinBackoff = time.Second
+	// resubscriptionMaxBackoff is the maximum delay between attempts to
+	// re-establish a failed event subscription.
+	resubscriptionMaxBackoff = 2 * time.Minute
+	// resubscriptionReplayMargin is the number of blocks before the last
+	// block seen when the subscription failed from which missed events are
+	// replayed. Events of the last seen blocks might have not been delivered
+	// before the subscription failed.
+	resubscriptionReplayMargin = 2
+	// deliveredLogsCapacity is the number of recently delivered logs
+	// remembered by each subscription so that logs both replayed and received
+	// from the re-established subscription are delivered once.
+	deliveredLogsCapacity = 100
+)
+
+// logKey identifies a log by the transaction it was emitted in and its index
+// in the block.
+type logKey struct {
+	transactionHash common.Hash
+	index           uint
+}
+
+// deliveredLogs remembers a fixed number of most recently delivered logs.
+type deliveredLogs struct {
+	capacity int
+	keys     map[logKey]bool
+	order    []logKey
+}
+
+func newDeliveredLogs(capacity int) *deliveredLogs {
+	return &deliveredLogs{
+		capacity: capacity,
+		keys:     make(map[logKey]bool),
+		order:    make([]logKey, 0, capacity),
+	}
+}
+
+// add remembers the given log as delivered and returns true if it has not
+// been delivered before.
+func (dl *deliveredLogs) add(log types.Log) bool {
+	key := logKey{log.TxHash, log.Index}
+	if dl.keys[key] {
+		return false
+	}
+
+	if len(dl.order) == dl.capacity {
+		delete(dl.keys, dl.order[0])
+		dl.order = dl.order[1:]
+	}
+
+	dl.keys[key] = true
+	dl.order = append(dl.order, key)
+
+	return true
+}
+
+// logWatch keeps a subscription to logs matching the query alive. If the
+// subscription fails, for example because the WebSocket connection to the
+// Ethereum node dropped, it is re-established with an exponential backoff
+// and logs emitted since the last block seen before the failure are replayed.
+type logWatch struct {
+	name         string
+	filterer     bind.ContractFilterer
+	query        ethereum.FilterQuery
+	currentBlock func() (uint64, error)
+	handle       func(log types.Log)
+
+	minBackoff time.Duration
+	maxBackoff time.Duration
+
+	delivered *deliveredLogs
+}
+
+// watchLogs subscribes to logs of the operator contract event with the given
+// name and passes each of them to the handler. It returns an error if the
+// initial subscription could not be established. Once established, the
+// subscription is re-established each time it fails and logs emitted in the
+// meantime are replayed, so that the handler observes events even if the
+// connection to the Ethereum node drops.
+func (ec *ethereumChain) watchLogs(
+	eventName string,
+	handle func(log types.Log),
+) (subscription.EventSubscription, error) {
+	address, err := addressForContract(ec.config, "KeepRandomBeaconOperator")
+	if err != nil {
+		return nil, fmt.Errorf(
+			"error resolving KeepRandomBeaconOperator contract: [%v]",
+			err,
+		)
+	}
+
+	operatorABI, err := ethabi.JSON(
+		strings.NewReader(abi.KeepRandomBeaconOperatorABI),
+	)
+	if err != nil {
+		return nil, fmt.Errorf(
+			"could not parse KeepRandomBeaconOperator ABI: [%v]",
+			err,
+		)
+	}
+
+	event, ok := operatorABI.Events[eventName]
+	if !ok {
+		return nil, fmt.Errorf("unknown event [%v]", eventName)
+	}
+
+	watch := &logWatch{
+		name:     eventName,
+		filterer: ec.client,
+		query: ethereum.FilterQuery{
+			Addresses: []common.Address{*address},
+			Topics:    [][]common.Hash{{event.ID()}},
+		},
+		currentBlock: ec.blockCounter.CurrentBlock,
+		handle:       handle,
+		minBackoff:   resubscriptionMinBackoff,
+		maxBackoff:   resubscriptionMaxBackoff,
+		delivered:    newDeliveredLogs(deliveredLogsCapacity),
+	}
+
+	return watch.start()
+}
+
+// operatorFilterer returns the filterer parsing logs of the operator contract
+// events.
+func (ec *ethereumChain) operatorFilterer() (
+	*abi.KeepRandomBeaconOperatorFilterer,
+	error,
+) {
+	address, err := addressForContract(ec.config, "KeepRandomBeaconOperator")
+	if err != nil {
+		return nil, fmt.Errorf(
+			"error resolving KeepRandomBeaconOperator contract: [%v]",
+			err,
+		)
+	}
+
+	filterer, err := abi.NewKeepRandomBeaconOperatorFilterer(*address, ec.client)
+	if err != nil {
+		return nil, fmt.Errorf(
+			"error attaching to KeepRandomBeaconOperator contract: [%v]",
+			err,
+		)
+	}
+
+	return filterer, nil
+}
+
+// start establishes the subscription and keeps it alive in the background
+// until unsubscribed.
+func (lw *logWatch) start() (subscription.EventSubscription, error) {
+	ctx, cancelCtx := context.WithCancel(context.Background())
+
+	logSubscription, logs, err := lw.subscribe(ctx)
+	if err != nil {
+		cancelCtx()
+		return nil, fmt.Errorf(
+			"could not subscribe to [%v] events: [%v]",
+			lw.name,
+			err,
+		)
+	}
+
+	go lw.watch(ctx, logSubscription, logs)
+
+	return subscription.NewEventSubscription(cancelCtx), nil
+}
+
+func (lw *logWatch) subscribe(
+	ctx context.Context,
+) (ethereum.Subscription, <-chan types.Log, error) {
+	logs := make(chan types.Log)
+
+	logSubscription, err := lw.filterer.SubscribeFilterLogs(ctx, lw.query, logs)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return logSubscription, logs, nil
+}
+
+// watch delivers logs received from the subscription and re-establishes it
+// each time it fails until the context is done.
+func (lw *logWatch) watch(
+	ctx context.Context,
+	logSubscription ethereum.Subscription,
+	logs <-chan types.Log,
+) {
+	for {
+		if !lw.receive(ctx, logSubscription, logs) {
+			return
+		}
+
+		replayFromBlock := lw.replayFromBlock()
+
+		logSubscription, logs = lw.resubscribe(ctx, replayFromBlock)
+		if logSubscription == nil {
+			return
+		}
+	}
+}
+
+// receive delivers logs received from the subscription until it fails or the
+// context is done. It returns true if the subscription failed.
+func (lw *logWatch) receive(
+	ctx context.Context,
+	logSubscription ethereum.Subscription,
+	logs <-chan types.Log,
+) bool {
+	defer logSubscription.Unsubscribe()
+
+	for {
+		select {
+		case log := <-logs:
+			lw.deliver(log)
+		case err := <-logSubscription.Err():
+			logger.Warningf(
+				"subscription to [%v] events failed: [%v]",
+				lw.name,
+				err,
+			)
+			return true
+		case <-ctx.Done():
+			return false
+		}
+	}
+}
+
+// replayFromBlock returns the block from which logs missed while the
+// subscription was down should be replayed.
+func (lw *logWatch) replayFromBlock() uint64 {
+	lastSeenBlock, err := lw.currentBlock()
+	if err != nil {
+		logger.Warningf(
+			"could not determine the last block seen by [%v] "+
+				"subscription: [%v]",
+			lw.name,
+			err,
+		)
+		return 0
+	}
+
+	if lastSeenBlock < resubscriptionReplayMargin {
+		return 0
+	}
+
+	return lastSeenBlock - resubscriptionReplayMargin
+}
+
+// resubscribe re-establishes the subscription with an exponential backoff and
+// replays logs emitted since the given block. It returns a nil subscription
+// if the context is done before the subscription is re-established.
+func (lw *logWatch) resubscribe(
+	ctx context.Context,
+	replayFromBlock uint64,
+) (ethereum.Subscription, <-chan types.Log) {
+	backoff := lw.minBackoff
+
+	for attempt := 1; ; attempt++ {
+		select {
+		case <-time.After(backoff):
+		case <-ctx.Done():
+			return nil, nil
+		}
+
+		logSubscription, logs, err := lw.subscribe(ctx)
+		if err == nil {
+			// The subscription is established before past logs are
+			// replayed so that no log falls between the two.
+			err = lw.replay(ctx, replayFromBlock)
+			if err == nil {
+				logger.Infof(
+					"re-established subscription to [%v] events after "+
+						"[%v] attempts; replayed events since block [%v]",
+					lw.name,
+					attempt,
+					replayFromBlock,
+				)
+				return logSubscription, logs
+			}
+
+			logSubscription.Unsubscribe()
+		}
+
+		backoff *= 2
+		if backoff > lw.maxBackoff {
+			backoff = lw.maxBackoff
+		}
+
+		logger.Warningf(
+			"could not re-establish subscription to [%v] events; "+
+				"retrying in [%v]: [%v]",
+			lw.name,
+			backoff,
+			err,
+		)
+	}
+}
+
+// replay delivers logs emitted from the given block up to the current block.
+func (lw *logWatch) replay(ctx context.Context, fromBlock uint64) error {
+	toBlock, err := lw.currentBlock()
+	if err != nil {
+		return fmt.Errorf("could not get current block: [%v]", err)
+	}
+
+	if toBlock < fromBlock {
+		return nil
+	}
+
+	query := lw.query
+	query.FromBlock = new(big.Int).SetUint64(fromBlock)
+	query.ToBlock = new(big.Int).SetUint64(toBlock)
+
+	logs, err := lw.filterer.FilterLogs(ctx, query)
+	if err != nil {
+		return fmt.Errorf(
+			"could not replay events from blocks [%v-%v]: [%v]",
+			fromBlock,
+			toBlock,
+			err,
+		)
+	}
+
+	for _, log := range logs {
+		lw.deliver(log)
+	}
+
+	return nil
+}
+
+// deliver passes the log to the handler unless it has been delivered before.
+func (lw *logWatch) deliver(log types.Log) {
+	if !lw.delivered.add(log) {
+		return
+	}
+
+	lw.handle(log)
+}
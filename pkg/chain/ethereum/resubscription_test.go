@@ -0,0 +1,256 @@
+package ethereum
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+)
+
+func TestLogWatchReplaysMissedLogs(t *testing.T) {
+	filterer := newTestLogFilterer()
+
+	var currentBlock uint64 = 10
+	var currentBlockMutex sync.Mutex
+	setCurrentBlock := func(block uint64) {
+		currentBlockMutex.Lock()
+		defer currentBlockMutex.Unlock()
+		currentBlock = block
+	}
+
+	delivered := make(chan types.Log, 10)
+
+	watch := &logWatch{
+		name:     "RelayEntryRequested",
+		filterer: filterer,
+		currentBlock: func() (uint64, error) {
+			currentBlockMutex.Lock()
+			defer currentBlockMutex.Unlock()
+			return currentBlock, nil
+		},
+		handle:     func(log types.Log) { delivered <- log },
+		minBackoff: time.Millisecond,
+		maxBackoff: 10 * time.Millisecond,
+		delivered:  newDeliveredLogs(deliveredLogsCapacity),
+	}
+
+	subscription, err := watch.start()
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer subscription.Unsubscribe()
+
+	logA := testLog(10, 1)
+	logB := testLog(11, 2)
+	logC := testLog(12, 3)
+
+	firstSubscription := <-filterer.subscriptions
+	firstSubscription.logs <- logA
+	expectDelivered(t, delivered, logA)
+
+	// logB is emitted while the subscription is down; logA is replayed again
+	// as it falls into the replay margin
+	setCurrentBlock(11)
+	filterer.setPastLogs([]types.Log{logA, logB})
+	filterer.failSubscriptions(1)
+	firstSubscription.errors <- fmt.Errorf("connection dropped")
+
+	secondSubscription := <-filterer.subscriptions
+	expectDelivered(t, delivered, logB)
+
+	secondSubscription.logs <- logC
+	expectDelivered(t, delivered, logC)
+
+	expectedReplayFromBlock := uint64(11 - resubscriptionReplayMargin)
+	replayFromBlock := filterer.lastQuery().FromBlock.Uint64()
+	if expectedReplayFromBlock != replayFromBlock {
+		t.Errorf(
+			"unexpected replay start block\nexpected: %v\nactual:   %v\n",
+			expectedReplayFromBlock,
+			replayFromBlock,
+		)
+	}
+
+	if attempts := filterer.subscriptionAttempts(); attempts != 3 {
+		t.Errorf(
+			"unexpected number of subscription attempts\n"+
+				"expected: %v\nactual:   %v\n",
+			3,
+			attempts,
+		)
+	}
+}
+
+func TestLogWatchFailsOnInitialSubscriptionError(t *testing.T) {
+	filterer := newTestLogFilterer()
+	filterer.failSubscriptions(1)
+
+	watch := &logWatch{
+		name:         "RelayEntryRequested",
+		filterer:     filterer,
+		currentBlock: func() (uint64, error) { return 0, nil },
+		handle:       func(log types.Log) {},
+		minBackoff:   time.Millisecond,
+		maxBackoff:   time.Millisecond,
+		delivered:    newDeliveredLogs(deliveredLogsCapacity),
+	}
+
+	_, err := watch.start()
+
+	expectedError := fmt.Errorf(
+		"could not subscribe to [RelayEntryRequested] events: " +
+			"[subscription refused]",
+	)
+	if !reflect.DeepEqual(expectedError, err) {
+		t.Errorf(
+			"unexpected error\nexpected: %v\nactual:   %v\n",
+			expectedError,
+			err,
+		)
+	}
+}
+
+func TestDeliveredLogsForgetsOldestLogs(t *testing.T) {
+	delivered := newDeliveredLogs(2)
+
+	logA := testLog(1, 1)
+	logB := testLog(2, 2)
+	logC := testLog(3, 3)
+
+	for _, log := range []types.Log{logA, logB, logC} {
+		if !delivered.add(log) {
+			t.Fatalf("log [%v] unexpectedly marked as delivered", log.TxHash)
+		}
+	}
+
+	if delivered.add(logC) {
+		t.Errorf("expected duplicated log to be rejected")
+	}
+
+	if !delivered.add(logA) {
+		t.Errorf("expected forgotten log to be accepted")
+	}
+}
+
+func expectDelivered(t *testing.T, delivered <-chan types.Log, expected types.Log) {
+	select {
+	case actual := <-delivered:
+		if !reflect.DeepEqual(expected, actual) {
+			t.Fatalf(
+				"unexpected log\nexpected: %v\nactual:   %v\n",
+				expected,
+				actual,
+			)
+		}
+	case <-time.After(time.Second):
+		t.Fatalf("log [%v] has not been delivered", expected.TxHash.Hex())
+	}
+}
+
+func testLog(blockNumber uint64, transaction byte) types.Log {
+	return types.Log{
+		BlockNumber: blockNumber,
+		TxHash:      common.BytesToHash([]byte{transaction}),
+	}
+}
+
+type testLogSubscription struct {
+	logs   chan<- types.Log
+	errors chan error
+}
+
+func (tls *testLogSubscription) Unsubscribe() {}
+
+func (tls *testLogSubscription) Err() <-chan error {
+	return tls.errors
+}
+
+type testLogFilterer struct {
+	mutex sync.Mutex
+
+	failures      int
+	attempts      int
+	pastLogs      []types.Log
+	queries       []ethereum.FilterQuery
+	subscriptions chan *testLogSubscription
+}
+
+func newTestLogFilterer() *testLogFilterer {
+	return &testLogFilterer{
+		subscriptions: make(chan *testLogSubscription, 10),
+	}
+}
+
+func (tlf *testLogFilterer) failSubscriptions(count int) {
+	tlf.mutex.Lock()
+	defer tlf.mutex.Unlock()
+	tlf.failures = count
+}
+
+func (tlf *testLogFilterer) setPastLogs(logs []types.Log) {
+	tlf.mutex.Lock()
+	defer tlf.mutex.Unlock()
+	tlf.pastLogs = logs
+}
+
+func (tlf *testLogFilterer) subscriptionAttempts() int {
+	tlf.mutex.Lock()
+	defer tlf.mutex.Unlock()
+	return tlf.attempts
+}
+
+func (tlf *testLogFilterer) lastQuery() ethereum.FilterQuery {
+	tlf.mutex.Lock()
+	defer tlf.mutex.Unlock()
+	return tlf.queries[len(tlf.queries)-1]
+}
+
+func (tlf *testLogFilterer) FilterLogs(
+	ctx context.Context,
+	query ethereum.FilterQuery,
+) ([]types.Log, error) {
+	tlf.mutex.Lock()
+	defer tlf.mutex.Unlock()
+
+	tlf.queries = append(tlf.queries, query)
+
+	logs := make([]types.Log, 0)
+	for _, log := range tlf.pastLogs {
+		if log.BlockNumber >= query.FromBlock.Uint64() &&
+			log.BlockNumber <= query.ToBlock.Uint64() {
+			logs = append(logs, log)
+		}
+	}
+
+	return logs, nil
+}
+
+func (tlf *testLogFilterer) SubscribeFilterLogs(
+	ctx context.Context,
+	query ethereum.FilterQuery,
+	logs chan<- types.Log,
+) (ethereum.Subscription, error) {
+	tlf.mutex.Lock()
+	defer tlf.mutex.Unlock()
+
+	tlf.attempts++
+
+	if tlf.failures > 0 {
+		tlf.failures--
+		return nil, fmt.Errorf("subscription refused")
+	}
+
+	subscription := &testLogSubscription{
+		logs:   logs,
+		errors: make(chan error, 1),
+	}
+	tlf.subscriptions <- subscription
+
+	return subscription, nil
+}
@@ -3,8 +3,10 @@ package ethereum
 import (
 	"math/big"
 
+	"github.com/ethereum/go-ethereum/crypto"
 	"github.com/keep-network/keep-core/pkg/beacon/relay/event"
 	"github.com/keep-network/keep-core/pkg/gen/async"
+	"github.com/keep-network/keep-core/pkg/subscription"
 )
 
 func (euc *ethereumUtilityChain) Genesis() error {
@@ -26,14 +28,21 @@ func (euc *ethereumUtilityChain) Genesis() error {
 	return err
 }
 
-func (euc *ethereumUtilityChain) RequestRelayEntry() *async.EventEntryGeneratedPromise {
+func (euc *ethereumUtilityChain) RequestRelayEntry(
+	payment *big.Int,
+) *async.EventEntryGeneratedPromise {
 	promise := &async.EventEntryGeneratedPromise{}
 
-	callbackGas := big.NewInt(0) // no callback
-	payment, err := euc.keepRandomBeaconServiceContract.EntryFeeEstimate(callbackGas)
-	if err != nil {
-		promise.Fail(err)
-		return promise
+	if payment == nil {
+		callbackGas := big.NewInt(0) // no callback
+		estimate, err := euc.keepRandomBeaconServiceContract.EntryFeeEstimate(
+			callbackGas,
+		)
+		if err != nil {
+			promise.Fail(err)
+			return promise
+		}
+		payment = estimate
 	}
 
 	onWatchError := func(err error) error {
@@ -45,7 +54,7 @@ func (euc *ethereumUtilityChain) RequestRelayEntry() *async.EventEntryGeneratedP
 	// the same block, we need to make sure we install relay entry generated
 	// callback after relay entry request tx has been confirmed to do not
 	// react on the previous relay entry.
-	_, err = euc.keepRandomBeaconServiceContract.WatchRelayEntryRequested(
+	_, err := euc.keepRandomBeaconServiceContract.WatchRelayEntryRequested(
 		func(requestId *big.Int, blockNumber uint64) {
 			logger.Infof(
 				"Relay request with id [%v] created at block [%v]",
@@ -73,3 +82,36 @@ func (euc *ethereumUtilityChain) RequestRelayEntry() *async.EventEntryGeneratedP
 
 	return promise
 }
+
+// LatestRelayEntry returns the most recent relay entry as a number. The
+// service contract stores the entry as bytes and reports the keccak256 hash
+// of those bytes as the generated entry. Before the first entry is
+// generated, the hash of the beacon seed is returned.
+func (euc *ethereumUtilityChain) LatestRelayEntry() (*big.Int, error) {
+	previousEntry, err := euc.keepRandomBeaconServiceContract.PreviousEntry()
+	if err != nil {
+		return nil, err
+	}
+
+	return new(big.Int).SetBytes(crypto.Keccak256(previousEntry)), nil
+}
+
+func (euc *ethereumUtilityChain) OnRelayEntryGenerated(
+	handle func(entry *event.EntryGenerated),
+) (subscription.EventSubscription, error) {
+	return euc.keepRandomBeaconServiceContract.WatchRelayEntryGenerated(
+		func(_, entry *big.Int, blockNumber uint64) {
+			handle(&event.EntryGenerated{
+				Value:       entry,
+				BlockNumber: blockNumber,
+			})
+		},
+		func(err error) error {
+			logger.Warningf(
+				"watching relay entry generated events failed: [%v]",
+				err,
+			)
+			return err
+		},
+	)
+}
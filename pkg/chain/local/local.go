@@ -11,6 +11,7 @@ import (
 	"sort"
 	"sync"
 
+	bn256 "github.com/ethereum/go-ethereum/crypto/bn256/cloudflare"
 	"github.com/ipfs/go-log"
 
 	crand "crypto/rand"
@@ -32,6 +33,14 @@ var seedRelayEntry = big.NewInt(123456789)
 var groupActiveTime = uint64(10)
 var relayRequestTimeout = uint64(8)
 
+// genesisRelayEntry is the previous entry of the first relay request. It is
+// a valid G1 point, just like the genesis entry on Ethereum.
+var genesisRelayEntry = new(bn256.G1).ScalarBaseMult(seedRelayEntry).Marshal()
+
+// localChainID is the ID of the local chain used as a domain separator of
+// DKG result hashes.
+const localChainID = 1337
+
 // Chain is an extention of chain.Handle interface which exposes
 // additional functions useful for testing.
 type Chain interface {
@@ -47,6 +56,16 @@ type Chain interface {
 	// GetRelayEntryTimeoutReports returns an array of blocks which denote at what
 	// block a relay entry timeout occured.
 	GetRelayEntryTimeoutReports() []uint64
+
+	// StartGroupSelection starts selection of a new group with the given
+	// seed, the way the operator contract does once a new group is due.
+	StartGroupSelection(seed *big.Int)
+
+	// RequestRelayEntry queues a new relay entry request. Requests are
+	// dispatched to registered groups one at a time, each once the entry
+	// for the previous one has been submitted or timed out. It returns an
+	// error if no group has been registered yet.
+	RequestRelayEntry() error
 }
 
 type localGroup struct {
@@ -56,6 +75,8 @@ type localGroup struct {
 
 type localChain struct {
 	relayConfig *relayconfig.Chain
+	// chainID separates DKG result hashes of different chains.
+	chainID *big.Int
 
 	groups []localGroup
 
@@ -63,6 +84,10 @@ type localChain struct {
 	lastSubmittedDKGResultSignatures map[relaychain.GroupMemberIndex][]byte
 	lastSubmittedRelayEntry          []byte
 
+	submittedTransactionsMutex sync.Mutex
+	submittedTransactions      map[string]bool
+	submittedDKGResults        []*event.DKGResultSubmission
+
 	handlerMutex                  sync.Mutex
 	relayEntryHandlers            map[int]func(entry *event.EntrySubmitted)
 	relayRequestHandlers          map[int]func(request *event.Request)
@@ -76,10 +101,17 @@ type localChain struct {
 
 	tickets      []*relaychain.Ticket
 	ticketsMutex sync.Mutex
+	// groupSelectionInProgress is set from the start of the group selection
+	// until the DKG result is submitted.
+	groupSelectionInProgress bool
 
 	relayEntryTimeoutReportsMutex sync.Mutex
 	relayEntryTimeoutReports      []uint64
 
+	requestsMutex     sync.Mutex
+	pendingRequests   int
+	requestInProgress bool
+
 	operatorKey *ecdsa.PrivateKey
 }
 
@@ -160,6 +192,13 @@ func (c *localChain) GetSelectedParticipants() ([]relaychain.StakerAddress, erro
 	return selectedParticipants, nil
 }
 
+func (c *localChain) IsGroupSelectionPossible() (bool, error) {
+	c.ticketsMutex.Lock()
+	defer c.ticketsMutex.Unlock()
+
+	return !c.groupSelectionInProgress, nil
+}
+
 func (c *localChain) SubmitRelayEntry(newEntry []byte) *async.EventEntrySubmittedPromise {
 	c.ticketsMutex.Lock()
 	c.tickets = make([]*relaychain.Ticket, 0)
@@ -189,6 +228,8 @@ func (c *localChain) SubmitRelayEntry(newEntry []byte) *async.EventEntrySubmitte
 
 	c.lastSubmittedRelayEntry = newEntry
 
+	c.completeRelayRequest()
+
 	return relayEntryPromise
 }
 
@@ -265,6 +306,109 @@ func (c *localChain) OnGroupRegistered(
 	}), nil
 }
 
+func (c *localChain) StartGroupSelection(seed *big.Int) {
+	c.ticketsMutex.Lock()
+	c.tickets = make([]*relaychain.Ticket, 0)
+	c.groupSelectionInProgress = true
+	c.ticketsMutex.Unlock()
+
+	currentBlock, err := c.blockCounter.CurrentBlock()
+	if err != nil {
+		logger.Errorf("could not start group selection: [%v]", err)
+		return
+	}
+
+	groupSelectionStart := &event.GroupSelectionStart{
+		NewEntry:    seed,
+		BlockNumber: currentBlock,
+	}
+
+	c.handlerMutex.Lock()
+	for _, handler := range c.groupSelectionStartedHandlers {
+		go func(
+			handler func(*event.GroupSelectionStart),
+			groupSelectionStart *event.GroupSelectionStart,
+		) {
+			handler(groupSelectionStart)
+		}(handler, groupSelectionStart)
+	}
+	c.handlerMutex.Unlock()
+}
+
+func (c *localChain) RequestRelayEntry() error {
+	c.handlerMutex.Lock()
+	registeredGroups := len(c.groups) - 1
+	c.handlerMutex.Unlock()
+
+	if registeredGroups == 0 {
+		return fmt.Errorf("no group has been registered yet")
+	}
+
+	c.requestsMutex.Lock()
+	defer c.requestsMutex.Unlock()
+
+	c.pendingRequests++
+	c.dispatchRelayRequest()
+
+	return nil
+}
+
+// completeRelayRequest marks the relay request in progress as served and
+// dispatches the next pending one, if any.
+func (c *localChain) completeRelayRequest() {
+	c.requestsMutex.Lock()
+	defer c.requestsMutex.Unlock()
+
+	c.requestInProgress = false
+	c.dispatchRelayRequest()
+}
+
+// dispatchRelayRequest notifies handlers about the next pending relay request
+// if no request is in progress. The request is served by one of the groups
+// registered with a DKG result, selected with the previous relay entry. It
+// has to be called with the requests mutex held.
+func (c *localChain) dispatchRelayRequest() {
+	if c.requestInProgress || c.pendingRequests == 0 {
+		return
+	}
+
+	currentBlock, err := c.blockCounter.CurrentBlock()
+	if err != nil {
+		logger.Errorf("could not dispatch relay request: [%v]", err)
+		return
+	}
+
+	previousEntry := c.lastSubmittedRelayEntry
+	if previousEntry == nil {
+		previousEntry = genesisRelayEntry
+	}
+
+	c.handlerMutex.Lock()
+	defer c.handlerMutex.Unlock()
+
+	// The seed group has no members able to serve the request.
+	registeredGroups := c.groups[1:]
+	group := registeredGroups[selectGroup(
+		new(big.Int).SetBytes(previousEntry),
+		len(registeredGroups),
+	)]
+
+	request := &event.Request{
+		PreviousEntry:  previousEntry,
+		GroupPublicKey: group.groupPublicKey,
+		BlockNumber:    currentBlock,
+	}
+
+	for _, handler := range c.relayRequestHandlers {
+		go func(handler func(*event.Request), request *event.Request) {
+			handler(request)
+		}(handler, request)
+	}
+
+	c.pendingRequests--
+	c.requestInProgress = true
+}
+
 func (c *localChain) ThresholdRelay() relaychain.Interface {
 	return relaychain.Interface(c)
 }
@@ -303,18 +447,23 @@ func ConnectWithKey(
 	resultPublicationBlockStep := uint64(3)
 
 	return &localChain{
+		chainID: big.NewInt(localChainID),
 		relayConfig: &relayconfig.Chain{
 			GroupSize:                  groupSize,
 			HonestThreshold:            honestThreshold,
-			TicketSubmissionTimeout:    6,
+			TicketSubmissionTimeout:    12,
 			ResultPublicationBlockStep: resultPublicationBlockStep,
 			MinimumStake:               minimumStake,
 			RelayEntryTimeout:          resultPublicationBlockStep * uint64(groupSize),
 		},
-		relayEntryHandlers:       make(map[int]func(request *event.EntrySubmitted)),
-		relayRequestHandlers:     make(map[int]func(request *event.Request)),
+		relayEntryHandlers:   make(map[int]func(request *event.EntrySubmitted)),
+		relayRequestHandlers: make(map[int]func(request *event.Request)),
+		groupSelectionStartedHandlers: make(
+			map[int]func(groupSelectionStart *event.GroupSelectionStart),
+		),
 		groupRegisteredHandlers:  make(map[int]func(groupRegistration *event.GroupRegistration)),
 		resultSubmissionHandlers: make(map[int]func(submission *event.DKGResultSubmission)),
+		submittedTransactions:    make(map[string]bool),
 		blockCounter:             bc,
 		stakeMonitor:             NewStakeMonitor(minimumStake),
 		tickets:                  make([]*relaychain.Ticket, 0),
@@ -395,15 +544,32 @@ func (c *localChain) SubmitDKGResult(
 		MemberIndex:    uint32(participantIndex),
 		GroupPublicKey: resultToPublish.GroupPublicKey[:],
 		Misbehaved:     resultToPublish.Misbehaved,
-		BlockNumber:    currentBlock,
+		TransactionHash: localTransactionHash(
+			participantIndex,
+			resultToPublish.GroupPublicKey,
+			currentBlock,
+		),
+		BlockNumber: currentBlock,
 	}
 
+	c.submittedTransactionsMutex.Lock()
+	c.submittedTransactions[dkgResultPublicationEvent.TransactionHash] = true
+	c.submittedDKGResults = append(
+		c.submittedDKGResults,
+		dkgResultPublicationEvent,
+	)
+	c.submittedTransactionsMutex.Unlock()
+
 	myGroup := localGroup{
 		groupPublicKey:          resultToPublish.GroupPublicKey,
 		registrationBlockHeight: currentBlock,
 	}
 	c.groups = append(c.groups, myGroup)
 	c.lastSubmittedDKGResult = resultToPublish
+
+	c.ticketsMutex.Lock()
+	c.groupSelectionInProgress = false
+	c.ticketsMutex.Unlock()
 	c.lastSubmittedDKGResultSignatures = signatures
 
 	groupRegistrationEvent := &event.GroupRegistration{
@@ -467,6 +633,9 @@ func (c *localChain) ReportRelayEntryTimeout() error {
 	}
 
 	c.relayEntryTimeoutReports = append(c.relayEntryTimeoutReports, currentBlock)
+
+	c.completeRelayRequest()
+
 	return nil
 }
 
@@ -474,14 +643,71 @@ func (c *localChain) GetRelayEntryTimeoutReports() []uint64 {
 	return c.relayEntryTimeoutReports
 }
 
-// CalculateDKGResultHash calculates a 256-bit hash of the DKG result.
+// CalculateDKGResultHash calculates a 256-bit hash of the DKG result. The
+// hash includes the chain ID as a domain separator.
 func (c *localChain) CalculateDKGResultHash(
 	dkgResult *relaychain.DKGResult,
 ) (relaychain.DKGResultHash, error) {
-	encodedDKGResult := fmt.Sprint(dkgResult)
+	encodedDKGResult := fmt.Sprintf("%v:%v", c.chainID, dkgResult)
 	dkgResultHash := relaychain.DKGResultHash(
 		sha3.Sum256([]byte(encodedDKGResult)),
 	)
 
 	return dkgResultHash, nil
 }
+
+// localTransactionHash returns a deterministic, Ethereum-like hash of the
+// local chain DKG result submission transaction.
+func localTransactionHash(
+	participantIndex relaychain.GroupMemberIndex,
+	groupPublicKey []byte,
+	blockNumber uint64,
+) string {
+	blockNumberBytes := make([]byte, 8)
+	binary.BigEndian.PutUint64(blockNumberBytes, blockNumber)
+
+	hash := sha3.NewLegacyKeccak256()
+	hash.Write([]byte{byte(participantIndex)})
+	hash.Write(groupPublicKey)
+	hash.Write(blockNumberBytes)
+
+	return fmt.Sprintf("0x%x", hash.Sum(nil))
+}
+
+// IsTransactionSuccessful returns true if the transaction with the given hash
+// has been submitted to the local chain. All transactions accepted by the
+// local chain are considered successful.
+func (c *localChain) IsTransactionSuccessful(transactionHash string) (bool, error) {
+	c.submittedTransactionsMutex.Lock()
+	defer c.submittedTransactionsMutex.Unlock()
+
+	if !c.submittedTransactions[transactionHash] {
+		return false, fmt.Errorf(
+			"transaction [%v] has not been submitted",
+			transactionHash,
+		)
+	}
+
+	return true, nil
+}
+
+// PastDKGResultSubmissions returns DKG result submissions accepted by the
+// local chain between the given blocks, both inclusive, ordered by block
+// number.
+func (c *localChain) PastDKGResultSubmissions(
+	startBlock uint64,
+	endBlock uint64,
+) ([]*event.DKGResultSubmission, error) {
+	c.submittedTransactionsMutex.Lock()
+	defer c.submittedTransactionsMutex.Unlock()
+
+	submissions := make([]*event.DKGResultSubmission, 0)
+	for _, submission := range c.submittedDKGResults {
+		if submission.BlockNumber >= startBlock &&
+			submission.BlockNumber <= endBlock {
+			submissions = append(submissions, submission)
+		}
+	}
+
+	return submissions, nil
+}
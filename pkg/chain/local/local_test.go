@@ -280,6 +280,141 @@ func TestLocalOnGroupRegisteredUnsubscribed(t *testing.T) {
 	}
 }
 
+func TestLocalStartGroupSelection(t *testing.T) {
+	ctx, cancel := context.WithTimeout(context.Background(), 1*time.Second)
+	defer cancel()
+
+	localChain := Connect(10, 4, big.NewInt(200))
+
+	eventFired := make(chan *event.GroupSelectionStart)
+
+	subscription, err := localChain.ThresholdRelay().OnGroupSelectionStarted(
+		func(groupSelectionStart *event.GroupSelectionStart) {
+			eventFired <- groupSelectionStart
+		},
+	)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	defer subscription.Unsubscribe()
+
+	seed := big.NewInt(31415)
+
+	localChain.StartGroupSelection(seed)
+
+	select {
+	case event := <-eventFired:
+		if event.NewEntry.Cmp(seed) != 0 {
+			t.Errorf(
+				"unexpected group selection seed\nexpected: %v\nactual:   %v\n",
+				seed,
+				event.NewEntry,
+			)
+		}
+	case <-ctx.Done():
+		t.Fatal(ctx.Err())
+	}
+}
+
+func TestLocalRequestRelayEntryNoGroup(t *testing.T) {
+	localChain := Connect(10, 4, big.NewInt(200))
+
+	err := localChain.RequestRelayEntry()
+
+	expectedError := fmt.Errorf("no group has been registered yet")
+	if !reflect.DeepEqual(expectedError, err) {
+		t.Errorf(
+			"unexpected error\nexpected: %v\nactual:   %v\n",
+			expectedError,
+			err,
+		)
+	}
+}
+
+func TestLocalRequestRelayEntry(t *testing.T) {
+	ctx, cancel := context.WithTimeout(context.Background(), 1*time.Second)
+	defer cancel()
+
+	localChain := Connect(10, 4, big.NewInt(200))
+	chainHandle := localChain.ThresholdRelay()
+
+	groupPublicKey := []byte("1")
+	chainHandle.SubmitDKGResult(
+		relaychain.GroupMemberIndex(1),
+		&relaychain.DKGResult{GroupPublicKey: groupPublicKey},
+		map[relaychain.GroupMemberIndex][]byte{
+			1: []byte{101},
+			2: []byte{102},
+			3: []byte{103},
+			4: []byte{104},
+		},
+	)
+
+	requests := make(chan *event.Request, 2)
+
+	subscription, err := chainHandle.OnRelayEntryRequested(
+		func(request *event.Request) {
+			requests <- request
+		},
+	)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	defer subscription.Unsubscribe()
+
+	for i := 0; i < 2; i++ {
+		if err := localChain.RequestRelayEntry(); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	select {
+	case request := <-requests:
+		if !reflect.DeepEqual(groupPublicKey, request.GroupPublicKey) {
+			t.Errorf(
+				"unexpected group public key\nexpected: %v\nactual:   %v\n",
+				groupPublicKey,
+				request.GroupPublicKey,
+			)
+		}
+		if !reflect.DeepEqual(genesisRelayEntry, request.PreviousEntry) {
+			t.Errorf(
+				"unexpected previous entry\nexpected: %v\nactual:   %v\n",
+				genesisRelayEntry,
+				request.PreviousEntry,
+			)
+		}
+	case <-ctx.Done():
+		t.Fatal(ctx.Err())
+	}
+
+	// The second request waits until the entry for the first one is
+	// submitted.
+	select {
+	case request := <-requests:
+		t.Fatalf("unexpected request dispatched: [%v]", request)
+	case <-time.After(100 * time.Millisecond):
+	}
+
+	newEntry := big.NewInt(19).Bytes()
+	chainHandle.SubmitRelayEntry(newEntry)
+
+	select {
+	case request := <-requests:
+		if !reflect.DeepEqual(newEntry, request.PreviousEntry) {
+			t.Errorf(
+				"unexpected previous entry\nexpected: %v\nactual:   %v\n",
+				newEntry,
+				request.PreviousEntry,
+			)
+		}
+	case <-ctx.Done():
+		t.Fatal(ctx.Err())
+	}
+}
+
 func TestLocalOnDKGResultSubmitted(t *testing.T) {
 	ctx, cancel := context.WithTimeout(context.Background(), 1*time.Second)
 	defer cancel()
@@ -312,8 +447,9 @@ func TestLocalOnDKGResultSubmitted(t *testing.T) {
 	chainHandle.SubmitDKGResult(memberIndex, dkgResult, signatures)
 
 	expectedResultSubmissionEvent := &event.DKGResultSubmission{
-		MemberIndex:    uint32(memberIndex),
-		GroupPublicKey: groupPublicKey,
+		MemberIndex:     uint32(memberIndex),
+		GroupPublicKey:  groupPublicKey,
+		TransactionHash: localTransactionHash(memberIndex, groupPublicKey, 0),
 	}
 
 	select {
@@ -714,13 +850,13 @@ func TestLocalSubmitDKGResultWithSignatures(t *testing.T) {
 }
 
 func TestCalculateDKGResultHash(t *testing.T) {
-	localChain := &localChain{}
+	localChain := &localChain{chainID: big.NewInt(localChainID)}
 
 	dkgResult := &relaychain.DKGResult{
 		GroupPublicKey: []byte{3, 40, 200},
 		Misbehaved:     []byte{1, 2, 8, 14},
 	}
-	expectedHashString := "97a94a3b11a0f780c9510df852ac7f77072085d5bda4b07e5d198396dd4f68e5"
+	expectedHashString := "080069a4a71ec0d48d23b6c6ccd4924ca311c5e77e01454fe5fa0aed845959c2"
 
 	actualHash, err := localChain.CalculateDKGResultHash(dkgResult)
 	if err != nil {
@@ -741,6 +877,69 @@ func TestCalculateDKGResultHash(t *testing.T) {
 	}
 }
 
+func TestDKGResultSignatureIsBoundToChainID(t *testing.T) {
+	firstChain := Connect(5, 3, big.NewInt(200)).(*localChain)
+	firstChain.chainID = big.NewInt(1)
+
+	secondChain := Connect(5, 3, big.NewInt(200)).(*localChain)
+	secondChain.chainID = big.NewInt(2)
+
+	dkgResult := &relaychain.DKGResult{
+		GroupPublicKey: []byte{3, 40, 200},
+		Misbehaved:     []byte{1, 2, 8, 14},
+	}
+
+	firstHash, err := firstChain.CalculateDKGResultHash(dkgResult)
+	if err != nil {
+		t.Fatal(err)
+	}
+	secondHash, err := secondChain.CalculateDKGResultHash(dkgResult)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	signing := firstChain.Signing()
+	signature, err := signing.Sign(firstHash[:])
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var tests = map[string]struct {
+		resultHash    relaychain.DKGResultHash
+		expectedValid bool
+	}{
+		"verification under the same chain ID": {
+			resultHash:    firstHash,
+			expectedValid: true,
+		},
+		"verification under a different chain ID": {
+			resultHash:    secondHash,
+			expectedValid: false,
+		},
+	}
+
+	for testName, test := range tests {
+		t.Run(testName, func(t *testing.T) {
+			valid, err := signing.VerifyWithPublicKey(
+				test.resultHash[:],
+				signature,
+				signing.PublicKey(),
+			)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			if valid != test.expectedValid {
+				t.Errorf(
+					"unexpected signature validity\nexpected: %v\nactual:   %v\n",
+					test.expectedValid,
+					valid,
+				)
+			}
+		})
+	}
+}
+
 func newTestContext(timeout ...time.Duration) (context.Context, context.CancelFunc) {
 	defaultTimeout := 3 * time.Second
 	if len(timeout) > 0 {
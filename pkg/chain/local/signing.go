@@ -8,6 +8,8 @@ import (
 	"encoding/asn1"
 	"fmt"
 	"math/big"
+
+	"github.com/ethereum/go-ethereum/crypto"
 )
 
 type localSigning struct {
@@ -84,10 +86,14 @@ func unmarshalPublicKey(
 	return (*ecdsa.PublicKey)(ecdsaPublicKey), nil
 }
 
+// PublicKeyToAddress derives the address the same way as Ethereum does so
+// that addresses of local operators match the addresses of their stakers.
 func (ls *localSigning) PublicKeyToAddress(publicKey ecdsa.PublicKey) []byte {
-	return elliptic.Marshal(publicKey.Curve, publicKey.X, publicKey.Y)
+	return ls.PublicKeyBytesToAddress(
+		elliptic.Marshal(publicKey.Curve, publicKey.X, publicKey.Y),
+	)
 }
 
 func (ls *localSigning) PublicKeyBytesToAddress(publicKey []byte) []byte {
-	return publicKey
+	return crypto.Keccak256(publicKey[1:])[12:]
 }
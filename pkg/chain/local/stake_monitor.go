@@ -110,8 +110,10 @@ type localStaker struct {
 	stake   *big.Int
 }
 
+// Address returns the staker address as 20 bytes, the way the Ethereum
+// staker does.
 func (ls *localStaker) Address() relaychain.StakerAddress {
-	return []byte(ls.address)
+	return common.HexToAddress(ls.address).Bytes()
 }
 
 func (ls *localStaker) Stake() (*big.Int, error) {
@@ -0,0 +1,211 @@
+// Package diagnostics exposes the internal state of a running node over HTTP
+// so that operators can inspect what the node is currently doing.
+//
+// Each piece of the state is provided by a named source registered in the
+// diagnostics registry. All sources are available as a single JSON document
+// under the root path and each source is also available separately under
+// a path equal to its name. Queries selecting a part of the state with URL
+// query parameters are available under a path equal to their name. Live feeds of events are provided by named streams
+// served over WebSocket under the stream path followed by the stream name.
+package diagnostics
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+	"sync"
+
+	"github.com/ipfs/go-log"
+)
+
+var logger = log.Logger("keep-diagnostics")
+
+// Source provides a JSON-serializable snapshot of the node's state.
+type Source func() (interface{}, error)
+
+// Query provides a JSON-serializable part of the node's state selected with
+// the given URL query parameters.
+type Query func(parameters url.Values) (interface{}, error)
+
+// InvalidQueryError is returned by a query for invalid query parameters.
+type InvalidQueryError struct {
+	Err error
+}
+
+// NewInvalidQueryError creates an error of a query with invalid parameters
+// failing for the given reason.
+func NewInvalidQueryError(err error) *InvalidQueryError {
+	return &InvalidQueryError{err}
+}
+
+func (iqe *InvalidQueryError) Error() string {
+	return fmt.Sprintf("invalid query: [%v]", iqe.Err)
+}
+
+// Registry holds all diagnostics sources, queries and streams of the node.
+type Registry struct {
+	mutex   sync.RWMutex
+	sources map[string]Source
+	queries map[string]Query
+	streams map[string]Stream
+}
+
+// NewRegistry creates an empty diagnostics registry.
+func NewRegistry() *Registry {
+	return &Registry{
+		sources: make(map[string]Source),
+		queries: make(map[string]Query),
+		streams: make(map[string]Stream),
+	}
+}
+
+// RegisterSource registers a diagnostics source under the given name.
+// Registering another source under the same name replaces the previous one.
+func (r *Registry) RegisterSource(name string, source Source) {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+
+	r.sources[name] = source
+}
+
+// RegisterQuery registers a diagnostics query under the given name. Queries
+// are not included in the document with all diagnostics sources. Registering
+// another query under the same name replaces the previous one.
+func (r *Registry) RegisterQuery(name string, query Query) {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+
+	r.queries[name] = query
+}
+
+// Initialize creates a diagnostics registry and starts serving it over HTTP
+// on the given port. If the port is not set, the registry is not exposed.
+func Initialize(port int) *Registry {
+	registry := NewRegistry()
+
+	if port == 0 {
+		return registry
+	}
+
+	go func() {
+		address := fmt.Sprintf(":%v", port)
+		logger.Infof("serving diagnostics on [%v]", address)
+
+		if err := http.ListenAndServe(address, registry); err != nil {
+			logger.Errorf("diagnostics server failed: [%v]", err)
+		}
+	}()
+
+	return registry
+}
+
+// ServeHTTP serves all registered diagnostics sources as a JSON document.
+// If the request path is equal to a name of a registered source, only that
+// source is served. If the request path is equal to a name of a registered
+// query, the query is executed with the request's query parameters. If the
+// request path points to a registered stream, the
+// stream is served over WebSocket.
+func (r *Registry) ServeHTTP(writer http.ResponseWriter, request *http.Request) {
+	if request.Method != http.MethodGet {
+		http.Error(writer, "method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+
+	if isStreamRequest(request) {
+		r.serveStream(writer, request)
+		return
+	}
+
+	name := strings.Trim(request.URL.Path, "/")
+
+	var (
+		response interface{}
+		err      error
+	)
+	if name == "" {
+		response, err = r.snapshotAll()
+	} else if query, ok := r.query(name); ok {
+		response, err = query(request.URL.Query())
+	} else {
+		response, err = r.snapshot(name)
+	}
+
+	if err != nil {
+		if _, ok := err.(*unknownSourceError); ok {
+			http.Error(writer, err.Error(), http.StatusNotFound)
+			return
+		}
+		if _, ok := err.(*InvalidQueryError); ok {
+			http.Error(writer, err.Error(), http.StatusBadRequest)
+			return
+		}
+
+		http.Error(writer, err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	writer.Header().Set("Content-Type", "application/json")
+	if err := json.NewEncoder(writer).Encode(response); err != nil {
+		logger.Errorf("could not write diagnostics response: [%v]", err)
+	}
+}
+
+type unknownSourceError struct {
+	name string
+}
+
+func (use *unknownSourceError) Error() string {
+	return fmt.Sprintf("unknown diagnostics source [%v]", use.name)
+}
+
+func (r *Registry) query(name string) (Query, bool) {
+	r.mutex.RLock()
+	defer r.mutex.RUnlock()
+
+	query, ok := r.queries[name]
+	return query, ok
+}
+
+func (r *Registry) snapshot(name string) (interface{}, error) {
+	r.mutex.RLock()
+	source, ok := r.sources[name]
+	r.mutex.RUnlock()
+
+	if !ok {
+		return nil, &unknownSourceError{name}
+	}
+
+	snapshot, err := source()
+	if err != nil {
+		return nil, fmt.Errorf(
+			"could not get diagnostics from source [%v]: [%v]",
+			name,
+			err,
+		)
+	}
+
+	return snapshot, nil
+}
+
+func (r *Registry) snapshotAll() (map[string]interface{}, error) {
+	r.mutex.RLock()
+	names := make([]string, 0, len(r.sources))
+	for name := range r.sources {
+		names = append(names, name)
+	}
+	r.mutex.RUnlock()
+
+	snapshots := make(map[string]interface{}, len(names))
+	for _, name := range names {
+		snapshot, err := r.snapshot(name)
+		if err != nil {
+			return nil, err
+		}
+
+		snapshots[name] = snapshot
+	}
+
+	return snapshots, nil
+}
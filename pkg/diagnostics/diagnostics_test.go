@@ -0,0 +1,182 @@
+package diagnostics
+
+import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"testing"
+)
+
+func TestServeHTTP(t *testing.T) {
+	registry := NewRegistry()
+	registry.RegisterSource("first", func() (interface{}, error) {
+		return map[string]int{"value": 1}, nil
+	})
+	registry.RegisterSource("second", func() (interface{}, error) {
+		return []string{"a", "b"}, nil
+	})
+	registry.RegisterSource("broken", func() (interface{}, error) {
+		return nil, fmt.Errorf("source failed")
+	})
+
+	var tests = map[string]struct {
+		method         string
+		path           string
+		expectedStatus int
+		expectedBody   string
+	}{
+		"single source": {
+			method:         http.MethodGet,
+			path:           "/first",
+			expectedStatus: http.StatusOK,
+			expectedBody:   `{"value":1}`,
+		},
+		"single source with trailing slash": {
+			method:         http.MethodGet,
+			path:           "/second/",
+			expectedStatus: http.StatusOK,
+			expectedBody:   `["a","b"]`,
+		},
+		"unknown source": {
+			method:         http.MethodGet,
+			path:           "/third",
+			expectedStatus: http.StatusNotFound,
+			expectedBody:   "unknown diagnostics source [third]",
+		},
+		"failing source": {
+			method:         http.MethodGet,
+			path:           "/broken",
+			expectedStatus: http.StatusInternalServerError,
+			expectedBody:   "could not get diagnostics from source [broken]: [source failed]",
+		},
+		"unsupported method": {
+			method:         http.MethodPost,
+			path:           "/first",
+			expectedStatus: http.StatusMethodNotAllowed,
+			expectedBody:   "method not allowed",
+		},
+	}
+
+	for testName, test := range tests {
+		t.Run(testName, func(t *testing.T) {
+			recorder := httptest.NewRecorder()
+			registry.ServeHTTP(
+				recorder,
+				httptest.NewRequest(test.method, test.path, nil),
+			)
+
+			if recorder.Code != test.expectedStatus {
+				t.Errorf(
+					"unexpected status\nexpected: %v\nactual:   %v\n",
+					test.expectedStatus,
+					recorder.Code,
+				)
+			}
+
+			body := strings.TrimSpace(recorder.Body.String())
+			if body != test.expectedBody {
+				t.Errorf(
+					"unexpected body\nexpected: %v\nactual:   %v\n",
+					test.expectedBody,
+					body,
+				)
+			}
+		})
+	}
+}
+
+func TestServeHTTPAllSources(t *testing.T) {
+	registry := NewRegistry()
+	registry.RegisterSource("first", func() (interface{}, error) {
+		return 1, nil
+	})
+	registry.RegisterSource("second", func() (interface{}, error) {
+		return "two", nil
+	})
+
+	recorder := httptest.NewRecorder()
+	registry.ServeHTTP(recorder, httptest.NewRequest(http.MethodGet, "/", nil))
+
+	expectedBody := `{"first":1,"second":"two"}`
+	body := strings.TrimSpace(recorder.Body.String())
+	if body != expectedBody {
+		t.Errorf(
+			"unexpected body\nexpected: %v\nactual:   %v\n",
+			expectedBody,
+			body,
+		)
+	}
+}
+
+func TestServeHTTPQuery(t *testing.T) {
+	registry := NewRegistry()
+	registry.RegisterSource("source", func() (interface{}, error) {
+		return 1, nil
+	})
+	registry.RegisterQuery("query", func(parameters url.Values) (interface{}, error) {
+		switch parameters.Get("value") {
+		case "invalid":
+			return nil, NewInvalidQueryError(fmt.Errorf("invalid value"))
+		case "broken":
+			return nil, fmt.Errorf("query failed")
+		default:
+			return parameters.Get("value"), nil
+		}
+	})
+
+	var tests = map[string]struct {
+		path           string
+		expectedStatus int
+		expectedBody   string
+	}{
+		"query with parameters": {
+			path:           "/query?value=abc",
+			expectedStatus: http.StatusOK,
+			expectedBody:   `"abc"`,
+		},
+		"query with invalid parameters": {
+			path:           "/query?value=invalid",
+			expectedStatus: http.StatusBadRequest,
+			expectedBody:   "invalid query: [invalid value]",
+		},
+		"failing query": {
+			path:           "/query?value=broken",
+			expectedStatus: http.StatusInternalServerError,
+			expectedBody:   "query failed",
+		},
+		"queries not included in all sources": {
+			path:           "/",
+			expectedStatus: http.StatusOK,
+			expectedBody:   `{"source":1}`,
+		},
+	}
+
+	for testName, test := range tests {
+		t.Run(testName, func(t *testing.T) {
+			recorder := httptest.NewRecorder()
+			registry.ServeHTTP(
+				recorder,
+				httptest.NewRequest(http.MethodGet, test.path, nil),
+			)
+
+			if recorder.Code != test.expectedStatus {
+				t.Errorf(
+					"unexpected status\nexpected: %v\nactual:   %v\n",
+					test.expectedStatus,
+					recorder.Code,
+				)
+			}
+
+			body := strings.TrimSpace(recorder.Body.String())
+			if body != test.expectedBody {
+				t.Errorf(
+					"unexpected body\nexpected: %v\nactual:   %v\n",
+					test.expectedBody,
+					body,
+				)
+			}
+		})
+	}
+}
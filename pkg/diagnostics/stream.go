@@ -0,0 +1,132 @@
+package diagnostics
+
+import (
+	"fmt"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/gorilla/websocket"
+)
+
+// streamPathPrefix is the path prefix under which registered streams are
+// served; each stream is available under this prefix followed by its name.
+const streamPathPrefix = "stream/"
+
+const (
+	// streamBufferSize is the number of events buffered for a connected
+	// client. Events are dropped for a client not keeping up with the feed.
+	streamBufferSize = 256
+	// streamWriteTimeout is the maximum time of writing a single event to
+	// a connected client.
+	streamWriteTimeout = 10 * time.Second
+)
+
+// Stream provides a live feed of JSON-serializable events. The stream is
+// subscribed once for each connected client; the handler receives events
+// until the returned function is called. The handler does not block.
+type Stream func(handler func(event interface{})) (unsubscribe func())
+
+var upgrader = websocket.Upgrader{
+	// The endpoint exposes read-only diagnostics of the node, so it is
+	// available to dashboards served from any origin.
+	CheckOrigin: func(request *http.Request) bool { return true },
+}
+
+// RegisterStream registers a diagnostics stream under the given name. Clients
+// receive events of the stream as JSON messages over a WebSocket connection
+// to the stream path. Registering another stream under the same name replaces
+// the previous one.
+func (r *Registry) RegisterStream(name string, stream Stream) {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+
+	r.streams[name] = stream
+}
+
+// isStreamRequest returns true if the request path points to a stream.
+func isStreamRequest(request *http.Request) bool {
+	return strings.HasPrefix(
+		strings.TrimPrefix(request.URL.Path, "/"),
+		streamPathPrefix,
+	)
+}
+
+// serveStream upgrades the connection to WebSocket and sends events of the
+// stream with the requested name to the client until the client disconnects.
+func (r *Registry) serveStream(
+	writer http.ResponseWriter,
+	request *http.Request,
+) {
+	name := strings.Trim(
+		strings.TrimPrefix(
+			strings.TrimPrefix(request.URL.Path, "/"),
+			streamPathPrefix,
+		),
+		"/",
+	)
+
+	r.mutex.RLock()
+	stream, ok := r.streams[name]
+	r.mutex.RUnlock()
+
+	if !ok {
+		http.Error(
+			writer,
+			fmt.Sprintf("unknown diagnostics stream [%v]", name),
+			http.StatusNotFound,
+		)
+		return
+	}
+
+	connection, err := upgrader.Upgrade(writer, request, nil)
+	if err != nil {
+		// the upgrader has already replied to the client
+		logger.Warningf("could not upgrade stream [%v] connection: [%v]", name, err)
+		return
+	}
+	defer connection.Close()
+
+	events := make(chan interface{}, streamBufferSize)
+	unsubscribe := stream(func(event interface{}) {
+		select {
+		case events <- event:
+		default:
+			logger.Warningf(
+				"dropping event of stream [%v] for slow client [%v]",
+				name,
+				request.RemoteAddr,
+			)
+		}
+	})
+	defer unsubscribe()
+
+	// The client is not expected to send anything; reading detects that
+	// the client has disconnected.
+	disconnected := make(chan struct{})
+	go func() {
+		defer close(disconnected)
+		for {
+			if _, _, err := connection.NextReader(); err != nil {
+				return
+			}
+		}
+	}()
+
+	for {
+		select {
+		case event := <-events:
+			connection.SetWriteDeadline(time.Now().Add(streamWriteTimeout))
+			if err := connection.WriteJSON(event); err != nil {
+				logger.Warningf(
+					"could not write event of stream [%v]: [%v]",
+					name,
+					err,
+				)
+				return
+			}
+		case <-disconnected:
+			return
+		}
+	}
+}
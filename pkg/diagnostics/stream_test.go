@@ -0,0 +1,109 @@
+package diagnostics
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/gorilla/websocket"
+)
+
+type streamEvent struct {
+	Type  string `json:"type"`
+	Phase string `json:"phase,omitempty"`
+}
+
+func TestServeStream(t *testing.T) {
+	subscribed := make(chan func(event interface{}))
+	unsubscribed := make(chan struct{})
+
+	registry := NewRegistry()
+	registry.RegisterStream("dkg", func(handler func(event interface{})) func() {
+		subscribed <- handler
+		return func() { close(unsubscribed) }
+	})
+
+	server := httptest.NewServer(registry)
+	defer server.Close()
+
+	connection, _, err := websocket.DefaultDialer.Dial(
+		"ws"+strings.TrimPrefix(server.URL, "http")+"/stream/dkg",
+		nil,
+	)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var handler func(event interface{})
+	select {
+	case handler = <-subscribed:
+	case <-time.After(5 * time.Second):
+		t.Fatal("stream has not been subscribed")
+	}
+
+	expectedEvents := []*streamEvent{
+		{Type: "phase", Phase: "commitment"},
+		{Type: "phase", Phase: "result submission"},
+		{Type: "submission"},
+	}
+	for _, event := range expectedEvents {
+		handler(event)
+	}
+
+	connection.SetReadDeadline(time.Now().Add(5 * time.Second))
+	events := make([]*streamEvent, 0)
+	for range expectedEvents {
+		event := &streamEvent{}
+		if err := connection.ReadJSON(event); err != nil {
+			t.Fatal(err)
+		}
+		events = append(events, event)
+	}
+
+	if !reflect.DeepEqual(expectedEvents, events) {
+		t.Errorf(
+			"unexpected events\nexpected: %v\nactual:   %v\n",
+			expectedEvents,
+			events,
+		)
+	}
+
+	connection.Close()
+
+	select {
+	case <-unsubscribed:
+	case <-time.After(5 * time.Second):
+		t.Errorf("stream has not been unsubscribed after disconnection")
+	}
+}
+
+func TestServeUnknownStream(t *testing.T) {
+	registry := NewRegistry()
+
+	recorder := httptest.NewRecorder()
+	registry.ServeHTTP(
+		recorder,
+		httptest.NewRequest(http.MethodGet, "/stream/dkg", nil),
+	)
+
+	if recorder.Code != http.StatusNotFound {
+		t.Errorf(
+			"unexpected status\nexpected: %v\nactual:   %v\n",
+			http.StatusNotFound,
+			recorder.Code,
+		)
+	}
+
+	expectedBody := "unknown diagnostics stream [dkg]"
+	body := strings.TrimSpace(recorder.Body.String())
+	if body != expectedBody {
+		t.Errorf(
+			"unexpected body\nexpected: %v\nactual:   %v\n",
+			expectedBody,
+			body,
+		)
+	}
+}
@@ -0,0 +1,84 @@
+// Package identifier formats big integer identifiers, such as relay request
+// IDs and group selection seeds, for logs and command-line output.
+package identifier
+
+import (
+	"fmt"
+	"math/big"
+	"sync"
+)
+
+// Format is a display format of big integer identifiers.
+type Format string
+
+const (
+	// Decimal displays identifiers as decimal numbers. It is the default
+	// format.
+	Decimal Format = "decimal"
+	// Hexadecimal displays identifiers as 0x-prefixed hexadecimal numbers,
+	// the same way block explorers do.
+	Hexadecimal Format = "hex"
+)
+
+var (
+	formatMutex   sync.RWMutex
+	currentFormat = Decimal
+)
+
+// ParseFormat parses the display format of the given name. Empty name means
+// the default decimal format.
+func ParseFormat(name string) (Format, error) {
+	switch Format(name) {
+	case "", Decimal:
+		return Decimal, nil
+	case Hexadecimal:
+		return Hexadecimal, nil
+	default:
+		return "", fmt.Errorf(
+			"unknown identifier format [%v]; expected [%v] or [%v]",
+			name,
+			Decimal,
+			Hexadecimal,
+		)
+	}
+}
+
+// Configure sets the display format of identifiers to the format of the
+// given name. Empty name means the default decimal format.
+func Configure(name string) error {
+	format, err := ParseFormat(name)
+	if err != nil {
+		return err
+	}
+
+	formatMutex.Lock()
+	defer formatMutex.Unlock()
+
+	currentFormat = format
+	return nil
+}
+
+// String returns the representation of the given identifier in the
+// configured display format.
+func String(id *big.Int) string {
+	formatMutex.RLock()
+	defer formatMutex.RUnlock()
+
+	return currentFormat.String(id)
+}
+
+// String returns the representation of the given identifier in the format.
+func (f Format) String(id *big.Int) string {
+	if id == nil {
+		return "<nil>"
+	}
+
+	if f == Hexadecimal {
+		if id.Sign() < 0 {
+			return "-0x" + new(big.Int).Neg(id).Text(16)
+		}
+		return "0x" + id.Text(16)
+	}
+
+	return id.Text(10)
+}
@@ -0,0 +1,124 @@
+package identifier
+
+import (
+	"fmt"
+	"math/big"
+	"reflect"
+	"testing"
+)
+
+func TestFormatString(t *testing.T) {
+	requestID, _ := new(big.Int).SetString(
+		"31415926535897932384626433832795028841971693993751",
+		10,
+	)
+
+	var tests = map[string]struct {
+		id                  *big.Int
+		expectedDecimal     string
+		expectedHexadecimal string
+	}{
+		"zero": {
+			id:                  big.NewInt(0),
+			expectedDecimal:     "0",
+			expectedHexadecimal: "0x0",
+		},
+		"small request ID": {
+			id:                  big.NewInt(255),
+			expectedDecimal:     "255",
+			expectedHexadecimal: "0xff",
+		},
+		"large request ID": {
+			id:                  requestID,
+			expectedDecimal:     "31415926535897932384626433832795028841971693993751",
+			expectedHexadecimal: "0x157ee2de15fd7333af23d76e19c99051cfd1fd3f17",
+		},
+		"negative ID": {
+			id:                  big.NewInt(-26),
+			expectedDecimal:     "-26",
+			expectedHexadecimal: "-0x1a",
+		},
+		"nil ID": {
+			id:                  nil,
+			expectedDecimal:     "<nil>",
+			expectedHexadecimal: "<nil>",
+		},
+	}
+
+	for testName, test := range tests {
+		t.Run(testName, func(t *testing.T) {
+			decimal := Decimal.String(test.id)
+			if decimal != test.expectedDecimal {
+				t.Errorf(
+					"unexpected decimal representation\nexpected: %v\nactual:   %v\n",
+					test.expectedDecimal,
+					decimal,
+				)
+			}
+
+			hexadecimal := Hexadecimal.String(test.id)
+			if hexadecimal != test.expectedHexadecimal {
+				t.Errorf(
+					"unexpected hexadecimal representation\nexpected: %v\nactual:   %v\n",
+					test.expectedHexadecimal,
+					hexadecimal,
+				)
+			}
+		})
+	}
+}
+
+func TestConfigure(t *testing.T) {
+	defer Configure("")
+
+	id := big.NewInt(3054)
+
+	var tests = map[string]struct {
+		format        string
+		expectedID    string
+		expectedError error
+	}{
+		"default format": {
+			format:     "",
+			expectedID: "3054",
+		},
+		"decimal format": {
+			format:     "decimal",
+			expectedID: "3054",
+		},
+		"hexadecimal format": {
+			format:     "hex",
+			expectedID: "0xbee",
+		},
+		"unknown format": {
+			format:     "octal",
+			expectedID: "3054",
+			expectedError: fmt.Errorf(
+				"unknown identifier format [octal]; expected [decimal] or [hex]",
+			),
+		},
+	}
+
+	for testName, test := range tests {
+		t.Run(testName, func(t *testing.T) {
+			Configure("")
+
+			err := Configure(test.format)
+			if !reflect.DeepEqual(test.expectedError, err) {
+				t.Errorf(
+					"unexpected error\nexpected: %v\nactual:   %v\n",
+					test.expectedError,
+					err,
+				)
+			}
+
+			if String(id) != test.expectedID {
+				t.Errorf(
+					"unexpected identifier\nexpected: %v\nactual:   %v\n",
+					test.expectedID,
+					String(id),
+				)
+			}
+		})
+	}
+}
@@ -1,9 +1,11 @@
 package dkgtest
 
 import (
+	"reflect"
 	"testing"
 
 	"github.com/keep-network/keep-core/pkg/altbn128"
+	"github.com/keep-network/keep-core/pkg/beacon/relay/dkg"
 	"github.com/keep-network/keep-core/pkg/beacon/relay/group"
 	"github.com/keep-network/keep-core/pkg/internal/testutils"
 )
@@ -212,3 +214,56 @@ func AssertResultSupportingMembers(
 		}
 	}
 }
+
+// AssertReplayedResults checks if replaying transcripts recorded by members
+// supporting the final result reproduces the result and its supporting
+// signatures.
+func AssertReplayedResults(t *testing.T, testResult *Result) {
+	replayed := 0
+	for _, recorded := range testResult.transcripts {
+		if _, ok := testResult.dkgResultSignatures[recorded.MemberIndex]; !ok {
+			continue
+		}
+
+		result, signatures, err := dkg.Replay(recorded)
+		if err != nil {
+			t.Errorf(
+				"could not replay transcript of member [%v]: [%v]",
+				recorded.MemberIndex,
+				err,
+			)
+			continue
+		}
+
+		if !reflect.DeepEqual(testResult.dkgResult, result) {
+			t.Errorf(
+				"unexpected result replayed by member [%v]\n"+
+					"expected: %v\nactual:   %v\n",
+				recorded.MemberIndex,
+				testResult.dkgResult,
+				result,
+			)
+		}
+
+		if !reflect.DeepEqual(testResult.dkgResultSignatures, signatures) {
+			t.Errorf(
+				"unexpected signatures replayed by member [%v]\n"+
+					"expected: %v\nactual:   %v\n",
+				recorded.MemberIndex,
+				testResult.dkgResultSignatures,
+				signatures,
+			)
+		}
+
+		replayed++
+	}
+
+	if replayed != len(testResult.dkgResultSignatures) {
+		t.Errorf(
+			"unexpected number of replayed transcripts\n"+
+				"expected: %v\nactual:   %v\n",
+			len(testResult.dkgResultSignatures),
+			replayed,
+		)
+	}
+}
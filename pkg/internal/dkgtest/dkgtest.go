@@ -15,6 +15,7 @@ import (
 	relaychain "github.com/keep-network/keep-core/pkg/beacon/relay/chain"
 	"github.com/keep-network/keep-core/pkg/beacon/relay/dkg"
 	dkgResult "github.com/keep-network/keep-core/pkg/beacon/relay/dkg/result"
+	"github.com/keep-network/keep-core/pkg/beacon/relay/dkg/transcript"
 	"github.com/keep-network/keep-core/pkg/beacon/relay/event"
 	"github.com/keep-network/keep-core/pkg/beacon/relay/gjkr"
 	"github.com/keep-network/keep-core/pkg/beacon/relay/group"
@@ -33,6 +34,7 @@ type Result struct {
 	dkgResultSignatures map[group.MemberIndex][]byte
 	signers             []*dkg.ThresholdSigner
 	memberFailures      []error
+	transcripts         []*transcript.Transcript
 }
 
 // GetSigners returns all signers created from DKG protocol execution.
@@ -42,6 +44,12 @@ func (r *Result) GetSigners() []*dkg.ThresholdSigner {
 	return r.signers
 }
 
+// GetTranscripts returns transcripts of DKG executions of all members which
+// completed the result publication.
+func (r *Result) GetTranscripts() []*transcript.Transcript {
+	return r.transcripts
+}
+
 // RandomSeed generates a random DKG seed value. It is important to do not
 // reuse the same seed value between integration tests run in parallel.
 // Broadcast channel name contains a seed to avoid mixing up channel messages
@@ -147,6 +155,9 @@ func executeDKG(
 		chain.Signing(),
 	)
 
+	transcriptsHandle := newTranscriptsHandle()
+	transcripts := transcript.NewStorage(transcriptsHandle, transcriptsKey)
+
 	for i := 0; i < relayConfig.GroupSize; i++ {
 		i := i // capture for goroutine
 		go func() {
@@ -161,6 +172,11 @@ func executeDKG(
 				chain.ThresholdRelay(),
 				chain.Signing(),
 				broadcastChannel,
+				gjkr.DefaultPhaseBudgets(),
+				dkg.ExecutionConfig{
+					VerifyGroupPublicKey: true,
+					Transcripts:          transcripts,
+				},
 			)
 			if signer != nil {
 				signersMutex.Lock()
@@ -176,6 +192,11 @@ func executeDKG(
 	}
 	wg.Wait()
 
+	recordedTranscripts, err := transcriptsHandle.transcripts()
+	if err != nil {
+		return nil, err
+	}
+
 	// We give 5 seconds so that OnDKGResultSubmitted async handler
 	// is fired. If it's not, than it means no result was published
 	// to the chain.
@@ -191,6 +212,7 @@ func executeDKG(
 			dkgResultSignatures,
 			signers,
 			memberFailures,
+			recordedTranscripts,
 		}, nil
 
 	case <-ctx.Done():
@@ -200,6 +222,7 @@ func executeDKG(
 			nil,
 			signers,
 			memberFailures,
+			recordedTranscripts,
 		}, nil
 	}
 }
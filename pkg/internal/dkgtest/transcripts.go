@@ -0,0 +1,66 @@
+package dkgtest
+
+import (
+	"sync"
+
+	"github.com/keep-network/keep-common/pkg/encryption"
+	"github.com/keep-network/keep-common/pkg/persistence"
+	"github.com/keep-network/keep-core/pkg/beacon/relay/dkg/transcript"
+)
+
+var transcriptsKey = [encryption.KeyLength]byte{0x01}
+
+// transcriptsHandle is an in-memory persistence handle keeping transcripts
+// saved by members executing DKG.
+type transcriptsHandle struct {
+	mutex sync.Mutex
+	saved [][]byte
+}
+
+func newTranscriptsHandle() *transcriptsHandle {
+	return &transcriptsHandle{
+		saved: make([][]byte, 0),
+	}
+}
+
+func (th *transcriptsHandle) Save(data []byte, directory, name string) error {
+	th.mutex.Lock()
+	defer th.mutex.Unlock()
+
+	th.saved = append(th.saved, data)
+	return nil
+}
+
+func (th *transcriptsHandle) ReadAll() (
+	<-chan persistence.DataDescriptor,
+	<-chan error,
+) {
+	dataChannel := make(chan persistence.DataDescriptor)
+	errorChannel := make(chan error)
+
+	close(dataChannel)
+	close(errorChannel)
+
+	return dataChannel, errorChannel
+}
+
+func (th *transcriptsHandle) Archive(directory string) error {
+	return nil
+}
+
+func (th *transcriptsHandle) transcripts() ([]*transcript.Transcript, error) {
+	th.mutex.Lock()
+	defer th.mutex.Unlock()
+
+	transcripts := make([]*transcript.Transcript, 0)
+	for _, encrypted := range th.saved {
+		decrypted, err := transcript.Decrypt(encrypted, transcriptsKey)
+		if err != nil {
+			return nil, err
+		}
+
+		transcripts = append(transcripts, decrypted)
+	}
+
+	return transcripts, nil
+}
@@ -0,0 +1,176 @@
+// Package dqtest provides a deterministic simulation of disqualifications and
+// inactivity of DKG group members. Scenarios script which members get
+// disqualified or marked as inactive in which phase of the protocol and drive
+// the group state up to the result preparation, without running the
+// cryptographic part of the protocol.
+package dqtest
+
+import (
+	"fmt"
+	"math/big"
+	"sort"
+
+	bn256 "github.com/ethereum/go-ethereum/crypto/bn256/cloudflare"
+	relaychain "github.com/keep-network/keep-core/pkg/beacon/relay/chain"
+	dkgResult "github.com/keep-network/keep-core/pkg/beacon/relay/dkg/result"
+	"github.com/keep-network/keep-core/pkg/beacon/relay/gjkr"
+	"github.com/keep-network/keep-core/pkg/beacon/relay/group"
+)
+
+// ResultPreparationPhase is the protocol phase in which members prepare the
+// DKG result for signing. Changes scripted for later phases are applied to the
+// group after the result has been prepared, as late disqualifications.
+//
+// See Phase 13 of the protocol specification.
+const ResultPreparationPhase = 13
+
+// Misbehaviour is the kind of change of the member's state in the group.
+type Misbehaviour int
+
+const (
+	// Disqualified marks the member as disqualified.
+	Disqualified Misbehaviour = iota
+	// Inactive marks the member as inactive.
+	Inactive
+)
+
+func (m Misbehaviour) String() string {
+	switch m {
+	case Disqualified:
+		return "disqualified"
+	case Inactive:
+		return "inactive"
+	default:
+		return fmt.Sprintf("misbehaviour(%d)", int(m))
+	}
+}
+
+// Change is a scripted change of the member's state in the given phase.
+type Change struct {
+	Phase        int
+	Member       group.MemberIndex
+	Misbehaviour Misbehaviour
+}
+
+// Scenario scripts changes of the group state during a single DKG execution.
+// Changes in the same phase are applied in the order they are listed.
+type Scenario struct {
+	GroupSize          int
+	DishonestThreshold int
+	Changes            []*Change
+}
+
+// Disqualify appends disqualifications of the given members in the given
+// phase to the scenario.
+func (s *Scenario) Disqualify(phase int, members ...group.MemberIndex) *Scenario {
+	return s.append(phase, Disqualified, members)
+}
+
+// Inactivate appends marking the given members as inactive in the given
+// phase to the scenario.
+func (s *Scenario) Inactivate(phase int, members ...group.MemberIndex) *Scenario {
+	return s.append(phase, Inactive, members)
+}
+
+func (s *Scenario) append(
+	phase int,
+	misbehaviour Misbehaviour,
+	members []group.MemberIndex,
+) *Scenario {
+	for _, member := range members {
+		s.Changes = append(s.Changes, &Change{
+			Phase:        phase,
+			Member:       member,
+			Misbehaviour: misbehaviour,
+		})
+	}
+
+	return s
+}
+
+// Outcome is the result of the scenario execution.
+type Outcome struct {
+	// Group is the final state of the group.
+	Group *group.Group
+	// Prepared is the DKG result prepared in ResultPreparationPhase.
+	Prepared *dkgResult.PreparedResult
+	// ThresholdSatisfied tells for each phase with a scripted change if the
+	// group has been still secure enough after the phase.
+	ThresholdSatisfied map[int]bool
+}
+
+// IsFailure returns true if the prepared result reflects a failed DKG.
+func (o *Outcome) IsFailure() bool {
+	return o.Prepared.IsFailure()
+}
+
+// Result returns the chain specific form of the prepared result.
+func (o *Outcome) Result() *relaychain.DKGResult {
+	return o.Prepared.Result()
+}
+
+// Run executes the scenario. Changes are applied to the group phase by phase,
+// the result is prepared in ResultPreparationPhase, and changes scripted for
+// later phases are applied after that. An error is returned if the scenario
+// refers to a member outside of the group.
+func Run(scenario *Scenario) (*Outcome, error) {
+	changes := make([]*Change, len(scenario.Changes))
+	copy(changes, scenario.Changes)
+	sort.SliceStable(changes, func(i, j int) bool {
+		return changes[i].Phase < changes[j].Phase
+	})
+
+	for _, change := range changes {
+		if change.Member < 1 || int(change.Member) > scenario.GroupSize {
+			return nil, fmt.Errorf(
+				"member [%v] %v in phase [%v] is not in the group of size [%v]",
+				change.Member,
+				change.Misbehaviour,
+				change.Phase,
+				scenario.GroupSize,
+			)
+		}
+	}
+
+	dkgGroup := group.NewDkgGroup(
+		scenario.DishonestThreshold,
+		scenario.GroupSize,
+	)
+
+	outcome := &Outcome{
+		Group:              dkgGroup,
+		ThresholdSatisfied: make(map[int]bool),
+	}
+
+	prepare := func() {
+		outcome.Prepared = dkgResult.PrepareResult(&gjkr.Result{
+			Group:          dkgGroup,
+			GroupPublicKey: new(bn256.G2).ScalarBaseMult(big.NewInt(1)),
+		})
+	}
+
+	for _, change := range changes {
+		if outcome.Prepared == nil && change.Phase > ResultPreparationPhase {
+			prepare()
+		}
+
+		switch change.Misbehaviour {
+		case Disqualified:
+			dkgGroup.MarkMemberAsDisqualified(
+				change.Member,
+				fmt.Sprintf("scripted in phase [%v]", change.Phase),
+			)
+		case Inactive:
+			dkgGroup.MarkMemberAsInactive(change.Member)
+		}
+
+		outcome.ThresholdSatisfied[change.Phase] =
+			dkgGroup.IsThresholdSatisfied()
+	}
+
+	if outcome.Prepared == nil {
+		prepare()
+	}
+
+	return outcome, nil
+}
@@ -132,9 +132,10 @@ func executeSigning(
 
 	for _, signer := range signers {
 		go func(signer *dkg.ThresholdSigner) {
-			err := entry.SignAndSubmit(
+			_, err := entry.SignAndSubmit(
 				blockCounter,
 				broadcastChannel,
+				nil,
 				chain.ThresholdRelay(),
 				previousEntry,
 				threshold,
@@ -0,0 +1,119 @@
+// Package logformat configures the format of log entries written by all
+// subsystem loggers.
+//
+// Log messages of the client are conventionally prefixed with a group of
+// tags identifying the entity the message concerns, e.g.
+// `[member:3] submitting DKG result` or `[member:3,state:*gjkr.State]`.
+// In the JSON format, such tags are written as separate fields of the entry
+// so that entries can be filtered by member index, request ID or block
+// height without parsing the message.
+package logformat
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"path/filepath"
+	"runtime"
+	"strings"
+	"time"
+
+	logging "github.com/whyrusleeping/go-logging"
+)
+
+const (
+	// Console is the human-readable format set up by default.
+	Console = "console"
+	// JSON is the format writing each log entry as a single-line JSON object.
+	JSON = "json"
+)
+
+// Configure sets the format of log entries to the format of the given name.
+// Empty name leaves the default console format.
+func Configure(format string) error {
+	switch format {
+	case "", Console:
+		return nil
+	case JSON:
+		logging.SetFormatter(&jsonFormatter{})
+		return nil
+	default:
+		return fmt.Errorf(
+			"unsupported log format [%v]; use [%v] or [%v]",
+			format,
+			Console,
+			JSON,
+		)
+	}
+}
+
+type jsonEntry struct {
+	Time    string            `json:"time"`
+	Level   string            `json:"level"`
+	Module  string            `json:"module"`
+	Caller  string            `json:"caller"`
+	Tags    map[string]string `json:"tags,omitempty"`
+	Message string            `json:"message"`
+}
+
+// jsonFormatter writes log records as single-line JSON objects.
+type jsonFormatter struct{}
+
+func (jf *jsonFormatter) Format(
+	calldepth int,
+	record *logging.Record,
+	output io.Writer,
+) error {
+	caller := "???"
+	if _, file, line, ok := runtime.Caller(calldepth + 1); ok {
+		caller = fmt.Sprintf("%s:%d", filepath.Base(file), line)
+	}
+
+	tags, message := splitTags(record.Message())
+
+	encoded, err := json.Marshal(&jsonEntry{
+		Time:    record.Time.UTC().Format(time.RFC3339Nano),
+		Level:   record.Level.String(),
+		Module:  record.Module,
+		Caller:  caller,
+		Tags:    tags,
+		Message: message,
+	})
+	if err != nil {
+		return err
+	}
+
+	_, err = output.Write(encoded)
+	return err
+}
+
+// splitTags separates the leading group of tags from the message. Tags are
+// expected in the `[key:value,key:value]` form. If the message does not start
+// with such a group, no tags are returned along with the unchanged message.
+func splitTags(message string) (map[string]string, string) {
+	if !strings.HasPrefix(message, "[") {
+		return nil, message
+	}
+
+	end := strings.Index(message, "]")
+	if end < 0 {
+		return nil, message
+	}
+
+	tags := make(map[string]string)
+	for _, tag := range strings.Split(message[1:end], ",") {
+		keyValue := strings.SplitN(tag, ":", 2)
+		if len(keyValue) != 2 {
+			return nil, message
+		}
+
+		key := strings.TrimSpace(keyValue[0])
+		if key == "" || strings.ContainsAny(key, " []") {
+			return nil, message
+		}
+
+		tags[key] = strings.TrimSpace(keyValue[1])
+	}
+
+	return tags, strings.TrimSpace(message[end+1:])
+}
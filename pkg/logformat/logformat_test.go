@@ -0,0 +1,133 @@
+package logformat
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"strings"
+	"testing"
+
+	logging "github.com/whyrusleeping/go-logging"
+)
+
+func TestSplitTags(t *testing.T) {
+	var tests = map[string]struct {
+		message         string
+		expectedTags    map[string]string
+		expectedMessage string
+	}{
+		"no tags": {
+			message:         "monitoring chain for a new relay entry",
+			expectedTags:    nil,
+			expectedMessage: "monitoring chain for a new relay entry",
+		},
+		"single tag": {
+			message:         "[member:3] waiting for block [120] to submit",
+			expectedTags:    map[string]string{"member": "3"},
+			expectedMessage: "waiting for block [120] to submit",
+		},
+		"multiple tags with spaces": {
+			message: "[member: 3, state:*gjkr.State] failed to receive",
+			expectedTags: map[string]string{
+				"member": "3",
+				"state":  "*gjkr.State",
+			},
+			expectedMessage: "failed to receive",
+		},
+		"bracketed value": {
+			message:         "[0x1f] is not a valid group",
+			expectedTags:    nil,
+			expectedMessage: "[0x1f] is not a valid group",
+		},
+	}
+
+	for testName, test := range tests {
+		t.Run(testName, func(t *testing.T) {
+			tags, message := splitTags(test.message)
+
+			if !reflect.DeepEqual(test.expectedTags, tags) {
+				t.Errorf(
+					"unexpected tags\nexpected: %v\nactual:   %v\n",
+					test.expectedTags,
+					tags,
+				)
+			}
+			if test.expectedMessage != message {
+				t.Errorf(
+					"unexpected message\nexpected: %v\nactual:   %v\n",
+					test.expectedMessage,
+					message,
+				)
+			}
+		})
+	}
+}
+
+func TestJSONFormat(t *testing.T) {
+	buffer := &bytes.Buffer{}
+	backend := logging.AddModuleLevel(
+		logging.NewBackendFormatter(
+			logging.NewLogBackend(buffer, "", 0),
+			&jsonFormatter{},
+		),
+	)
+
+	logger := logging.MustGetLogger("keep-logformat-test")
+	logger.SetBackend(backend)
+
+	logger.Warningf("[member:%v] deferring DKG result submission", 5)
+
+	entry := &jsonEntry{}
+	if err := json.Unmarshal(buffer.Bytes(), entry); err != nil {
+		t.Fatal(err)
+	}
+
+	if entry.Level != "WARNING" {
+		t.Errorf(
+			"unexpected level\nexpected: %v\nactual:   %v\n",
+			"WARNING",
+			entry.Level,
+		)
+	}
+	if entry.Module != "keep-logformat-test" {
+		t.Errorf(
+			"unexpected module\nexpected: %v\nactual:   %v\n",
+			"keep-logformat-test",
+			entry.Module,
+		)
+	}
+	if !strings.HasPrefix(entry.Caller, "logformat_test.go:") {
+		t.Errorf("unexpected caller [%v]", entry.Caller)
+	}
+	expectedTags := map[string]string{"member": "5"}
+	if !reflect.DeepEqual(expectedTags, entry.Tags) {
+		t.Errorf(
+			"unexpected tags\nexpected: %v\nactual:   %v\n",
+			expectedTags,
+			entry.Tags,
+		)
+	}
+	if entry.Message != "deferring DKG result submission" {
+		t.Errorf(
+			"unexpected message\nexpected: %v\nactual:   %v\n",
+			"deferring DKG result submission",
+			entry.Message,
+		)
+	}
+}
+
+func TestConfigureUnsupportedFormat(t *testing.T) {
+	err := Configure("xml")
+
+	expectedError := fmt.Errorf(
+		"unsupported log format [xml]; use [console] or [json]",
+	)
+	if !reflect.DeepEqual(expectedError, err) {
+		t.Errorf(
+			"unexpected error\nexpected: %v\nactual:   %v\n",
+			expectedError,
+			err,
+		)
+	}
+}
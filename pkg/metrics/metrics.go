@@ -0,0 +1,105 @@
+// Package metrics records the node's operational metrics and exports them to
+// the monitoring backend selected by the operator.
+//
+// Metrics are recorded through the Recorder interface independently of the
+// backend. The Prometheus backend serves recorded metrics over HTTP to be
+// scraped, and the statsd backends push each recorded metric event to the
+// configured statsd or DogStatsD agent.
+package metrics
+
+import (
+	"fmt"
+	"sort"
+
+	"github.com/ipfs/go-log"
+)
+
+var logger = log.Logger("keep-metrics")
+
+const (
+	// PrometheusBackend serves metrics in the Prometheus text format.
+	PrometheusBackend = "prometheus"
+	// StatsdBackend pushes metrics to a statsd agent. Labels are appended to
+	// the metric name since plain statsd does not support tags.
+	StatsdBackend = "statsd"
+	// DogStatsdBackend pushes metrics to a DogStatsD agent with labels sent
+	// as tags.
+	DogStatsdBackend = "dogstatsd"
+)
+
+// Config stores configuration of the metrics backend.
+type Config struct {
+	// Backend is the name of the metrics backend. If not set, metrics are
+	// not exported.
+	Backend string
+	// Port on which the Prometheus backend serves metrics.
+	Port int
+	// Address is the host:port UDP address of the statsd agent.
+	Address string
+	// Prefix is prepended to names of all metrics pushed to the statsd agent.
+	Prefix string
+}
+
+// Labels are the dimensions of a metric, for example, the outcome of
+// a recorded operation.
+type Labels map[string]string
+
+// Recorder records metric events.
+type Recorder interface {
+	// IncrementCounter increments the counter with the given name and labels
+	// by one.
+	IncrementCounter(name string, labels Labels)
+	// SetGauge sets the gauge with the given name and labels to the given
+	// value.
+	SetGauge(name string, labels Labels, value float64)
+}
+
+// Initialize creates a recorder for the configured backend. If no backend is
+// configured, the returned recorder discards all events.
+func Initialize(config Config) (Recorder, error) {
+	switch config.Backend {
+	case "":
+		return &noopRecorder{}, nil
+	case PrometheusBackend:
+		if config.Port == 0 {
+			return nil, fmt.Errorf("port is required for prometheus backend")
+		}
+
+		recorder := NewPrometheusRecorder()
+		recorder.Serve(config.Port)
+		return recorder, nil
+	case StatsdBackend, DogStatsdBackend:
+		if config.Address == "" {
+			return nil, fmt.Errorf(
+				"address is required for [%v] backend",
+				config.Backend,
+			)
+		}
+
+		return NewStatsdRecorder(
+			config.Address,
+			config.Prefix,
+			config.Backend == DogStatsdBackend,
+		)
+	default:
+		return nil, fmt.Errorf("unsupported metrics backend [%v]", config.Backend)
+	}
+}
+
+type noopRecorder struct{}
+
+func (nr *noopRecorder) IncrementCounter(name string, labels Labels) {}
+
+func (nr *noopRecorder) SetGauge(name string, labels Labels, value float64) {}
+
+// sortedKeys returns label names in the lexicographical order so that
+// metrics are exported deterministically.
+func (l Labels) sortedKeys() []string {
+	keys := make([]string, 0, len(l))
+	for key := range l {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	return keys
+}
@@ -0,0 +1,40 @@
+package metrics
+
+import (
+	"fmt"
+	"reflect"
+	"testing"
+)
+
+func TestInitializeInvalidConfig(t *testing.T) {
+	var tests = map[string]struct {
+		config        Config
+		expectedError error
+	}{
+		"prometheus without port": {
+			config:        Config{Backend: PrometheusBackend},
+			expectedError: fmt.Errorf("port is required for prometheus backend"),
+		},
+		"statsd without address": {
+			config:        Config{Backend: StatsdBackend},
+			expectedError: fmt.Errorf("address is required for [statsd] backend"),
+		},
+		"unsupported backend": {
+			config:        Config{Backend: "graphite"},
+			expectedError: fmt.Errorf("unsupported metrics backend [graphite]"),
+		},
+	}
+
+	for testName, test := range tests {
+		t.Run(testName, func(t *testing.T) {
+			_, err := Initialize(test.config)
+			if !reflect.DeepEqual(test.expectedError, err) {
+				t.Errorf(
+					"unexpected error\nexpected: %v\nactual:   %v\n",
+					test.expectedError,
+					err,
+				)
+			}
+		})
+	}
+}
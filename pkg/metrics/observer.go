@@ -0,0 +1,40 @@
+package metrics
+
+import (
+	"context"
+	"time"
+)
+
+// DefaultObservationInterval is the default interval at which observed gauges
+// are updated.
+const DefaultObservationInterval = time.Minute
+
+// ObserveGauge periodically sets the gauge with the given name to the value
+// returned by the given source until the context is done. The gauge is set
+// for the first time immediately. If the source fails, the gauge keeps its
+// previous value until the next observation.
+func ObserveGauge(
+	ctx context.Context,
+	recorder Recorder,
+	name string,
+	interval time.Duration,
+	source func() (float64, error),
+) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		value, err := source()
+		if err != nil {
+			logger.Warningf("could not observe gauge [%v]: [%v]", name, err)
+		} else {
+			recorder.SetGauge(name, nil, value)
+		}
+
+		select {
+		case <-ticker.C:
+		case <-ctx.Done():
+			return
+		}
+	}
+}
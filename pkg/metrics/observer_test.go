@@ -0,0 +1,55 @@
+package metrics
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestObserveGauge(t *testing.T) {
+	recorder := NewPrometheusRecorder()
+
+	ctx, cancelCtx := context.WithCancel(context.Background())
+
+	observations := make(chan struct{}, 100)
+	observation := 0
+	done := make(chan struct{})
+	go func() {
+		ObserveGauge(
+			ctx,
+			recorder,
+			"connected_peers",
+			time.Millisecond,
+			func() (float64, error) {
+				observation++
+				defer func() { observations <- struct{}{} }()
+
+				if observation == 2 {
+					return 0, fmt.Errorf("source failed")
+				}
+				return float64(observation), nil
+			},
+		)
+		close(done)
+	}()
+
+	for i := 0; i < 3; i++ {
+		<-observations
+	}
+	cancelCtx()
+	<-done
+
+	// the failed second observation is followed by successful ones
+	expectedValue := fmt.Sprintf("connected_peers %v\n", observation)
+
+	exposition := string(recorder.exposition())
+	if !strings.Contains(exposition, expectedValue) {
+		t.Errorf(
+			"unexpected exposition\nexpected: %v\nactual:   %v\n",
+			expectedValue,
+			exposition,
+		)
+	}
+}
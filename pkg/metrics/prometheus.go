@@ -0,0 +1,175 @@
+package metrics
+
+import (
+	"bytes"
+	"fmt"
+	"net/http"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+)
+
+const (
+	counterType = "counter"
+	gaugeType   = "gauge"
+)
+
+// PrometheusRecorder keeps recorded metrics in memory and serves their current
+// values in the Prometheus text exposition format.
+type PrometheusRecorder struct {
+	mutex   sync.Mutex
+	metrics map[string]*prometheusMetric
+
+	serverMutex sync.Mutex
+	server      *http.Server
+}
+
+type prometheusMetric struct {
+	metricType string
+	// values of the metric keyed by the formatted labels
+	values map[string]float64
+}
+
+// NewPrometheusRecorder creates a recorder with no metrics recorded.
+func NewPrometheusRecorder() *PrometheusRecorder {
+	return &PrometheusRecorder{
+		metrics: make(map[string]*prometheusMetric),
+	}
+}
+
+// Serve starts serving recorded metrics over HTTP under the /metrics path on
+// the given port. If metrics are already served on another port, that server
+// is closed first.
+func (pr *PrometheusRecorder) Serve(port int) {
+	mux := http.NewServeMux()
+	mux.Handle("/metrics", pr)
+
+	server := &http.Server{
+		Addr:    fmt.Sprintf(":%v", port),
+		Handler: mux,
+	}
+
+	pr.serverMutex.Lock()
+	if pr.server != nil {
+		if err := pr.server.Close(); err != nil {
+			logger.Errorf("could not close metrics server: [%v]", err)
+		}
+	}
+	pr.server = server
+	pr.serverMutex.Unlock()
+
+	go func() {
+		logger.Infof("serving metrics on [%v]", server.Addr)
+
+		err := server.ListenAndServe()
+		if err != nil && err != http.ErrServerClosed {
+			logger.Errorf("metrics server failed: [%v]", err)
+		}
+	}()
+}
+
+// IncrementCounter increments the counter with the given name and labels
+// by one.
+func (pr *PrometheusRecorder) IncrementCounter(name string, labels Labels) {
+	pr.mutex.Lock()
+	defer pr.mutex.Unlock()
+
+	pr.metric(name, counterType).values[formatPrometheusLabels(labels)]++
+}
+
+// SetGauge sets the gauge with the given name and labels to the given value.
+func (pr *PrometheusRecorder) SetGauge(
+	name string,
+	labels Labels,
+	value float64,
+) {
+	pr.mutex.Lock()
+	defer pr.mutex.Unlock()
+
+	pr.metric(name, gaugeType).values[formatPrometheusLabels(labels)] = value
+}
+
+// metric returns the metric with the given name, creating it if it has not
+// been recorded yet. Must be called with the mutex held.
+func (pr *PrometheusRecorder) metric(
+	name string,
+	metricType string,
+) *prometheusMetric {
+	metric, ok := pr.metrics[name]
+	if !ok {
+		metric = &prometheusMetric{
+			metricType: metricType,
+			values:     make(map[string]float64),
+		}
+		pr.metrics[name] = metric
+	}
+
+	return metric
+}
+
+// ServeHTTP writes all recorded metrics in the Prometheus text format.
+func (pr *PrometheusRecorder) ServeHTTP(
+	writer http.ResponseWriter,
+	request *http.Request,
+) {
+	if request.Method != http.MethodGet {
+		http.Error(writer, "method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+
+	writer.Header().Set("Content-Type", "text/plain; version=0.0.4")
+	if _, err := writer.Write(pr.exposition()); err != nil {
+		logger.Errorf("could not write metrics response: [%v]", err)
+	}
+}
+
+// exposition formats all recorded metrics in the Prometheus text format with
+// metrics and their values sorted for a deterministic output.
+func (pr *PrometheusRecorder) exposition() []byte {
+	pr.mutex.Lock()
+	defer pr.mutex.Unlock()
+
+	names := make([]string, 0, len(pr.metrics))
+	for name := range pr.metrics {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	var buffer bytes.Buffer
+	for _, name := range names {
+		metric := pr.metrics[name]
+		fmt.Fprintf(&buffer, "# TYPE %v %v\n", name, metric.metricType)
+
+		labels := make([]string, 0, len(metric.values))
+		for formattedLabels := range metric.values {
+			labels = append(labels, formattedLabels)
+		}
+		sort.Strings(labels)
+
+		for _, formattedLabels := range labels {
+			fmt.Fprintf(
+				&buffer,
+				"%v%v %v\n",
+				name,
+				formattedLabels,
+				strconv.FormatFloat(metric.values[formattedLabels], 'g', -1, 64),
+			)
+		}
+	}
+
+	return buffer.Bytes()
+}
+
+func formatPrometheusLabels(labels Labels) string {
+	if len(labels) == 0 {
+		return ""
+	}
+
+	pairs := make([]string, 0, len(labels))
+	for _, key := range labels.sortedKeys() {
+		pairs = append(pairs, fmt.Sprintf("%v=%q", key, labels[key]))
+	}
+
+	return "{" + strings.Join(pairs, ",") + "}"
+}
@@ -0,0 +1,52 @@
+package metrics
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestPrometheusRecorderServeHTTP(t *testing.T) {
+	recorder := NewPrometheusRecorder()
+	recorder.IncrementCounter(
+		"dkg_result_submissions",
+		Labels{"outcome": "submitted"},
+	)
+	recorder.IncrementCounter(
+		"dkg_result_submissions",
+		Labels{"outcome": "submitted"},
+	)
+	recorder.IncrementCounter(
+		"dkg_result_submissions",
+		Labels{"outcome": "failed"},
+	)
+	recorder.SetGauge("connected_peers", nil, 3)
+	recorder.SetGauge("connected_peers", nil, 4)
+
+	response := httptest.NewRecorder()
+	recorder.ServeHTTP(
+		response,
+		httptest.NewRequest(http.MethodGet, "/metrics", nil),
+	)
+
+	expectedBody := "# TYPE connected_peers gauge\n" +
+		"connected_peers 4\n" +
+		"# TYPE dkg_result_submissions counter\n" +
+		"dkg_result_submissions{outcome=\"failed\"} 1\n" +
+		"dkg_result_submissions{outcome=\"submitted\"} 2\n"
+
+	if response.Code != http.StatusOK {
+		t.Errorf(
+			"unexpected status\nexpected: %v\nactual:   %v\n",
+			http.StatusOK,
+			response.Code,
+		)
+	}
+	if response.Body.String() != expectedBody {
+		t.Errorf(
+			"unexpected body\nexpected: %v\nactual:   %v\n",
+			expectedBody,
+			response.Body.String(),
+		)
+	}
+}
@@ -0,0 +1,98 @@
+package metrics
+
+import (
+	"fmt"
+	"net"
+	"strconv"
+	"strings"
+)
+
+// StatsdRecorder pushes each recorded metric event to a statsd agent as
+// a separate UDP packet. Delivery is best-effort; events that could not be
+// sent are dropped.
+type StatsdRecorder struct {
+	connection net.Conn
+	prefix     string
+	// whether labels are sent as DogStatsD tags or appended to the name
+	tagged bool
+}
+
+// NewStatsdRecorder creates a recorder pushing metrics to the statsd agent at
+// the given UDP address. If tagged is true, labels are sent as DogStatsD tags.
+// Otherwise, label values are appended to the metric name.
+func NewStatsdRecorder(
+	address string,
+	prefix string,
+	tagged bool,
+) (*StatsdRecorder, error) {
+	connection, err := net.Dial("udp", address)
+	if err != nil {
+		return nil, fmt.Errorf(
+			"could not connect to statsd agent [%v]: [%v]",
+			address,
+			err,
+		)
+	}
+
+	return &StatsdRecorder{
+		connection: connection,
+		prefix:     prefix,
+		tagged:     tagged,
+	}, nil
+}
+
+// IncrementCounter sends a counter increment of the metric with the given name
+// and labels.
+func (sr *StatsdRecorder) IncrementCounter(name string, labels Labels) {
+	sr.send(sr.packet(name, labels, "1", "c"))
+}
+
+// SetGauge sends the value of the gauge with the given name and labels.
+func (sr *StatsdRecorder) SetGauge(name string, labels Labels, value float64) {
+	sr.send(sr.packet(
+		name,
+		labels,
+		strconv.FormatFloat(value, 'g', -1, 64),
+		"g",
+	))
+}
+
+// packet formats the metric event in the statsd line format.
+func (sr *StatsdRecorder) packet(
+	name string,
+	labels Labels,
+	value string,
+	metricType string,
+) string {
+	if sr.prefix != "" {
+		name = sr.prefix + "." + name
+	}
+
+	if !sr.tagged {
+		for _, key := range labels.sortedKeys() {
+			name = name + "." + labels[key]
+		}
+	}
+
+	packet := fmt.Sprintf("%v:%v|%v", name, value, metricType)
+	if sr.tagged && len(labels) > 0 {
+		tags := make([]string, 0, len(labels))
+		for _, key := range labels.sortedKeys() {
+			tags = append(tags, key+":"+labels[key])
+		}
+		packet = packet + "|#" + strings.Join(tags, ",")
+	}
+
+	return packet
+}
+
+func (sr *StatsdRecorder) send(packet string) {
+	if _, err := sr.connection.Write([]byte(packet)); err != nil {
+		logger.Warningf("could not send metric to statsd agent: [%v]", err)
+	}
+}
+
+// Close closes the connection to the statsd agent.
+func (sr *StatsdRecorder) Close() error {
+	return sr.connection.Close()
+}
@@ -0,0 +1,103 @@
+package metrics
+
+import (
+	"net"
+	"testing"
+	"time"
+)
+
+func TestStatsdRecorderPackets(t *testing.T) {
+	var tests = map[string]struct {
+		prefix         string
+		tagged         bool
+		record         func(recorder Recorder)
+		expectedPacket string
+	}{
+		"counter without labels": {
+			record: func(recorder Recorder) {
+				recorder.IncrementCounter("dkg_participations", nil)
+			},
+			expectedPacket: "dkg_participations:1|c",
+		},
+		"counter with prefix": {
+			prefix: "keep",
+			record: func(recorder Recorder) {
+				recorder.IncrementCounter("dkg_participations", nil)
+			},
+			expectedPacket: "keep.dkg_participations:1|c",
+		},
+		"counter with labels in name": {
+			record: func(recorder Recorder) {
+				recorder.IncrementCounter(
+					"dkg_result_submissions",
+					Labels{"outcome": "submitted", "attempt": "1"},
+				)
+			},
+			expectedPacket: "dkg_result_submissions.1.submitted:1|c",
+		},
+		"counter with tags": {
+			tagged: true,
+			record: func(recorder Recorder) {
+				recorder.IncrementCounter(
+					"dkg_result_submissions",
+					Labels{"outcome": "submitted", "attempt": "1"},
+				)
+			},
+			expectedPacket: "dkg_result_submissions:1|c|#attempt:1,outcome:submitted",
+		},
+		"gauge with tags": {
+			prefix: "keep",
+			tagged: true,
+			record: func(recorder Recorder) {
+				recorder.SetGauge(
+					"connected_peers",
+					Labels{"network": "libp2p"},
+					12.5,
+				)
+			},
+			expectedPacket: "keep.connected_peers:12.5|g|#network:libp2p",
+		},
+	}
+
+	for testName, test := range tests {
+		t.Run(testName, func(t *testing.T) {
+			listener, err := net.ListenPacket("udp", "127.0.0.1:0")
+			if err != nil {
+				t.Fatal(err)
+			}
+			defer listener.Close()
+
+			recorder, err := NewStatsdRecorder(
+				listener.LocalAddr().String(),
+				test.prefix,
+				test.tagged,
+			)
+			if err != nil {
+				t.Fatal(err)
+			}
+			defer recorder.Close()
+
+			test.record(recorder)
+
+			err = listener.SetReadDeadline(time.Now().Add(time.Second))
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			buffer := make([]byte, 1024)
+			n, _, err := listener.ReadFrom(buffer)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			packet := string(buffer[:n])
+			if packet != test.expectedPacket {
+				t.Errorf(
+					"unexpected packet\nexpected: %v\nactual:   %v\n",
+					test.expectedPacket,
+					packet,
+				)
+			}
+		})
+	}
+}
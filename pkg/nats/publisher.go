@@ -0,0 +1,98 @@
+// Package nats implements a minimal publisher for the NATS message broker
+// text protocol, sufficient to hand off messages to services draining
+// a NATS subject.
+package nats
+
+import (
+	"bufio"
+	"fmt"
+	"net"
+	"strings"
+	"time"
+)
+
+// DefaultTimeout is the maximum time a single publication may take,
+// including connecting to the broker.
+const DefaultTimeout = 5 * time.Second
+
+// Publisher publishes messages to the NATS broker at the configured address.
+// Each publication uses a fresh connection and is confirmed by the broker
+// before Publish returns.
+type Publisher struct {
+	address string
+	timeout time.Duration
+}
+
+// NewPublisher creates a publisher for the broker at the given host:port
+// address with the default timeout.
+func NewPublisher(address string) *Publisher {
+	return &Publisher{
+		address: address,
+		timeout: DefaultTimeout,
+	}
+}
+
+// Publish publishes the payload on the given subject. It returns an error
+// if the broker could not be reached or rejected the message.
+func (p *Publisher) Publish(subject string, payload []byte) error {
+	if subject == "" || strings.ContainsAny(subject, " \t\r\n") {
+		return fmt.Errorf("invalid subject [%v]", subject)
+	}
+
+	connection, err := net.DialTimeout("tcp", p.address, p.timeout)
+	if err != nil {
+		return fmt.Errorf("could not connect to broker: [%v]", err)
+	}
+	defer connection.Close()
+
+	if err := connection.SetDeadline(time.Now().Add(p.timeout)); err != nil {
+		return fmt.Errorf("could not set connection deadline: [%v]", err)
+	}
+
+	reader := bufio.NewReader(connection)
+
+	info, err := readLine(reader)
+	if err != nil {
+		return fmt.Errorf("could not read server info: [%v]", err)
+	}
+	if !strings.HasPrefix(info, "INFO") {
+		return fmt.Errorf("unexpected server greeting [%v]", info)
+	}
+
+	// Verbose mode is off so the broker only responds to the final PING,
+	// or with an error if any of the preceding commands failed.
+	message := fmt.Sprintf(
+		"CONNECT {\"verbose\":false,\"pedantic\":false}\r\n"+
+			"PUB %s %d\r\n%s\r\n"+
+			"PING\r\n",
+		subject,
+		len(payload),
+		payload,
+	)
+	if _, err := connection.Write([]byte(message)); err != nil {
+		return fmt.Errorf("could not write message: [%v]", err)
+	}
+
+	for {
+		response, err := readLine(reader)
+		if err != nil {
+			return fmt.Errorf("could not read broker response: [%v]", err)
+		}
+
+		switch {
+		case response == "PONG":
+			return nil
+		case strings.HasPrefix(response, "-ERR"):
+			return fmt.Errorf("broker rejected message: [%v]", response)
+		}
+	}
+}
+
+func readLine(reader *bufio.Reader) (string, error) {
+	line, err := reader.ReadString('\n')
+	if err != nil {
+		return "", err
+	}
+
+	return strings.TrimRight(line, "\r\n"), nil
+}
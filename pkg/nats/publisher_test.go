@@ -0,0 +1,122 @@
+package nats
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"net"
+	"strconv"
+	"strings"
+	"testing"
+)
+
+type publication struct {
+	subject string
+	payload string
+}
+
+// fakeBroker accepts a single connection, records the published message and
+// responds to PING with the given response.
+func fakeBroker(
+	t *testing.T,
+	pingResponse string,
+) (string, chan *publication) {
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	publications := make(chan *publication, 1)
+
+	go func() {
+		defer listener.Close()
+
+		connection, err := listener.Accept()
+		if err != nil {
+			return
+		}
+		defer connection.Close()
+
+		fmt.Fprintf(connection, "INFO {\"server_id\":\"test\"}\r\n")
+
+		reader := bufio.NewReader(connection)
+		for {
+			line, err := readLine(reader)
+			if err != nil {
+				return
+			}
+
+			fields := strings.Fields(line)
+			switch fields[0] {
+			case "PUB":
+				size, _ := strconv.Atoi(fields[2])
+				payload := make([]byte, size+2)
+				if _, err := io.ReadFull(reader, payload); err != nil {
+					return
+				}
+				publications <- &publication{
+					subject: fields[1],
+					payload: string(payload[:size]),
+				}
+			case "PING":
+				fmt.Fprintf(connection, "%s\r\n", pingResponse)
+			}
+		}
+	}()
+
+	return listener.Addr().String(), publications
+}
+
+func TestPublish(t *testing.T) {
+	address, publications := fakeBroker(t, "PONG")
+
+	payload := []byte("{\"memberIndex\":1}")
+
+	err := NewPublisher(address).Publish("keep.dkg.submission", payload)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	published := <-publications
+	if published.subject != "keep.dkg.submission" {
+		t.Errorf(
+			"unexpected subject\nexpected: %v\nactual:   %v\n",
+			"keep.dkg.submission",
+			published.subject,
+		)
+	}
+	if published.payload != string(payload) {
+		t.Errorf(
+			"unexpected payload\nexpected: %v\nactual:   %v\n",
+			string(payload),
+			published.payload,
+		)
+	}
+}
+
+func TestPublishErrors(t *testing.T) {
+	var tests = map[string]struct {
+		subject      string
+		pingResponse string
+	}{
+		"broker rejects message": {
+			subject:      "keep.dkg.submission",
+			pingResponse: "-ERR 'Permissions Violation'",
+		},
+		"invalid subject": {
+			subject:      "keep dkg",
+			pingResponse: "PONG",
+		},
+	}
+
+	for testName, test := range tests {
+		t.Run(testName, func(t *testing.T) {
+			address, _ := fakeBroker(t, test.pingResponse)
+
+			err := NewPublisher(address).Publish(test.subject, []byte("{}"))
+			if err == nil {
+				t.Errorf("expected publication to fail")
+			}
+		})
+	}
+}
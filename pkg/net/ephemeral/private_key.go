@@ -2,6 +2,8 @@ package ephemeral
 
 import (
 	"fmt"
+	"io"
+	"math/big"
 
 	"github.com/btcsuite/btcd/btcec"
 )
@@ -40,6 +42,33 @@ func GenerateKeyPair() (*KeyPair, error) {
 	}, nil
 }
 
+// GenerateKeyPairFrom generates a pair of public and private elliptic curve
+// ephemeral key from the provided source of randomness. The same randomness
+// always yields the same key pair.
+func GenerateKeyPairFrom(randomness io.Reader) (*KeyPair, error) {
+	// Eight additional bytes make the bias of the modular reduction
+	// negligible.
+	randomBytes := make([]byte, curve().BitSize/8+8)
+	if _, err := io.ReadFull(randomness, randomBytes); err != nil {
+		return nil, fmt.Errorf(
+			"could not generate new ephemeral keypair [%v]",
+			err,
+		)
+	}
+
+	// k = random mod (N - 1) + 1, so that the private key is in [1, N-1]
+	orderMinusOne := new(big.Int).Sub(curve().N, big.NewInt(1))
+	k := new(big.Int).Mod(new(big.Int).SetBytes(randomBytes), orderMinusOne)
+	k.Add(k, big.NewInt(1))
+
+	privateKey, publicKey := btcec.PrivKeyFromBytes(curve(), k.Bytes())
+
+	return &KeyPair{
+		(*PrivateKey)(privateKey),
+		(*PublicKey)(publicKey),
+	}, nil
+}
+
 // IsKeyMatching verifies if private key is valid for given public key.
 // It checks if public key equals `g^privateKey`, where `g` is a base point of
 // the curve.
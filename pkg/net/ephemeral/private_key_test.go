@@ -1,6 +1,7 @@
 package ephemeral
 
 import (
+	"bytes"
 	"reflect"
 	"testing"
 )
@@ -57,3 +58,31 @@ func TestIsKeyMatching(t *testing.T) {
 		t.Fatal("private key matches wrong public key")
 	}
 }
+
+func TestGenerateKeyPairFromIsDeterministic(t *testing.T) {
+	randomness := make([]byte, 80)
+	for i := range randomness {
+		randomness[i] = byte(i)
+	}
+
+	keyPair1, err := GenerateKeyPairFrom(bytes.NewReader(randomness))
+	if err != nil {
+		t.Fatal(err)
+	}
+	keyPair2, err := GenerateKeyPairFrom(bytes.NewReader(randomness))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if !reflect.DeepEqual(keyPair1, keyPair2) {
+		t.Fatal("key pairs generated from the same randomness do not match")
+	}
+	if !keyPair1.PublicKey.IsKeyMatching(keyPair1.PrivateKey) {
+		t.Fatal("private key does not match the public key")
+	}
+
+	_, err = GenerateKeyPairFrom(bytes.NewReader(randomness[:10]))
+	if err == nil {
+		t.Fatal("expected error for insufficient randomness")
+	}
+}
@@ -13,6 +13,7 @@ import (
 	"github.com/keep-network/keep-core/pkg/net/gen/pb"
 	"github.com/keep-network/keep-core/pkg/net/internal"
 	"github.com/keep-network/keep-core/pkg/net/key"
+	"github.com/keep-network/keep-core/pkg/net/reputation"
 	"github.com/keep-network/keep-core/pkg/net/retransmission"
 	crypto "github.com/libp2p/go-libp2p-core/crypto"
 	peer "github.com/libp2p/go-libp2p-core/peer"
@@ -55,6 +56,8 @@ type channel struct {
 	unmarshalersByType map[string]func() net.TaggedUnmarshaler
 
 	retransmissionTicker *retransmission.Ticker
+
+	reputation *reputation.Tracker
 }
 
 type messageHandler struct {
@@ -230,8 +233,16 @@ func (c *channel) incomingMessageWorker(ctx context.Context) {
 }
 
 func (c *channel) processPubsubMessage(pubsubMessage *pubsub.Message) error {
+	if c.isBanned(pubsubMessage.GetFrom()) {
+		return fmt.Errorf(
+			"dropping message from banned peer [%v]",
+			pubsubMessage.GetFrom(),
+		)
+	}
+
 	var messageProto pb.BroadcastNetworkMessage
 	if err := proto.Unmarshal(pubsubMessage.Data, &messageProto); err != nil {
+		c.penalize(pubsubMessage.GetFrom(), net.MalformedMessage)
 		return err
 	}
 
@@ -250,12 +261,14 @@ func (c *channel) processContainerMessage(
 	}
 
 	if err := unmarshaled.Unmarshal(message.GetPayload()); err != nil {
+		c.penalize(proposedSender, net.MalformedMessage)
 		return err
 	}
 
 	// Construct an identifier from the sender.
 	senderIdentifier := &identity{}
 	if err := senderIdentifier.Unmarshal(message.Sender); err != nil {
+		c.penalize(proposedSender, net.MalformedMessage)
 		return err
 	}
 
@@ -263,6 +276,7 @@ func (c *channel) processContainerMessage(
 	//     Test that the proposed sender (outer layer) matches the
 	//     sender identifier we grab from the message (inner layer).
 	if proposedSender != senderIdentifier.id {
+		c.penalize(proposedSender, net.MalformedMessage)
 		return fmt.Errorf(
 			"Outer layer sender [%v] does not match inner layer sender [%v]",
 			proposedSender,
@@ -272,6 +286,7 @@ func (c *channel) processContainerMessage(
 
 	networkKey := key.Libp2pKeyToNetworkKey(senderIdentifier.pubKey)
 	if networkKey == nil {
+		c.penalize(proposedSender, net.MalformedMessage)
 		return fmt.Errorf(
 			"sender [%v] with key [%v] is not of correct type",
 			senderIdentifier.id,
@@ -292,6 +307,18 @@ func (c *channel) processContainerMessage(
 	return nil
 }
 
+// penalize lowers the reputation of the peer which sent a message not
+// conforming to the protocol.
+func (c *channel) penalize(sender peer.ID, misbehavior net.Misbehavior) {
+	if c.reputation != nil {
+		c.reputation.Penalize(sender.String(), misbehavior)
+	}
+}
+
+func (c *channel) isBanned(sender peer.ID) bool {
+	return c.reputation != nil && c.reputation.IsBanned(sender.String())
+}
+
 func (c *channel) getUnmarshalingContainerByType(messageType string) (net.TaggedUnmarshaler, error) {
 	c.unmarshalersMutex.Lock()
 	defer c.unmarshalersMutex.Unlock()
@@ -327,11 +354,24 @@ func (c *channel) SetFilter(filter net.BroadcastChannelFilter) error {
 
 	c.pubsub.UnregisterTopicValidator(c.name)
 
-	return c.pubsub.RegisterTopicValidator(c.name, createTopicValidator(filter))
+	return c.pubsub.RegisterTopicValidator(
+		c.name,
+		createTopicValidator(filter, c.reputation),
+	)
 }
 
-func createTopicValidator(filter net.BroadcastChannelFilter) pubsub.Validator {
+func createTopicValidator(
+	filter net.BroadcastChannelFilter,
+	reputationTracker *reputation.Tracker,
+) pubsub.Validator {
 	return func(_ context.Context, _ peer.ID, message *pubsub.Message) bool {
+		// Only the author of the message is checked; the message may be
+		// relayed by a banned peer on behalf of an honest author.
+		if reputationTracker != nil &&
+			reputationTracker.IsBanned(message.GetFrom().String()) {
+			return false
+		}
+
 		authorPublicKey, err := extractPublicKey(message.GetFrom())
 		if err != nil {
 			logger.Warningf(
@@ -5,6 +5,7 @@ import (
 	"sync"
 
 	"github.com/keep-network/keep-core/pkg/net"
+	"github.com/keep-network/keep-core/pkg/net/reputation"
 	"github.com/keep-network/keep-core/pkg/net/retransmission"
 	"github.com/libp2p/go-libp2p-core/host"
 	peerstore "github.com/libp2p/go-libp2p-peerstore"
@@ -30,6 +31,8 @@ type channelManager struct {
 	pubsub *pubsub.PubSub
 
 	retransmissionTicker *retransmission.Ticker
+
+	reputation *reputation.Tracker
 }
 
 func newChannelManager(
@@ -37,6 +40,7 @@ func newChannelManager(
 	identity *identity,
 	p2phost host.Host,
 	retransmissionTicker *retransmission.Ticker,
+	reputationTracker *reputation.Tracker,
 ) (*channelManager, error) {
 	floodsub, err := pubsub.NewFloodSub(
 		ctx,
@@ -57,6 +61,7 @@ func newChannelManager(
 		identity:             identity,
 		ctx:                  ctx,
 		retransmissionTicker: retransmissionTicker,
+		reputation:           reputationTracker,
 	}, nil
 }
 
@@ -108,6 +113,7 @@ func (cm *channelManager) newChannel(name string) (*channel, error) {
 		messageHandlers:      make([]*messageHandler, 0),
 		unmarshalersByType:   make(map[string]func() net.TaggedUnmarshaler),
 		retransmissionTicker: cm.retransmissionTicker,
+		reputation:           cm.reputation,
 	}
 
 	go channel.handleMessages(cm.ctx)
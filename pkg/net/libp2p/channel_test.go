@@ -14,6 +14,7 @@ import (
 
 	"github.com/btcsuite/btcd/btcec"
 	"github.com/keep-network/keep-core/pkg/net"
+	"github.com/keep-network/keep-core/pkg/net/reputation"
 	crypto "github.com/libp2p/go-libp2p-core/crypto"
 	peer "github.com/libp2p/go-libp2p-core/peer"
 	pubsub "github.com/libp2p/go-libp2p-pubsub"
@@ -170,7 +171,7 @@ func TestCreateTopicValidator(t *testing.T) {
 		return isAuthorized
 	}
 
-	validator := createTopicValidator(filter)
+	validator := createTopicValidator(filter, nil)
 
 	expectedResults := []bool{true, false, false, true, false}
 	for i, publicKey := range publicKeys {
@@ -192,6 +193,45 @@ func TestCreateTopicValidator(t *testing.T) {
 	}
 }
 
+func TestCreateTopicValidatorBannedPeer(t *testing.T) {
+	_, publicKey, _ := crypto.GenerateSecp256k1Key(rand.Reader)
+	authorID, _ := peer.IDFromPublicKey(publicKey)
+	authorIDBytes, _ := authorID.Marshal()
+	message := &pubsub.Message{Message: &pubsubpb.Message{From: authorIDBytes}}
+
+	reputationTracker := reputation.NewTracker(10, time.Minute, nil)
+	validator := createTopicValidator(
+		func(publicKey *ecdsa.PublicKey) bool { return true },
+		reputationTracker,
+	)
+
+	if !validator(nil, authorID, message) {
+		t.Fatal("message from not banned peer should be accepted")
+	}
+
+	reputationTracker.Penalize(authorID.String(), net.MalformedMessage)
+
+	if validator(nil, authorID, message) {
+		t.Errorf("message from banned peer should be rejected")
+	}
+
+	_, otherPublicKey, _ := crypto.GenerateSecp256k1Key(rand.Reader)
+	otherAuthorID, _ := peer.IDFromPublicKey(otherPublicKey)
+	otherAuthorIDBytes, _ := otherAuthorID.Marshal()
+	relayedMessage := &pubsub.Message{
+		Message: &pubsubpb.Message{From: otherAuthorIDBytes},
+	}
+
+	if !validator(nil, authorID, relayedMessage) {
+		t.Errorf("message relayed by banned peer should be accepted")
+	}
+
+	firewall := &reputationFirewall{newMockFirewall(), reputationTracker}
+	if err := firewall.Validate(toEcdsaPublicKey(publicKey)); err == nil {
+		t.Errorf("connection with banned peer should be rejected")
+	}
+}
+
 func toEcdsaPublicKey(publicKey crypto.PubKey) *ecdsa.PublicKey {
 	secp256k1PublicKey, _ := publicKey.(*crypto.Secp256k1PublicKey)
 	return (*btcec.PublicKey)(secp256k1PublicKey).ToECDSA()
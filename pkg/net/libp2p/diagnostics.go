@@ -0,0 +1,267 @@
+package libp2p
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/keep-network/keep-core/pkg/net"
+	"github.com/keep-network/keep-core/pkg/net/key"
+
+	ggio "github.com/gogo/protobuf/io"
+	"github.com/libp2p/go-libp2p-core/helpers"
+	host "github.com/libp2p/go-libp2p-core/host"
+	peer "github.com/libp2p/go-libp2p-core/peer"
+	"github.com/libp2p/go-libp2p/p2p/protocol/identify"
+	identifypb "github.com/libp2p/go-libp2p/p2p/protocol/identify/pb"
+	"github.com/libp2p/go-libp2p/p2p/protocol/ping"
+	ma "github.com/multiformats/go-multiaddr"
+	manet "github.com/multiformats/go-multiaddr-net"
+)
+
+// bootstrapPeerDiagnosisTimeout is the time after which connecting to
+// a bootstrap peer and pinging it is abandoned by the network diagnosis.
+const bootstrapPeerDiagnosisTimeout = 30 * time.Second
+
+// BootstrapPeerDiagnosis describes the connectivity of the node to a single
+// bootstrap peer.
+type BootstrapPeerDiagnosis struct {
+	Address string
+	// Latency is the average round-trip time of pings sent to the peer over
+	// the libp2p ping protocol.
+	Latency time.Duration
+	// ObservedAddress is the address the peer sees the node's connection
+	// coming from; empty if the peer did not report it.
+	ObservedAddress string
+	// Err is the reason the peer could not be connected to or pinged.
+	Err error
+}
+
+// NetworkDiagnosis describes the connectivity of the node to the network.
+type NetworkDiagnosis struct {
+	// AnnouncedAddresses are the addresses the node announces to its peers;
+	// the configured announced addresses if any, the listen addresses
+	// otherwise.
+	AnnouncedAddresses []string
+	BootstrapPeers     []*BootstrapPeerDiagnosis
+}
+
+// PubliclyDialable tells if the node is dialable by other peers at one of
+// the addresses it announces. The node is considered dialable if one of
+// the bootstrap peers sees its connection coming from a public address the
+// node announces and that address is returned. Otherwise, the node is behind
+// NAT and has to announce the public address forwarded to it, or no bootstrap
+// peer could tell the address; the returned error tells which.
+//
+// Only addresses are compared; whether the announced port accepts incoming
+// connections can not be verified without a peer dialing the node back.
+func (nd *NetworkDiagnosis) PubliclyDialable() (string, error) {
+	announced := make(map[string]bool, len(nd.AnnouncedAddresses))
+	for _, address := range nd.AnnouncedAddresses {
+		if ip, ok := publicIP(address); ok {
+			announced[ip] = true
+		}
+	}
+
+	var observed []string
+	for _, bootstrapPeer := range nd.BootstrapPeers {
+		if bootstrapPeer.ObservedAddress == "" {
+			continue
+		}
+
+		observed = append(observed, bootstrapPeer.ObservedAddress)
+
+		ip, ok := publicIP(bootstrapPeer.ObservedAddress)
+		if ok && announced[ip] {
+			return bootstrapPeer.ObservedAddress, nil
+		}
+	}
+
+	if len(observed) == 0 {
+		return "", fmt.Errorf(
+			"no bootstrap peer reported the address it sees the node at",
+		)
+	}
+
+	return "", fmt.Errorf(
+		"node is behind NAT; bootstrap peers see it at %v which is not "+
+			"announced; forward the port to the node and set the public "+
+			"address in the LibP2P.AnnouncedAddresses setting",
+		observed,
+	)
+}
+
+// publicIP returns the IP address of the given multiaddress if it is
+// a public one.
+func publicIP(address string) (string, bool) {
+	multiaddress, err := ma.NewMultiaddr(address)
+	if err != nil || !manet.IsPublicAddr(multiaddress) {
+		return "", false
+	}
+
+	for _, protocol := range []int{ma.P_IP4, ma.P_IP6} {
+		if ip, err := multiaddress.ValueForProtocol(protocol); err == nil {
+			return ip, true
+		}
+	}
+
+	return "", false
+}
+
+// Diagnose checks the connectivity of the node to the network without
+// joining it. It starts a host with the given config and key, dials each
+// configured bootstrap peer, pings it the given number of times and asks
+// it for the address it sees the node at. The host is closed before
+// the function returns.
+func Diagnose(
+	ctx context.Context,
+	config Config,
+	staticKey *key.NetworkPrivate,
+	firewall net.Firewall,
+	pingCount int,
+) (*NetworkDiagnosis, error) {
+	identity, err := createIdentity(staticKey)
+	if err != nil {
+		return nil, err
+	}
+
+	host, err := discoverAndListen(
+		ctx,
+		identity,
+		config.Port,
+		config.AnnouncedAddresses,
+		firewall,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer func() {
+		if err := host.Close(); err != nil {
+			logger.Warningf("could not close diagnosis host: [%v]", err)
+		}
+	}()
+
+	diagnosis := &NetworkDiagnosis{}
+	for _, address := range host.Addrs() {
+		diagnosis.AnnouncedAddresses = append(
+			diagnosis.AnnouncedAddresses,
+			address.String(),
+		)
+	}
+
+	for _, address := range config.Peers {
+		diagnosis.BootstrapPeers = append(
+			diagnosis.BootstrapPeers,
+			diagnoseBootstrapPeer(ctx, host, address, pingCount),
+		)
+	}
+
+	return diagnosis, nil
+}
+
+func diagnoseBootstrapPeer(
+	ctx context.Context,
+	host host.Host,
+	address string,
+	pingCount int,
+) *BootstrapPeerDiagnosis {
+	diagnosis := &BootstrapPeerDiagnosis{Address: address}
+
+	ctx, cancelCtx := context.WithTimeout(ctx, bootstrapPeerDiagnosisTimeout)
+	defer cancelCtx()
+
+	peerInfos, err := extractMultiAddrFromPeers([]string{address})
+	if err != nil {
+		diagnosis.Err = fmt.Errorf("invalid address: [%v]", err)
+		return diagnosis
+	}
+	peerInfo := peerInfos[0]
+
+	if err := host.Connect(ctx, peerInfo); err != nil {
+		diagnosis.Err = fmt.Errorf("could not connect: [%v]", err)
+		return diagnosis
+	}
+
+	diagnosis.Latency, err = pingPeer(ctx, host, peerInfo.ID, pingCount)
+	if err != nil {
+		diagnosis.Err = fmt.Errorf("could not ping: [%v]", err)
+		return diagnosis
+	}
+
+	observedAddress, err := observedAddress(ctx, host, peerInfo.ID)
+	if err != nil {
+		logger.Warningf(
+			"could not get the observed address from peer [%v]: [%v]",
+			address,
+			err,
+		)
+	}
+	diagnosis.ObservedAddress = observedAddress
+
+	return diagnosis
+}
+
+// pingPeer pings the peer the given number of times and returns the average
+// round-trip time.
+func pingPeer(
+	ctx context.Context,
+	host host.Host,
+	peerID peer.ID,
+	count int,
+) (time.Duration, error) {
+	ctx, cancelCtx := context.WithCancel(ctx)
+	defer cancelCtx()
+
+	results := ping.Ping(ctx, host, peerID)
+
+	var total time.Duration
+	for i := 0; i < count; i++ {
+		select {
+		case result := <-results:
+			if result.Error != nil {
+				return 0, result.Error
+			}
+			total += result.RTT
+		case <-ctx.Done():
+			return 0, ctx.Err()
+		}
+	}
+
+	return total / time.Duration(count), nil
+}
+
+// observedAddress asks the peer over the libp2p identify protocol for
+// the address it sees the connection with the host coming from.
+func observedAddress(
+	ctx context.Context,
+	host host.Host,
+	peerID peer.ID,
+) (string, error) {
+	stream, err := host.NewStream(ctx, peerID, identify.ID)
+	if err != nil {
+		return "", err
+	}
+	defer func() { go helpers.FullClose(stream) }()
+
+	if deadline, ok := ctx.Deadline(); ok {
+		if err := stream.SetReadDeadline(deadline); err != nil {
+			return "", err
+		}
+	}
+
+	message := identifypb.Identify{}
+	if err := ggio.NewDelimitedReader(stream, 2048).ReadMsg(&message); err != nil {
+		return "", err
+	}
+
+	if len(message.GetObservedAddr()) == 0 {
+		return "", nil
+	}
+
+	observed, err := ma.NewMultiaddrBytes(message.GetObservedAddr())
+	if err != nil {
+		return "", err
+	}
+
+	return observed.String(), nil
+}
@@ -0,0 +1,166 @@
+package libp2p
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/keep-network/keep-core/pkg/firewall"
+	"github.com/keep-network/keep-core/pkg/net/key"
+)
+
+func TestNetworkDiagnosisPubliclyDialable(t *testing.T) {
+	var tests = map[string]struct {
+		announcedAddresses []string
+		observedAddresses  []string
+		expectedAddress    string
+		expectedDialable   bool
+	}{
+		"public address announced": {
+			announcedAddresses: []string{
+				"/ip4/192.168.1.10/tcp/3919",
+				"/ip4/100.20.50.30/tcp/3919",
+			},
+			observedAddresses: []string{"/ip4/100.20.50.30/tcp/3919"},
+			expectedAddress:   "/ip4/100.20.50.30/tcp/3919",
+			expectedDialable:  true,
+		},
+		"public address not announced": {
+			announcedAddresses: []string{"/ip4/192.168.1.10/tcp/3919"},
+			observedAddresses:  []string{"/ip4/100.20.50.30/tcp/41234"},
+			expectedDialable:   false,
+		},
+		"private address observed": {
+			announcedAddresses: []string{"/ip4/192.168.1.10/tcp/3919"},
+			observedAddresses:  []string{"/ip4/192.168.1.10/tcp/3919"},
+			expectedDialable:   false,
+		},
+		"no address observed": {
+			announcedAddresses: []string{"/ip4/100.20.50.30/tcp/3919"},
+			observedAddresses:  []string{""},
+			expectedDialable:   false,
+		},
+	}
+
+	for testName, test := range tests {
+		t.Run(testName, func(t *testing.T) {
+			diagnosis := &NetworkDiagnosis{
+				AnnouncedAddresses: test.announcedAddresses,
+			}
+			for _, observedAddress := range test.observedAddresses {
+				diagnosis.BootstrapPeers = append(
+					diagnosis.BootstrapPeers,
+					&BootstrapPeerDiagnosis{ObservedAddress: observedAddress},
+				)
+			}
+
+			address, err := diagnosis.PubliclyDialable()
+
+			if (err == nil) != test.expectedDialable {
+				t.Errorf(
+					"unexpected dialability\nexpected: %v\nactual:   %v\n",
+					test.expectedDialable,
+					err,
+				)
+			}
+			if address != test.expectedAddress {
+				t.Errorf(
+					"unexpected address\nexpected: %v\nactual:   %v\n",
+					test.expectedAddress,
+					address,
+				)
+			}
+		})
+	}
+}
+
+func TestDiagnose(t *testing.T) {
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	bootstrapKey, _, err := key.GenerateStaticNetworkKey()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	bootstrapProvider, err := Connect(
+		ctx,
+		Config{Port: 8083},
+		bootstrapKey,
+		firewall.Disabled,
+		idleTicker(),
+	)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var bootstrapAddress string
+	for _, address := range bootstrapProvider.ConnectionManager().AddrStrings() {
+		if strings.Contains(address, "127.0.0.1") {
+			bootstrapAddress = address
+		}
+	}
+
+	unreachableKey, _, err := key.GenerateStaticNetworkKey()
+	if err != nil {
+		t.Fatal(err)
+	}
+	unreachableIdentity, err := createIdentity(unreachableKey)
+	if err != nil {
+		t.Fatal(err)
+	}
+	unreachableAddress := fmt.Sprintf(
+		"/ip4/127.0.0.1/tcp/8085/ipfs/%v",
+		unreachableIdentity.id,
+	)
+
+	privateKey, _, err := key.GenerateStaticNetworkKey()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	diagnosis, err := Diagnose(
+		ctx,
+		Config{
+			Port:  8084,
+			Peers: []string{bootstrapAddress, unreachableAddress},
+		},
+		privateKey,
+		firewall.Disabled,
+		3,
+	)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(diagnosis.BootstrapPeers) != 2 {
+		t.Fatalf(
+			"unexpected number of diagnosed bootstrap peers\n"+
+				"expected: %v\nactual:   %v\n",
+			2,
+			len(diagnosis.BootstrapPeers),
+		)
+	}
+
+	reachable := diagnosis.BootstrapPeers[0]
+	if reachable.Err != nil {
+		t.Errorf("unexpected error: [%v]", reachable.Err)
+	}
+	if reachable.Latency <= 0 {
+		t.Errorf("expected positive latency; has: [%v]", reachable.Latency)
+	}
+	if !strings.Contains(reachable.ObservedAddress, "127.0.0.1") {
+		t.Errorf(
+			"unexpected observed address\nexpected: %v\nactual:   %v\n",
+			"loopback address",
+			reachable.ObservedAddress,
+		)
+	}
+
+	unreachable := diagnosis.BootstrapPeers[1]
+	if unreachable.Err == nil {
+		t.Errorf("expected error for the unreachable bootstrap peer")
+	}
+}
@@ -4,6 +4,7 @@ import (
 	"context"
 	"crypto/ecdsa"
 	"fmt"
+	"io"
 	"sync"
 	"time"
 
@@ -11,6 +12,7 @@ import (
 
 	"github.com/keep-network/keep-core/pkg/net"
 	"github.com/keep-network/keep-core/pkg/net/key"
+	"github.com/keep-network/keep-core/pkg/net/reputation"
 	"github.com/keep-network/keep-core/pkg/net/retransmission"
 	"github.com/keep-network/keep-core/pkg/net/watchtower"
 
@@ -60,6 +62,13 @@ type Config struct {
 	Peers              []string
 	Port               int
 	AnnouncedAddresses []string
+
+	// PeerBanThreshold is the number of penalty points a misbehaving peer
+	// has to accumulate before it gets disconnected and banned.
+	PeerBanThreshold int
+	// PeerBanDurationSeconds is the time for which a misbehaving peer is
+	// banned.
+	PeerBanDurationSeconds int
 }
 
 type provider struct {
@@ -72,6 +81,9 @@ type provider struct {
 	routing  *dht.IpfsDHT
 
 	connectionManager *connectionManager
+
+	bootstrapperMutex sync.Mutex
+	bootstrapper      io.Closer
 }
 
 func (p *provider) UnicastChannelWith(
@@ -114,6 +126,8 @@ func (p *provider) CreateTransportIdentifier(publicKey ecdsa.PublicKey) (
 
 type connectionManager struct {
 	host.Host
+
+	reputation *reputation.Tracker
 }
 
 func (cm *connectionManager) ConnectedPeers() []string {
@@ -161,6 +175,13 @@ func (cm *connectionManager) DisconnectPeer(peerHash string) {
 	}
 }
 
+func (cm *connectionManager) ReportMisbehavior(
+	connectedPeer string,
+	misbehavior net.Misbehavior,
+) {
+	cm.reputation.Penalize(connectedPeer, misbehavior)
+}
+
 func (cm *connectionManager) AddrStrings() []string {
 	multiaddrStrings := make([]string, 0, len(cm.Addrs()))
 	for _, multiaddr := range cm.Addrs() {
@@ -225,6 +246,18 @@ func Connect(
 		return nil, err
 	}
 
+	// Peers are disconnected as soon as they get banned. Connections with
+	// banned peers are rejected by the firewall until the ban expires.
+	var connections *connectionManager
+	reputationTracker := reputation.NewTracker(
+		config.PeerBanThreshold,
+		time.Duration(config.PeerBanDurationSeconds)*time.Second,
+		func(peer string) {
+			connections.DisconnectPeer(peer)
+		},
+	)
+	firewall = &reputationFirewall{firewall, reputationTracker}
+
 	host, err := discoverAndListen(
 		ctx,
 		identity,
@@ -236,9 +269,17 @@ func Connect(
 		return nil, err
 	}
 
+	connections = &connectionManager{host, reputationTracker}
+
 	host.Network().Notify(buildNotifiee())
 
-	broadcastChannelManager, err := newChannelManager(ctx, identity, host, ticker)
+	broadcastChannelManager, err := newChannelManager(
+		ctx,
+		identity,
+		host,
+		ticker,
+		reputationTracker,
+	)
 	if err != nil {
 		return nil, err
 	}
@@ -277,7 +318,10 @@ func Connect(
 		return nil, fmt.Errorf("Failed to bootstrap nodes with err: %v", err)
 	}
 
-	provider.connectionManager = &connectionManager{provider.host}
+	provider.connectionManager = &connectionManager{
+		provider.host,
+		reputationTracker,
+	}
 
 	// Instantiates and starts the connection management background process
 	watchtower.NewGuard(
@@ -287,6 +331,30 @@ func Connect(
 	return provider, nil
 }
 
+// reputationFirewall rejects peers banned for misbehavior before applying
+// the rules of the wrapped firewall.
+type reputationFirewall struct {
+	net.Firewall
+
+	reputation *reputation.Tracker
+}
+
+func (rf *reputationFirewall) Validate(
+	remotePeerPublicKey *ecdsa.PublicKey,
+) error {
+	networkPublicKey := key.NetworkPublic(*remotePeerPublicKey)
+	peerID, err := peer.IDFromPublicKey(&networkPublicKey)
+	if err != nil {
+		return err
+	}
+
+	if rf.reputation.IsBanned(peerID.String()) {
+		return fmt.Errorf("peer [%v] is banned", peerID)
+	}
+
+	return rf.Firewall.Validate(remotePeerPublicKey)
+}
+
 func discoverAndListen(
 	ctx context.Context,
 	identity *identity,
@@ -386,15 +454,52 @@ func (p *provider) bootstrap(
 
 	bootstrapConfig := bootstrap.BootstrapConfigWithPeers(peerInfos)
 
+	p.bootstrapperMutex.Lock()
+	defer p.bootstrapperMutex.Unlock()
+
 	// TODO: use the io.Closer to shutdown the bootstrapper when we build out
 	// a shutdown process.
-	_, err = bootstrap.Bootstrap(
+	bootstrapper, err := bootstrap.Bootstrap(
 		p.identity.id,
 		p.host,
 		p.routing,
 		bootstrapConfig,
 	)
-	return err
+	if err != nil {
+		return err
+	}
+
+	// The bootstrapper of the previous peers list is replaced, so that it
+	// stops connecting to peers no longer listed.
+	if p.bootstrapper != nil {
+		if err := p.bootstrapper.Close(); err != nil {
+			logger.Warningf("could not close previous bootstrapper: [%v]", err)
+		}
+	}
+	p.bootstrapper = bootstrapper
+
+	return nil
+}
+
+// UpdateBootstrapPeers makes the provider bootstrap with the given peers
+// instead of the ones it has been connected with.
+func (p *provider) UpdateBootstrapPeers(
+	ctx context.Context,
+	bootstrapPeers []string,
+) error {
+	return p.bootstrap(ctx, bootstrapPeers)
+}
+
+// PeerIDFromMultiaddress returns the ID of the peer with the given
+// multiaddress. The multiaddress has to include the peer identity, as
+// the addresses of bootstrap peers do.
+func PeerIDFromMultiaddress(multiaddress string) (string, error) {
+	peerInfos, err := extractMultiAddrFromPeers([]string{multiaddress})
+	if err != nil {
+		return "", err
+	}
+
+	return peerInfos[0].ID.String(), nil
 }
 
 func extractMultiAddrFromPeers(peers []string) ([]peerstore.PeerInfo, error) {
@@ -193,6 +193,37 @@ func TestProviderSetAnnouncedAddresses(t *testing.T) {
 	}
 }
 
+func TestPeerIDFromMultiaddress(t *testing.T) {
+	privateKey, _, err := key.GenerateStaticNetworkKey()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	identity, err := createIdentity(privateKey)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	peerID, err := PeerIDFromMultiaddress(
+		fmt.Sprintf("/ip4/127.0.0.1/tcp/3919/ipfs/%v", identity.id),
+	)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if peerID != identity.id.String() {
+		t.Errorf(
+			"unexpected peer ID\nexpected: %v\nactual:   %v\n",
+			identity.id.String(),
+			peerID,
+		)
+	}
+
+	if _, err := PeerIDFromMultiaddress("/ip4/127.0.0.1/tcp/3919"); err == nil {
+		t.Errorf("expected error for a multiaddress without the peer identity")
+	}
+}
+
 type testMessage struct {
 	Sender    *identity
 	Recipient *identity
@@ -11,6 +11,7 @@ import (
 	"github.com/ipfs/go-log"
 	"github.com/keep-network/keep-core/pkg/net"
 	"github.com/keep-network/keep-core/pkg/net/key"
+	"github.com/keep-network/keep-core/pkg/net/reputation"
 )
 
 var logger = log.Logger("keep-net-local")
@@ -84,10 +85,19 @@ func Connect() Provider {
 // over the network. The returned instance uses the provided network key to
 // identify network messages.
 func ConnectWithKey(staticKey *key.NetworkPublic) Provider {
+	connectionManager := &localConnectionManager{
+		peers: make(map[string]*key.NetworkPublic),
+	}
+	connectionManager.reputation = reputation.NewTracker(
+		reputation.DefaultBanThreshold,
+		reputation.DefaultBanDuration,
+		connectionManager.DisconnectPeer,
+	)
+
 	return &localProvider{
 		id:                    randomLocalIdentifier(),
 		staticKey:             staticKey,
-		connectionManager:     &localConnectionManager{peers: make(map[string]*key.NetworkPublic)},
+		connectionManager:     connectionManager,
 		unicastChannelManager: newUnicastChannelManager(staticKey),
 	}
 }
@@ -100,6 +110,8 @@ type localConnectionManager struct {
 	mutex sync.Mutex
 
 	peers map[string]*key.NetworkPublic
+
+	reputation *reputation.Tracker
 }
 
 func (lcm *localConnectionManager) ConnectedPeers() []string {
@@ -128,6 +140,13 @@ func (lcm *localConnectionManager) DisconnectPeer(connectedPeer string) {
 	delete(lcm.peers, connectedPeer)
 }
 
+func (lcm *localConnectionManager) ReportMisbehavior(
+	connectedPeer string,
+	misbehavior net.Misbehavior,
+) {
+	lcm.reputation.Penalize(connectedPeer, misbehavior)
+}
+
 func (lcm *localConnectionManager) AddrStrings() []string {
 	return make([]string, 0)
 }
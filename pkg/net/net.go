@@ -71,11 +71,40 @@ type ConnectionManager interface {
 	ConnectedPeers() []string
 	GetPeerPublicKey(connectedPeer string) (*key.NetworkPublic, error)
 	DisconnectPeer(connectedPeer string)
+	// ReportMisbehavior lowers the reputation of the given peer. Peers whose
+	// reputation drops below the configured threshold are disconnected and
+	// banned for some time.
+	ReportMisbehavior(connectedPeer string, misbehavior Misbehavior)
 
 	// AddrStrings returns all listen addresses of the provider.
 	AddrStrings() []string
 }
 
+// Misbehavior describes a kind of misbehavior of a remote peer which lowers
+// the reputation of that peer.
+type Misbehavior int
+
+const (
+	// MalformedMessage is reported when the peer sends a message which could
+	// not be unmarshaled or whose inner sender does not match the peer.
+	MalformedMessage Misbehavior = iota
+	// InvalidSignature is reported when the peer sends a message carrying
+	// a signature which does not verify, e.g. an invalid relay entry
+	// signature share.
+	InvalidSignature
+)
+
+func (m Misbehavior) String() string {
+	switch m {
+	case MalformedMessage:
+		return "malformed message"
+	case InvalidSignature:
+		return "invalid signature"
+	default:
+		return "unknown misbehavior"
+	}
+}
+
 // TaggedUnmarshaler is an interface that includes the proto.Unmarshaler
 // interface, but also provides a string type for the unmarshalable object. The
 // Type() method is expected to be invokable on a just-initialized instance of
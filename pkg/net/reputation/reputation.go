@@ -0,0 +1,161 @@
+// Package reputation keeps track of misbehavior of remote peers and bans
+// peers which misbehave repeatedly.
+package reputation
+
+import (
+	"sync"
+	"time"
+
+	"github.com/ipfs/go-log"
+
+	"github.com/keep-network/keep-core/pkg/net"
+)
+
+var logger = log.Logger("keep-net-reputation")
+
+const (
+	// DefaultBanThreshold is the default number of penalty points a peer
+	// has to accumulate to get banned.
+	DefaultBanThreshold = 100
+
+	// DefaultBanDuration is the default time for which a misbehaving peer
+	// is banned.
+	DefaultBanDuration = time.Hour
+
+	// scoreRecoveryPeriod is the time after which one penalty point of a peer
+	// is forgiven, so that an occasional failure does not lead to a ban.
+	scoreRecoveryPeriod = 30 * time.Second
+)
+
+// penalties holds the number of penalty points assigned to each kind of
+// misbehavior.
+var penalties = map[net.Misbehavior]int{
+	net.MalformedMessage: 10,
+	net.InvalidSignature: 25,
+}
+
+// Tracker scores remote peers based on the reported misbehavior. Once the
+// penalty points accumulated by a peer reach the threshold, the peer gets
+// banned for the configured duration and the ban handler is called so that
+// the peer can be disconnected.
+type Tracker struct {
+	threshold   int
+	banDuration time.Duration
+	onBan       func(peer string)
+
+	now func() time.Time
+
+	mutex  sync.Mutex
+	scores map[string]*score
+	bans   map[string]time.Time
+}
+
+type score struct {
+	points     int
+	lastUpdate time.Time
+}
+
+// NewTracker creates a new instance of Tracker. Zero threshold and ban
+// duration are replaced with the defaults. The ban handler may be nil.
+func NewTracker(
+	threshold int,
+	banDuration time.Duration,
+	onBan func(peer string),
+) *Tracker {
+	if threshold == 0 {
+		threshold = DefaultBanThreshold
+	}
+	if banDuration == 0 {
+		banDuration = DefaultBanDuration
+	}
+
+	return &Tracker{
+		threshold:   threshold,
+		banDuration: banDuration,
+		onBan:       onBan,
+		now:         time.Now,
+		scores:      make(map[string]*score),
+		bans:        make(map[string]time.Time),
+	}
+}
+
+// Penalize lowers the reputation of the given peer according to the kind of
+// misbehavior. It returns true if the peer got banned as a result.
+func (t *Tracker) Penalize(peer string, misbehavior net.Misbehavior) bool {
+	t.mutex.Lock()
+
+	if t.isBanned(peer) {
+		t.mutex.Unlock()
+		return false
+	}
+
+	now := t.now()
+
+	peerScore, ok := t.scores[peer]
+	if !ok {
+		peerScore = &score{lastUpdate: now}
+		t.scores[peer] = peerScore
+	}
+
+	recovered := int(now.Sub(peerScore.lastUpdate) / scoreRecoveryPeriod)
+	peerScore.points -= recovered
+	if peerScore.points < 0 {
+		peerScore.points = 0
+	}
+	if recovered > 0 || peerScore.points == 0 {
+		peerScore.lastUpdate = now
+	}
+
+	peerScore.points += penalties[misbehavior]
+
+	logger.Debugf(
+		"peer [%v] penalized for [%v]; current penalty points: [%v]",
+		peer,
+		misbehavior,
+		peerScore.points,
+	)
+
+	if peerScore.points < t.threshold {
+		t.mutex.Unlock()
+		return false
+	}
+
+	delete(t.scores, peer)
+	t.bans[peer] = now.Add(t.banDuration)
+	t.mutex.Unlock()
+
+	logger.Warningf(
+		"banning peer [%v] for [%v] after [%v]",
+		peer,
+		t.banDuration,
+		misbehavior,
+	)
+
+	if t.onBan != nil {
+		t.onBan(peer)
+	}
+
+	return true
+}
+
+// IsBanned returns true if the given peer is currently banned.
+func (t *Tracker) IsBanned(peer string) bool {
+	t.mutex.Lock()
+	defer t.mutex.Unlock()
+
+	return t.isBanned(peer)
+}
+
+func (t *Tracker) isBanned(peer string) bool {
+	bannedUntil, ok := t.bans[peer]
+	if !ok {
+		return false
+	}
+
+	if t.now().Before(bannedUntil) {
+		return true
+	}
+
+	delete(t.bans, peer)
+	return false
+}
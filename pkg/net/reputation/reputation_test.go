@@ -0,0 +1,104 @@
+package reputation
+
+import (
+	"testing"
+	"time"
+
+	"github.com/keep-network/keep-core/pkg/net"
+)
+
+func TestPenalize(t *testing.T) {
+	var tests = map[string]struct {
+		misbehaviors   []net.Misbehavior
+		interval       time.Duration
+		expectedBanned bool
+	}{
+		"below threshold": {
+			misbehaviors: []net.Misbehavior{
+				net.MalformedMessage,
+				net.InvalidSignature,
+				net.InvalidSignature,
+			},
+			expectedBanned: false,
+		},
+		"threshold reached": {
+			misbehaviors: []net.Misbehavior{
+				net.InvalidSignature,
+				net.InvalidSignature,
+				net.InvalidSignature,
+				net.InvalidSignature,
+			},
+			expectedBanned: true,
+		},
+		"penalty points recovered over time": {
+			misbehaviors: []net.Misbehavior{
+				net.InvalidSignature,
+				net.InvalidSignature,
+				net.InvalidSignature,
+				net.InvalidSignature,
+			},
+			interval:       5 * time.Minute,
+			expectedBanned: false,
+		},
+	}
+
+	for testName, test := range tests {
+		t.Run(testName, func(t *testing.T) {
+			now := time.Now()
+
+			var bannedPeers []string
+			tracker := NewTracker(0, 0, func(peer string) {
+				bannedPeers = append(bannedPeers, peer)
+			})
+			tracker.now = func() time.Time { return now }
+
+			banned := false
+			for _, misbehavior := range test.misbehaviors {
+				banned = tracker.Penalize("peer-1", misbehavior)
+				now = now.Add(test.interval)
+			}
+
+			if banned != test.expectedBanned {
+				t.Errorf(
+					"unexpected ban\nexpected: %v\nactual:   %v\n",
+					test.expectedBanned,
+					banned,
+				)
+			}
+			if tracker.IsBanned("peer-1") != test.expectedBanned {
+				t.Errorf(
+					"unexpected banned state\nexpected: %v\nactual:   %v\n",
+					test.expectedBanned,
+					tracker.IsBanned("peer-1"),
+				)
+			}
+			if test.expectedBanned && len(bannedPeers) != 1 {
+				t.Errorf("ban handler should be called once for the peer")
+			}
+			if tracker.IsBanned("peer-2") {
+				t.Errorf("other peer should not be banned")
+			}
+		})
+	}
+}
+
+func TestBanExpires(t *testing.T) {
+	now := time.Now()
+
+	tracker := NewTracker(25, time.Minute, nil)
+	tracker.now = func() time.Time { return now }
+
+	if !tracker.Penalize("peer-1", net.InvalidSignature) {
+		t.Fatal("peer should be banned")
+	}
+
+	if tracker.Penalize("peer-1", net.InvalidSignature) {
+		t.Errorf("banned peer should not be banned again")
+	}
+
+	now = now.Add(time.Minute)
+
+	if tracker.IsBanned("peer-1") {
+		t.Errorf("ban should expire")
+	}
+}
@@ -0,0 +1,83 @@
+package operator
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+
+	"github.com/ethereum/go-ethereum/common"
+)
+
+// KeyFileInfo is the metadata of an encrypted JSON key file. It describes
+// the key file and its security parameters but contains no secret material.
+type KeyFileInfo struct {
+	Address   string
+	ID        string
+	Version   int
+	Cipher    string
+	KDF       string
+	KDFParams map[string]interface{}
+}
+
+type encryptedKeyFileJSON struct {
+	Address string `json:"address"`
+	ID      string `json:"id"`
+	Version int    `json:"version"`
+	Crypto  struct {
+		Cipher    string          `json:"cipher"`
+		KDF       string          `json:"kdf"`
+		KDFParams json.RawMessage `json:"kdfparams"`
+	} `json:"crypto"`
+}
+
+// ReadKeyFileInfo reads the metadata of the encrypted JSON key file at the
+// given path. The key file is not decrypted so no password is required.
+func ReadKeyFileInfo(path string) (*KeyFileInfo, error) {
+	content, err := ioutil.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("could not read key file [%v]: [%v]", path, err)
+	}
+
+	return ParseKeyFileInfo(content)
+}
+
+// ParseKeyFileInfo parses the metadata of the given encrypted JSON key file.
+func ParseKeyFileInfo(content []byte) (*KeyFileInfo, error) {
+	keyFile := &encryptedKeyFileJSON{}
+	if err := json.Unmarshal(content, keyFile); err != nil {
+		return nil, fmt.Errorf("could not parse key file: [%v]", err)
+	}
+
+	if keyFile.Crypto.KDF == "" {
+		return nil, fmt.Errorf("key file does not specify key derivation function")
+	}
+
+	kdfParams := make(map[string]interface{})
+	if len(keyFile.Crypto.KDFParams) > 0 {
+		// numbers are kept as they appear in the file instead of being
+		// converted to floats
+		decoder := json.NewDecoder(bytes.NewReader(keyFile.Crypto.KDFParams))
+		decoder.UseNumber()
+		if err := decoder.Decode(&kdfParams); err != nil {
+			return nil, fmt.Errorf(
+				"could not parse key derivation function params: [%v]",
+				err,
+			)
+		}
+	}
+
+	address := keyFile.Address
+	if common.IsHexAddress(address) {
+		address = common.HexToAddress(address).Hex()
+	}
+
+	return &KeyFileInfo{
+		Address:   address,
+		ID:        keyFile.ID,
+		Version:   keyFile.Version,
+		Cipher:    keyFile.Crypto.Cipher,
+		KDF:       keyFile.Crypto.KDF,
+		KDFParams: kdfParams,
+	}, nil
+}
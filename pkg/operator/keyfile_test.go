@@ -0,0 +1,185 @@
+package operator
+
+import (
+	"encoding/json"
+	"io/ioutil"
+	"os"
+	"reflect"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/accounts/keystore"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/pborman/uuid"
+)
+
+const scryptKeyFile = `{
+	"address": "008aeeda4d805471df9b2a5b0f38a0c3bcba786b",
+	"crypto": {
+		"cipher": "aes-128-ctr",
+		"cipherparams": {"iv": "83dbcc02d8ccb40e466191a123791e0e"},
+		"ciphertext": "d172bf743a674da9cdad04534d56926ef8358534d458fffccd4e6ad2fbde479c",
+		"kdf": "scrypt",
+		"kdfparams": {
+			"dklen": 32,
+			"n": 262144,
+			"p": 8,
+			"r": 1,
+			"salt": "ab0c7876052600dd703518d6fc3fe8984592145b591fc8fb5c6d43190334ba19"
+		},
+		"mac": "2103ac29920d71da29f15d75b4a16dbe95cfd7ff8faea1056c33131d846e3097"
+	},
+	"id": "3198bc9c-6672-5ab3-d995-4942343ae5b6",
+	"version": 3
+}`
+
+const pbkdf2KeyFile = `{
+	"address": "008aeeda4d805471df9b2a5b0f38a0c3bcba786b",
+	"Crypto": {
+		"cipher": "aes-128-ctr",
+		"cipherparams": {"iv": "6087dab2f9fdbbfaddc31a909735c1e6"},
+		"ciphertext": "5318b4d5bcd28de64ee5559e671353e16f075ecae9f99c7a79a38af5f869aa46",
+		"kdf": "pbkdf2",
+		"kdfparams": {
+			"c": 262144,
+			"dklen": 32,
+			"prf": "hmac-sha256",
+			"salt": "ae3cd4e7013836a3df6bd7241b12db061dbe2c6785853cce422d148a624ce0bd"
+		},
+		"mac": "517ead924a9d0dc3124507e3393d175ce3ff7c1e96529c6c555ce9e51205e9b2"
+	},
+	"id": "3198bc9c-6672-5ab3-d995-4942343ae5b6",
+	"version": 3
+}`
+
+func TestParseKeyFileInfo(t *testing.T) {
+	var tests = map[string]struct {
+		keyFile      string
+		expectedInfo *KeyFileInfo
+	}{
+		"scrypt key file": {
+			keyFile: scryptKeyFile,
+			expectedInfo: &KeyFileInfo{
+				Address: "0x008AeEda4D805471dF9b2A5B0f38A0C3bCBA786b",
+				ID:      "3198bc9c-6672-5ab3-d995-4942343ae5b6",
+				Version: 3,
+				Cipher:  "aes-128-ctr",
+				KDF:     "scrypt",
+				KDFParams: map[string]interface{}{
+					"dklen": json.Number("32"),
+					"n":     json.Number("262144"),
+					"p":     json.Number("8"),
+					"r":     json.Number("1"),
+					"salt":  "ab0c7876052600dd703518d6fc3fe8984592145b591fc8fb5c6d43190334ba19",
+				},
+			},
+		},
+		"pbkdf2 key file": {
+			keyFile: pbkdf2KeyFile,
+			expectedInfo: &KeyFileInfo{
+				Address: "0x008AeEda4D805471dF9b2A5B0f38A0C3bCBA786b",
+				ID:      "3198bc9c-6672-5ab3-d995-4942343ae5b6",
+				Version: 3,
+				Cipher:  "aes-128-ctr",
+				KDF:     "pbkdf2",
+				KDFParams: map[string]interface{}{
+					"c":     json.Number("262144"),
+					"dklen": json.Number("32"),
+					"prf":   "hmac-sha256",
+					"salt":  "ae3cd4e7013836a3df6bd7241b12db061dbe2c6785853cce422d148a624ce0bd",
+				},
+			},
+		},
+	}
+
+	for testName, test := range tests {
+		t.Run(testName, func(t *testing.T) {
+			info, err := ParseKeyFileInfo([]byte(test.keyFile))
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			if !reflect.DeepEqual(test.expectedInfo, info) {
+				t.Errorf(
+					"unexpected key file info\nexpected: %+v\nactual:   %+v\n",
+					test.expectedInfo,
+					info,
+				)
+			}
+		})
+	}
+}
+
+func TestParseKeyFileInfoWithoutKDF(t *testing.T) {
+	_, err := ParseKeyFileInfo([]byte(`{"address": "", "crypto": {}}`))
+
+	expectedError := "key file does not specify key derivation function"
+	if err == nil || err.Error() != expectedError {
+		t.Errorf(
+			"unexpected error\nexpected: %v\nactual:   %v\n",
+			expectedError,
+			err,
+		)
+	}
+}
+
+func TestReadKeyFileInfo(t *testing.T) {
+	privateKey, err := crypto.GenerateKey()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	key := &keystore.Key{
+		Id:         uuid.NewRandom(),
+		Address:    crypto.PubkeyToAddress(privateKey.PublicKey),
+		PrivateKey: privateKey,
+	}
+
+	keyJSON, err := keystore.EncryptKey(
+		key,
+		"password",
+		keystore.LightScryptN,
+		keystore.LightScryptP,
+	)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	keyFile, err := ioutil.TempFile("", "keyfile")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.Remove(keyFile.Name())
+
+	if _, err := keyFile.Write(keyJSON); err != nil {
+		t.Fatal(err)
+	}
+	keyFile.Close()
+
+	info, err := ReadKeyFileInfo(keyFile.Name())
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if info.Address != key.Address.Hex() {
+		t.Errorf(
+			"unexpected address\nexpected: %v\nactual:   %v\n",
+			key.Address.Hex(),
+			info.Address,
+		)
+	}
+	if info.KDF != "scrypt" {
+		t.Errorf(
+			"unexpected key derivation function\nexpected: %v\nactual:   %v\n",
+			"scrypt",
+			info.KDF,
+		)
+	}
+	expectedN := json.Number("4096")
+	if info.KDFParams["n"] != expectedN {
+		t.Errorf(
+			"unexpected scrypt N\nexpected: %v\nactual:   %v\n",
+			expectedN,
+			info.KDFParams["n"],
+		)
+	}
+}
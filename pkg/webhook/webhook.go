@@ -0,0 +1,107 @@
+// Package webhook delivers JSON notifications about events happening in the
+// node to an HTTP endpoint configured by the operator.
+package webhook
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/ipfs/go-log"
+)
+
+var logger = log.Logger("keep-webhook")
+
+const (
+	// DefaultTimeout is the maximum time a single webhook request may take.
+	DefaultTimeout = 5 * time.Second
+	// DefaultMaxAttempts is the maximum number of times the notification is
+	// sent before it is dropped.
+	DefaultMaxAttempts = 3
+	// DefaultBackoff is the time to wait before the first retry. The backoff
+	// doubles with each subsequent retry.
+	DefaultBackoff = 1 * time.Second
+)
+
+// Notifier posts JSON payloads to the configured webhook URL.
+type Notifier struct {
+	url         string
+	client      *http.Client
+	maxAttempts int
+	backoff     time.Duration
+}
+
+// NewNotifier creates a notifier posting to the given URL with the default
+// timeout and retry settings.
+func NewNotifier(url string) *Notifier {
+	return &Notifier{
+		url:         url,
+		client:      &http.Client{Timeout: DefaultTimeout},
+		maxAttempts: DefaultMaxAttempts,
+		backoff:     DefaultBackoff,
+	}
+}
+
+// Notify posts the JSON-encoded payload to the webhook URL. The notification
+// is delivered in the background so that the caller is never blocked by
+// a slow or failing webhook. Failed deliveries are retried with an
+// exponential backoff and dropped once all attempts fail.
+func (n *Notifier) Notify(payload interface{}) {
+	body, err := json.Marshal(payload)
+	if err != nil {
+		logger.Errorf("could not marshal webhook payload: [%v]", err)
+		return
+	}
+
+	go n.deliver(body)
+}
+
+func (n *Notifier) deliver(body []byte) {
+	backoff := n.backoff
+
+	for attempt := 1; ; attempt++ {
+		err := n.send(body)
+		if err == nil {
+			return
+		}
+
+		if attempt >= n.maxAttempts {
+			logger.Errorf(
+				"dropping webhook notification after [%v] attempts: [%v]",
+				attempt,
+				err,
+			)
+			return
+		}
+
+		logger.Warningf(
+			"webhook notification attempt [%v] failed; retrying in [%v]: [%v]",
+			attempt,
+			backoff,
+			err,
+		)
+
+		time.Sleep(backoff)
+		backoff *= 2
+	}
+}
+
+func (n *Notifier) send(body []byte) error {
+	response, err := n.client.Post(
+		n.url,
+		"application/json",
+		bytes.NewReader(body),
+	)
+	if err != nil {
+		return err
+	}
+	defer response.Body.Close()
+
+	if response.StatusCode < 200 || response.StatusCode >= 300 {
+		return fmt.Errorf("unexpected response status [%v]", response.Status)
+	}
+
+	return nil
+}
@@ -0,0 +1,127 @@
+package webhook
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"sync"
+	"testing"
+	"time"
+)
+
+type testPayload struct {
+	Name  string `json:"name"`
+	Value int    `json:"value"`
+}
+
+func TestNotify(t *testing.T) {
+	received := make(chan *testPayload, 1)
+
+	server := httptest.NewServer(http.HandlerFunc(
+		func(writer http.ResponseWriter, request *http.Request) {
+			if request.Method != http.MethodPost {
+				t.Errorf("unexpected method [%v]", request.Method)
+			}
+			if contentType := request.Header.Get("Content-Type"); contentType != "application/json" {
+				t.Errorf("unexpected content type [%v]", contentType)
+			}
+
+			payload := &testPayload{}
+			if err := json.NewDecoder(request.Body).Decode(payload); err != nil {
+				t.Errorf("could not decode payload: [%v]", err)
+			}
+			received <- payload
+		},
+	))
+	defer server.Close()
+
+	expectedPayload := &testPayload{Name: "test", Value: 10}
+
+	NewNotifier(server.URL).Notify(expectedPayload)
+
+	select {
+	case payload := <-received:
+		if !reflect.DeepEqual(expectedPayload, payload) {
+			t.Errorf(
+				"unexpected payload\nexpected: %v\nactual:   %v\n",
+				expectedPayload,
+				payload,
+			)
+		}
+	case <-time.After(time.Second):
+		t.Fatal("webhook has not been called")
+	}
+}
+
+func TestNotifyRetriesFailedDelivery(t *testing.T) {
+	var (
+		mutex    sync.Mutex
+		attempts int
+	)
+	delivered := make(chan struct{})
+
+	server := httptest.NewServer(http.HandlerFunc(
+		func(writer http.ResponseWriter, request *http.Request) {
+			mutex.Lock()
+			defer mutex.Unlock()
+
+			attempts++
+			if attempts < 3 {
+				writer.WriteHeader(http.StatusInternalServerError)
+				return
+			}
+			close(delivered)
+		},
+	))
+	defer server.Close()
+
+	notifier := NewNotifier(server.URL)
+	notifier.backoff = 10 * time.Millisecond
+
+	notifier.Notify(&testPayload{})
+
+	select {
+	case <-delivered:
+	case <-time.After(time.Second):
+		t.Fatal("notification has not been delivered")
+	}
+
+	mutex.Lock()
+	defer mutex.Unlock()
+	if attempts != 3 {
+		t.Errorf(
+			"unexpected number of attempts\nexpected: %v\nactual:   %v\n",
+			3,
+			attempts,
+		)
+	}
+}
+
+func TestNotifyDoesNotBlockOnSlowWebhook(t *testing.T) {
+	release := make(chan struct{})
+
+	server := httptest.NewServer(http.HandlerFunc(
+		func(writer http.ResponseWriter, request *http.Request) {
+			<-release
+		},
+	))
+	defer server.Close()
+	defer close(release)
+
+	notifier := NewNotifier(server.URL)
+	notifier.client.Timeout = 50 * time.Millisecond
+	notifier.maxAttempts = 1
+
+	done := make(chan struct{})
+	go func() {
+		notifier.Notify(&testPayload{})
+		close(done)
+	}()
+
+	select {
+	case <-done:
+	case <-time.After(10 * time.Millisecond):
+		t.Fatal("notify blocked on a slow webhook")
+	}
+}
@@ -82,7 +82,13 @@ library DKGResultVerification {
         require(signaturesCount >= self.signatureThreshold, "Too few signatures");
         require(signaturesCount <= self.groupSize, "Too many signatures");
 
-        bytes32 resultHash = keccak256(abi.encodePacked(groupPubKey, misbehaved));
+        // Chain ID is a domain separator preventing signatures produced for
+        // one chain from being replayed on another one.
+        uint256 chainId;
+        /* solium-disable-next-line */
+        assembly { chainId := chainid() }
+
+        bytes32 resultHash = keccak256(abi.encodePacked(chainId, groupPubKey, misbehaved));
 
         bytes memory current; // Current signature to be checked.
 
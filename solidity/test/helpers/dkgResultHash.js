@@ -0,0 +1,16 @@
+const {web3} = require("@openzeppelin/test-environment")
+
+// Calculates the hash of the DKG result signed by group members the same way
+// as the operator contract does. The chain ID is a part of the hash so that
+// signatures produced for one chain can not be replayed on another one.
+const dkgResultHash = async (groupPubKey, misbehaved) => {
+    const chainId = await web3.eth.getChainId()
+
+    return web3.utils.soliditySha3(
+        {t: 'uint256', v: chainId},
+        groupPubKey,
+        misbehaved
+    )
+}
+
+module.exports = dkgResultHash
@@ -1,6 +1,7 @@
 const generateTickets = require('./generateTickets')
 const packTicket =  require('./packTicket')
 const sign =  require('./signature')
+const dkgResultHash =  require('./dkgResultHash')
 const blsData =  require('./data.js')
 const stakeDelegate =  require('./stakeDelegate')
 const {web3} = require("@openzeppelin/test-environment")
@@ -83,7 +84,7 @@ async function stakeAndGenesis(accounts, contracts) {
     await time.advanceBlockTo(resultPublicationBlock)
 
     let misbehaved = '0x';
-    let resultHash = web3.utils.soliditySha3(blsData.groupPubKey, misbehaved);
+    let resultHash = await dkgResultHash(blsData.groupPubKey, misbehaved);
 
     let signingMemberIndices = [];
     let signatures = undefined;
@@ -4,6 +4,7 @@ const { time } = require("@openzeppelin/test-helpers")
 var assert = require('chai').assert
 const initContracts = require('../helpers/initContracts')
 const sign = require('../helpers/signature')
+const dkgResultHash = require('../helpers/dkgResultHash')
 const stakeDelegate = require('../helpers/stakeDelegate')
 const packTicket = require('../helpers/packTicket')
 const generateTickets = require('../helpers/generateTickets')
@@ -23,9 +24,10 @@ describe('KeepRandomBeaconOperator/DkgMisbehavior', function () {
     selectedParticipants, signatures, signingMemberIndices = [],
     misbehaved = '0x0305', // disqualified operator with selected member index 3 and inactive with 5
     groupPubKey = blsData.groupPubKey,
-    resultHash = web3.utils.soliditySha3(groupPubKey, misbehaved)
+    resultHash
 
   before(async () => {
+    resultHash = await dkgResultHash(groupPubKey, misbehaved)
 
     let contracts = await initContracts(
       contract.fromArtifact('KeepToken'),
@@ -1,5 +1,6 @@
 const blsData = require("../helpers/data");
 const sign = require('../helpers/signature');
+const dkgResultHash = require('../helpers/dkgResultHash');
 const packTicket = require('../helpers/packTicket')
 const generateTickets = require('../helpers/generateTickets');
 const shuffleArray = require('../helpers/shuffle');
@@ -27,9 +28,10 @@ describe('KeepRandomBeaconOperator/PublishDkgResult', function () {
     noMisbehaved = '0x',
     maxMisbehaved = '0x0102030405', // 20 - 15 = 5 max could misbehave
     groupPubKey = blsData.groupPubKey,
-    resultHash = web3.utils.soliditySha3(groupPubKey, noMisbehaved);
+    resultHash;
 
   before(async () => {
+    resultHash = await dkgResultHash(groupPubKey, noMisbehaved);
 
     let contracts = await initContracts(
       contract.fromArtifact('KeepToken'),
@@ -372,7 +374,7 @@ describe('KeepRandomBeaconOperator/PublishDkgResult', function () {
   })
 
   async function signResult(groupPublicKey, misbehaved) {
-    let resultHash = web3.utils.soliditySha3(groupPublicKey, misbehaved)
+    let resultHash = await dkgResultHash(groupPublicKey, misbehaved)
 
     signingMemberIndices = []
     signatures = undefined